@@ -0,0 +1,188 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationEffectiveness is a validator's attestation rewards for an epoch compared
+// with the rewards it would have received for perfect attestations.
+type AttestationEffectiveness struct {
+	ValidatorIndex   phase0.ValidatorIndex
+	EffectiveBalance phase0.Gwei
+	// Ideal is the reward for perfect attestations, in Gwei.
+	Ideal int64
+	// Actual is the net reward received, in Gwei.  Penalties make this negative.
+	Actual int64
+	// Score is the actual reward as a proportion of the ideal reward.  A perfect
+	// validator scores 1, and a validator that is penalized scores below 0.
+	Score float64
+	// Percentile is the percentile rank of the score within the validator set,
+	// from 0 to 100.  Validators with equal scores share a rank.
+	Percentile float64
+}
+
+// String returns a string version of the structure.
+func (e *AttestationEffectiveness) String() string {
+	return fmt.Sprintf("validator %d: %d/%d Gwei (%.1f%%), percentile %.1f",
+		e.ValidatorIndex, e.Actual, e.Ideal, e.Score*100, e.Percentile)
+}
+
+// AnalyzeAttestationEffectiveness obtains the attestation rewards and effective balances
+// of the given validators for an epoch, and scores their attestations against the ideal.
+// If no indices are supplied all validators are scored.
+//
+// The client must provide attestation rewards, slots per epoch and validators.
+func AnalyzeAttestationEffectiveness(ctx context.Context,
+	client consensusclient.Service,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	[]*AttestationEffectiveness,
+	error,
+) {
+	rewardsProvider, isProvider := client.(consensusclient.AttestationRewardsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide attestation rewards")
+	}
+	slotsPerEpochProvider, isProvider := client.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide slots per epoch")
+	}
+	validatorsProvider, isProvider := client.(consensusclient.ValidatorsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide validators")
+	}
+
+	rewards, err := rewardsProvider.AttestationRewards(ctx, epoch, indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain attestation rewards")
+	}
+	if rewards == nil {
+		return nil, errors.New("no attestation rewards returned")
+	}
+
+	// Effective balances do not change within an epoch, so the state at its first slot suffices.
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	stateID := fmt.Sprintf("%d", uint64(epoch)*slotsPerEpoch)
+	validators, err := validatorsProvider.Validators(ctx, stateID, indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validators")
+	}
+	effectiveBalances := make(map[phase0.ValidatorIndex]phase0.Gwei, len(validators))
+	for index, validator := range validators {
+		if validator == nil || validator.Validator == nil {
+			continue
+		}
+		effectiveBalances[index] = validator.Validator.EffectiveBalance
+	}
+
+	return ScoreAttestationRewards(rewards, effectiveBalances)
+}
+
+// ScoreAttestationRewards scores the attestation rewards of each validator against the
+// ideal rewards for its effective balance, and ranks the scores across the set.  Results
+// are ordered by validator index.
+//
+// Validators for which there is no effective balance, or whose ideal reward is zero, cannot
+// be scored and are omitted from the results.
+func ScoreAttestationRewards(rewards *apiv1.AttestationRewards,
+	effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei,
+) (
+	[]*AttestationEffectiveness,
+	error,
+) {
+	if rewards == nil {
+		return nil, errors.New("no rewards supplied")
+	}
+
+	ideals := make(map[phase0.Gwei]int64, len(rewards.IdealRewards))
+	for _, ideal := range rewards.IdealRewards {
+		total := int64(ideal.Head + ideal.Target + ideal.Source)
+		if ideal.InclusionDelay != nil {
+			total += int64(*ideal.InclusionDelay)
+		}
+		ideals[ideal.EffectiveBalance] = total
+	}
+
+	res := make([]*AttestationEffectiveness, 0, len(rewards.TotalRewards))
+	for _, actual := range rewards.TotalRewards {
+		effectiveBalance, exists := effectiveBalances[actual.ValidatorIndex]
+		if !exists {
+			continue
+		}
+		ideal, exists := ideals[effectiveBalance]
+		if !exists {
+			if effectiveBalance == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("no ideal rewards for effective balance %d of validator %d", effectiveBalance, actual.ValidatorIndex)
+		}
+		if ideal == 0 {
+			continue
+		}
+
+		total := actual.Head + actual.Target + actual.Source + actual.Inactivity
+		if actual.InclusionDelay != nil {
+			total += int64(*actual.InclusionDelay)
+		}
+		res = append(res, &AttestationEffectiveness{
+			ValidatorIndex:   actual.ValidatorIndex,
+			EffectiveBalance: effectiveBalance,
+			Ideal:            ideal,
+			Actual:           total,
+			Score:            float64(total) / float64(ideal),
+		})
+	}
+
+	rankScores(res)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ValidatorIndex < res[j].ValidatorIndex
+	})
+
+	return res, nil
+}
+
+// rankScores sets the percentile rank of each score, counting validators with lower
+// scores in full and validators with equal scores by half.
+func rankScores(res []*AttestationEffectiveness) {
+	sorted := make([]*AttestationEffectiveness, len(res))
+	copy(sorted, res)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Score < sorted[j].Score
+	})
+
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Score == sorted[start].Score {
+			end++
+		}
+		percentile := (float64(start) + float64(end-start)/2) * 100 / float64(len(sorted))
+		for i := start; i < end; i++ {
+			sorted[i].Percentile = percentile
+		}
+		start = end
+	}
+}
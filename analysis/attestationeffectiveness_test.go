@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// rewardsClient provides attestation rewards and validators.
+type rewardsClient struct {
+	rewards    *apiv1.AttestationRewards
+	validators map[phase0.ValidatorIndex]*apiv1.Validator
+	stateID    string
+}
+
+func (*rewardsClient) Name() string { return "rewards" }
+
+func (*rewardsClient) Address() string { return "" }
+
+func (c *rewardsClient) AttestationRewards(_ context.Context, _ phase0.Epoch, _ []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	return c.rewards, nil
+}
+
+func (*rewardsClient) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 32, nil
+}
+
+func (c *rewardsClient) Validators(_ context.Context, stateID string, _ []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	c.stateID = stateID
+	return c.validators, nil
+}
+
+func (c *rewardsClient) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	return c.validators, nil
+}
+
+func testAttestationRewards() *apiv1.AttestationRewards {
+	return &apiv1.AttestationRewards{
+		IdealRewards: []*apiv1.IdealAttestationRewards{
+			{EffectiveBalance: 16000000000, Head: 1000, Target: 2000, Source: 1000},
+			{EffectiveBalance: 32000000000, Head: 2000, Target: 4000, Source: 2000},
+		},
+		TotalRewards: []*apiv1.ValidatorAttestationRewards{
+			{ValidatorIndex: 4, Head: 0, Target: -4000, Source: -2000},
+			{ValidatorIndex: 1, Head: 2000, Target: 4000, Source: 2000},
+			{ValidatorIndex: 2, Head: 0, Target: 4000, Source: 2000},
+			{ValidatorIndex: 3, Head: 1000, Target: 2000, Source: 1000},
+		},
+	}
+}
+
+func TestScoreAttestationRewards(t *testing.T) {
+	effectiveBalances := map[phase0.ValidatorIndex]phase0.Gwei{
+		1: 32000000000,
+		2: 32000000000,
+		3: 16000000000,
+		4: 32000000000,
+	}
+
+	tests := []struct {
+		name              string
+		rewards           *apiv1.AttestationRewards
+		effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei
+		indices           []phase0.ValidatorIndex
+		scores            []float64
+		percentiles       []float64
+		err               string
+	}{
+		{
+			name: "NilRewards",
+			err:  "no rewards supplied",
+		},
+		{
+			name:    "MissingIdeal",
+			rewards: testAttestationRewards(),
+			effectiveBalances: map[phase0.ValidatorIndex]phase0.Gwei{
+				1: 31000000000,
+			},
+			err: "no ideal rewards for effective balance 31000000000 of validator 1",
+		},
+		{
+			name:              "Good",
+			rewards:           testAttestationRewards(),
+			effectiveBalances: effectiveBalances,
+			indices:           []phase0.ValidatorIndex{1, 2, 3, 4},
+			scores:            []float64{1, 0.75, 1, -0.75},
+			percentiles:       []float64{75, 37.5, 75, 12.5},
+		},
+		{
+			name:    "UnknownValidatorOmitted",
+			rewards: testAttestationRewards(),
+			effectiveBalances: map[phase0.ValidatorIndex]phase0.Gwei{
+				2: 32000000000,
+				4: 32000000000,
+			},
+			indices:     []phase0.ValidatorIndex{2, 4},
+			scores:      []float64{0.75, -0.75},
+			percentiles: []float64{75, 25},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := analysis.ScoreAttestationRewards(test.rewards, test.effectiveBalances)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, len(test.indices))
+			for i := range res {
+				require.Equal(t, test.indices[i], res[i].ValidatorIndex)
+				require.InDelta(t, test.scores[i], res[i].Score, 1e-9)
+				require.InDelta(t, test.percentiles[i], res[i].Percentile, 1e-9)
+			}
+		})
+	}
+}
+
+func TestAnalyzeAttestationEffectiveness(t *testing.T) {
+	ctx := context.Background()
+
+	client := &rewardsClient{
+		rewards: testAttestationRewards(),
+		validators: map[phase0.ValidatorIndex]*apiv1.Validator{
+			1: {Index: 1, Validator: &phase0.Validator{EffectiveBalance: 32000000000}},
+			3: {Index: 3, Validator: &phase0.Validator{EffectiveBalance: 16000000000}},
+		},
+	}
+
+	res, err := analysis.AnalyzeAttestationEffectiveness(ctx, client, 10, []phase0.ValidatorIndex{1, 3})
+	require.NoError(t, err)
+	require.Equal(t, "320", client.stateID)
+	require.Len(t, res, 2)
+	require.Equal(t, int64(8000), res[0].Ideal)
+	require.Equal(t, int64(8000), res[0].Actual)
+	require.Equal(t, int64(4000), res[1].Ideal)
+	require.InDelta(t, 50.0, res[1].Percentile, 1e-9)
+}
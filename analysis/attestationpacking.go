@@ -0,0 +1,201 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationPacking is the result of analysing how well a block packed the attestations available to it.
+type AttestationPacking struct {
+	Slot phase0.Slot
+	// Included is the number of attestations included in the block.
+	Included int
+	// IncludedVotes is the number of attester votes first included in the block.
+	// Votes already included in prior blocks are not counted.
+	IncludedVotes int
+	// OmittedVotes is the number of known attester votes that were eligible for
+	// inclusion in the block but were included in neither the block nor prior blocks.
+	OmittedVotes int
+	// Omitted are the known attestations that contain at least one omitted vote.
+	Omitted []*phase0.Attestation
+	// MissedReward is the estimated proposer reward lost by omitting votes, in Gwei.
+	MissedReward phase0.Gwei
+}
+
+// Efficiency returns the proportion of available votes that were included in the block,
+// from 0 to 1.  A block with no votes available to it is considered fully efficient.
+func (p *AttestationPacking) Efficiency() float64 {
+	if p.IncludedVotes+p.OmittedVotes == 0 {
+		return 1
+	}
+
+	return float64(p.IncludedVotes) / float64(p.IncludedVotes+p.OmittedVotes)
+}
+
+// String returns a string version of the structure.
+func (p *AttestationPacking) String() string {
+	return fmt.Sprintf("slot %d: %d/%d votes included (%.1f%%), %d Gwei missed",
+		p.Slot, p.IncludedVotes, p.IncludedVotes+p.OmittedVotes, p.Efficiency()*100, p.MissedReward)
+}
+
+// vote is a single validator's vote, identified by the root of the data it voted for
+// and its position in the committee.
+type vote struct {
+	dataRoot phase0.Root
+	position uint64
+}
+
+// AnalyzeAttestationPacking compares the attestations included in a block with those known
+// to have been available when it was proposed, for example from the attestation pool or from
+// monitoring gossip, and estimates the proposer reward lost through any omissions.
+//
+// prior contains the attestations included in recent blocks prior to this one, and is used to
+// avoid counting votes that were already on chain; it may be nil.  Known attestations that were
+// not eligible for inclusion in the block due to their slot are ignored.
+//
+// baseReward is the base reward of a validator with the maximum effective balance.  The missed
+// reward is an upper bound, as omitted votes are assumed to be correct and timely.
+func AnalyzeAttestationPacking(block *spec.VersionedSignedBeaconBlock,
+	known []*phase0.Attestation,
+	prior []*phase0.Attestation,
+	slotsPerEpoch uint64,
+	baseReward phase0.Gwei,
+) (
+	*AttestationPacking,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch not supplied")
+	}
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, err
+	}
+	attestations, err := block.Attestations()
+	if err != nil {
+		return nil, err
+	}
+
+	onChain := make(map[vote]struct{})
+	if err := addVotes(onChain, prior, nil); err != nil {
+		return nil, errors.Wrap(err, "invalid prior attestation")
+	}
+	included := make(map[vote]struct{})
+	if err := addVotes(included, attestations, onChain); err != nil {
+		return nil, errors.Wrap(err, "invalid block attestation")
+	}
+
+	res := &AttestationPacking{
+		Slot:          slot,
+		Included:      len(attestations),
+		IncludedVotes: len(included),
+		Omitted:       make([]*phase0.Attestation, 0),
+	}
+	omitted := make(map[vote]struct{})
+	for _, attestation := range known {
+		if attestation == nil || attestation.Data == nil {
+			return nil, errors.New("invalid known attestation")
+		}
+		if !attestationEligible(block.Version, attestation.Data, slot, slotsPerEpoch) {
+			continue
+		}
+		dataRoot, err := attestation.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain root of known attestation data")
+		}
+		hasOmitted := false
+		for position := uint64(0); position < attestation.AggregationBits.Len(); position++ {
+			if !attestation.AggregationBits.BitAt(position) {
+				continue
+			}
+			v := vote{dataRoot: dataRoot, position: position}
+			if _, exists := onChain[v]; exists {
+				continue
+			}
+			if _, exists := included[v]; exists {
+				continue
+			}
+			hasOmitted = true
+			omitted[v] = struct{}{}
+		}
+		if hasOmitted {
+			res.Omitted = append(res.Omitted, attestation)
+		}
+	}
+	res.OmittedVotes = len(omitted)
+	res.MissedReward = phase0.Gwei(uint64(res.OmittedVotes) * uint64(proposerRewardPerVote(block.Version, baseReward)))
+
+	return res, nil
+}
+
+// addVotes adds the votes in the attestations to the set, ignoring any that are in the exclusion set.
+func addVotes(votes map[vote]struct{}, attestations []*phase0.Attestation, exclude map[vote]struct{}) error {
+	for _, attestation := range attestations {
+		if attestation == nil || attestation.Data == nil {
+			return errors.New("attestation missing data")
+		}
+		dataRoot, err := attestation.Data.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain root of attestation data")
+		}
+		for position := uint64(0); position < attestation.AggregationBits.Len(); position++ {
+			if !attestation.AggregationBits.BitAt(position) {
+				continue
+			}
+			v := vote{dataRoot: dataRoot, position: position}
+			if _, exists := exclude[v]; exists {
+				continue
+			}
+			votes[v] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// attestationEligible returns true if an attestation with the given data could be included in a block at the given slot.
+func attestationEligible(version spec.DataVersion, data *phase0.AttestationData, slot phase0.Slot, slotsPerEpoch uint64) bool {
+	if data.Slot >= slot {
+		return false
+	}
+	if version >= spec.DataVersionDeneb {
+		// EIP-7045: attestations are valid until the end of the epoch following their target.
+		epoch := phase0.Epoch(uint64(slot) / slotsPerEpoch)
+		return data.Target != nil && data.Target.Epoch+1 >= epoch
+	}
+
+	return uint64(slot-data.Slot) <= slotsPerEpoch
+}
+
+// proposerRewardPerVote returns the proposer reward for including a single correct and timely vote.
+func proposerRewardPerVote(version spec.DataVersion, baseReward phase0.Gwei) phase0.Gwei {
+	if version == spec.DataVersionPhase0 {
+		// Phase 0 proposers receive base_reward / PROPOSER_REWARD_QUOTIENT.
+		return baseReward / 8
+	}
+
+	// From altair the proposer receives PROPOSER_WEIGHT / (WEIGHT_DENOMINATOR - PROPOSER_WEIGHT)
+	// of the attester's rewards, which for a correct and timely vote are
+	// (TIMELY_SOURCE_WEIGHT + TIMELY_TARGET_WEIGHT + TIMELY_HEAD_WEIGHT) / WEIGHT_DENOMINATOR
+	// of the base reward.
+	return phase0.Gwei(uint64(baseReward) * (14 + 26 + 14) * 8 / ((64 - 8) * 64))
+}
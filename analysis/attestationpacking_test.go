@@ -0,0 +1,136 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testAttestation(slot phase0.Slot, size uint64, bits ...uint64) *phase0.Attestation {
+	aggregationBits := bitfield.NewBitlist(size)
+	for _, bit := range bits {
+		aggregationBits.SetBitAt(bit, true)
+	}
+
+	return &phase0.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &phase0.AttestationData{
+			Slot:            slot,
+			BeaconBlockRoot: phase0.Root{0x01},
+			Source:          &phase0.Checkpoint{},
+			Target:          &phase0.Checkpoint{},
+		},
+		Signature: phase0.BLSSignature{},
+	}
+}
+
+func TestAnalyzeAttestationPacking(t *testing.T) {
+	block := bellatrixBlock(bellatrix.ExecutionAddress{})
+	block.Bellatrix.Message.Body.Attestations = []*phase0.Attestation{
+		testAttestation(9, 4, 0, 1),
+	}
+
+	tests := []struct {
+		name          string
+		block         *spec.VersionedSignedBeaconBlock
+		known         []*phase0.Attestation
+		prior         []*phase0.Attestation
+		includedVotes int
+		omittedVotes  int
+		omitted       int
+		missedReward  phase0.Gwei
+		efficiency    float64
+		err           string
+	}{
+		{
+			name: "Nil",
+			err:  "no block supplied",
+		},
+		{
+			name:          "NoKnown",
+			block:         block,
+			includedVotes: 2,
+			efficiency:    1,
+		},
+		{
+			name:          "AllIncluded",
+			block:         block,
+			known:         []*phase0.Attestation{testAttestation(9, 4, 0), testAttestation(9, 4, 1)},
+			includedVotes: 2,
+			efficiency:    1,
+		},
+		{
+			name:          "Omitted",
+			block:         block,
+			known:         []*phase0.Attestation{testAttestation(9, 4, 0, 1, 2), testAttestation(8, 4, 0, 1)},
+			prior:         []*phase0.Attestation{testAttestation(8, 4, 0)},
+			includedVotes: 2,
+			omittedVotes:  2,
+			omitted:       2,
+			missedReward:  241070,
+			efficiency:    0.5,
+		},
+		{
+			name:          "AlreadyOnChain",
+			block:         block,
+			known:         []*phase0.Attestation{testAttestation(9, 4, 0, 1)},
+			prior:         []*phase0.Attestation{testAttestation(9, 4, 0)},
+			includedVotes: 1,
+			efficiency:    1,
+		},
+		{
+			name:  "Ineligible",
+			block: block,
+			known: []*phase0.Attestation{
+				// Same slot as the block.
+				testAttestation(10, 4, 3),
+				// Too old.
+				testAttestation(0, 4, 3),
+			},
+			includedVotes: 2,
+			efficiency:    1,
+		},
+		{
+			name:  "InvalidKnown",
+			block: block,
+			known: []*phase0.Attestation{{}},
+			err:   "invalid known attestation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := analysis.AnalyzeAttestationPacking(test.block, test.known, test.prior, 8, 1000000)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(10), res.Slot)
+			require.Equal(t, 1, res.Included)
+			require.Equal(t, test.includedVotes, res.IncludedVotes)
+			require.Equal(t, test.omittedVotes, res.OmittedVotes)
+			require.Len(t, res.Omitted, test.omitted)
+			require.Equal(t, test.missedReward, res.MissedReward)
+			require.InDelta(t, test.efficiency, res.Efficiency(), 0.0001)
+		})
+	}
+}
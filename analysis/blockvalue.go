@@ -0,0 +1,252 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// BlockValue is the value of a proposed block, decomposed in to its parts.
+type BlockValue struct {
+	Slot          phase0.Slot
+	ProposerIndex phase0.ValidatorIndex
+	// ConsensusTotal is the total consensus reward received by the proposer, in Gwei.
+	ConsensusTotal phase0.Gwei
+	// Attestations is the reward for including attestations, in Gwei.
+	Attestations phase0.Gwei
+	// SyncAggregate is the reward for including the sync aggregate, in Gwei.
+	SyncAggregate phase0.Gwei
+	// ProposerSlashings is the reward for including proposer slashings, in Gwei.
+	ProposerSlashings phase0.Gwei
+	// AttesterSlashings is the reward for including attester slashings, in Gwei.
+	AttesterSlashings phase0.Gwei
+	// FeeRecipient is the fee recipient of the execution payload, if present.
+	FeeRecipient *bellatrix.ExecutionAddress
+	// MEVPaymentRecipient is the recipient of the payment from the block builder, if detected.
+	MEVPaymentRecipient *bellatrix.ExecutionAddress
+	// MEVPaymentValue is the value of the payment from the block builder, in Wei, if detected.
+	MEVPaymentValue *uint256.Int
+	// ExecutionFees is the total of the priority fees paid to the fee recipient by the
+	// transactions of the execution payload, in Wei, if the gas used by the transactions
+	// was supplied.
+	ExecutionFees *uint256.Int
+	// UndecodedTransactions is the number of transactions whose fees could not be decoded,
+	// and so are not included in ExecutionFees.
+	UndecodedTransactions int
+	// ExecutionValue is the value of the execution payload to the proposer, in Wei.  This is
+	// the payment from the block builder if detected, otherwise the execution fees if known.
+	ExecutionValue *uint256.Int
+}
+
+// String returns a string version of the structure.
+func (v *BlockValue) String() string {
+	res := fmt.Sprintf("slot %d validator %d: consensus %d Gwei", v.Slot, v.ProposerIndex, v.ConsensusTotal)
+	if v.MEVPaymentValue != nil {
+		res = fmt.Sprintf("%s, MEV payment %s Wei to %#x", res, v.MEVPaymentValue.Dec(), *v.MEVPaymentRecipient)
+	}
+	if v.ExecutionFees != nil {
+		res = fmt.Sprintf("%s, execution fees %s Wei", res, v.ExecutionFees.Dec())
+	}
+
+	return res
+}
+
+// DecomposeBlockValue obtains the block and its rewards for the given block ID, and
+// decomposes its value.  It returns nil if the block is not found.
+//
+// The client must provide both block rewards and signed beacon blocks.
+func DecomposeBlockValue(ctx context.Context,
+	client consensusclient.Service,
+	blockID string,
+) (
+	*BlockValue,
+	error,
+) {
+	rewardsProvider, isProvider := client.(consensusclient.BlockRewardsProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide block rewards")
+	}
+	blockProvider, isProvider := client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+
+	block, err := blockProvider.SignedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block")
+	}
+	if block == nil {
+		return nil, nil
+	}
+
+	// Use the block root for rewards, to avoid a race if the block ID is relative.
+	root, err := block.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block root")
+	}
+	rewards, err := rewardsProvider.BlockRewards(ctx, fmt.Sprintf("%#x", root))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block rewards")
+	}
+	if rewards == nil {
+		return nil, errors.New("no block rewards returned")
+	}
+
+	return DecomposeBlock(block, rewards)
+}
+
+// DecomposeBlock decomposes the value of a block given the block and its rewards.
+//
+// Execution transaction fees are not included, as they require the gas used by each
+// transaction to calculate; use DecomposeBlockWithGasUsed to include them.  A payment
+// from the block builder is detected if the final transaction of the execution payload
+// transfers value to an address other than the fee recipient, as is the case for blocks
+// built by MEV builders.  Because the sender of the final transaction is not checked, an
+// ordinary transfer at the end of a locally built block can also be reported as a builder
+// payment.
+func DecomposeBlock(block *spec.VersionedSignedBeaconBlock,
+	rewards *apiv1.BlockRewards,
+) (
+	*BlockValue,
+	error,
+) {
+	return DecomposeBlockWithGasUsed(block, rewards, nil)
+}
+
+// DecomposeBlockWithGasUsed decomposes the value of a block given the block, its rewards
+// and the gas used by each transaction of its execution payload, in payload order, as
+// obtained from the transaction receipts.  In addition to the values provided by
+// DecomposeBlock this provides the execution fees paid to the fee recipient.
+// If gasUsed is nil the execution fees are not calculated.
+func DecomposeBlockWithGasUsed(block *spec.VersionedSignedBeaconBlock,
+	rewards *apiv1.BlockRewards,
+	gasUsed []uint64,
+) (
+	*BlockValue,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+	if rewards == nil {
+		return nil, errors.New("no rewards supplied")
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, err
+	}
+	if proposerIndex != rewards.ProposerIndex {
+		return nil, fmt.Errorf("rewards for validator %d do not match block proposer %d", rewards.ProposerIndex, proposerIndex)
+	}
+
+	value := &BlockValue{
+		Slot:              slot,
+		ProposerIndex:     proposerIndex,
+		ConsensusTotal:    rewards.Total,
+		Attestations:      rewards.Attestations,
+		SyncAggregate:     rewards.SyncAggregate,
+		ProposerSlashings: rewards.ProposerSlashings,
+		AttesterSlashings: rewards.AttesterSlashings,
+	}
+
+	if block.Version < spec.DataVersionBellatrix {
+		// No execution payload.
+		return value, nil
+	}
+
+	feeRecipient, err := block.FeeRecipient()
+	if err != nil {
+		return nil, err
+	}
+	value.FeeRecipient = &feeRecipient
+
+	transactions, err := block.ExecutionTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	if gasUsed != nil {
+		value.ExecutionFees, value.UndecodedTransactions, err = executionFees(block, transactions, gasUsed)
+		if err != nil {
+			return nil, err
+		}
+		value.ExecutionValue = value.ExecutionFees
+	}
+
+	if len(transactions) == 0 {
+		return value, nil
+	}
+	payment, err := utilbellatrix.DecodeTransactionPayment(transactions[len(transactions)-1])
+	if err != nil {
+		// Final transaction is not a recognisable payment.
+		return value, nil
+	}
+	if payment.To != nil && *payment.To != feeRecipient && !payment.Value.IsZero() {
+		value.MEVPaymentRecipient = payment.To
+		value.MEVPaymentValue = payment.Value
+		value.ExecutionValue = payment.Value
+	}
+
+	return value, nil
+}
+
+// executionFees calculates the total priority fees paid by the transactions of the
+// execution payload of a block, given the gas used by each transaction.  Transactions
+// whose fees cannot be decoded are skipped, and their number returned.
+func executionFees(block *spec.VersionedSignedBeaconBlock,
+	transactions []bellatrix.Transaction,
+	gasUsed []uint64,
+) (
+	*uint256.Int,
+	int,
+	error,
+) {
+	if len(gasUsed) != len(transactions) {
+		return nil, 0, fmt.Errorf("gas used supplied for %d transactions but payload has %d", len(gasUsed), len(transactions))
+	}
+
+	fees, _, err := payloadFees(block)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := uint256.NewInt(0)
+	undecoded := 0
+	for i, transaction := range transactions {
+		txFees, err := utilbellatrix.DecodeTransactionFees(transaction)
+		if err != nil {
+			undecoded++
+			continue
+		}
+		fee := txFees.EffectivePriorityFeePerGas(fees.BaseFeePerGas)
+		total.Add(total, fee.Mul(fee, uint256.NewInt(gasUsed[i])))
+	}
+
+	return total, undecoded, nil
+}
@@ -0,0 +1,213 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecomposeBlock(t *testing.T) {
+	builder := hexToAddress("0x3535353535353535353535353535353535353535")
+	proposer := hexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297")
+	// Dynamic fee transaction paying 0.8 ETH to the proposer.
+	payment := bellatrix.Transaction(hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297880b1a2bc2ec50000080c0800101"))
+	// Legacy transaction paying 1 ETH to the builder.
+	builderPayment := bellatrix.Transaction(hexToBytes("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"))
+	rewards := &apiv1.BlockRewards{
+		ProposerIndex:     5,
+		Total:             35000000,
+		Attestations:      30000000,
+		SyncAggregate:     4000000,
+		ProposerSlashings: 1000000,
+	}
+
+	tests := []struct {
+		name       string
+		block      *spec.VersionedSignedBeaconBlock
+		rewards    *apiv1.BlockRewards
+		recipient  *bellatrix.ExecutionAddress
+		mevPayment *uint256.Int
+		err        string
+	}{
+		{
+			name:    "NilBlock",
+			rewards: rewards,
+			err:     "no block supplied",
+		},
+		{
+			name:  "NilRewards",
+			block: bellatrixBlock(proposer),
+			err:   "no rewards supplied",
+		},
+		{
+			name:  "ProposerMismatch",
+			block: bellatrixBlock(proposer),
+			rewards: &apiv1.BlockRewards{
+				ProposerIndex: 6,
+			},
+			err: "rewards for validator 6 do not match block proposer 5",
+		},
+		{
+			name: "Phase0",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.SignedBeaconBlock{
+					Message: &phase0.BeaconBlock{
+						Slot:          10,
+						ProposerIndex: 5,
+					},
+				},
+			},
+			rewards: rewards,
+		},
+		{
+			name:      "Local",
+			block:     bellatrixBlock(proposer, builderPayment, payment),
+			rewards:   rewards,
+			recipient: &proposer,
+		},
+		{
+			name:       "MEV",
+			block:      bellatrixBlock(builder, builderPayment, payment),
+			rewards:    rewards,
+			recipient:  &builder,
+			mevPayment: uint256.NewInt(800000000000000000),
+		},
+		{
+			name:      "UnparseableTransaction",
+			block:     bellatrixBlock(builder, bellatrix.Transaction{0x7f}),
+			rewards:   rewards,
+			recipient: &builder,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := analysis.DecomposeBlock(test.block, test.rewards)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, phase0.Slot(10), value.Slot)
+			require.Equal(t, phase0.ValidatorIndex(5), value.ProposerIndex)
+			require.Equal(t, rewards.Total, value.ConsensusTotal)
+			require.Equal(t, rewards.Attestations, value.Attestations)
+			require.Equal(t, rewards.SyncAggregate, value.SyncAggregate)
+			require.Equal(t, rewards.ProposerSlashings, value.ProposerSlashings)
+			require.Equal(t, rewards.AttesterSlashings, value.AttesterSlashings)
+			require.Equal(t, test.recipient, value.FeeRecipient)
+			if test.mevPayment == nil {
+				require.Nil(t, value.MEVPaymentValue)
+				require.Nil(t, value.MEVPaymentRecipient)
+			} else {
+				require.Equal(t, test.mevPayment, value.MEVPaymentValue)
+				require.Equal(t, proposer, *value.MEVPaymentRecipient)
+			}
+		})
+	}
+}
+
+func TestDecomposeBlockWithGasUsed(t *testing.T) {
+	builder := hexToAddress("0x3535353535353535353535353535353535353535")
+	proposer := hexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297")
+	// Dynamic fee transaction paying 0.8 ETH to the proposer, with a priority fee of 1 Wei per gas.
+	payment := bellatrix.Transaction(hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297880b1a2bc2ec50000080c0800101"))
+	// Legacy transaction paying 1 ETH to the builder, with a gas price of 20 Gwei.
+	builderPayment := bellatrix.Transaction(hexToBytes("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"))
+	rewards := &apiv1.BlockRewards{
+		ProposerIndex: 5,
+		Total:         35000000,
+	}
+
+	tests := []struct {
+		name           string
+		block          *spec.VersionedSignedBeaconBlock
+		gasUsed        []uint64
+		executionFees  *uint256.Int
+		executionValue *uint256.Int
+		undecoded      int
+		err            string
+	}{
+		{
+			name:  "NoGasUsed",
+			block: bellatrixBlock(proposer, builderPayment, payment),
+		},
+		{
+			name:    "GasUsedMismatch",
+			block:   bellatrixBlock(proposer, builderPayment, payment),
+			gasUsed: []uint64{21000},
+			err:     "gas used supplied for 1 transactions but payload has 2",
+		},
+		{
+			name:           "Empty",
+			block:          bellatrixBlock(proposer),
+			gasUsed:        []uint64{},
+			executionFees:  uint256.NewInt(0),
+			executionValue: uint256.NewInt(0),
+		},
+		{
+			name:           "Local",
+			block:          bellatrixBlock(proposer, builderPayment, payment),
+			gasUsed:        []uint64{21000, 21000},
+			executionFees:  uint256.NewInt(420000000021000),
+			executionValue: uint256.NewInt(420000000021000),
+		},
+		{
+			name:           "MEV",
+			block:          bellatrixBlock(builder, builderPayment, payment),
+			gasUsed:        []uint64{21000, 21000},
+			executionFees:  uint256.NewInt(420000000021000),
+			executionValue: uint256.NewInt(800000000000000000),
+		},
+		{
+			name: "SetCodeTransaction",
+			// Set code transaction with a priority fee of 1 Gwei per gas.
+			block:          bellatrixBlock(proposer, bellatrix.Transaction(hexToBytes("0x04f84e0180843b9aca00847735940082c35094388c818ca8b9251b393131c08a736a67ccb19297880de0b6b3a764000080c0dbda0194000000000000000000000000000000000000000180800101800101"))),
+			gasUsed:        []uint64{50000},
+			executionFees:  uint256.NewInt(50000000000000),
+			executionValue: uint256.NewInt(50000000000000),
+		},
+		{
+			name:           "UnparseableTransaction",
+			block:          bellatrixBlock(proposer, bellatrix.Transaction{0x7f}, payment),
+			gasUsed:        []uint64{21000, 21000},
+			executionFees:  uint256.NewInt(21000),
+			executionValue: uint256.NewInt(21000),
+			undecoded:      1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := analysis.DecomposeBlockWithGasUsed(test.block, rewards, test.gasUsed)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.executionFees, value.ExecutionFees)
+			require.Equal(t, test.executionValue, value.ExecutionValue)
+			require.Equal(t, test.undecoded, value.UndecodedTransactions)
+		})
+	}
+}
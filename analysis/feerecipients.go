@@ -0,0 +1,240 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// FeeRecipientDiscrepancyKind is the kind of a fee recipient discrepancy.
+type FeeRecipientDiscrepancyKind int
+
+const (
+	// FeeRecipientDiscrepancyUnknown is an unknown discrepancy.
+	FeeRecipientDiscrepancyUnknown FeeRecipientDiscrepancyKind = iota
+	// FeeRecipientDiscrepancyMismatch is when the fee recipient of the execution payload
+	// does not match the expected recipient, and the final transaction of the payload
+	// does not pay the expected recipient either.
+	FeeRecipientDiscrepancyMismatch
+	// FeeRecipientDiscrepancyNoPayment is when the fee recipient of the execution payload
+	// does not match the expected recipient, and the payload has no final transaction
+	// that could be a payment to the expected recipient.
+	FeeRecipientDiscrepancyNoPayment
+)
+
+var feeRecipientDiscrepancyKindStrings = [...]string{
+	"unknown",
+	"mismatch",
+	"no payment",
+}
+
+// String returns a string representation of the discrepancy kind.
+func (k FeeRecipientDiscrepancyKind) String() string {
+	if int(k) < 0 || int(k) >= len(feeRecipientDiscrepancyKindStrings) {
+		return "unknown"
+	}
+	return feeRecipientDiscrepancyKindStrings[k]
+}
+
+// FeeRecipientDiscrepancy is a record of a proposal where the proceeds did not go to
+// the expected recipient.
+type FeeRecipientDiscrepancy struct {
+	Kind           FeeRecipientDiscrepancyKind
+	Slot           phase0.Slot
+	ValidatorIndex phase0.ValidatorIndex
+	// Expected is the configured fee recipient for the validator.
+	Expected bellatrix.ExecutionAddress
+	// FeeRecipient is the fee recipient of the execution payload.
+	FeeRecipient bellatrix.ExecutionAddress
+	// PaymentRecipient is the recipient of the final transaction of the execution payload, if any.
+	PaymentRecipient *bellatrix.ExecutionAddress
+	// PaymentValue is the value of the final transaction of the execution payload, if any.
+	PaymentValue *uint256.Int
+}
+
+// String returns a string version of the structure.
+func (d *FeeRecipientDiscrepancy) String() string {
+	return fmt.Sprintf("slot %d validator %d: %s (expected %#x, fee recipient %#x)", d.Slot, d.ValidatorIndex, d.Kind, d.Expected, d.FeeRecipient)
+}
+
+// AuditFeeRecipients scans the proposals made by the given validators between the two epochs
+// (inclusive), and checks that the proceeds of each proposal went to the validator's configured
+// fee recipient.  Proceeds are considered to have gone to the configured recipient if either the
+// fee recipient of the execution payload matches, or the final transaction of the execution
+// payload transfers value to it (as is the case for blocks built by MEV builders).
+//
+// The client must provide both proposer duties and signed beacon blocks.  Missed proposals and
+// proposals prior to bellatrix are ignored.
+func AuditFeeRecipients(ctx context.Context,
+	client consensusclient.Service,
+	recipients map[phase0.ValidatorIndex]bellatrix.ExecutionAddress,
+	fromEpoch phase0.Epoch,
+	toEpoch phase0.Epoch,
+) (
+	[]*FeeRecipientDiscrepancy,
+	error,
+) {
+	dutiesProvider, isProvider := client.(consensusclient.ProposerDutiesProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide proposer duties")
+	}
+	blockProvider, isProvider := client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+	if toEpoch < fromEpoch {
+		return nil, errors.New("to epoch before from epoch")
+	}
+	if len(recipients) == 0 {
+		return []*FeeRecipientDiscrepancy{}, nil
+	}
+
+	indices := make([]phase0.ValidatorIndex, 0, len(recipients))
+	for index := range recipients {
+		indices = append(indices, index)
+	}
+
+	res := make([]*FeeRecipientDiscrepancy, 0)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		duties, err := dutiesProvider.ProposerDuties(ctx, epoch, indices)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain proposer duties for epoch %d", epoch)
+		}
+		for _, duty := range duties {
+			expected, exists := recipients[duty.ValidatorIndex]
+			if !exists {
+				continue
+			}
+			block, err := blockProvider.SignedBeaconBlock(ctx, fmt.Sprintf("%d", duty.Slot))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to obtain block for slot %d", duty.Slot)
+			}
+			discrepancy, err := auditFeeRecipient(block, duty.ValidatorIndex, expected)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to audit block for slot %d", duty.Slot)
+			}
+			if discrepancy != nil {
+				discrepancy.Slot = duty.Slot
+				res = append(res, discrepancy)
+			}
+		}
+		if epoch == toEpoch {
+			// Avoid overflow at the far future epoch.
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// AuditFeeRecipient checks the proceeds of a single block against the expected recipient,
+// returning a discrepancy if the proceeds did not go to the expected recipient.
+// It returns nil if there is no discrepancy, or if the block has no execution payload.
+func AuditFeeRecipient(block *spec.VersionedSignedBeaconBlock,
+	expected bellatrix.ExecutionAddress,
+) (
+	*FeeRecipientDiscrepancy,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, err
+	}
+	discrepancy, err := auditFeeRecipient(block, proposerIndex, expected)
+	if err != nil {
+		return nil, err
+	}
+	if discrepancy != nil {
+		discrepancy.Slot, err = block.Slot()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return discrepancy, nil
+}
+
+func auditFeeRecipient(block *spec.VersionedSignedBeaconBlock,
+	validatorIndex phase0.ValidatorIndex,
+	expected bellatrix.ExecutionAddress,
+) (
+	*FeeRecipientDiscrepancy,
+	error,
+) {
+	if block == nil {
+		// Missed proposal.
+		return nil, nil
+	}
+	if block.Version < spec.DataVersionBellatrix {
+		// No execution payload.
+		return nil, nil
+	}
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return nil, err
+	}
+	if proposerIndex != validatorIndex {
+		// Block was proposed by someone else; this can happen if the chain has reorganised.
+		return nil, nil
+	}
+
+	feeRecipient, err := block.FeeRecipient()
+	if err != nil {
+		return nil, err
+	}
+	if feeRecipient == expected {
+		return nil, nil
+	}
+
+	discrepancy := &FeeRecipientDiscrepancy{
+		Kind:           FeeRecipientDiscrepancyNoPayment,
+		ValidatorIndex: validatorIndex,
+		Expected:       expected,
+		FeeRecipient:   feeRecipient,
+	}
+
+	transactions, err := block.ExecutionTransactions()
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		return discrepancy, nil
+	}
+	payment, err := utilbellatrix.DecodeTransactionPayment(transactions[len(transactions)-1])
+	if err != nil {
+		// Final transaction is not a recognisable payment.
+		return discrepancy, nil
+	}
+	discrepancy.PaymentRecipient = payment.To
+	discrepancy.PaymentValue = payment.Value
+	if payment.To != nil && *payment.To == expected && !payment.Value.IsZero() {
+		// MEV payment to the expected recipient.
+		return nil, nil
+	}
+	discrepancy.Kind = FeeRecipientDiscrepancyMismatch
+
+	return discrepancy, nil
+}
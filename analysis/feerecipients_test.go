@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func hexToBytes(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func hexToAddress(input string) bellatrix.ExecutionAddress {
+	res := bellatrix.ExecutionAddress{}
+	copy(res[:], hexToBytes(input))
+	return res
+}
+
+func bellatrixBlock(feeRecipient bellatrix.ExecutionAddress, transactions ...bellatrix.Transaction) *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionBellatrix,
+		Bellatrix: &bellatrix.SignedBeaconBlock{
+			Message: &bellatrix.BeaconBlock{
+				Slot:          10,
+				ProposerIndex: 5,
+				Body: &bellatrix.BeaconBlockBody{
+					ExecutionPayload: &bellatrix.ExecutionPayload{
+						FeeRecipient: feeRecipient,
+						Transactions: transactions,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAuditFeeRecipient(t *testing.T) {
+	expected := hexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297")
+	other := hexToAddress("0x3535353535353535353535353535353535353535")
+	// Dynamic fee transaction paying 0.8 ETH to the expected recipient.
+	payment := bellatrix.Transaction(hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297880b1a2bc2ec50000080c0800101"))
+	// Legacy transaction paying 1 ETH to the other address.
+	otherPayment := bellatrix.Transaction(hexToBytes("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"))
+
+	tests := []struct {
+		name     string
+		block    *spec.VersionedSignedBeaconBlock
+		kind     analysis.FeeRecipientDiscrepancyKind
+		expected bool
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no block supplied",
+		},
+		{
+			name: "Phase0",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.SignedBeaconBlock{
+					Message: &phase0.BeaconBlock{
+						Slot:          10,
+						ProposerIndex: 5,
+					},
+				},
+			},
+		},
+		{
+			name:  "FeeRecipientMatches",
+			block: bellatrixBlock(expected),
+		},
+		{
+			name:  "PaymentMatches",
+			block: bellatrixBlock(other, otherPayment, payment),
+		},
+		{
+			name:     "NoTransactions",
+			block:    bellatrixBlock(other),
+			kind:     analysis.FeeRecipientDiscrepancyNoPayment,
+			expected: true,
+		},
+		{
+			name:     "UnparseableTransaction",
+			block:    bellatrixBlock(other, bellatrix.Transaction{0x7f}),
+			kind:     analysis.FeeRecipientDiscrepancyNoPayment,
+			expected: true,
+		},
+		{
+			name:     "PaymentMismatch",
+			block:    bellatrixBlock(other, payment, otherPayment),
+			kind:     analysis.FeeRecipientDiscrepancyMismatch,
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			discrepancy, err := analysis.AuditFeeRecipient(test.block, expected)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if !test.expected {
+				require.Nil(t, discrepancy)
+				return
+			}
+			require.NotNil(t, discrepancy)
+			require.Equal(t, test.kind, discrepancy.Kind)
+			require.Equal(t, phase0.Slot(10), discrepancy.Slot)
+			require.Equal(t, phase0.ValidatorIndex(5), discrepancy.ValidatorIndex)
+			require.Equal(t, expected, discrepancy.Expected)
+			require.Equal(t, other, discrepancy.FeeRecipient)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HeaderFetcher fetches beacon block headers by root, concurrently, and caches them.
+// It is safe for concurrent use.
+//
+// Headers are immutable for a given root, with the exception of the canonical flag,
+// which reflects the state of the chain when the header was first fetched.
+type HeaderFetcher struct {
+	provider    consensusclient.BeaconBlockHeadersProvider
+	concurrency int
+
+	mu      sync.Mutex
+	size    int
+	entries map[phase0.Root]*apiv1.BeaconBlockHeader
+	// order holds the roots in order of insertion, for eviction.
+	order []phase0.Root
+}
+
+// NewHeaderFetcher creates a header fetcher that makes up to the given number of
+// concurrent requests, and caches up to the given number of headers.
+//
+// The client must provide beacon block headers.
+func NewHeaderFetcher(client consensusclient.Service,
+	cacheSize int,
+	concurrency int,
+) (
+	*HeaderFetcher,
+	error,
+) {
+	provider, isProvider := client.(consensusclient.BeaconBlockHeadersProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon block headers")
+	}
+	if cacheSize < 0 {
+		return nil, errors.New("cache size cannot be negative")
+	}
+	if concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+
+	return &HeaderFetcher{
+		provider:    provider,
+		concurrency: concurrency,
+		size:        cacheSize,
+		entries:     make(map[phase0.Root]*apiv1.BeaconBlockHeader, cacheSize),
+		order:       make([]phase0.Root, 0, cacheSize),
+	}, nil
+}
+
+// HeadersByRoots returns the headers of the blocks with the given roots, keyed by root.
+// Headers that are not cached are fetched concurrently.  Roots for which the node has
+// no block are not present in the result.
+func (f *HeaderFetcher) HeadersByRoots(ctx context.Context,
+	roots []phase0.Root,
+) (
+	map[phase0.Root]*apiv1.BeaconBlockHeader,
+	error,
+) {
+	res := make(map[phase0.Root]*apiv1.BeaconBlockHeader, len(roots))
+	missing := make([]phase0.Root, 0)
+	f.mu.Lock()
+	for _, root := range roots {
+		if _, exists := res[root]; exists {
+			continue
+		}
+		if header, exists := f.entries[root]; exists {
+			res[root] = header
+			continue
+		}
+		// Mark as seen to avoid duplicate fetches.
+		res[root] = nil
+		missing = append(missing, root)
+	}
+	f.mu.Unlock()
+
+	if len(missing) > 0 {
+		if err := f.fetch(ctx, missing, res); err != nil {
+			return nil, err
+		}
+	}
+
+	for root, header := range res {
+		if header == nil {
+			delete(res, root)
+		}
+	}
+
+	return res, nil
+}
+
+// fetch fetches the headers for the given roots, adding them to the cache and the results.
+func (f *HeaderFetcher) fetch(ctx context.Context,
+	roots []phase0.Root,
+	res map[phase0.Root]*apiv1.BeaconBlockHeader,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var resMu sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, f.concurrency)
+	for _, root := range roots {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(root phase0.Root) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			header, err := f.provider.BeaconBlockHeader(ctx, fmt.Sprintf("%#x", root))
+			resMu.Lock()
+			defer resMu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "failed to obtain header for block %#x", root)
+					cancel()
+				}
+				return
+			}
+			if header == nil {
+				return
+			}
+			res[root] = header
+			f.add(root, header)
+		}(root)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// Ancestors returns the headers of the block with the given root and up to count-1 of
+// its ancestors, newest first.  The walk stops early if a block is not available from
+// the node, or at genesis.
+func (f *HeaderFetcher) Ancestors(ctx context.Context,
+	root phase0.Root,
+	count int,
+) (
+	[]*apiv1.BeaconBlockHeader,
+	error,
+) {
+	res := make([]*apiv1.BeaconBlockHeader, 0, count)
+	for len(res) < count {
+		headers, err := f.HeadersByRoots(ctx, []phase0.Root{root})
+		if err != nil {
+			return nil, err
+		}
+		header, exists := headers[root]
+		if !exists {
+			break
+		}
+		res = append(res, header)
+		if header.Header == nil || header.Header.Message == nil {
+			return nil, fmt.Errorf("header for block %#x is missing data", root)
+		}
+		if header.Header.Message.Slot == 0 {
+			// Genesis.
+			break
+		}
+		root = header.Header.Message.ParentRoot
+	}
+
+	return res, nil
+}
+
+// add adds a header to the cache, evicting the oldest header if the cache is full.
+func (f *HeaderFetcher) add(root phase0.Root, header *apiv1.BeaconBlockHeader) {
+	if f.size == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, exists := f.entries[root]; exists {
+		return
+	}
+	if len(f.order) >= f.size {
+		delete(f.entries, f.order[0])
+		f.order = f.order[1:]
+	}
+	f.entries[root] = header
+	f.order = append(f.order, root)
+}
+
+// Len returns the number of headers in the cache.
+func (f *HeaderFetcher) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.entries)
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// headersClient provides headers for a chain of blocks.
+type headersClient struct {
+	mu        sync.Mutex
+	headers   map[string]*apiv1.BeaconBlockHeader
+	calls     int
+	inFlight  int
+	maxFlight int
+	fail      string
+}
+
+func newHeadersClient(blocks int) *headersClient {
+	c := &headersClient{
+		headers: make(map[string]*apiv1.BeaconBlockHeader),
+	}
+	parent := phase0.Root{}
+	for i := 0; i < blocks; i++ {
+		root := phase0.Root{byte(i + 1)}
+		c.headers[fmt.Sprintf("%#x", root)] = &apiv1.BeaconBlockHeader{
+			Root:      root,
+			Canonical: true,
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{
+					Slot:       phase0.Slot(i),
+					ParentRoot: parent,
+				},
+			},
+		}
+		parent = root
+	}
+
+	return c
+}
+
+func (*headersClient) Name() string { return "headers" }
+
+func (*headersClient) Address() string { return "" }
+
+func (c *headersClient) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	c.mu.Lock()
+	c.calls++
+	c.inFlight++
+	if c.inFlight > c.maxFlight {
+		c.maxFlight = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	if blockID == c.fail {
+		return nil, errors.New("mock error")
+	}
+
+	return c.headers[blockID], nil
+}
+
+func TestHeadersByRoots(t *testing.T) {
+	ctx := context.Background()
+	client := newHeadersClient(10)
+
+	_, err := analysis.NewHeaderFetcher(client, 10, 0)
+	require.EqualError(t, err, "concurrency must be at least 1")
+
+	fetcher, err := analysis.NewHeaderFetcher(client, 4, 2)
+	require.NoError(t, err)
+
+	roots := []phase0.Root{{1}, {2}, {3}, {2}, {4}, {0xff}}
+	headers, err := fetcher.HeadersByRoots(ctx, roots)
+	require.NoError(t, err)
+	require.Len(t, headers, 4)
+	for _, root := range []phase0.Root{{1}, {2}, {3}, {4}} {
+		require.Equal(t, root, headers[root].Root)
+	}
+	// Duplicate roots are fetched once, and concurrency is limited.
+	require.Equal(t, 5, client.calls)
+	require.LessOrEqual(t, client.maxFlight, 2)
+	require.Equal(t, 4, fetcher.Len())
+
+	// Cached headers are not refetched.
+	headers, err = fetcher.HeadersByRoots(ctx, []phase0.Root{{1}, {4}})
+	require.NoError(t, err)
+	require.Len(t, headers, 2)
+	require.Equal(t, 5, client.calls)
+
+	// Adding further headers evicts the oldest.
+	_, err = fetcher.HeadersByRoots(ctx, []phase0.Root{{5}})
+	require.NoError(t, err)
+	require.Equal(t, 4, fetcher.Len())
+	_, err = fetcher.HeadersByRoots(ctx, []phase0.Root{{1}})
+	require.NoError(t, err)
+	require.Equal(t, 7, client.calls)
+
+	// Errors are returned.
+	client.fail = fmt.Sprintf("%#x", phase0.Root{6})
+	_, err = fetcher.HeadersByRoots(ctx, []phase0.Root{{6}, {7}})
+	require.ErrorContains(t, err, "failed to obtain header for block 0x0600000000000000000000000000000000000000000000000000000000000000: mock error")
+}
+
+func TestAncestors(t *testing.T) {
+	ctx := context.Background()
+	client := newHeadersClient(5)
+	fetcher, err := analysis.NewHeaderFetcher(client, 10, 1)
+	require.NoError(t, err)
+
+	ancestors, err := fetcher.Ancestors(ctx, phase0.Root{5}, 3)
+	require.NoError(t, err)
+	require.Len(t, ancestors, 3)
+	require.Equal(t, phase0.Root{5}, ancestors[0].Root)
+	require.Equal(t, phase0.Root{3}, ancestors[2].Root)
+
+	// Walk stops at genesis, reusing cached headers.
+	ancestors, err = fetcher.Ancestors(ctx, phase0.Root{5}, 10)
+	require.NoError(t, err)
+	require.Len(t, ancestors, 5)
+	require.Equal(t, phase0.Root{1}, ancestors[4].Root)
+	require.Equal(t, 5, client.calls)
+
+	// Walk stops at an unknown block.
+	ancestors, err = fetcher.Ancestors(ctx, phase0.Root{0xff}, 10)
+	require.NoError(t, err)
+	require.Empty(t, ancestors)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/attestantio/go-eth2-client/util/stateutil"
+	"github.com/pkg/errors"
+)
+
+// EpochDuties are the attester and proposer duties for an epoch.
+type EpochDuties struct {
+	Epoch          phase0.Epoch
+	AttesterDuties []*apiv1.AttesterDuty
+	ProposerDuties []*apiv1.ProposerDuty
+}
+
+// ReconstructEpochDuties reconstructs the duties for the given validators in a past epoch,
+// from the state at the first slot of the epoch.  This allows duties to be obtained for
+// epochs that the node no longer serves duties for, provided that it can supply the state.
+//
+// If indices is empty then duties are returned for all validators.  The cache may be nil,
+// but supplying one allows the shuffle to be shared with other computations for the epoch.
+func ReconstructEpochDuties(ctx context.Context,
+	client consensusclient.Service,
+	cache *shuffling.Cache,
+	epoch phase0.Epoch,
+	indices []phase0.ValidatorIndex,
+) (
+	*EpochDuties,
+	error,
+) {
+	specProvider, isProvider := client.(consensusclient.SpecProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide spec")
+	}
+	stateProvider, isProvider := client.(consensusclient.BeaconStateProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide beacon states")
+	}
+
+	chainSpec, err := specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+	params, err := shuffling.ChainParametersFromSpec(chainSpec)
+	if err != nil {
+		return nil, err
+	}
+	slot := phase0.Slot(uint64(epoch) * params.SlotsPerEpoch)
+	state, err := stateProvider.BeaconState(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain state for slot %d", slot)
+	}
+	if state == nil {
+		return nil, errors.Errorf("no state available for slot %d", slot)
+	}
+
+	return ReconstructDuties(state, params, cache, indices)
+}
+
+// ReconstructDuties reconstructs the duties for the given validators in the epoch of the
+// supplied state.  The state can be from any slot in the epoch.
+//
+// If indices is empty then duties are returned for all validators.  The cache may be nil.
+func ReconstructDuties(state *spec.VersionedBeaconState,
+	params *shuffling.ChainParameters,
+	cache *shuffling.Cache,
+	indices []phase0.ValidatorIndex,
+) (
+	*EpochDuties,
+	error,
+) {
+	if cache == nil {
+		cache = shuffling.NewCache(1)
+	}
+	st, err := stateutil.New(state, params, cache)
+	if err != nil {
+		return nil, err
+	}
+	epoch := st.Epoch()
+	validators := st.Validators()
+
+	var required map[phase0.ValidatorIndex]bool
+	if len(indices) > 0 {
+		required = make(map[phase0.ValidatorIndex]bool, len(indices))
+		for _, index := range indices {
+			required[index] = true
+		}
+	}
+
+	if len(st.ActiveIndices(epoch)) == 0 {
+		return nil, errors.New("no active validators")
+	}
+
+	res := &EpochDuties{
+		Epoch:          epoch,
+		AttesterDuties: make([]*apiv1.AttesterDuty, 0),
+		ProposerDuties: make([]*apiv1.ProposerDuty, 0),
+	}
+
+	committeesPerSlot, err := st.CommitteesPerSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	firstSlot := phase0.Slot(uint64(epoch) * params.SlotsPerEpoch)
+	for slot := firstSlot; slot < firstSlot+phase0.Slot(params.SlotsPerEpoch); slot++ {
+		for committeeIndex := phase0.CommitteeIndex(0); uint64(committeeIndex) < committeesPerSlot; committeeIndex++ {
+			committee, err := st.BeaconCommittee(slot, committeeIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to compute committee %d for slot %d", committeeIndex, slot)
+			}
+			for position, index := range committee {
+				if required != nil && !required[index] {
+					continue
+				}
+				res.AttesterDuties = append(res.AttesterDuties, &apiv1.AttesterDuty{
+					PubKey:                  validators[index].PublicKey,
+					Slot:                    slot,
+					ValidatorIndex:          index,
+					CommitteeIndex:          committeeIndex,
+					CommitteeLength:         uint64(len(committee)),
+					CommitteesAtSlot:        committeesPerSlot,
+					ValidatorCommitteeIndex: uint64(position),
+				})
+			}
+		}
+	}
+
+	for slot := firstSlot; slot < firstSlot+phase0.Slot(params.SlotsPerEpoch); slot++ {
+		if slot == 0 {
+			// There is no proposer for the genesis slot.
+			continue
+		}
+		index, err := st.ProposerIndex(slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute proposer for slot %d", slot)
+		}
+		if required != nil && !required[index] {
+			continue
+		}
+		res.ProposerDuties = append(res.ProposerDuties, &apiv1.ProposerDuty{
+			PubKey:         validators[index].PublicKey,
+			Slot:           slot,
+			ValidatorIndex: index,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/stretchr/testify/require"
+)
+
+func testState(slot phase0.Slot, validators int) *spec.VersionedBeaconState {
+	state := &phase0.BeaconState{
+		Slot:        slot,
+		Validators:  make([]*phase0.Validator, validators),
+		RANDAOMixes: make([]phase0.Root, 16),
+	}
+	for i := range state.Validators {
+		state.Validators[i] = &phase0.Validator{
+			PublicKey:        phase0.BLSPubKey{byte(i)},
+			EffectiveBalance: 32000000000,
+			ExitEpoch:        0xffffffffffffffff,
+		}
+	}
+	for i := range state.RANDAOMixes {
+		state.RANDAOMixes[i] = phase0.Root{byte(i)}
+	}
+	// Validator 0 has exited.
+	state.Validators[0].ExitEpoch = 1
+
+	return &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0:  state,
+	}
+}
+
+func TestReconstructDuties(t *testing.T) {
+	params := &shuffling.ChainParameters{
+		SlotsPerEpoch:        4,
+		TargetCommitteeSize:  4,
+		MaxCommitteesPerSlot: 4,
+		MaxEffectiveBalance:  32000000000,
+	}
+
+	_, err := analysis.ReconstructDuties(nil, params, nil, nil)
+	require.EqualError(t, err, "no state supplied")
+	_, err = analysis.ReconstructDuties(testState(10, 64), nil, nil, nil)
+	require.EqualError(t, err, "invalid chain parameters")
+
+	cache := shuffling.NewCache(1)
+	duties, err := analysis.ReconstructDuties(testState(10, 64), params, cache, nil)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(2), duties.Epoch)
+	require.Len(t, duties.ProposerDuties, 4)
+	// Every active validator should attest exactly once.
+	require.Len(t, duties.AttesterDuties, 63)
+	seen := make(map[phase0.ValidatorIndex]bool)
+	for _, duty := range duties.AttesterDuties {
+		require.NotEqual(t, phase0.ValidatorIndex(0), duty.ValidatorIndex)
+		require.False(t, seen[duty.ValidatorIndex])
+		seen[duty.ValidatorIndex] = true
+		require.True(t, duty.Slot >= 8 && duty.Slot < 12)
+		require.Equal(t, uint64(3), duty.CommitteesAtSlot)
+		require.Equal(t, phase0.BLSPubKey{byte(duty.ValidatorIndex)}, duty.PubKey)
+	}
+	for i, duty := range duties.ProposerDuties {
+		require.Equal(t, phase0.Slot(8+i), duty.Slot)
+		require.NotEqual(t, phase0.ValidatorIndex(0), duty.ValidatorIndex)
+	}
+	require.Equal(t, 1, cache.Len())
+
+	// Filtering by index should return the same duties for the selected validators.
+	filtered, err := analysis.ReconstructDuties(testState(8, 64), params, cache, []phase0.ValidatorIndex{duties.AttesterDuties[0].ValidatorIndex})
+	require.NoError(t, err)
+	require.Equal(t, duties.AttesterDuties[:1], filtered.AttesterDuties)
+}
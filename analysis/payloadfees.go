@@ -0,0 +1,363 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// baseFeeChangeDenominator bounds the change in base fee between blocks, as per EIP-1559.
+const baseFeeChangeDenominator = 8
+
+// elasticityMultiplier is the ratio of the gas limit to the gas target, as per EIP-1559.
+const elasticityMultiplier = 2
+
+// PayloadFees contains the gas and fee information of an execution payload.
+type PayloadFees struct {
+	Slot        phase0.Slot
+	BlockNumber uint64
+	GasLimit    uint64
+	GasUsed     uint64
+	// BaseFeePerGas is the base fee per gas of the payload, in Wei.
+	BaseFeePerGas *uint256.Int
+	// Burned is the amount burned by the base fee of the payload, in Wei.
+	Burned *uint256.Int
+	// PriorityFeesPerGas are the effective priority fees per gas of the transactions in
+	// the payload, in Wei, in ascending order.
+	PriorityFeesPerGas []*uint256.Int
+	// UndecodedTransactions is the number of transactions whose fees could not be decoded,
+	// and so are not included in PriorityFeesPerGas.
+	UndecodedTransactions int
+}
+
+// String returns a string version of the structure.
+func (f *PayloadFees) String() string {
+	return fmt.Sprintf("slot %d block %d: gas used %d/%d (%.2f%%), base fee %s Wei, burned %s Wei", f.Slot, f.BlockNumber, f.GasUsed, f.GasLimit, f.GasUtilisation(), f.BaseFeePerGas.Dec(), f.Burned.Dec())
+}
+
+// GasUtilisation returns the gas used by the payload as a percentage of its gas limit.
+func (f *PayloadFees) GasUtilisation() float64 {
+	return gasUtilisation(f.GasUsed, f.GasLimit)
+}
+
+// NextBaseFeePerGas returns the base fee per gas of the payload that follows this payload,
+// in Wei, as per EIP-1559.
+func (f *PayloadFees) NextBaseFeePerGas() *uint256.Int {
+	gasTarget := f.GasLimit / elasticityMultiplier
+	if gasTarget == 0 || f.GasUsed == gasTarget {
+		return new(uint256.Int).Set(f.BaseFeePerGas)
+	}
+
+	if f.GasUsed > gasTarget {
+		delta := new(uint256.Int).Mul(f.BaseFeePerGas, uint256.NewInt(f.GasUsed-gasTarget))
+		delta.Div(delta, uint256.NewInt(gasTarget))
+		delta.Div(delta, uint256.NewInt(baseFeeChangeDenominator))
+		if delta.IsZero() {
+			delta.SetOne()
+		}
+
+		return delta.Add(delta, f.BaseFeePerGas)
+	}
+
+	delta := new(uint256.Int).Mul(f.BaseFeePerGas, uint256.NewInt(gasTarget-f.GasUsed))
+	delta.Div(delta, uint256.NewInt(gasTarget))
+	delta.Div(delta, uint256.NewInt(baseFeeChangeDenominator))
+
+	return delta.Sub(f.BaseFeePerGas, delta)
+}
+
+// CalculatePayloadFees calculates the gas and fee information of the execution payload
+// of a block.  It returns nil if the block has no execution payload.
+//
+// Priority fees are calculated per gas from the fee parameters of each transaction and
+// the base fee of the payload.  The total priority fees paid by the payload require
+// transaction receipts to calculate, so are not provided.
+func CalculatePayloadFees(block *spec.VersionedSignedBeaconBlock) (*PayloadFees, error) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+	if block.Version < spec.DataVersionBellatrix {
+		// No execution payload.
+		return nil, nil
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, err
+	}
+	fees, transactions, err := payloadFees(block)
+	if err != nil {
+		return nil, err
+	}
+	fees.Slot = slot
+	fees.Burned = new(uint256.Int).Mul(fees.BaseFeePerGas, uint256.NewInt(fees.GasUsed))
+
+	fees.PriorityFeesPerGas = make([]*uint256.Int, 0, len(transactions))
+	for _, transaction := range transactions {
+		txFees, err := utilbellatrix.DecodeTransactionFees(transaction)
+		if err != nil {
+			fees.UndecodedTransactions++
+			continue
+		}
+		fees.PriorityFeesPerGas = append(fees.PriorityFeesPerGas, txFees.EffectivePriorityFeePerGas(fees.BaseFeePerGas))
+	}
+	sort.Slice(fees.PriorityFeesPerGas, func(i, j int) bool {
+		return fees.PriorityFeesPerGas[i].Lt(fees.PriorityFeesPerGas[j])
+	})
+
+	return fees, nil
+}
+
+// payloadFees returns the gas and base fee information and the transactions of the
+// execution payload of a block.
+func payloadFees(block *spec.VersionedSignedBeaconBlock) (*PayloadFees, []bellatrix.Transaction, error) {
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil || block.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, nil, errors.New("no bellatrix block")
+		}
+		payload := block.Bellatrix.Message.Body.ExecutionPayload
+
+		return &PayloadFees{
+			BlockNumber:   payload.BlockNumber,
+			GasLimit:      payload.GasLimit,
+			GasUsed:       payload.GasUsed,
+			BaseFeePerGas: leBytesToUint256(payload.BaseFeePerGas),
+		}, payload.Transactions, nil
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil || block.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, nil, errors.New("no capella block")
+		}
+		payload := block.Capella.Message.Body.ExecutionPayload
+
+		return &PayloadFees{
+			BlockNumber:   payload.BlockNumber,
+			GasLimit:      payload.GasLimit,
+			GasUsed:       payload.GasUsed,
+			BaseFeePerGas: leBytesToUint256(payload.BaseFeePerGas),
+		}, payload.Transactions, nil
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil || block.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, nil, errors.New("no deneb block")
+		}
+		payload := block.Deneb.Message.Body.ExecutionPayload
+		if payload.BaseFeePerGas == nil {
+			return nil, nil, errors.New("no deneb base fee")
+		}
+
+		return &PayloadFees{
+			BlockNumber:   payload.BlockNumber,
+			GasLimit:      payload.GasLimit,
+			GasUsed:       payload.GasUsed,
+			BaseFeePerGas: new(uint256.Int).Set(payload.BaseFeePerGas),
+		}, payload.Transactions, nil
+	case spec.DataVersionElectra:
+		if block.Electra == nil || block.Electra.Message == nil || block.Electra.Message.Body == nil || block.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, nil, errors.New("no electra block")
+		}
+		payload := block.Electra.Message.Body.ExecutionPayload
+		if payload.BaseFeePerGas == nil {
+			return nil, nil, errors.New("no electra base fee")
+		}
+
+		return &PayloadFees{
+			BlockNumber:   payload.BlockNumber,
+			GasLimit:      payload.GasLimit,
+			GasUsed:       payload.GasUsed,
+			BaseFeePerGas: new(uint256.Int).Set(payload.BaseFeePerGas),
+		}, payload.Transactions, nil
+	default:
+		return nil, nil, errors.New("unsupported version")
+	}
+}
+
+// FetchPayloadFees obtains the blocks between the two slots (inclusive) and calculates the
+// gas and fee information of their execution payloads, in slot order.
+//
+// The client must provide signed beacon blocks.  Missed slots and blocks prior to bellatrix
+// are ignored.
+func FetchPayloadFees(ctx context.Context,
+	client consensusclient.Service,
+	fromSlot phase0.Slot,
+	toSlot phase0.Slot,
+) (
+	[]*PayloadFees,
+	error,
+) {
+	blockProvider, isProvider := client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+	if toSlot < fromSlot {
+		return nil, errors.New("to slot before from slot")
+	}
+
+	res := make([]*PayloadFees, 0)
+	for slot := fromSlot; slot <= toSlot; slot++ {
+		block, err := blockProvider.SignedBeaconBlock(ctx, fmt.Sprintf("%d", slot))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain block for slot %d", slot)
+		}
+		if block != nil {
+			fees, err := CalculatePayloadFees(block)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to calculate fees for slot %d", slot)
+			}
+			if fees != nil {
+				res = append(res, fees)
+			}
+		}
+		if slot == toSlot {
+			// Avoid overflow at the far future slot.
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// PayloadFeesSummary summarises the gas and fee information of a number of execution payloads.
+type PayloadFeesSummary struct {
+	Payloads int
+	GasLimit uint64
+	GasUsed  uint64
+	// Burned is the total amount burned by the base fees of the payloads, in Wei.
+	Burned *uint256.Int
+	// MinBaseFeePerGas is the lowest base fee per gas of the payloads, in Wei.
+	MinBaseFeePerGas *uint256.Int
+	// MaxBaseFeePerGas is the highest base fee per gas of the payloads, in Wei.
+	MaxBaseFeePerGas *uint256.Int
+}
+
+// GasUtilisation returns the total gas used by the payloads as a percentage of their total gas limit.
+func (s *PayloadFeesSummary) GasUtilisation() float64 {
+	return gasUtilisation(s.GasUsed, s.GasLimit)
+}
+
+// SummarisePayloadFees summarises the gas and fee information of a number of execution payloads.
+func SummarisePayloadFees(fees []*PayloadFees) *PayloadFeesSummary {
+	res := &PayloadFeesSummary{
+		Burned: uint256.NewInt(0),
+	}
+	for _, payloadFees := range fees {
+		res.Payloads++
+		res.GasLimit += payloadFees.GasLimit
+		res.GasUsed += payloadFees.GasUsed
+		res.Burned.Add(res.Burned, payloadFees.Burned)
+		if res.MinBaseFeePerGas == nil || payloadFees.BaseFeePerGas.Lt(res.MinBaseFeePerGas) {
+			res.MinBaseFeePerGas = payloadFees.BaseFeePerGas
+		}
+		if res.MaxBaseFeePerGas == nil || payloadFees.BaseFeePerGas.Gt(res.MaxBaseFeePerGas) {
+			res.MaxBaseFeePerGas = payloadFees.BaseFeePerGas
+		}
+	}
+
+	return res
+}
+
+// BaseFeeChange is the base fee of an execution payload and its change from the previous payload.
+type BaseFeeChange struct {
+	Slot        phase0.Slot
+	BlockNumber uint64
+	// BaseFeePerGas is the base fee per gas of the payload, in Wei.
+	BaseFeePerGas *uint256.Int
+	// ChangePercent is the change in base fee from the previous payload, as a percentage.
+	// It is 0 for the first payload.
+	ChangePercent float64
+}
+
+// BaseFeeTrajectory returns the base fee of each of the execution payloads in block number
+// order, along with its change from the previous payload.
+func BaseFeeTrajectory(fees []*PayloadFees) []*BaseFeeChange {
+	sorted := make([]*PayloadFees, len(fees))
+	copy(sorted, fees)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].BlockNumber < sorted[j].BlockNumber
+	})
+
+	res := make([]*BaseFeeChange, 0, len(sorted))
+	for i, payloadFees := range sorted {
+		change := &BaseFeeChange{
+			Slot:          payloadFees.Slot,
+			BlockNumber:   payloadFees.BlockNumber,
+			BaseFeePerGas: payloadFees.BaseFeePerGas,
+		}
+		if i > 0 && !sorted[i-1].BaseFeePerGas.IsZero() {
+			previous := new(big.Float).SetInt(sorted[i-1].BaseFeePerGas.ToBig())
+			current := new(big.Float).SetInt(payloadFees.BaseFeePerGas.ToBig())
+			ratio, _ := new(big.Float).Quo(current, previous).Float64()
+			change.ChangePercent = (ratio - 1) * 100
+		}
+		res = append(res, change)
+	}
+
+	return res
+}
+
+// EstimatePriorityFee estimates the priority fee per gas required for inclusion, in Wei,
+// as the given percentile (0-100) of the priority fees per gas of the transactions in
+// the execution payloads.
+func EstimatePriorityFee(fees []*PayloadFees, percentile float64) (*uint256.Int, error) {
+	if percentile < 0 || percentile > 100 {
+		return nil, errors.New("percentile must be between 0 and 100")
+	}
+
+	priorityFees := make([]*uint256.Int, 0)
+	for _, payloadFees := range fees {
+		priorityFees = append(priorityFees, payloadFees.PriorityFeesPerGas...)
+	}
+	if len(priorityFees) == 0 {
+		return nil, errors.New("no transactions")
+	}
+	sort.Slice(priorityFees, func(i, j int) bool {
+		return priorityFees[i].Lt(priorityFees[j])
+	})
+
+	// Nearest rank.
+	rank := int(percentile / 100 * float64(len(priorityFees)))
+	if rank == len(priorityFees) {
+		rank--
+	}
+
+	return new(uint256.Int).Set(priorityFees[rank]), nil
+}
+
+func gasUtilisation(gasUsed uint64, gasLimit uint64) float64 {
+	if gasLimit == 0 {
+		return 0
+	}
+
+	return float64(gasUsed) * 100 / float64(gasLimit)
+}
+
+// leBytesToUint256 converts a little-endian byte array to an integer.
+func leBytesToUint256(input [32]byte) *uint256.Int {
+	beBytes := make([]byte, len(input))
+	for i := range input {
+		beBytes[i] = input[len(input)-1-i]
+	}
+
+	return new(uint256.Int).SetBytes(beBytes)
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/analysis"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculatePayloadFees(t *testing.T) {
+	// Dynamic fee transaction with a max priority fee of 1 Wei and a max fee of 2 Wei.
+	dynamicFee := bellatrix.Transaction(hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297880b1a2bc2ec50000080c0800101"))
+	// Legacy transaction with a gas price of 20 Gwei.
+	legacy := bellatrix.Transaction(hexToBytes("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"))
+
+	tests := []struct {
+		name     string
+		block    *spec.VersionedSignedBeaconBlock
+		expected *analysis.PayloadFees
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no block supplied",
+		},
+		{
+			name: "Phase0",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+			},
+		},
+		{
+			name: "Missing",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionCapella,
+			},
+			err: "no capella block",
+		},
+		{
+			name: "Capella",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.SignedBeaconBlock{
+					Message: &capella.BeaconBlock{
+						Slot: 10,
+						Body: &capella.BeaconBlockBody{
+							ExecutionPayload: &capella.ExecutionPayload{
+								BlockNumber:   100,
+								GasLimit:      30000000,
+								GasUsed:       15000000,
+								BaseFeePerGas: [32]byte{0x01},
+								Transactions:  []bellatrix.Transaction{legacy, dynamicFee, {0x7f}},
+							},
+						},
+					},
+				},
+			},
+			expected: &analysis.PayloadFees{
+				Slot:                  10,
+				BlockNumber:           100,
+				GasLimit:              30000000,
+				GasUsed:               15000000,
+				BaseFeePerGas:         uint256.NewInt(1),
+				Burned:                uint256.NewInt(15000000),
+				PriorityFeesPerGas:    []*uint256.Int{uint256.NewInt(1), uint256.NewInt(19999999999)},
+				UndecodedTransactions: 1,
+			},
+		},
+		{
+			name: "Deneb",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionDeneb,
+				Deneb: &deneb.SignedBeaconBlock{
+					Message: &deneb.BeaconBlock{
+						Slot: 11,
+						Body: &deneb.BeaconBlockBody{
+							ExecutionPayload: &deneb.ExecutionPayload{
+								BlockNumber:   101,
+								GasLimit:      30000000,
+								GasUsed:       21000,
+								BaseFeePerGas: uint256.NewInt(10000000000),
+								Transactions:  []bellatrix.Transaction{dynamicFee, legacy},
+							},
+						},
+					},
+				},
+			},
+			expected: &analysis.PayloadFees{
+				Slot:               11,
+				BlockNumber:        101,
+				GasLimit:           30000000,
+				GasUsed:            21000,
+				BaseFeePerGas:      uint256.NewInt(10000000000),
+				Burned:             uint256.NewInt(210000000000000),
+				PriorityFeesPerGas: []*uint256.Int{uint256.NewInt(0), uint256.NewInt(10000000000)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fees, err := analysis.CalculatePayloadFees(test.block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, fees)
+			}
+		})
+	}
+}
+
+func TestNextBaseFeePerGas(t *testing.T) {
+	tests := []struct {
+		name     string
+		gasUsed  uint64
+		baseFee  uint64
+		expected uint64
+	}{
+		{
+			name:     "Target",
+			gasUsed:  15000000,
+			baseFee:  1000000000,
+			expected: 1000000000,
+		},
+		{
+			name:     "Full",
+			gasUsed:  30000000,
+			baseFee:  1000000000,
+			expected: 1125000000,
+		},
+		{
+			name:     "Empty",
+			gasUsed:  0,
+			baseFee:  1000000000,
+			expected: 875000000,
+		},
+		{
+			name:     "MinimumIncrease",
+			gasUsed:  15000001,
+			baseFee:  7,
+			expected: 8,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fees := &analysis.PayloadFees{
+				GasLimit:      30000000,
+				GasUsed:       test.gasUsed,
+				BaseFeePerGas: uint256.NewInt(test.baseFee),
+			}
+			require.Equal(t, uint256.NewInt(test.expected), fees.NextBaseFeePerGas())
+		})
+	}
+}
+
+func TestPayloadFeesAggregates(t *testing.T) {
+	fees := []*analysis.PayloadFees{
+		{
+			Slot:               12,
+			BlockNumber:        102,
+			GasLimit:           30000000,
+			GasUsed:            30000000,
+			BaseFeePerGas:      uint256.NewInt(1100),
+			Burned:             uint256.NewInt(33000000000),
+			PriorityFeesPerGas: []*uint256.Int{uint256.NewInt(5), uint256.NewInt(50)},
+		},
+		{
+			Slot:               10,
+			BlockNumber:        101,
+			GasLimit:           30000000,
+			GasUsed:            0,
+			BaseFeePerGas:      uint256.NewInt(1000),
+			Burned:             uint256.NewInt(0),
+			PriorityFeesPerGas: []*uint256.Int{uint256.NewInt(1), uint256.NewInt(2), uint256.NewInt(3)},
+		},
+	}
+
+	summary := analysis.SummarisePayloadFees(fees)
+	require.Equal(t, 2, summary.Payloads)
+	require.Equal(t, uint64(30000000), summary.GasUsed)
+	require.Equal(t, float64(50), summary.GasUtilisation())
+	require.Equal(t, uint256.NewInt(33000000000), summary.Burned)
+	require.Equal(t, uint256.NewInt(1000), summary.MinBaseFeePerGas)
+	require.Equal(t, uint256.NewInt(1100), summary.MaxBaseFeePerGas)
+
+	trajectory := analysis.BaseFeeTrajectory(fees)
+	require.Len(t, trajectory, 2)
+	require.Equal(t, phase0.Slot(10), trajectory[0].Slot)
+	require.Equal(t, float64(0), trajectory[0].ChangePercent)
+	require.Equal(t, phase0.Slot(12), trajectory[1].Slot)
+	require.InDelta(t, 10, trajectory[1].ChangePercent, 0.0001)
+
+	_, err := analysis.EstimatePriorityFee(fees, 101)
+	require.EqualError(t, err, "percentile must be between 0 and 100")
+	_, err = analysis.EstimatePriorityFee(nil, 50)
+	require.EqualError(t, err, "no transactions")
+	for percentile, expected := range map[float64]uint64{0: 1, 50: 3, 90: 50, 100: 50} {
+		estimate, err := analysis.EstimatePriorityFee(fees, percentile)
+		require.NoError(t, err)
+		require.Equal(t, uint256.NewInt(expected), estimate)
+	}
+}
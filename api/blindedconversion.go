@@ -0,0 +1,120 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// ConvertToBlinded returns the signed blinded beacon block that corresponds to
+// the supplied signed beacon block.
+func ConvertToBlinded(block *spec.VersionedSignedBeaconBlock) (*VersionedSignedBlindedBeaconBlock, error) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+
+	res := &VersionedSignedBlindedBeaconBlock{
+		Version: block.Version,
+	}
+	var err error
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix, err = apiv1bellatrix.BlindSignedBeaconBlock(block.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella, err = apiv1capella.BlindSignedBeaconBlock(block.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb, err = apiv1deneb.BlindSignedBeaconBlock(block.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra, err = apiv1electra.BlindSignedBeaconBlock(block.Electra)
+	default:
+		return nil, errors.Errorf("unsupported version %v", block.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to blind %v block", block.Version)
+	}
+
+	return res, nil
+}
+
+// ConvertToUnblinded returns the signed beacon block that corresponds to the
+// supplied signed blinded beacon block and the execution payload, or for deneb
+// onwards the execution payload and blobs bundle, that it commits to.
+// An error is returned if the root of the payload does not match the root of the
+// execution payload header in the blinded block.
+func ConvertToUnblinded(block *VersionedSignedBlindedBeaconBlock,
+	response *VersionedSubmitBlindedBlockResponse,
+) (
+	*spec.VersionedSignedBeaconBlock,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+	if response == nil {
+		return nil, errors.New("no response supplied")
+	}
+	if response.Version != block.Version {
+		return nil, errors.New("response version does not match block version")
+	}
+
+	var matches bool
+	var err error
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil ||
+			block.Bellatrix.Message.Body.ExecutionPayloadHeader == nil {
+			return nil, errors.New("no bellatrix execution payload header")
+		}
+		matches, err = block.Bellatrix.Message.Body.ExecutionPayloadHeader.MatchesPayload(response.Bellatrix)
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil ||
+			block.Capella.Message.Body.ExecutionPayloadHeader == nil {
+			return nil, errors.New("no capella execution payload header")
+		}
+		matches, err = block.Capella.Message.Body.ExecutionPayloadHeader.MatchesPayload(response.Capella)
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil ||
+			block.Deneb.Message.Body.ExecutionPayloadHeader == nil {
+			return nil, errors.New("no deneb execution payload header")
+		}
+		if response.Deneb == nil {
+			return nil, errors.New("no deneb response")
+		}
+		matches, err = block.Deneb.Message.Body.ExecutionPayloadHeader.MatchesPayload(response.Deneb.ExecutionPayload)
+	case spec.DataVersionElectra:
+		if block.Electra == nil || block.Electra.Message == nil || block.Electra.Message.Body == nil ||
+			block.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return nil, errors.New("no electra execution payload header")
+		}
+		if response.Electra == nil {
+			return nil, errors.New("no electra response")
+		}
+		matches, err = block.Electra.Message.Body.ExecutionPayloadHeader.MatchesPayload(response.Electra.ExecutionPayload)
+	default:
+		return nil, errors.Errorf("unsupported version %v", block.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check execution payload")
+	}
+	if !matches {
+		return nil, errors.New("execution payload root does not match execution payload header root")
+	}
+
+	return block.Unblind(response)
+}
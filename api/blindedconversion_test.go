@@ -0,0 +1,258 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testBellatrixPayload() *bellatrix.ExecutionPayload {
+	return &bellatrix.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		BlockNumber:   10,
+		GasLimit:      30000000,
+		ExtraData:     []byte{0x02},
+		BaseFeePerGas: [32]byte{0x03},
+		BlockHash:     phase0.Hash32{0x04},
+		Transactions:  []bellatrix.Transaction{{0x05, 0x06}, {0x07}},
+	}
+}
+
+func testDenebPayload() *deneb.ExecutionPayload {
+	return &deneb.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		BlockNumber:   10,
+		GasLimit:      30000000,
+		ExtraData:     []byte{0x02},
+		BaseFeePerGas: uint256.NewInt(7),
+		BlockHash:     phase0.Hash32{0x04},
+		Transactions:  []bellatrix.Transaction{{0x05, 0x06}, {0x07}},
+		Withdrawals: []*capella.Withdrawal{
+			{Index: 1, ValidatorIndex: 2, Amount: 3},
+		},
+		BlobGasUsed:   131072,
+		ExcessBlobGas: 0,
+	}
+}
+
+func testBellatrixBlock() *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionBellatrix,
+		Bellatrix: &bellatrix.SignedBeaconBlock{
+			Message: &bellatrix.BeaconBlock{
+				Slot:          1,
+				ProposerIndex: 2,
+				Body: &bellatrix.BeaconBlockBody{
+					ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					ProposerSlashings: []*phase0.ProposerSlashing{},
+					AttesterSlashings: []*phase0.AttesterSlashing{},
+					Attestations:      []*phase0.Attestation{},
+					Deposits:          []*phase0.Deposit{},
+					VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:     &altair.SyncAggregate{SyncCommitteeBits: make(bitfield.Bitvector512, syncCommitteeSize/8)},
+					ExecutionPayload:  testBellatrixPayload(),
+				},
+			},
+			Signature: phase0.BLSSignature{0x08},
+		},
+	}
+}
+
+func testElectraBlock() *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.SignedBeaconBlock{
+			Message: &electra.BeaconBlock{
+				Slot:          1,
+				ProposerIndex: 2,
+				Body: &electra.BeaconBlockBody{
+					ETH1Data:              &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					ProposerSlashings:     []*phase0.ProposerSlashing{},
+					AttesterSlashings:     []*electra.AttesterSlashing{},
+					Attestations:          []*electra.Attestation{},
+					Deposits:              []*phase0.Deposit{},
+					VoluntaryExits:        []*phase0.SignedVoluntaryExit{},
+					SyncAggregate:         &altair.SyncAggregate{SyncCommitteeBits: make(bitfield.Bitvector512, syncCommitteeSize/8)},
+					ExecutionPayload:      testDenebPayload(),
+					BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+					BlobKzgCommitments:    []deneb.KzgCommitment{{0x09}},
+					ExecutionRequests: &electra.ExecutionRequests{
+						Deposits:       []*electra.DepositRequest{},
+						Withdrawals:    []*electra.WithdrawalRequest{},
+						Consolidations: []*electra.ConsolidationRequest{},
+					},
+				},
+			},
+			Signature: phase0.BLSSignature{0x08},
+		},
+	}
+}
+
+func TestConvertToBlinded(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *spec.VersionedSignedBeaconBlock
+		// mainnetOnly is set if the blinded block is only encoded for the mainnet preset.
+		mainnetOnly bool
+		err         string
+	}{
+		{
+			name: "Nil",
+			err:  "no block supplied",
+		},
+		{
+			name:  "Phase0",
+			block: &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionPhase0},
+			err:   "unsupported version phase0",
+		},
+		{
+			name:  "BellatrixMissing",
+			block: &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionBellatrix},
+			err:   "failed to blind bellatrix block: no block supplied",
+		},
+		{
+			name:  "Bellatrix",
+			block: testBellatrixBlock(),
+		},
+		{
+			name:        "Electra",
+			block:       testElectraBlock(),
+			mainnetOnly: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.mainnetOnly && !mainnetPreset {
+				t.Skip("blinded block encoded for the mainnet preset")
+			}
+			blinded, err := api.ConvertToBlinded(test.block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.block.Version, blinded.Version)
+
+			// The blinded block has the same root as the full block.
+			expectedRoot, err := test.block.Root()
+			require.NoError(t, err)
+			root, err := blinded.Root()
+			require.NoError(t, err)
+			require.Equal(t, expectedRoot, root)
+		})
+	}
+}
+
+func TestConvertToUnblinded(t *testing.T) {
+	bellatrixBlinded, err := api.ConvertToBlinded(testBellatrixBlock())
+	require.NoError(t, err)
+	electraBlinded, err := api.ConvertToBlinded(testElectraBlock())
+	require.NoError(t, err)
+
+	mismatchedPayload := testDenebPayload()
+	mismatchedPayload.GasUsed = 1
+
+	tests := []struct {
+		name     string
+		block    *api.VersionedSignedBlindedBeaconBlock
+		response *api.VersionedSubmitBlindedBlockResponse
+		expected *spec.VersionedSignedBeaconBlock
+		err      string
+	}{
+		{
+			name:     "Nil",
+			response: &api.VersionedSubmitBlindedBlockResponse{},
+			err:      "no block supplied",
+		},
+		{
+			name:  "ResponseNil",
+			block: bellatrixBlinded,
+			err:   "no response supplied",
+		},
+		{
+			name:  "VersionMismatch",
+			block: bellatrixBlinded,
+			response: &api.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.ExecutionPayload{},
+			},
+			err: "response version does not match block version",
+		},
+		{
+			name:  "ResponseMissing",
+			block: electraBlinded,
+			response: &api.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionElectra,
+			},
+			err: "no electra response",
+		},
+		{
+			name:  "PayloadMismatch",
+			block: electraBlinded,
+			response: &api.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionElectra,
+				Electra: &apiv1deneb.ExecutionPayloadAndBlobsBundle{
+					ExecutionPayload: mismatchedPayload,
+				},
+			},
+			err: "execution payload root does not match execution payload header root",
+		},
+		{
+			name:  "Bellatrix",
+			block: bellatrixBlinded,
+			response: &api.VersionedSubmitBlindedBlockResponse{
+				Version:   spec.DataVersionBellatrix,
+				Bellatrix: testBellatrixPayload(),
+			},
+			expected: testBellatrixBlock(),
+		},
+		{
+			name:  "Electra",
+			block: electraBlinded,
+			response: &api.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionElectra,
+				Electra: &apiv1deneb.ExecutionPayloadAndBlobsBundle{
+					ExecutionPayload: testDenebPayload(),
+				},
+			},
+			expected: testElectraBlock(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.ConvertToUnblinded(test.block, test.response)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// PageOpts are the options for fetching a single page from a paged endpoint.
+type PageOpts struct {
+	// Cursor is the cursor returned with the previous page, or empty for the first page.
+	Cursor string
+	// Limit is the maximum number of items to return in the page.
+	// If 0 the page size is chosen by the node.
+	Limit uint64
+}
+
+// Page is a single page of items from a paged endpoint.
+type Page[T any] struct {
+	// Data is the items in the page.
+	Data []T
+	// NextCursor is the cursor with which to fetch the next page, or empty if this is the last page.
+	NextCursor string
+}
+
+// PageFunc fetches a single page of items.
+type PageFunc[T any] func(ctx context.Context, opts *PageOpts) (*Page[T], error)
+
+// PageIterator iterates over the items of a paged endpoint, fetching the next
+// page when the items of the current page have been consumed.
+// A pull iterator is used rather than a range function as this module supports
+// versions of Go that predate range-over-func.
+type PageIterator[T any] struct {
+	fetch  PageFunc[T]
+	limit  uint64
+	cursor string
+	items  []T
+	item   T
+	done   bool
+	err    error
+}
+
+// NewPageIterator creates an iterator that fetches pages of up to limit items with fetch.
+// If limit is 0 the page size is chosen by the node.
+func NewPageIterator[T any](fetch PageFunc[T], limit uint64) *PageIterator[T] {
+	return &PageIterator[T]{
+		fetch: fetch,
+		limit: limit,
+	}
+}
+
+// Next moves the iterator to the next item, fetching the next page if required.
+// It returns false when there are no more items or a page could not be fetched,
+// in which case Err returns the error.
+func (i *PageIterator[T]) Next(ctx context.Context) bool {
+	for len(i.items) == 0 {
+		if i.done || i.err != nil {
+			return false
+		}
+
+		page, err := i.fetch(ctx, &PageOpts{
+			Cursor: i.cursor,
+			Limit:  i.limit,
+		})
+		if err != nil {
+			i.err = err
+
+			return false
+		}
+		switch page.NextCursor {
+		case "":
+			i.done = true
+		case i.cursor:
+			// Refetching the same page would never finish.
+			i.err = errors.New("page cursor did not advance")
+
+			return false
+		}
+		i.cursor = page.NextCursor
+		i.items = page.Data
+	}
+
+	i.item = i.items[0]
+	i.items = i.items[1:]
+
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *PageIterator[T]) Item() T {
+	return i.item
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (i *PageIterator[T]) Err() error {
+	return i.err
+}
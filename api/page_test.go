@@ -0,0 +1,112 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageIterator(t *testing.T) {
+	tests := []struct {
+		name     string
+		pages    map[string]*api.Page[int]
+		expected []int
+		cursors  []string
+		err      string
+	}{
+		{
+			name: "Single",
+			pages: map[string]*api.Page[int]{
+				"": {Data: []int{1, 2, 3}},
+			},
+			expected: []int{1, 2, 3},
+			cursors:  []string{""},
+		},
+		{
+			name: "Multiple",
+			pages: map[string]*api.Page[int]{
+				"":  {Data: []int{1, 2}, NextCursor: "a"},
+				"a": {Data: []int{}, NextCursor: "b"},
+				"b": {Data: []int{3}},
+			},
+			expected: []int{1, 2, 3},
+			cursors:  []string{"", "a", "b"},
+		},
+		{
+			name: "Empty",
+			pages: map[string]*api.Page[int]{
+				"": {Data: []int{}},
+			},
+			expected: []int{},
+			cursors:  []string{""},
+		},
+		{
+			name: "RepeatedCursor",
+			pages: map[string]*api.Page[int]{
+				"":  {Data: []int{1}, NextCursor: "a"},
+				"a": {Data: []int{2}, NextCursor: "a"},
+			},
+			expected: []int{1},
+			cursors:  []string{"", "a"},
+			err:      "page cursor did not advance",
+		},
+		{
+			name: "FetchError",
+			pages: map[string]*api.Page[int]{
+				"": {Data: []int{1}, NextCursor: "a"},
+			},
+			expected: []int{1},
+			cursors:  []string{"", "a"},
+			err:      "no page",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cursors := make([]string, 0)
+			fetch := func(_ context.Context, opts *api.PageOpts) (*api.Page[int], error) {
+				require.Equal(t, uint64(2), opts.Limit)
+				cursors = append(cursors, opts.Cursor)
+				page, exists := test.pages[opts.Cursor]
+				if !exists {
+					return nil, errors.New("no page")
+				}
+
+				return page, nil
+			}
+
+			iterator := api.NewPageIterator(fetch, 2)
+			res := make([]int, 0)
+			for iterator.Next(context.Background()) {
+				res = append(res, iterator.Item())
+			}
+			if test.err != "" {
+				require.EqualError(t, iterator.Err(), test.err)
+			} else {
+				require.NoError(t, iterator.Err())
+			}
+			require.Equal(t, test.expected, res)
+			require.Equal(t, test.cursors, cursors)
+
+			// Further calls do not fetch again.
+			require.False(t, iterator.Next(context.Background()))
+			require.Equal(t, test.cursors, cursors)
+		})
+	}
+}
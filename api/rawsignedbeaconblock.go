@@ -0,0 +1,56 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// Encoding is the encoding of pre-serialized data.
+type Encoding int
+
+const (
+	// EncodingUnknown is an unknown encoding.
+	EncodingUnknown Encoding = iota
+	// EncodingJSON is JSON encoding.
+	EncodingJSON
+	// EncodingSSZ is SSZ encoding.
+	EncodingSSZ
+)
+
+var encodingStrings = [...]string{
+	"unknown",
+	"json",
+	"ssz",
+}
+
+// String returns a string representation of the encoding.
+func (e Encoding) String() string {
+	if int(e) < 0 || int(e) >= len(encodingStrings) {
+		return "unknown"
+	}
+	return encodingStrings[e]
+}
+
+// RawSignedBeaconBlock is a signed beacon block, blinded or otherwise,
+// that has already been serialized.  It allows a block that has been
+// received in serialized form to be forwarded without decoding it.
+type RawSignedBeaconBlock struct {
+	// Version is the consensus version of the block.
+	Version spec.DataVersion
+	// Encoding is the encoding of the data.
+	Encoding Encoding
+	// Data is the serialized block.
+	Data []byte
+}
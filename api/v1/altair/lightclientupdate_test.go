@@ -0,0 +1,158 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !minimal
+
+package altair_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/altair"
+	specaltair "github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+func testBranch(length int) []phase0.Root {
+	branch := make([]phase0.Root, length)
+	for i := range branch {
+		branch[i] = phase0.Root{byte(i + 1)}
+	}
+
+	return branch
+}
+
+// testLightClientUpdate returns an update sized for the mainnet preset, which the JSON and YAML
+// encodings of sync committees require, so these tests are not built with the minimal preset.
+func testLightClientUpdate() *altair.LightClientUpdate {
+	pubkeys := make([]phase0.BLSPubKey, 512)
+	for i := range pubkeys {
+		pubkeys[i] = phase0.BLSPubKey{byte(i), byte(i >> 8)}
+	}
+
+	return &altair.LightClientUpdate{
+		AttestedHeader: &altair.LightClientHeader{
+			Beacon: &phase0.BeaconBlockHeader{
+				Slot:          100,
+				ProposerIndex: 1,
+				ParentRoot:    phase0.Root{0x01},
+				StateRoot:     phase0.Root{0x02},
+				BodyRoot:      phase0.Root{0x03},
+			},
+		},
+		NextSyncCommittee: &specaltair.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: phase0.BLSPubKey{0x04},
+		},
+		NextSyncCommitteeBranch: testBranch(5),
+		FinalizedHeader: &altair.LightClientHeader{
+			Beacon: &phase0.BeaconBlockHeader{
+				Slot:          64,
+				ProposerIndex: 2,
+				ParentRoot:    phase0.Root{0x05},
+				StateRoot:     phase0.Root{0x06},
+				BodyRoot:      phase0.Root{0x07},
+			},
+		},
+		FinalityBranch: testBranch(6),
+		SyncAggregate: &specaltair.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: phase0.BLSSignature{0x08},
+		},
+		SignatureSlot: 101,
+	}
+}
+
+func TestLightClientUpdateRoundTrip(t *testing.T) {
+	update := testLightClientUpdate()
+
+	data, err := json.Marshal(update)
+	require.NoError(t, err)
+	var jsonRes altair.LightClientUpdate
+	require.NoError(t, json.Unmarshal(data, &jsonRes))
+	require.Equal(t, update, &jsonRes)
+
+	data, err = yaml.Marshal(update)
+	require.NoError(t, err)
+	var yamlRes altair.LightClientUpdate
+	require.NoError(t, yaml.Unmarshal(data, &yamlRes))
+	require.Equal(t, update, &yamlRes)
+
+	data, err = update.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, update.SizeSSZ())
+	var sszRes altair.LightClientUpdate
+	require.NoError(t, sszRes.UnmarshalSSZ(data))
+	require.Equal(t, update, &sszRes)
+}
+
+func TestLightClientUpdateJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(map[string]json.RawMessage)
+		err    string
+	}{
+		{
+			name:   "AttestedHeaderMissing",
+			modify: func(data map[string]json.RawMessage) { delete(data, "attested_header") },
+			err:    "attested header missing",
+		},
+		{
+			name:   "NextSyncCommitteeBranchMissing",
+			modify: func(data map[string]json.RawMessage) { delete(data, "next_sync_committee_branch") },
+			err:    "next sync committee branch missing",
+		},
+		{
+			name: "FinalityBranchShort",
+			modify: func(data map[string]json.RawMessage) {
+				data["finality_branch"] = json.RawMessage(`["0x0100000000000000000000000000000000000000000000000000000000000000"]`)
+			},
+			err: "incorrect length for finality branch",
+		},
+		{
+			name:   "SyncAggregateMissing",
+			modify: func(data map[string]json.RawMessage) { delete(data, "sync_aggregate") },
+			err:    "sync aggregate missing",
+		},
+		{
+			name:   "SignatureSlotMissing",
+			modify: func(data map[string]json.RawMessage) { delete(data, "signature_slot") },
+			err:    "signature slot missing",
+		},
+		{
+			name:   "SignatureSlotInvalid",
+			modify: func(data map[string]json.RawMessage) { data["signature_slot"] = json.RawMessage(`"-1"`) },
+			err:    `invalid value for signature slot: strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+	}
+
+	input, err := json.Marshal(testLightClientUpdate())
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := make(map[string]json.RawMessage)
+			require.NoError(t, json.Unmarshal(input, &data))
+			test.modify(data)
+			modified, err := json.Marshal(data)
+			require.NoError(t, err)
+
+			var res altair.LightClientUpdate
+			require.EqualError(t, json.Unmarshal(modified, &res), test.err)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	specbellatrix "github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
+	require "github.com/stretchr/testify/require"
+)
+
+func testSignedBuilderBid() *bellatrix.SignedBuilderBid {
+	return &bellatrix.SignedBuilderBid{
+		Message: &bellatrix.BuilderBid{
+			Header: &specbellatrix.ExecutionPayloadHeader{
+				ParentHash:    phase0.Hash32{0x01},
+				FeeRecipient:  specbellatrix.ExecutionAddress{0x02},
+				BlockNumber:   100,
+				GasLimit:      30000000,
+				Timestamp:     1700000000,
+				ExtraData:     []byte{0x03},
+				BaseFeePerGas: [32]byte{0x04},
+				BlockHash:     phase0.Hash32{0x05},
+			},
+			Value:  uint256.NewInt(123456789),
+			Pubkey: phase0.BLSPubKey{0x06},
+		},
+		Signature: phase0.BLSSignature{0x07},
+	}
+}
+
+func TestBuilderBidJSON(t *testing.T) {
+	header, err := json.Marshal(testSignedBuilderBid().Message.Header)
+	require.NoError(t, err)
+	pubKey := `"0x060000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"`
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type bellatrix.builderBidJSON",
+		},
+		{
+			name:  "HeaderMissing",
+			input: []byte(fmt.Sprintf(`{"value":"1","pubkey":%s}`, pubKey)),
+			err:   "header missing",
+		},
+		{
+			name:  "ValueMissing",
+			input: []byte(fmt.Sprintf(`{"header":%s,"pubkey":%s}`, header, pubKey)),
+			err:   "value missing",
+		},
+		{
+			name:  "ValueInvalid",
+			input: []byte(fmt.Sprintf(`{"header":%s,"value":"-1","pubkey":%s}`, header, pubKey)),
+			err:   `invalid value for value: strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(fmt.Sprintf(`{"header":%s,"value":"1"}`, header)),
+			err:   "public key missing",
+		},
+		{
+			name:  "PubkeyInvalid",
+			input: []byte(fmt.Sprintf(`{"header":%s,"value":"1","pubkey":"invalid"}`, header)),
+			err:   "invalid value for public key: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "PubkeyShort",
+			input: []byte(fmt.Sprintf(`{"header":%s,"value":"1","pubkey":"0x0102"}`, header)),
+			err:   "incorrect length 2 for public key",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"header":%s,"value":"123456789","pubkey":%s}`, header, pubKey)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res bellatrix.BuilderBid
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSignedBuilderBidRoundTrip(t *testing.T) {
+	bid := testSignedBuilderBid()
+
+	jsonData, err := json.Marshal(bid)
+	require.NoError(t, err)
+	var jsonRes bellatrix.SignedBuilderBid
+	require.NoError(t, json.Unmarshal(jsonData, &jsonRes))
+	require.Equal(t, bid, &jsonRes)
+
+	yamlData, err := yaml.Marshal(bid)
+	require.NoError(t, err)
+	var yamlRes bellatrix.SignedBuilderBid
+	require.NoError(t, yaml.Unmarshal(yamlData, &yamlRes))
+	require.Equal(t, bid, &yamlRes)
+
+	sszData, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+	var sszRes bellatrix.SignedBuilderBid
+	require.NoError(t, sszRes.UnmarshalSSZ(sszData))
+	require.Equal(t, bid, &sszRes)
+}
+
+func TestBuilderBidSSZValue(t *testing.T) {
+	bid := testSignedBuilderBid().Message
+	bid.Value = uint256.NewInt(0x0102)
+
+	data, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+	// The value follows the header offset, and is little-endian.
+	require.Equal(t, []byte{0x02, 0x01, 0x00}, data[4:7])
+
+	root, err := bid.HashTreeRoot()
+	require.NoError(t, err)
+	var res bellatrix.BuilderBid
+	require.NoError(t, res.UnmarshalSSZ(data))
+	rtRoot, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+	require.Equal(t, uint64(0x0102), res.Value.Uint64())
+}
@@ -14,10 +14,13 @@
 package bellatrix
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
 // SignedBlindedBeaconBlock is a signed beacon block.
@@ -34,3 +37,76 @@ func (s *SignedBlindedBeaconBlock) String() string {
 	}
 	return string(data)
 }
+
+// Unblind returns the full signed beacon block obtained by replacing the execution
+// payload header of the blinded block with the supplied execution payload.
+func (s *SignedBlindedBeaconBlock) Unblind(payload *bellatrix.ExecutionPayload) (*bellatrix.SignedBeaconBlock, error) {
+	if s.Message == nil || s.Message.Body == nil || s.Message.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.New("no execution payload header in blinded block")
+	}
+	if payload == nil {
+		return nil, errors.New("no execution payload supplied")
+	}
+	if !bytes.Equal(s.Message.Body.ExecutionPayloadHeader.BlockHash[:], payload.BlockHash[:]) {
+		return nil, errors.New("execution payload block hash does not match blinded block")
+	}
+
+	m := s.Message
+	return &bellatrix.SignedBeaconBlock{
+		Message: &bellatrix.BeaconBlock{
+			Slot:          m.Slot,
+			ProposerIndex: m.ProposerIndex,
+			ParentRoot:    m.ParentRoot,
+			StateRoot:     m.StateRoot,
+			Body: &bellatrix.BeaconBlockBody{
+				RANDAOReveal:      m.Body.RANDAOReveal,
+				ETH1Data:          m.Body.ETH1Data,
+				Graffiti:          m.Body.Graffiti,
+				ProposerSlashings: m.Body.ProposerSlashings,
+				AttesterSlashings: m.Body.AttesterSlashings,
+				Attestations:      m.Body.Attestations,
+				Deposits:          m.Body.Deposits,
+				VoluntaryExits:    m.Body.VoluntaryExits,
+				SyncAggregate:     m.Body.SyncAggregate,
+				ExecutionPayload:  payload,
+			},
+		},
+		Signature: s.Signature,
+	}, nil
+}
+
+// BlindSignedBeaconBlock returns the signed blinded beacon block obtained by replacing
+// the execution payload of the supplied block with its execution payload header.
+// The signature is unchanged, as the blinded and full blocks share the same root.
+func BlindSignedBeaconBlock(block *bellatrix.SignedBeaconBlock) (*SignedBlindedBeaconBlock, error) {
+	if block == nil || block.Message == nil || block.Message.Body == nil {
+		return nil, errors.New("no block supplied")
+	}
+	header, err := bellatrix.HeaderFromPayload(block.Message.Body.ExecutionPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain execution payload header")
+	}
+
+	b := block.Message
+	return &SignedBlindedBeaconBlock{
+		Message: &BlindedBeaconBlock{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     b.StateRoot,
+			Body: &BlindedBeaconBlockBody{
+				RANDAOReveal:           b.Body.RANDAOReveal,
+				ETH1Data:               b.Body.ETH1Data,
+				Graffiti:               b.Body.Graffiti,
+				ProposerSlashings:      b.Body.ProposerSlashings,
+				AttesterSlashings:      b.Body.AttesterSlashings,
+				Attestations:           b.Body.Attestations,
+				Deposits:               b.Body.Deposits,
+				VoluntaryExits:         b.Body.VoluntaryExits,
+				SyncAggregate:          b.Body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+			},
+		},
+		Signature: block.Signature,
+	}, nil
+}
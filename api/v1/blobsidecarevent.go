@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlobSidecarEvent is the data for the blob sidecar event.
+type BlobSidecarEvent struct {
+	BlockRoot     phase0.Root
+	Index         deneb.BlobIndex
+	Slot          phase0.Slot
+	KZGCommitment deneb.KzgCommitment
+	VersionedHash deneb.VersionedHash
+}
+
+// blobSidecarEventJSON is the spec representation of the struct.
+type blobSidecarEventJSON struct {
+	BlockRoot     string `json:"block_root"`
+	Index         string `json:"index"`
+	Slot          string `json:"slot"`
+	KZGCommitment string `json:"kzg_commitment"`
+	VersionedHash string `json:"versioned_hash"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *BlobSidecarEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&blobSidecarEventJSON{
+		BlockRoot:     fmt.Sprintf("%#x", e.BlockRoot),
+		Index:         fmt.Sprintf("%d", e.Index),
+		Slot:          fmt.Sprintf("%d", e.Slot),
+		KZGCommitment: fmt.Sprintf("%#x", e.KZGCommitment),
+		VersionedHash: fmt.Sprintf("%#x", e.VersionedHash),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *BlobSidecarEvent) UnmarshalJSON(input []byte) error {
+	var data blobSidecarEventJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.BlockRoot == "" {
+		return errors.New("block root missing")
+	}
+	blockRoot, err := hex.DecodeString(strings.TrimPrefix(data.BlockRoot, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for block root")
+	}
+	if len(blockRoot) != rootLength {
+		return fmt.Errorf("incorrect length %d for block root", len(blockRoot))
+	}
+	copy(e.BlockRoot[:], blockRoot)
+
+	if data.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(data.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	e.Index = deneb.BlobIndex(index)
+
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	e.Slot = phase0.Slot(slot)
+
+	if data.KZGCommitment == "" {
+		return errors.New("kzg commitment missing")
+	}
+	kzgCommitment, err := hex.DecodeString(strings.TrimPrefix(data.KZGCommitment, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for kzg commitment")
+	}
+	if len(kzgCommitment) != len(e.KZGCommitment) {
+		return fmt.Errorf("incorrect length %d for kzg commitment", len(kzgCommitment))
+	}
+	copy(e.KZGCommitment[:], kzgCommitment)
+
+	if data.VersionedHash == "" {
+		return errors.New("versioned hash missing")
+	}
+	versionedHash, err := hex.DecodeString(strings.TrimPrefix(data.VersionedHash, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for versioned hash")
+	}
+	if len(versionedHash) != len(e.VersionedHash) {
+		return fmt.Errorf("incorrect length %d for versioned hash", len(versionedHash))
+	}
+	copy(e.VersionedHash[:], versionedHash)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (e *BlobSidecarEvent) String() string {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -33,29 +33,33 @@ type ChainReorgEvent struct {
 	OldHeadState phase0.Root
 	NewHeadState phase0.Root
 	Epoch        phase0.Epoch
+	// ExecutionOptimistic is true if the new head block has not yet been fully verified by an execution client.
+	ExecutionOptimistic bool
 }
 
 // chainReorgEventJSON is the spec representation of the struct.
 type chainReorgEventJSON struct {
-	Slot         string `json:"slot"`
-	Depth        string `json:"depth"`
-	OldHeadBlock string `json:"old_head_block"`
-	NewHeadBlock string `json:"new_head_block"`
-	OldHeadState string `json:"old_head_state"`
-	NewHeadState string `json:"new_head_state"`
-	Epoch        string `json:"epoch"`
+	Slot                string `json:"slot"`
+	Depth               string `json:"depth"`
+	OldHeadBlock        string `json:"old_head_block"`
+	NewHeadBlock        string `json:"new_head_block"`
+	OldHeadState        string `json:"old_head_state"`
+	NewHeadState        string `json:"new_head_state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
 func (e *ChainReorgEvent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&chainReorgEventJSON{
-		Slot:         fmt.Sprintf("%d", e.Slot),
-		Depth:        fmt.Sprintf("%d", e.Depth),
-		OldHeadBlock: fmt.Sprintf("%#x", e.OldHeadBlock),
-		NewHeadBlock: fmt.Sprintf("%#x", e.NewHeadBlock),
-		OldHeadState: fmt.Sprintf("%#x", e.OldHeadState),
-		NewHeadState: fmt.Sprintf("%#x", e.NewHeadState),
-		Epoch:        fmt.Sprintf("%d", e.Epoch),
+		Slot:                fmt.Sprintf("%d", e.Slot),
+		Depth:               fmt.Sprintf("%d", e.Depth),
+		OldHeadBlock:        fmt.Sprintf("%#x", e.OldHeadBlock),
+		NewHeadBlock:        fmt.Sprintf("%#x", e.NewHeadBlock),
+		OldHeadState:        fmt.Sprintf("%#x", e.OldHeadState),
+		NewHeadState:        fmt.Sprintf("%#x", e.NewHeadState),
+		Epoch:               fmt.Sprintf("%d", e.Epoch),
+		ExecutionOptimistic: e.ExecutionOptimistic,
 	})
 }
 
@@ -133,6 +137,7 @@ func (e *ChainReorgEvent) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "invalid value for epoch")
 	}
 	e.Epoch = phase0.Epoch(epoch)
+	e.ExecutionOptimistic = chainReorgEventJSON.ExecutionOptimistic
 
 	return nil
 }
@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api/v1/deneb"
+	specdeneb "github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
+	require "github.com/stretchr/testify/require"
+)
+
+func testSignedBuilderBid() *deneb.SignedBuilderBid {
+	return &deneb.SignedBuilderBid{
+		Message: &deneb.BuilderBid{
+			Header: &specdeneb.ExecutionPayloadHeader{
+				ParentHash:    phase0.Hash32{0x01},
+				BlockNumber:   100,
+				GasLimit:      30000000,
+				Timestamp:     1700000000,
+				ExtraData:     []byte{},
+				BaseFeePerGas: uint256.NewInt(7),
+				BlockHash:     phase0.Hash32{0x05},
+				BlobGasUsed:   131072,
+			},
+			BlobKzgCommitments: []specdeneb.KzgCommitment{{0x08}, {0x09}},
+			Value:              uint256.NewInt(123456789),
+			Pubkey:             phase0.BLSPubKey{0x06},
+		},
+		Signature: phase0.BLSSignature{0x07},
+	}
+}
+
+func TestBuilderBidBlobKzgCommitmentsJSON(t *testing.T) {
+	header, err := json.Marshal(testSignedBuilderBid().Message.Header)
+	require.NoError(t, err)
+	pubKey := `"0x060000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"`
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "BlobKzgCommitmentsMissing",
+			input: []byte(fmt.Sprintf(`{"header":%s,"value":"1","pubkey":%s}`, header, pubKey)),
+			err:   "blob KZG commitments missing",
+		},
+		{
+			name:  "BlobKzgCommitmentInvalid",
+			input: []byte(fmt.Sprintf(`{"header":%s,"blob_kzg_commitments":["invalid"],"value":"1","pubkey":%s}`, header, pubKey)),
+			err:   "failed to parse blob KZG commitment: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "BlobKzgCommitmentShort",
+			input: []byte(fmt.Sprintf(`{"header":%s,"blob_kzg_commitments":["0x0102"],"value":"1","pubkey":%s}`, header, pubKey)),
+			err:   "incorrect length for blob KZG commitment",
+		},
+		{
+			name:  "Good",
+			input: []byte(fmt.Sprintf(`{"header":%s,"blob_kzg_commitments":[],"value":"1","pubkey":%s}`, header, pubKey)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res deneb.BuilderBid
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSignedBuilderBidRoundTrip(t *testing.T) {
+	bid := testSignedBuilderBid()
+
+	jsonData, err := json.Marshal(bid)
+	require.NoError(t, err)
+	var jsonRes deneb.SignedBuilderBid
+	require.NoError(t, json.Unmarshal(jsonData, &jsonRes))
+	require.Equal(t, bid, &jsonRes)
+
+	yamlData, err := yaml.Marshal(bid)
+	require.NoError(t, err)
+	var yamlRes deneb.SignedBuilderBid
+	require.NoError(t, yaml.Unmarshal(yamlData, &yamlRes))
+	require.Equal(t, bid, &yamlRes)
+
+	sszData, err := bid.MarshalSSZ()
+	require.NoError(t, err)
+	var sszRes deneb.SignedBuilderBid
+	require.NoError(t, sszRes.UnmarshalSSZ(sszData))
+	require.Equal(t, bid, &sszRes)
+
+	root, err := bid.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := sszRes.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// lightClientHeaderJSON is the spec representation of the struct.
+type lightClientHeaderJSON struct {
+	Beacon          *phase0.BeaconBlockHeader     `json:"beacon"`
+	Execution       *deneb.ExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []phase0.Root                 `json:"execution_branch"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *LightClientHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&lightClientHeaderJSON{
+		Beacon:          h.Beacon,
+		Execution:       h.Execution,
+		ExecutionBranch: h.ExecutionBranch,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (h *LightClientHeader) UnmarshalJSON(input []byte) error {
+	var data lightClientHeaderJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return h.unpack(&data)
+}
+
+func (h *LightClientHeader) unpack(data *lightClientHeaderJSON) error {
+	if data.Beacon == nil {
+		return errors.New("beacon header missing")
+	}
+	h.Beacon = data.Beacon
+	if data.Execution == nil {
+		return errors.New("execution payload header missing")
+	}
+	h.Execution = data.Execution
+	if data.ExecutionBranch == nil {
+		return errors.New("execution branch missing")
+	}
+	if len(data.ExecutionBranch) != 4 {
+		return errors.New("incorrect length for execution branch")
+	}
+	h.ExecutionBranch = data.ExecutionBranch
+
+	return nil
+}
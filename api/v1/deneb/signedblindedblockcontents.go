@@ -14,9 +14,13 @@
 package deneb
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	ssz "github.com/ferranbt/fastssz"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
 // SignedBlindedBlockContents represents the contents of a block, both block and blob.
@@ -33,3 +37,90 @@ func (s *SignedBlindedBlockContents) String() string {
 	}
 	return string(data)
 }
+
+// Unblind returns the full signed block contents obtained by combining the signed
+// blinded block contents with the execution payload and blobs bundle returned by
+// the builder.
+// The signatures of the blinded block and blinded blob sidecars are carried over,
+// as the roots of the blinded and full structures are identical.
+func (s *SignedBlindedBlockContents) Unblind(bundle *ExecutionPayloadAndBlobsBundle) (*SignedBlockContents, error) {
+	if s.SignedBlindedBlock == nil {
+		return nil, errors.New("no signed blinded block")
+	}
+	if bundle == nil {
+		return nil, errors.New("no execution payload and blobs bundle supplied")
+	}
+	if bundle.BlobsBundle == nil {
+		return nil, errors.New("no blobs bundle supplied")
+	}
+
+	signedBlock, err := s.SignedBlindedBlock.Unblind(bundle.ExecutionPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	blobsBundle := bundle.BlobsBundle
+	commitments := signedBlock.Message.Body.BlobKzgCommitments
+	if len(blobsBundle.Commitments) != len(commitments) ||
+		len(blobsBundle.Proofs) != len(commitments) ||
+		len(blobsBundle.Blobs) != len(commitments) {
+		return nil, fmt.Errorf("blobs bundle has %d commitments, %d proofs and %d blobs but block has %d commitments",
+			len(blobsBundle.Commitments), len(blobsBundle.Proofs), len(blobsBundle.Blobs), len(commitments))
+	}
+	if len(s.SignedBlindedBlobSidecars) != len(commitments) {
+		return nil, fmt.Errorf("block has %d commitments but %d blinded blob sidecars supplied", len(commitments), len(s.SignedBlindedBlobSidecars))
+	}
+
+	signedBlobSidecars := make([]*deneb.SignedBlobSidecar, len(s.SignedBlindedBlobSidecars))
+	for i, signedBlindedBlobSidecar := range s.SignedBlindedBlobSidecars {
+		if signedBlindedBlobSidecar == nil || signedBlindedBlobSidecar.Message == nil {
+			return nil, fmt.Errorf("blinded blob sidecar %d missing", i)
+		}
+		blindedBlobSidecar := signedBlindedBlobSidecar.Message
+		index := int(blindedBlobSidecar.Index)
+		if index >= len(commitments) {
+			return nil, fmt.Errorf("blinded blob sidecar %d has out of range index %d", i, index)
+		}
+		if !bytes.Equal(blobsBundle.Commitments[index][:], commitments[index][:]) ||
+			!bytes.Equal(blindedBlobSidecar.KzgCommitment[:], commitments[index][:]) {
+			return nil, fmt.Errorf("commitment mismatch for blob %d", index)
+		}
+		if !bytes.Equal(blindedBlobSidecar.KzgProof[:], blobsBundle.Proofs[index][:]) {
+			return nil, fmt.Errorf("proof mismatch for blob %d", index)
+		}
+		blobRoot, err := blobRoot(&blobsBundle.Blobs[index])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to calculate root for blob %d", index)
+		}
+		if !bytes.Equal(blindedBlobSidecar.BlobRoot[:], blobRoot[:]) {
+			return nil, fmt.Errorf("root mismatch for blob %d", index)
+		}
+
+		signedBlobSidecars[i] = &deneb.SignedBlobSidecar{
+			Message: &deneb.BlobSidecar{
+				BlockRoot:       blindedBlobSidecar.BlockRoot,
+				Index:           blindedBlobSidecar.Index,
+				Slot:            blindedBlobSidecar.Slot,
+				BlockParentRoot: blindedBlobSidecar.BlockParentRoot,
+				ProposerIndex:   blindedBlobSidecar.ProposerIndex,
+				Blob:            blobsBundle.Blobs[index],
+				KzgCommitment:   blindedBlobSidecar.KzgCommitment,
+				KzgProof:        blindedBlobSidecar.KzgProof,
+			},
+			Signature: signedBlindedBlobSidecar.Signature,
+		}
+	}
+
+	return &SignedBlockContents{
+		SignedBlock:        signedBlock,
+		SignedBlobSidecars: signedBlobSidecars,
+	}, nil
+}
+
+// blobRoot calculates the hash tree root of a blob.
+func blobRoot(blob *deneb.Blob) ([32]byte, error) {
+	hh := ssz.NewHasher()
+	hh.PutBytes(blob[:])
+
+	return hh.HashRoot()
+}
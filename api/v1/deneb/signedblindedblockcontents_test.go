@@ -19,7 +19,14 @@ import (
 	"testing"
 
 	"github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	spec "github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	utildeneb "github.com/attestantio/go-eth2-client/util/deneb"
 	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/assert"
 	require "github.com/stretchr/testify/require"
 )
@@ -126,3 +133,124 @@ func TestSignedBlindedBlockContentsYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestSignedBlindedBlockContentsUnblind(t *testing.T) {
+	payload := &spec.ExecutionPayload{
+		BlockHash:     phase0.Hash32{0x01},
+		Transactions:  []bellatrix.Transaction{},
+		Withdrawals:   []*capella.Withdrawal{},
+		BaseFeePerGas: uint256.NewInt(7),
+	}
+	blob := spec.Blob{0x01, 0x02, 0x03}
+	blobRoot, err := (&utildeneb.BeaconBlockBlob{Blob: blob}).HashTreeRoot()
+	require.NoError(t, err)
+	commitment := spec.KzgCommitment{0x04}
+	proof := spec.KzgProof{0x05}
+
+	blindedContents := func() *deneb.SignedBlindedBlockContents {
+		return &deneb.SignedBlindedBlockContents{
+			SignedBlindedBlock: &deneb.SignedBlindedBeaconBlock{
+				Message: &deneb.BlindedBeaconBlock{
+					Slot: 1,
+					Body: &deneb.BlindedBeaconBlockBody{
+						ETH1Data:               &phase0.ETH1Data{},
+						SyncAggregate:          &altair.SyncAggregate{},
+						ExecutionPayloadHeader: &spec.ExecutionPayloadHeader{BlockHash: phase0.Hash32{0x01}},
+						BlobKzgCommitments:     []spec.KzgCommitment{commitment},
+					},
+				},
+				Signature: phase0.BLSSignature{0x06},
+			},
+			SignedBlindedBlobSidecars: []*deneb.SignedBlindedBlobSidecar{
+				{
+					Message: &deneb.BlindedBlobSidecar{
+						Slot:          1,
+						BlobRoot:      blobRoot,
+						KzgCommitment: commitment,
+						KzgProof:      proof,
+					},
+					Signature: phase0.BLSSignature{0x07},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		contents *deneb.SignedBlindedBlockContents
+		bundle   *deneb.ExecutionPayloadAndBlobsBundle
+		err      string
+	}{
+		{
+			name:     "BundleMissing",
+			contents: blindedContents(),
+			err:      "no execution payload and blobs bundle supplied",
+		},
+		{
+			name:     "BlockHashMismatch",
+			contents: blindedContents(),
+			bundle: &deneb.ExecutionPayloadAndBlobsBundle{
+				ExecutionPayload: &spec.ExecutionPayload{BlockHash: phase0.Hash32{0x02}},
+				BlobsBundle:      &deneb.BlobsBundle{},
+			},
+			err: "execution payload block hash does not match blinded block",
+		},
+		{
+			name:     "BlobCountMismatch",
+			contents: blindedContents(),
+			bundle: &deneb.ExecutionPayloadAndBlobsBundle{
+				ExecutionPayload: payload,
+				BlobsBundle:      &deneb.BlobsBundle{},
+			},
+			err: "blobs bundle has 0 commitments, 0 proofs and 0 blobs but block has 1 commitments",
+		},
+		{
+			name:     "BlobMismatch",
+			contents: blindedContents(),
+			bundle: &deneb.ExecutionPayloadAndBlobsBundle{
+				ExecutionPayload: payload,
+				BlobsBundle: &deneb.BlobsBundle{
+					Commitments: []spec.KzgCommitment{commitment},
+					Proofs:      []spec.KzgProof{proof},
+					Blobs:       []spec.Blob{{0x08}},
+				},
+			},
+			err: "root mismatch for blob 0",
+		},
+		{
+			name:     "Good",
+			contents: blindedContents(),
+			bundle: &deneb.ExecutionPayloadAndBlobsBundle{
+				ExecutionPayload: payload,
+				BlobsBundle: &deneb.BlobsBundle{
+					Commitments: []spec.KzgCommitment{commitment},
+					Proofs:      []spec.KzgProof{proof},
+					Blobs:       []spec.Blob{blob},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.contents.Unblind(test.bundle)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, payload, res.SignedBlock.Message.Body.ExecutionPayload)
+				require.Equal(t, test.contents.SignedBlindedBlock.Signature, res.SignedBlock.Signature)
+				require.Len(t, res.SignedBlobSidecars, 1)
+				require.Equal(t, blob, res.SignedBlobSidecars[0].Message.Blob)
+				require.Equal(t, test.contents.SignedBlindedBlobSidecars[0].Signature, res.SignedBlobSidecars[0].Signature)
+
+				// Roots of blinded and unblinded structures should match.
+				blindedRoot, err := test.contents.SignedBlindedBlobSidecars[0].Message.HashTreeRoot()
+				require.NoError(t, err)
+				root, err := res.SignedBlobSidecars[0].Message.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, blindedRoot, root)
+			}
+		})
+	}
+}
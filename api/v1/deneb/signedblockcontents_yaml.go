@@ -15,21 +15,24 @@ package deneb
 
 import (
 	"bytes"
+	"encoding/json"
 
+	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
 )
 
 // signedBlockContentsYAML is the spec representation of the struct.
 type signedBlockContentsYAML struct {
-	Message   *BlockContents `yaml:"message"`
-	Signature string         `yaml:"signature"`
+	SignedBlock        *deneb.SignedBeaconBlock   `json:"signed_block"`
+	SignedBlobSidecars []*deneb.SignedBlobSidecar `json:"signed_blob_sidecars"`
 }
 
 // MarshalYAML implements yaml.Marshaler.
 func (s *SignedBlockContents) MarshalYAML() ([]byte, error) {
 	yamlBytes, err := yaml.MarshalWithOptions(&signedBlockContentsYAML{
-		Message:   s.Message,
-		Signature: s.Signature.String(),
+		SignedBlock:        s.SignedBlock,
+		SignedBlobSidecars: s.SignedBlobSidecars,
 	}, yaml.Flow(true))
 	if err != nil {
 		return nil, err
@@ -39,10 +42,14 @@ func (s *SignedBlockContents) MarshalYAML() ([]byte, error) {
 
 // UnmarshalYAML implements yaml.Unmarshaler.
 func (s *SignedBlockContents) UnmarshalYAML(input []byte) error {
-	// We unmarshal to the JSON struct to save on duplicate code.
-	var data signedBlockContentsJSON
+	var data signedBlockContentsYAML
 	if err := yaml.Unmarshal(input, &data); err != nil {
-		return err
+		return errors.Wrap(err, "failed to unmarshal YAML")
 	}
-	return s.unpack(&data)
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+
+	return s.UnmarshalJSON(bytes)
 }
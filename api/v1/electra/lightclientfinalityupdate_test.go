@@ -0,0 +1,150 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !minimal
+
+package electra_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+func testBranch(length int) []phase0.Root {
+	branch := make([]phase0.Root, length)
+	for i := range branch {
+		branch[i] = phase0.Root{byte(i + 1)}
+	}
+
+	return branch
+}
+
+func testLightClientHeader(slot phase0.Slot) *apiv1deneb.LightClientHeader {
+	return &apiv1deneb.LightClientHeader{
+		Beacon: &phase0.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: 1,
+			ParentRoot:    phase0.Root{0x01},
+			StateRoot:     phase0.Root{0x02},
+			BodyRoot:      phase0.Root{0x03},
+		},
+		Execution: &deneb.ExecutionPayloadHeader{
+			ParentHash:    phase0.Hash32{0x04},
+			BlockNumber:   uint64(slot),
+			GasLimit:      30000000,
+			ExtraData:     []byte{0x05},
+			BaseFeePerGas: uint256.NewInt(7),
+			BlockHash:     phase0.Hash32{0x06},
+		},
+		ExecutionBranch: testBranch(4),
+	}
+}
+
+// testLightClientFinalityUpdate returns an update sized for the mainnet preset, which the JSON and YAML
+// encodings of sync committees require, so these tests are not built with the minimal preset.
+func testLightClientFinalityUpdate() *electra.LightClientFinalityUpdate {
+	return &electra.LightClientFinalityUpdate{
+		AttestedHeader:  testLightClientHeader(100),
+		FinalizedHeader: testLightClientHeader(64),
+		FinalityBranch:  testBranch(7),
+		SyncAggregate: &altair.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: phase0.BLSSignature{0x08},
+		},
+		SignatureSlot: 101,
+	}
+}
+
+func TestLightClientFinalityUpdateRoundTrip(t *testing.T) {
+	update := testLightClientFinalityUpdate()
+
+	data, err := json.Marshal(update)
+	require.NoError(t, err)
+	var jsonRes electra.LightClientFinalityUpdate
+	require.NoError(t, json.Unmarshal(data, &jsonRes))
+	require.Equal(t, update, &jsonRes)
+
+	data, err = yaml.Marshal(update)
+	require.NoError(t, err)
+	var yamlRes electra.LightClientFinalityUpdate
+	require.NoError(t, yaml.Unmarshal(data, &yamlRes))
+	require.Equal(t, update, &yamlRes)
+
+	data, err = update.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, update.SizeSSZ())
+	var sszRes electra.LightClientFinalityUpdate
+	require.NoError(t, sszRes.UnmarshalSSZ(data))
+	require.Equal(t, update, &sszRes)
+}
+
+func TestLightClientFinalityUpdateJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*testing.T, map[string]json.RawMessage)
+		err    string
+	}{
+		{
+			name:   "FinalizedHeaderMissing",
+			modify: func(_ *testing.T, data map[string]json.RawMessage) { delete(data, "finalized_header") },
+			err:    "finalized header missing",
+		},
+		{
+			name: "FinalityBranchAltairLength",
+			modify: func(t *testing.T, data map[string]json.RawMessage) {
+				branch, err := json.Marshal(testBranch(6))
+				require.NoError(t, err)
+				data["finality_branch"] = branch
+			},
+			err: "incorrect length for finality branch",
+		},
+		{
+			name: "ExecutionBranchMissing",
+			modify: func(t *testing.T, data map[string]json.RawMessage) {
+				header := make(map[string]json.RawMessage)
+				require.NoError(t, json.Unmarshal(data["attested_header"], &header))
+				delete(header, "execution_branch")
+				attestedHeader, err := json.Marshal(header)
+				require.NoError(t, err)
+				data["attested_header"] = attestedHeader
+			},
+			err: "invalid JSON: execution branch missing",
+		},
+	}
+
+	input, err := json.Marshal(testLightClientFinalityUpdate())
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := make(map[string]json.RawMessage)
+			require.NoError(t, json.Unmarshal(input, &data))
+			test.modify(t, data)
+			modified, err := json.Marshal(data)
+			require.NoError(t, err)
+
+			var res electra.LightClientFinalityUpdate
+			require.EqualError(t, json.Unmarshal(modified, &res), test.err)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// SignedBlindedBeaconBlock is a signed beacon block.
+type SignedBlindedBeaconBlock struct {
+	Message   *BlindedBeaconBlock
+	Signature phase0.BLSSignature `ssz-size:"96"`
+}
+
+// String returns a string version of the structure.
+func (s *SignedBlindedBeaconBlock) String() string {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
+
+// Unblind returns the full signed beacon block obtained by replacing the execution
+// payload header of the blinded block with the supplied execution payload.
+func (s *SignedBlindedBeaconBlock) Unblind(payload *deneb.ExecutionPayload) (*electra.SignedBeaconBlock, error) {
+	if s.Message == nil || s.Message.Body == nil || s.Message.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.New("no execution payload header in blinded block")
+	}
+	if payload == nil {
+		return nil, errors.New("no execution payload supplied")
+	}
+	if !bytes.Equal(s.Message.Body.ExecutionPayloadHeader.BlockHash[:], payload.BlockHash[:]) {
+		return nil, errors.New("execution payload block hash does not match blinded block")
+	}
+
+	m := s.Message
+	return &electra.SignedBeaconBlock{
+		Message: &electra.BeaconBlock{
+			Slot:          m.Slot,
+			ProposerIndex: m.ProposerIndex,
+			ParentRoot:    m.ParentRoot,
+			StateRoot:     m.StateRoot,
+			Body: &electra.BeaconBlockBody{
+				RANDAOReveal:          m.Body.RANDAOReveal,
+				ETH1Data:              m.Body.ETH1Data,
+				Graffiti:              m.Body.Graffiti,
+				ProposerSlashings:     m.Body.ProposerSlashings,
+				AttesterSlashings:     m.Body.AttesterSlashings,
+				Attestations:          m.Body.Attestations,
+				Deposits:              m.Body.Deposits,
+				VoluntaryExits:        m.Body.VoluntaryExits,
+				SyncAggregate:         m.Body.SyncAggregate,
+				ExecutionPayload:      payload,
+				BLSToExecutionChanges: m.Body.BLSToExecutionChanges,
+				BlobKzgCommitments:    m.Body.BlobKzgCommitments,
+				ExecutionRequests:     m.Body.ExecutionRequests,
+			},
+		},
+		Signature: s.Signature,
+	}, nil
+}
+
+// BlindSignedBeaconBlock returns the signed blinded beacon block obtained by replacing
+// the execution payload of the supplied block with its execution payload header.
+// The signature is unchanged, as the blinded and full blocks share the same root.
+func BlindSignedBeaconBlock(block *electra.SignedBeaconBlock) (*SignedBlindedBeaconBlock, error) {
+	if block == nil || block.Message == nil || block.Message.Body == nil {
+		return nil, errors.New("no block supplied")
+	}
+	header, err := deneb.HeaderFromPayload(block.Message.Body.ExecutionPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain execution payload header")
+	}
+
+	b := block.Message
+	return &SignedBlindedBeaconBlock{
+		Message: &BlindedBeaconBlock{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     b.StateRoot,
+			Body: &BlindedBeaconBlockBody{
+				RANDAOReveal:           b.Body.RANDAOReveal,
+				ETH1Data:               b.Body.ETH1Data,
+				Graffiti:               b.Body.Graffiti,
+				ProposerSlashings:      b.Body.ProposerSlashings,
+				AttesterSlashings:      b.Body.AttesterSlashings,
+				Attestations:           b.Body.Attestations,
+				Deposits:               b.Body.Deposits,
+				VoluntaryExits:         b.Body.VoluntaryExits,
+				SyncAggregate:          b.Body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+				BLSToExecutionChanges:  b.Body.BLSToExecutionChanges,
+				BlobKzgCommitments:     b.Body.BlobKzgCommitments,
+				ExecutionRequests:      b.Body.ExecutionRequests,
+			},
+		},
+		Signature: block.Signature,
+	}, nil
+}
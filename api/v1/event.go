@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -32,14 +33,18 @@ type Event struct {
 
 // SupportedEventTopics is a map of supported event topics.
 var SupportedEventTopics = map[string]bool{
-	"attestation":            true,
-	"block":                  true,
-	"chain_reorg":            true,
-	"finalized_checkpoint":   true,
-	"head":                   true,
-	"voluntary_exit":         true,
-	"contribution_and_proof": true,
-	"payload_attributes":     true,
+	"attestation":             true,
+	"block":                   true,
+	"chain_reorg":             true,
+	"finalized_checkpoint":    true,
+	"head":                    true,
+	"voluntary_exit":          true,
+	"contribution_and_proof":  true,
+	"payload_attributes":      true,
+	"blob_sidecar":            true,
+	"proposer_slashing":       true,
+	"attester_slashing":       true,
+	"bls_to_execution_change": true,
 }
 
 // eventJSON is the spec representation of the struct.
@@ -99,6 +104,14 @@ func (e *Event) UnmarshalJSON(input []byte) error {
 		e.Data = &altair.SignedContributionAndProof{}
 	case "payload_attributes":
 		e.Data = &PayloadAttributesEvent{}
+	case "blob_sidecar":
+		e.Data = &BlobSidecarEvent{}
+	case "proposer_slashing":
+		e.Data = &phase0.ProposerSlashing{}
+	case "attester_slashing":
+		e.Data = &phase0.AttesterSlashing{}
+	case "bls_to_execution_change":
+		e.Data = &capella.SignedBLSToExecutionChange{}
 	default:
 		return fmt.Errorf("unsupported event topic %s", eventJSON.Topic)
 	}
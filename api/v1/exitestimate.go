@@ -0,0 +1,120 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ExitEstimate provides an estimate of when a validator would exit and become
+// withdrawable if it were to request a voluntary exit now.
+type ExitEstimate struct {
+	ValidatorIndex    phase0.ValidatorIndex
+	ExitEpoch         phase0.Epoch
+	WithdrawableEpoch phase0.Epoch
+	ExitTime          time.Time
+	WithdrawableTime  time.Time
+}
+
+// exitEstimateJSON is the spec representation of the struct.
+type exitEstimateJSON struct {
+	ValidatorIndex    string `json:"validator_index"`
+	ExitEpoch         string `json:"exit_epoch"`
+	WithdrawableEpoch string `json:"withdrawable_epoch"`
+	ExitTime          string `json:"exit_time"`
+	WithdrawableTime  string `json:"withdrawable_time"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e *ExitEstimate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&exitEstimateJSON{
+		ValidatorIndex:    fmt.Sprintf("%d", e.ValidatorIndex),
+		ExitEpoch:         fmt.Sprintf("%d", e.ExitEpoch),
+		WithdrawableEpoch: fmt.Sprintf("%d", e.WithdrawableEpoch),
+		ExitTime:          fmt.Sprintf("%d", e.ExitTime.Unix()),
+		WithdrawableTime:  fmt.Sprintf("%d", e.WithdrawableTime.Unix()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *ExitEstimate) UnmarshalJSON(input []byte) error {
+	var err error
+
+	var exitEstimateJSON exitEstimateJSON
+	if err = json.Unmarshal(input, &exitEstimateJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if exitEstimateJSON.ValidatorIndex == "" {
+		return errors.New("validator index missing")
+	}
+	validatorIndex, err := strconv.ParseUint(exitEstimateJSON.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for validator index")
+	}
+	e.ValidatorIndex = phase0.ValidatorIndex(validatorIndex)
+
+	if exitEstimateJSON.ExitEpoch == "" {
+		return errors.New("exit epoch missing")
+	}
+	exitEpoch, err := strconv.ParseUint(exitEstimateJSON.ExitEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for exit epoch")
+	}
+	e.ExitEpoch = phase0.Epoch(exitEpoch)
+
+	if exitEstimateJSON.WithdrawableEpoch == "" {
+		return errors.New("withdrawable epoch missing")
+	}
+	withdrawableEpoch, err := strconv.ParseUint(exitEstimateJSON.WithdrawableEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for withdrawable epoch")
+	}
+	e.WithdrawableEpoch = phase0.Epoch(withdrawableEpoch)
+
+	if exitEstimateJSON.ExitTime == "" {
+		return errors.New("exit time missing")
+	}
+	exitTime, err := strconv.ParseInt(exitEstimateJSON.ExitTime, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for exit time")
+	}
+	e.ExitTime = time.Unix(exitTime, 0)
+
+	if exitEstimateJSON.WithdrawableTime == "" {
+		return errors.New("withdrawable time missing")
+	}
+	withdrawableTime, err := strconv.ParseInt(exitEstimateJSON.WithdrawableTime, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for withdrawable time")
+	}
+	e.WithdrawableTime = time.Unix(withdrawableTime, 0)
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (e *ExitEstimate) String() string {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
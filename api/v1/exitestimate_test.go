@@ -0,0 +1,126 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestExitEstimateJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type v1.exitEstimateJSON",
+		},
+		{
+			name:  "ValidatorIndexMissing",
+			input: []byte(`{"exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "validator index missing",
+		},
+		{
+			name:  "ValidatorIndexWrongType",
+			input: []byte(`{"validator_index":true,"exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field exitEstimateJSON.validator_index of type string",
+		},
+		{
+			name:  "ValidatorIndexInvalid",
+			input: []byte(`{"validator_index":"-1","exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "invalid value for validator index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ExitEpochMissing",
+			input: []byte(`{"validator_index":"12345","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "exit epoch missing",
+		},
+		{
+			name:  "ExitEpochWrongType",
+			input: []byte(`{"validator_index":"12345","exit_epoch":true,"withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field exitEstimateJSON.exit_epoch of type string",
+		},
+		{
+			name:  "ExitEpochInvalid",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"-1","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "invalid value for exit epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "WithdrawableEpochMissing",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "withdrawable epoch missing",
+		},
+		{
+			name:  "WithdrawableEpochWrongType",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":true,"exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field exitEstimateJSON.withdrawable_epoch of type string",
+		},
+		{
+			name:  "WithdrawableEpochInvalid",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":"-1","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+			err:   "invalid value for withdrawable epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ExitTimeMissing",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":"200256","withdrawable_time":"1683495527"}`),
+			err:   "exit time missing",
+		},
+		{
+			name:  "ExitTimeWrongType",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":true,"withdrawable_time":"1683495527"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field exitEstimateJSON.exit_time of type string",
+		},
+		{
+			name:  "WithdrawableTimeMissing",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":"1683397223"}`),
+			err:   "withdrawable time missing",
+		},
+		{
+			name:  "WithdrawableTimeWrongType",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":true}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field exitEstimateJSON.withdrawable_time of type string",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"validator_index":"12345","exit_epoch":"200000","withdrawable_epoch":"200256","exit_time":"1683397223","withdrawable_time":"1683495527"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ExitEstimate
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
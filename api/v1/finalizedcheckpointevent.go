@@ -29,21 +29,25 @@ type FinalizedCheckpointEvent struct {
 	Block phase0.Root
 	State phase0.Root
 	Epoch phase0.Epoch
+	// ExecutionOptimistic is true if the block has not yet been fully verified by an execution client.
+	ExecutionOptimistic bool
 }
 
 // finalizedCheckpointEventJSON is the spec representation of the struct.
 type finalizedCheckpointEventJSON struct {
-	Block string `json:"block"`
-	State string `json:"state"`
-	Epoch string `json:"epoch"`
+	Block               string `json:"block"`
+	State               string `json:"state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic,omitempty"`
 }
 
 // MarshalJSON implements json.Marshaler.
 func (e *FinalizedCheckpointEvent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&finalizedCheckpointEventJSON{
-		Block: fmt.Sprintf("%#x", e.Block),
-		State: fmt.Sprintf("%#x", e.State),
-		Epoch: fmt.Sprintf("%d", e.Epoch),
+		Block:               fmt.Sprintf("%#x", e.Block),
+		State:               fmt.Sprintf("%#x", e.State),
+		Epoch:               fmt.Sprintf("%d", e.Epoch),
+		ExecutionOptimistic: e.ExecutionOptimistic,
 	})
 }
 
@@ -85,6 +89,7 @@ func (e *FinalizedCheckpointEvent) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "invalid value for epoch")
 	}
 	e.Epoch = phase0.Epoch(epoch)
+	e.ExecutionOptimistic = finalizedCheckpointEventJSON.ExecutionOptimistic
 
 	return nil
 }
@@ -106,6 +106,10 @@ func TestFinalizedCheckpointEventJSON(t *testing.T) {
 			name:  "Good",
 			input: []byte(`{"block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch":"2"}`),
 		},
+		{
+			name:  "ExecutionOptimistic",
+			input: []byte(`{"block":"0x99e3f24aab3dd084045a0c927a33b8463eb5c7b17eeadfecdcf4e4badf7b6028","state":"0x749a95b1355828b758864ea601c007e69aabed7b34a0f2084c43c26242f77e28","epoch":"2","execution_optimistic":true}`),
+		},
 	}
 
 	for _, test := range tests {
@@ -14,6 +14,7 @@
 package v1
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -32,6 +33,8 @@ type ForkChoice struct {
 	FinalizedCheckpoint phase0.Checkpoint
 	// ForkChoiceNodes contains the fork choice nodes.
 	ForkChoiceNodes []*ForkChoiceNode
+	// ExtraData is any additional client-specific data.
+	ExtraData map[string]interface{}
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -40,6 +43,7 @@ func (f *ForkChoice) MarshalJSON() ([]byte, error) {
 		JustifiedCheckpoint: &f.JustifiedCheckpoint,
 		FinalizedCheckpoint: &f.FinalizedCheckpoint,
 		ForkChoiceNodes:     f.ForkChoiceNodes,
+		ExtraData:           f.ExtraData,
 	})
 }
 
@@ -66,6 +70,7 @@ func (f *ForkChoice) UnmarshalJSON(input []byte) error {
 		return errors.New("fork choice nodes missing")
 	}
 	f.ForkChoiceNodes = forkChoiceJSON.ForkChoiceNodes
+	f.ExtraData = forkChoiceJSON.ExtraData
 
 	return nil
 }
@@ -79,11 +84,64 @@ func (f *ForkChoice) String() string {
 	return string(data)
 }
 
+// Node returns the fork choice node with the given block root, or nil if it is not present.
+func (f *ForkChoice) Node(blockRoot phase0.Root) *ForkChoiceNode {
+	for _, node := range f.ForkChoiceNodes {
+		if node.BlockRoot == blockRoot {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// Children returns the fork choice nodes whose parent has the given block root.
+func (f *ForkChoice) Children(blockRoot phase0.Root) []*ForkChoiceNode {
+	children := make([]*ForkChoiceNode, 0)
+	for _, node := range f.ForkChoiceNodes {
+		if node.ParentRoot == blockRoot {
+			children = append(children, node)
+		}
+	}
+
+	return children
+}
+
+// Head returns the head of the chain as selected by the fork choice rule: starting at
+// the justified checkpoint, repeatedly move to the child with the highest weight, ties
+// broken by the lexicographically higher block root.  Invalid nodes are ignored.
+// Returns nil if the justified checkpoint is not one of the nodes.
+func (f *ForkChoice) Head() *ForkChoiceNode {
+	head := f.Node(f.JustifiedCheckpoint.Root)
+	if head == nil {
+		return nil
+	}
+
+	for {
+		var best *ForkChoiceNode
+		for _, child := range f.Children(head.BlockRoot) {
+			if child.Validity == ForkChoiceNodeValidityInvalid {
+				continue
+			}
+			if best == nil ||
+				child.Weight > best.Weight ||
+				(child.Weight == best.Weight && bytes.Compare(child.BlockRoot[:], best.BlockRoot[:]) > 0) {
+				best = child
+			}
+		}
+		if best == nil {
+			return head
+		}
+		head = best
+	}
+}
+
 // forkChoiceJSON is the json representation of the struct.
 type forkChoiceJSON struct {
-	JustifiedCheckpoint *phase0.Checkpoint `json:"justified_checkpoint"`
-	FinalizedCheckpoint *phase0.Checkpoint `json:"finalized_checkpoint"`
-	ForkChoiceNodes     []*ForkChoiceNode  `json:"fork_choice_nodes"`
+	JustifiedCheckpoint *phase0.Checkpoint     `json:"justified_checkpoint"`
+	FinalizedCheckpoint *phase0.Checkpoint     `json:"finalized_checkpoint"`
+	ForkChoiceNodes     []*ForkChoiceNode      `json:"fork_choice_nodes"`
+	ExtraData           map[string]interface{} `json:"extra_data,omitempty"`
 }
 
 // ForkChoiceNodeValidity represents the validity of a fork choice node.
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,6 +32,10 @@ func TestForkChoiceJSON(t *testing.T) {
 			expected: `{"justified_checkpoint":{"epoch":"1","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"finalized_checkpoint":{"epoch":"2","root":"0x0100000000000000000000000000000000000000000000000000000000000000"},"fork_choice_nodes":[{"slot":"1962336","block_root":"0x0f61e82f7b51f41fcd552cbdc64547bd9e1ba54b8404482732927645c2e13ec6","parent_root":"0xe399a2ee74cf0570b4f980772983bdc5cfbfde1f87f3ab395f2bee96103978c7","justified_epoch":"61322","finalized_epoch":"61321","weight":"57481550000000","validity":"valid","execution_block_hash":"0x06a0277e02eae44c332bcec82d6715c3113dddce427982014cf5f43432f479e9","extra_data":{"justified_root":"0xdee6c83ee7dc6c0916a8d43c4e7cda93655857da0487f193a62852699e5c39f7","state_root":"0x4bcecf56081291ab95df1dba25b0f83343d38217e9cec198510b61f6f35afdb3","unrealised_finalized_epoch":"61321","unrealised_justified_epoch":"61322","unrealized_finalized_root":"0x57a41f26678190d3e319c19fe9f4ea3830c4b21710a1e1ae41adcc23d0f030a2","unrealized_justified_root":"0xdee6c83ee7dc6c0916a8d43c4e7cda93655857da0487f193a62852699e5c39f7"}}]}`,
 			err:      "",
 		},
+		{
+			name:  "ExtraData",
+			input: []byte(`{"justified_checkpoint":{"epoch":"1","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"finalized_checkpoint":{"epoch":"2","root":"0x0100000000000000000000000000000000000000000000000000000000000000"},"fork_choice_nodes":[],"extra_data":{"proposer_boost_root":"0x0000000000000000000000000000000000000000000000000000000000000000"}}`),
+		},
 		{
 			name:  "JustifiedCheckpointMissing",
 			input: []byte(`{"finalized_checkpoint":{"epoch":"2","root":"0x0100000000000000000000000000000000000000000000000000000000000000"},"fork_choice_nodes":[]}`),
@@ -157,3 +162,78 @@ func TestForkChoiceNodeJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestForkChoiceHead(t *testing.T) {
+	// Build a tree of:
+	//
+	//   1 -> 2 -> 4
+	//     -> 3 -> 5
+	//          -> 6 (invalid)
+	root := func(b byte) phase0.Root { return phase0.Root{b} }
+	node := func(b byte, parent byte, weight uint64, validity api.ForkChoiceNodeValidity) *api.ForkChoiceNode {
+		return &api.ForkChoiceNode{
+			BlockRoot:  root(b),
+			ParentRoot: root(parent),
+			Weight:     weight,
+			Validity:   validity,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		justified phase0.Root
+		nodes     []*api.ForkChoiceNode
+		expected  *phase0.Root
+	}{
+		{
+			name:      "JustifiedMissing",
+			justified: root(9),
+			nodes:     []*api.ForkChoiceNode{node(1, 0, 10, api.ForkChoiceNodeValidityValid)},
+		},
+		{
+			name:      "JustifiedOnly",
+			justified: root(1),
+			nodes:     []*api.ForkChoiceNode{node(1, 0, 10, api.ForkChoiceNodeValidityValid)},
+			expected:  &phase0.Root{1},
+		},
+		{
+			name:      "HeaviestBranch",
+			justified: root(1),
+			nodes: []*api.ForkChoiceNode{
+				node(1, 0, 10, api.ForkChoiceNodeValidityValid),
+				node(2, 1, 4, api.ForkChoiceNodeValidityValid),
+				node(3, 1, 6, api.ForkChoiceNodeValidityValid),
+				node(4, 2, 4, api.ForkChoiceNodeValidityValid),
+				node(5, 3, 2, api.ForkChoiceNodeValidityOptimistic),
+				node(6, 3, 4, api.ForkChoiceNodeValidityInvalid),
+			},
+			expected: &phase0.Root{5},
+		},
+		{
+			name:      "TieBreak",
+			justified: root(1),
+			nodes: []*api.ForkChoiceNode{
+				node(1, 0, 10, api.ForkChoiceNodeValidityValid),
+				node(2, 1, 5, api.ForkChoiceNodeValidityValid),
+				node(3, 1, 5, api.ForkChoiceNodeValidityValid),
+			},
+			expected: &phase0.Root{3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fc := &api.ForkChoice{
+				JustifiedCheckpoint: phase0.Checkpoint{Root: test.justified},
+				ForkChoiceNodes:     test.nodes,
+			}
+			head := fc.Head()
+			if test.expected == nil {
+				require.Nil(t, head)
+			} else {
+				require.NotNil(t, head)
+				require.Equal(t, *test.expected, head.BlockRoot)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@
 package v1
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
 //go:generate rm -f blindedbeaconblock_encoding.go signedblindedbeaconblock_encoding.go validatorregistration_encoding.go
-//go:generate sszgen -include ../../spec/phase0,../../spec/altair,../../spec/bellatrix -path . -objs BlindedBeaconBlock,SignedBlindedBeaconBlock,ValidatorRegistration
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET -include ../../spec/phase0,../../spec/altair,../../spec/bellatrix -path . -objs BlindedBeaconBlock,SignedBlindedBeaconBlock,ValidatorRegistration
 //go:generate goimports -w blindedbeaconblock_encoding.go signedblindedbeaconblock_encoding.go validatorregistration_encoding.go
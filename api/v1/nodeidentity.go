@@ -0,0 +1,168 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// NodeIdentity is the network identity of the node.
+type NodeIdentity struct {
+	// PeerID is the libp2p identifier of the node.
+	PeerID string
+	// ENR is the Ethereum node record of the node.
+	ENR string
+	// P2PAddresses are the multiaddrs on which the node listens for libp2p connections.
+	P2PAddresses []string
+	// DiscoveryAddresses are the multiaddrs on which the node listens for discovery.
+	DiscoveryAddresses []string
+	// Metadata is the metadata the node advertises to its peers.
+	Metadata *NodeMetadata
+}
+
+// NodeMetadata is the metadata a node advertises to its peers.
+type NodeMetadata struct {
+	// SeqNumber is incremented each time the metadata changes.
+	SeqNumber uint64
+	// Attnets are the attestation subnets to which the node is subscribed.
+	Attnets bitfield.Bitvector64
+	// Syncnets are the sync committee subnets to which the node is subscribed.
+	Syncnets bitfield.Bitvector4
+}
+
+// nodeIdentityJSON is the spec representation of the struct.
+type nodeIdentityJSON struct {
+	PeerID             string            `json:"peer_id"`
+	ENR                string            `json:"enr"`
+	P2PAddresses       []string          `json:"p2p_addresses"`
+	DiscoveryAddresses []string          `json:"discovery_addresses"`
+	Metadata           *nodeMetadataJSON `json:"metadata"`
+}
+
+// nodeMetadataJSON is the spec representation of the struct.
+type nodeMetadataJSON struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *NodeIdentity) MarshalJSON() ([]byte, error) {
+	var metadata *nodeMetadataJSON
+	if n.Metadata != nil {
+		metadata = &nodeMetadataJSON{
+			SeqNumber: fmt.Sprintf("%d", n.Metadata.SeqNumber),
+			Attnets:   fmt.Sprintf("%#x", []byte(n.Metadata.Attnets)),
+		}
+		if n.Metadata.Syncnets != nil {
+			metadata.Syncnets = fmt.Sprintf("%#x", []byte(n.Metadata.Syncnets))
+		}
+	}
+
+	return json.Marshal(&nodeIdentityJSON{
+		PeerID:             n.PeerID,
+		ENR:                n.ENR,
+		P2PAddresses:       n.P2PAddresses,
+		DiscoveryAddresses: n.DiscoveryAddresses,
+		Metadata:           metadata,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NodeIdentity) UnmarshalJSON(input []byte) error {
+	var nodeIdentityJSON nodeIdentityJSON
+	if err := json.Unmarshal(input, &nodeIdentityJSON); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if nodeIdentityJSON.PeerID == "" {
+		return errors.New("peer ID missing")
+	}
+	n.PeerID = nodeIdentityJSON.PeerID
+	if nodeIdentityJSON.ENR == "" {
+		return errors.New("ENR missing")
+	}
+	n.ENR = nodeIdentityJSON.ENR
+	if nodeIdentityJSON.P2PAddresses == nil {
+		return errors.New("p2p addresses missing")
+	}
+	n.P2PAddresses = nodeIdentityJSON.P2PAddresses
+	if nodeIdentityJSON.DiscoveryAddresses == nil {
+		return errors.New("discovery addresses missing")
+	}
+	n.DiscoveryAddresses = nodeIdentityJSON.DiscoveryAddresses
+	if nodeIdentityJSON.Metadata == nil {
+		return errors.New("metadata missing")
+	}
+	metadata, err := nodeIdentityJSON.Metadata.unpack()
+	if err != nil {
+		return err
+	}
+	n.Metadata = metadata
+
+	return nil
+}
+
+func (n *nodeMetadataJSON) unpack() (*NodeMetadata, error) {
+	var err error
+
+	metadata := &NodeMetadata{}
+	if n.SeqNumber == "" {
+		return nil, errors.New("sequence number missing")
+	}
+	metadata.SeqNumber, err = strconv.ParseUint(n.SeqNumber, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for sequence number")
+	}
+	if n.Attnets == "" {
+		return nil, errors.New("attnets missing")
+	}
+	attnets, err := hex.DecodeString(strings.TrimPrefix(n.Attnets, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for attnets")
+	}
+	if len(attnets) != 8 {
+		return nil, fmt.Errorf("incorrect length %d for attnets", len(attnets))
+	}
+	metadata.Attnets = bitfield.Bitvector64(attnets)
+	// Syncnets are not present in metadata prior to altair.
+	if n.Syncnets != "" {
+		syncnets, err := hex.DecodeString(strings.TrimPrefix(n.Syncnets, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid value for syncnets")
+		}
+		if len(syncnets) != 1 {
+			return nil, fmt.Errorf("incorrect length %d for syncnets", len(syncnets))
+		}
+		metadata.Syncnets = bitfield.Bitvector4(syncnets)
+	}
+
+	return metadata, nil
+}
+
+// String returns a string version of the structure.
+func (n *NodeIdentity) String() string {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestNodeIdentityJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "PeerIDMissing",
+			input: []byte(`{"enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003","syncnets":"0x01"}}`),
+			err:   "peer ID missing",
+		},
+		{
+			name:  "ENRMissing",
+			input: []byte(`{"peer_id":"a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003","syncnets":"0x01"}}`),
+			err:   "ENR missing",
+		},
+		{
+			name:  "P2PAddressesMissing",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003","syncnets":"0x01"}}`),
+			err:   "p2p addresses missing",
+		},
+		{
+			name:  "DiscoveryAddressesMissing",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003","syncnets":"0x01"}}`),
+			err:   "discovery addresses missing",
+		},
+		{
+			name:  "MetadataMissing",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"]}`),
+			err:   "metadata missing",
+		},
+		{
+			name:  "SeqNumberMissing",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"attnets":"0x0000000000000003","syncnets":"0x01"}}`),
+			err:   "sequence number missing",
+		},
+		{
+			name:  "AttnetsMissing",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","syncnets":"0x01"}}`),
+			err:   "attnets missing",
+		},
+		{
+			name:  "AttnetsShort",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x03","syncnets":"0x01"}}`),
+			err:   "incorrect length 1 for attnets",
+		},
+		{
+			name:  "SyncnetsLong",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003","syncnets":"0x0101"}}`),
+			err:   "incorrect length 2 for syncnets",
+		},
+		{
+			name:  "NoSyncnets",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003"}}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0000000000000003","syncnets":"0x01"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.NodeIdentity
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+				assert.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
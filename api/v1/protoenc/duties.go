@@ -0,0 +1,163 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protoenc
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// MarshalAttesterDuty encodes an attester duty.
+func MarshalAttesterDuty(duty *apiv1.AttesterDuty) ([]byte, error) {
+	if duty == nil {
+		return nil, errors.New("no duty supplied")
+	}
+
+	m := &message{}
+	m.bytes(1, duty.PubKey[:])
+	m.uint64(2, uint64(duty.Slot))
+	m.uint64(3, uint64(duty.ValidatorIndex))
+	m.uint64(4, uint64(duty.CommitteeIndex))
+	m.uint64(5, duty.CommitteeLength)
+	m.uint64(6, duty.CommitteesAtSlot)
+	m.uint64(7, duty.ValidatorCommitteeIndex)
+
+	return m.buf, nil
+}
+
+// UnmarshalAttesterDuty decodes an attester duty.
+func UnmarshalAttesterDuty(data []byte) (*apiv1.AttesterDuty, error) {
+	duty := &apiv1.AttesterDuty{}
+	err := parse(data, func(f *field) error {
+		var err error
+		var val uint64
+		switch f.num {
+		case 1:
+			err = f.fixed(duty.PubKey[:])
+		case 2:
+			val, err = f.uint64()
+			duty.Slot = phase0.Slot(val)
+		case 3:
+			val, err = f.uint64()
+			duty.ValidatorIndex = phase0.ValidatorIndex(val)
+		case 4:
+			val, err = f.uint64()
+			duty.CommitteeIndex = phase0.CommitteeIndex(val)
+		case 5:
+			duty.CommitteeLength, err = f.uint64()
+		case 6:
+			duty.CommitteesAtSlot, err = f.uint64()
+		case 7:
+			duty.ValidatorCommitteeIndex, err = f.uint64()
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode attester duty")
+	}
+
+	return duty, nil
+}
+
+// MarshalProposerDuty encodes a proposer duty.
+func MarshalProposerDuty(duty *apiv1.ProposerDuty) ([]byte, error) {
+	if duty == nil {
+		return nil, errors.New("no duty supplied")
+	}
+
+	m := &message{}
+	m.bytes(1, duty.PubKey[:])
+	m.uint64(2, uint64(duty.Slot))
+	m.uint64(3, uint64(duty.ValidatorIndex))
+
+	return m.buf, nil
+}
+
+// UnmarshalProposerDuty decodes a proposer duty.
+func UnmarshalProposerDuty(data []byte) (*apiv1.ProposerDuty, error) {
+	duty := &apiv1.ProposerDuty{}
+	err := parse(data, func(f *field) error {
+		var err error
+		var val uint64
+		switch f.num {
+		case 1:
+			err = f.fixed(duty.PubKey[:])
+		case 2:
+			val, err = f.uint64()
+			duty.Slot = phase0.Slot(val)
+		case 3:
+			val, err = f.uint64()
+			duty.ValidatorIndex = phase0.ValidatorIndex(val)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode proposer duty")
+	}
+
+	return duty, nil
+}
+
+// MarshalSyncCommitteeDuty encodes a sync committee duty.
+func MarshalSyncCommitteeDuty(duty *apiv1.SyncCommitteeDuty) ([]byte, error) {
+	if duty == nil {
+		return nil, errors.New("no duty supplied")
+	}
+
+	m := &message{}
+	m.bytes(1, duty.PubKey[:])
+	m.uint64(2, uint64(duty.ValidatorIndex))
+	indices := make([]uint64, len(duty.ValidatorSyncCommitteeIndices))
+	for i, index := range duty.ValidatorSyncCommitteeIndices {
+		indices[i] = uint64(index)
+	}
+	m.packed(3, indices)
+
+	return m.buf, nil
+}
+
+// UnmarshalSyncCommitteeDuty decodes a sync committee duty.
+func UnmarshalSyncCommitteeDuty(data []byte) (*apiv1.SyncCommitteeDuty, error) {
+	duty := &apiv1.SyncCommitteeDuty{
+		ValidatorSyncCommitteeIndices: make([]phase0.CommitteeIndex, 0),
+	}
+	err := parse(data, func(f *field) error {
+		switch f.num {
+		case 1:
+			return f.fixed(duty.PubKey[:])
+		case 2:
+			val, err := f.uint64()
+			duty.ValidatorIndex = phase0.ValidatorIndex(val)
+
+			return err
+		case 3:
+			vals, err := f.packed()
+			for _, val := range vals {
+				duty.ValidatorSyncCommitteeIndices = append(duty.ValidatorSyncCommitteeIndices, phase0.CommitteeIndex(val))
+			}
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode sync committee duty")
+	}
+
+	return duty, nil
+}
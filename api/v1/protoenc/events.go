@@ -0,0 +1,207 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protoenc
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// MarshalHeadEvent encodes a head event.
+func MarshalHeadEvent(event *apiv1.HeadEvent) ([]byte, error) {
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	m := &message{}
+	m.uint64(1, uint64(event.Slot))
+	m.bytes(2, event.Block[:])
+	m.bytes(3, event.State[:])
+	m.bool(4, event.EpochTransition)
+	m.bytes(5, event.CurrentDutyDependentRoot[:])
+	m.bytes(6, event.PreviousDutyDependentRoot[:])
+	m.bool(7, event.ExecutionOptimistic)
+
+	return m.buf, nil
+}
+
+// UnmarshalHeadEvent decodes a head event.
+func UnmarshalHeadEvent(data []byte) (*apiv1.HeadEvent, error) {
+	event := &apiv1.HeadEvent{}
+	err := parse(data, func(f *field) error {
+		var err error
+		switch f.num {
+		case 1:
+			var val uint64
+			val, err = f.uint64()
+			event.Slot = phase0.Slot(val)
+		case 2:
+			err = f.root(&event.Block)
+		case 3:
+			err = f.root(&event.State)
+		case 4:
+			event.EpochTransition, err = f.bool()
+		case 5:
+			err = f.root(&event.CurrentDutyDependentRoot)
+		case 6:
+			err = f.root(&event.PreviousDutyDependentRoot)
+		case 7:
+			event.ExecutionOptimistic, err = f.bool()
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode head event")
+	}
+
+	return event, nil
+}
+
+// MarshalBlockEvent encodes a block event.
+func MarshalBlockEvent(event *apiv1.BlockEvent) ([]byte, error) {
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	m := &message{}
+	m.uint64(1, uint64(event.Slot))
+	m.bytes(2, event.Block[:])
+	m.bool(3, event.ExecutionOptimistic)
+
+	return m.buf, nil
+}
+
+// UnmarshalBlockEvent decodes a block event.
+func UnmarshalBlockEvent(data []byte) (*apiv1.BlockEvent, error) {
+	event := &apiv1.BlockEvent{}
+	err := parse(data, func(f *field) error {
+		var err error
+		switch f.num {
+		case 1:
+			var val uint64
+			val, err = f.uint64()
+			event.Slot = phase0.Slot(val)
+		case 2:
+			err = f.root(&event.Block)
+		case 3:
+			event.ExecutionOptimistic, err = f.bool()
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode block event")
+	}
+
+	return event, nil
+}
+
+// MarshalChainReorgEvent encodes a chain reorg event.
+func MarshalChainReorgEvent(event *apiv1.ChainReorgEvent) ([]byte, error) {
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	m := &message{}
+	m.uint64(1, uint64(event.Slot))
+	m.uint64(2, event.Depth)
+	m.bytes(3, event.OldHeadBlock[:])
+	m.bytes(4, event.NewHeadBlock[:])
+	m.bytes(5, event.OldHeadState[:])
+	m.bytes(6, event.NewHeadState[:])
+	m.uint64(7, uint64(event.Epoch))
+	m.bool(8, event.ExecutionOptimistic)
+
+	return m.buf, nil
+}
+
+// UnmarshalChainReorgEvent decodes a chain reorg event.
+func UnmarshalChainReorgEvent(data []byte) (*apiv1.ChainReorgEvent, error) {
+	event := &apiv1.ChainReorgEvent{}
+	err := parse(data, func(f *field) error {
+		var err error
+		var val uint64
+		switch f.num {
+		case 1:
+			val, err = f.uint64()
+			event.Slot = phase0.Slot(val)
+		case 2:
+			event.Depth, err = f.uint64()
+		case 3:
+			err = f.root(&event.OldHeadBlock)
+		case 4:
+			err = f.root(&event.NewHeadBlock)
+		case 5:
+			err = f.root(&event.OldHeadState)
+		case 6:
+			err = f.root(&event.NewHeadState)
+		case 7:
+			val, err = f.uint64()
+			event.Epoch = phase0.Epoch(val)
+		case 8:
+			event.ExecutionOptimistic, err = f.bool()
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode chain reorg event")
+	}
+
+	return event, nil
+}
+
+// MarshalFinalizedCheckpointEvent encodes a finalized checkpoint event.
+func MarshalFinalizedCheckpointEvent(event *apiv1.FinalizedCheckpointEvent) ([]byte, error) {
+	if event == nil {
+		return nil, errors.New("no event supplied")
+	}
+
+	m := &message{}
+	m.bytes(1, event.Block[:])
+	m.bytes(2, event.State[:])
+	m.uint64(3, uint64(event.Epoch))
+	m.bool(4, event.ExecutionOptimistic)
+
+	return m.buf, nil
+}
+
+// UnmarshalFinalizedCheckpointEvent decodes a finalized checkpoint event.
+func UnmarshalFinalizedCheckpointEvent(data []byte) (*apiv1.FinalizedCheckpointEvent, error) {
+	event := &apiv1.FinalizedCheckpointEvent{}
+	err := parse(data, func(f *field) error {
+		var err error
+		switch f.num {
+		case 1:
+			err = f.root(&event.Block)
+		case 2:
+			err = f.root(&event.State)
+		case 3:
+			var val uint64
+			val, err = f.uint64()
+			event.Epoch = phase0.Epoch(val)
+		case 4:
+			event.ExecutionOptimistic, err = f.bool()
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode finalized checkpoint event")
+	}
+
+	return event, nil
+}
@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protoenc provides protobuf encodings of api/v1 types that are commonly
+// streamed from services built on this client, such as duties, rewards and events.
+// The wire format is described by analytics.proto in this directory.
+//
+// This package is kept separate from api/v1 so that users who do not require
+// protobuf encodings do not take on the dependency.
+package protoenc
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// message builds an encoded protobuf message.
+// As per proto3, fields with default values are omitted.
+type message struct {
+	buf []byte
+}
+
+func (m *message) uint64(num protowire.Number, val uint64) {
+	if val == 0 {
+		return
+	}
+	m.buf = protowire.AppendTag(m.buf, num, protowire.VarintType)
+	m.buf = protowire.AppendVarint(m.buf, val)
+}
+
+func (m *message) bool(num protowire.Number, val bool) {
+	if !val {
+		return
+	}
+	m.buf = protowire.AppendTag(m.buf, num, protowire.VarintType)
+	m.buf = protowire.AppendVarint(m.buf, protowire.EncodeBool(val))
+}
+
+func (m *message) bytes(num protowire.Number, val []byte) {
+	if len(val) == 0 {
+		return
+	}
+	m.buf = protowire.AppendTag(m.buf, num, protowire.BytesType)
+	m.buf = protowire.AppendBytes(m.buf, val)
+}
+
+func (m *message) packed(num protowire.Number, vals []uint64) {
+	if len(vals) == 0 {
+		return
+	}
+	packed := make([]byte, 0, len(vals))
+	for _, val := range vals {
+		packed = protowire.AppendVarint(packed, val)
+	}
+	m.bytes(num, packed)
+}
+
+// field is a single decoded field.
+type field struct {
+	num   protowire.Number
+	typ   protowire.Type
+	value uint64
+	bytes []byte
+}
+
+// parse decodes a protobuf message, calling the handler for each known field.
+// Fields with types other than varint or length-delimited are skipped, as are any
+// fields the handler does not recognise, allowing for new fields to be added.
+func parse(data []byte, handler func(*field) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return errors.Wrap(protowire.ParseError(n), "invalid tag")
+		}
+		data = data[n:]
+		f := &field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.value, n = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			f = nil
+		}
+		if n < 0 {
+			return errors.Wrapf(protowire.ParseError(n), "invalid value for field %d", num)
+		}
+		data = data[n:]
+		if f == nil {
+			continue
+		}
+		if err := handler(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uint64 returns the field's value as an integer.
+func (f *field) uint64() (uint64, error) {
+	if f.typ != protowire.VarintType {
+		return 0, errors.Errorf("invalid type for field %d", f.num)
+	}
+
+	return f.value, nil
+}
+
+// bool returns the field's value as a boolean.
+func (f *field) bool() (bool, error) {
+	val, err := f.uint64()
+
+	return protowire.DecodeBool(val), err
+}
+
+// fixed copies the field's value to a fixed-length destination.
+func (f *field) fixed(dst []byte) error {
+	if f.typ != protowire.BytesType {
+		return errors.Errorf("invalid type for field %d", f.num)
+	}
+	if len(f.bytes) != len(dst) {
+		return errors.Errorf("incorrect length %d for field %d", len(f.bytes), f.num)
+	}
+	copy(dst, f.bytes)
+
+	return nil
+}
+
+// packed returns the field's values as a list of integers.
+// Unpacked values are also accepted, as required by the protobuf specification.
+func (f *field) packed() ([]uint64, error) {
+	if f.typ == protowire.VarintType {
+		return []uint64{f.value}, nil
+	}
+	if f.typ != protowire.BytesType {
+		return nil, errors.Errorf("invalid type for field %d", f.num)
+	}
+	vals := make([]uint64, 0)
+	data := f.bytes
+	for len(data) > 0 {
+		val, n := protowire.ConsumeVarint(data)
+		if n < 0 {
+			return nil, errors.Wrapf(protowire.ParseError(n), "invalid value for field %d", f.num)
+		}
+		vals = append(vals, val)
+		data = data[n:]
+	}
+
+	return vals, nil
+}
+
+// root returns the field's value as a root.
+func (f *field) root(dst *phase0.Root) error {
+	return f.fixed(dst[:])
+}
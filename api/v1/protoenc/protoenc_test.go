@@ -0,0 +1,188 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protoenc_test
+
+import (
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/api/v1/protoenc"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     any
+		marshal   func(any) ([]byte, error)
+		unmarshal func([]byte) (any, error)
+	}{
+		{
+			name: "AttesterDuty",
+			input: &apiv1.AttesterDuty{
+				PubKey:                  phase0.BLSPubKey{0x01, 0x02},
+				Slot:                    123456,
+				ValidatorIndex:          789,
+				CommitteeIndex:          12,
+				CommitteeLength:         130,
+				CommitteesAtSlot:        64,
+				ValidatorCommitteeIndex: 17,
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalAttesterDuty(v.(*apiv1.AttesterDuty)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalAttesterDuty(d) },
+		},
+		{
+			name: "ProposerDuty",
+			input: &apiv1.ProposerDuty{
+				PubKey:         phase0.BLSPubKey{0x01, 0x02},
+				Slot:           123456,
+				ValidatorIndex: 789,
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalProposerDuty(v.(*apiv1.ProposerDuty)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalProposerDuty(d) },
+		},
+		{
+			name: "SyncCommitteeDuty",
+			input: &apiv1.SyncCommitteeDuty{
+				PubKey:                        phase0.BLSPubKey{0x01, 0x02},
+				ValidatorIndex:                789,
+				ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{0, 5, 500},
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalSyncCommitteeDuty(v.(*apiv1.SyncCommitteeDuty)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalSyncCommitteeDuty(d) },
+		},
+		{
+			name: "BlockRewards",
+			input: &apiv1.BlockRewards{
+				ProposerIndex:     789,
+				Total:             40000000,
+				Attestations:      30000000,
+				SyncAggregate:     9000000,
+				ProposerSlashings: 1000000,
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalBlockRewards(v.(*apiv1.BlockRewards)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalBlockRewards(d) },
+		},
+		{
+			name: "HeadEvent",
+			input: &apiv1.HeadEvent{
+				Slot:                      123456,
+				Block:                     phase0.Root{0x01},
+				State:                     phase0.Root{0x02},
+				EpochTransition:           true,
+				CurrentDutyDependentRoot:  phase0.Root{0x03},
+				PreviousDutyDependentRoot: phase0.Root{0x04},
+				ExecutionOptimistic:       true,
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalHeadEvent(v.(*apiv1.HeadEvent)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalHeadEvent(d) },
+		},
+		{
+			name: "BlockEvent",
+			input: &apiv1.BlockEvent{
+				Slot:                123456,
+				Block:               phase0.Root{0x01},
+				ExecutionOptimistic: true,
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalBlockEvent(v.(*apiv1.BlockEvent)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalBlockEvent(d) },
+		},
+		{
+			name: "ChainReorgEvent",
+			input: &apiv1.ChainReorgEvent{
+				Slot:                123456,
+				Depth:               2,
+				OldHeadBlock:        phase0.Root{0x01},
+				NewHeadBlock:        phase0.Root{0x02},
+				OldHeadState:        phase0.Root{0x03},
+				NewHeadState:        phase0.Root{0x04},
+				Epoch:               3858,
+				ExecutionOptimistic: true,
+			},
+			marshal:   func(v any) ([]byte, error) { return protoenc.MarshalChainReorgEvent(v.(*apiv1.ChainReorgEvent)) },
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalChainReorgEvent(d) },
+		},
+		{
+			name: "FinalizedCheckpointEvent",
+			input: &apiv1.FinalizedCheckpointEvent{
+				Block:               phase0.Root{0x01},
+				State:               phase0.Root{0x02},
+				Epoch:               3858,
+				ExecutionOptimistic: true,
+			},
+			marshal: func(v any) ([]byte, error) {
+				return protoenc.MarshalFinalizedCheckpointEvent(v.(*apiv1.FinalizedCheckpointEvent))
+			},
+			unmarshal: func(d []byte) (any, error) { return protoenc.UnmarshalFinalizedCheckpointEvent(d) },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := test.marshal(test.input)
+			require.NoError(t, err)
+			res, err := test.unmarshal(data)
+			require.NoError(t, err)
+			require.Equal(t, test.input, res)
+		})
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+		},
+		{
+			name: "UnknownField",
+			// Field 15, varint 1.
+			input: []byte{0x78, 0x01},
+		},
+		{
+			name: "TruncatedValue",
+			// Field 2, varint with continuation bit set.
+			input: []byte{0x10, 0x80},
+			err:   "failed to decode proposer duty: invalid value for field 2: unexpected EOF",
+		},
+		{
+			name: "WrongType",
+			// Field 2, length-delimited.
+			input: []byte{0x12, 0x00},
+			err:   "failed to decode proposer duty: invalid type for field 2",
+		},
+		{
+			name: "ShortPubKey",
+			// Field 1, 2 bytes.
+			input: []byte{0x0a, 0x02, 0x01, 0x02},
+			err:   "failed to decode proposer duty: incorrect length 2 for field 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := protoenc.UnmarshalProposerDuty(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protoenc
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// MarshalBlockRewards encodes block rewards.
+func MarshalBlockRewards(rewards *apiv1.BlockRewards) ([]byte, error) {
+	if rewards == nil {
+		return nil, errors.New("no rewards supplied")
+	}
+
+	m := &message{}
+	m.uint64(1, uint64(rewards.ProposerIndex))
+	m.uint64(2, uint64(rewards.Total))
+	m.uint64(3, uint64(rewards.Attestations))
+	m.uint64(4, uint64(rewards.SyncAggregate))
+	m.uint64(5, uint64(rewards.ProposerSlashings))
+	m.uint64(6, uint64(rewards.AttesterSlashings))
+
+	return m.buf, nil
+}
+
+// UnmarshalBlockRewards decodes block rewards.
+func UnmarshalBlockRewards(data []byte) (*apiv1.BlockRewards, error) {
+	rewards := &apiv1.BlockRewards{}
+	err := parse(data, func(f *field) error {
+		var dst *phase0.Gwei
+		switch f.num {
+		case 1:
+			val, err := f.uint64()
+			rewards.ProposerIndex = phase0.ValidatorIndex(val)
+
+			return err
+		case 2:
+			dst = &rewards.Total
+		case 3:
+			dst = &rewards.Attestations
+		case 4:
+			dst = &rewards.SyncAggregate
+		case 5:
+			dst = &rewards.ProposerSlashings
+		case 6:
+			dst = &rewards.AttesterSlashings
+		default:
+			return nil
+		}
+		val, err := f.uint64()
+		*dst = phase0.Gwei(val)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode block rewards")
+	}
+
+	return rewards, nil
+}
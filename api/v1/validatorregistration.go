@@ -138,6 +138,51 @@ func (v *ValidatorRegistration) UnmarshalYAML(input []byte) error {
 	return v.unpack(&data)
 }
 
+// Equal returns true if the registration is identical to the other registration.
+func (v *ValidatorRegistration) Equal(other *ValidatorRegistration) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	return v.FeeRecipient == other.FeeRecipient &&
+		v.GasLimit == other.GasLimit &&
+		v.Pubkey == other.Pubkey &&
+		v.Timestamp.Equal(other.Timestamp)
+}
+
+// EquivalentTo returns true if the registration differs from the other registration
+// by at most the given window in its timestamp.
+func (v *ValidatorRegistration) EquivalentTo(other *ValidatorRegistration, window time.Duration) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+
+	if v.FeeRecipient != other.FeeRecipient ||
+		v.GasLimit != other.GasLimit ||
+		v.Pubkey != other.Pubkey {
+		return false
+	}
+
+	diff := v.Timestamp.Sub(other.Timestamp)
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff <= window
+}
+
+// RequiresSignature returns true if the registration cannot be served by the previously
+// signed registration, and so needs to be signed afresh.
+// A previous registration whose timestamp is within the given window of this registration's
+// timestamp, and whose other fields are identical, can be reused as-is.
+func (v *ValidatorRegistration) RequiresSignature(previous *SignedValidatorRegistration, window time.Duration) bool {
+	if previous == nil || previous.Message == nil {
+		return true
+	}
+
+	return !v.EquivalentTo(previous.Message, window)
+}
+
 // String returns a string version of the structure.
 func (v *ValidatorRegistration) String() string {
 	data, err := yaml.Marshal(v)
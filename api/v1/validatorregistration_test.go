@@ -17,8 +17,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/goccy/go-yaml"
 	require "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/assert"
@@ -166,3 +169,112 @@ func TestValidatorRegistrationYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorRegistrationRequiresSignature(t *testing.T) {
+	base := &api.ValidatorRegistration{
+		FeeRecipient: bellatrix.ExecutionAddress{0x01},
+		GasLimit:     30000000,
+		Timestamp:    time.Unix(1700000000, 0),
+		Pubkey:       phase0.BLSPubKey{0x02},
+	}
+
+	tests := []struct {
+		name     string
+		intended *api.ValidatorRegistration
+		previous *api.SignedValidatorRegistration
+		window   time.Duration
+		equal    bool
+		required bool
+	}{
+		{
+			name:     "NoPrevious",
+			intended: base,
+			required: true,
+		},
+		{
+			name:     "NoPreviousMessage",
+			intended: base,
+			previous: &api.SignedValidatorRegistration{},
+			required: true,
+		},
+		{
+			name:     "Identical",
+			intended: base,
+			previous: &api.SignedValidatorRegistration{
+				Message: &api.ValidatorRegistration{
+					FeeRecipient: base.FeeRecipient,
+					GasLimit:     base.GasLimit,
+					Timestamp:    base.Timestamp,
+					Pubkey:       base.Pubkey,
+				},
+			},
+			equal:    true,
+			required: false,
+		},
+		{
+			name:     "TimestampWithinWindow",
+			intended: base,
+			previous: &api.SignedValidatorRegistration{
+				Message: &api.ValidatorRegistration{
+					FeeRecipient: base.FeeRecipient,
+					GasLimit:     base.GasLimit,
+					Timestamp:    base.Timestamp.Add(-time.Hour),
+					Pubkey:       base.Pubkey,
+				},
+			},
+			window:   time.Hour,
+			required: false,
+		},
+		{
+			name:     "TimestampOutsideWindow",
+			intended: base,
+			previous: &api.SignedValidatorRegistration{
+				Message: &api.ValidatorRegistration{
+					FeeRecipient: base.FeeRecipient,
+					GasLimit:     base.GasLimit,
+					Timestamp:    base.Timestamp.Add(-2 * time.Hour),
+					Pubkey:       base.Pubkey,
+				},
+			},
+			window:   time.Hour,
+			required: true,
+		},
+		{
+			name:     "FeeRecipientChanged",
+			intended: base,
+			previous: &api.SignedValidatorRegistration{
+				Message: &api.ValidatorRegistration{
+					FeeRecipient: bellatrix.ExecutionAddress{0x03},
+					GasLimit:     base.GasLimit,
+					Timestamp:    base.Timestamp,
+					Pubkey:       base.Pubkey,
+				},
+			},
+			window:   time.Hour,
+			required: true,
+		},
+		{
+			name:     "GasLimitChanged",
+			intended: base,
+			previous: &api.SignedValidatorRegistration{
+				Message: &api.ValidatorRegistration{
+					FeeRecipient: base.FeeRecipient,
+					GasLimit:     36000000,
+					Timestamp:    base.Timestamp,
+					Pubkey:       base.Pubkey,
+				},
+			},
+			window:   time.Hour,
+			required: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.required, test.intended.RequiresSignature(test.previous, test.window))
+			if test.previous != nil && test.previous.Message != nil {
+				require.Equal(t, test.equal, test.intended.Equal(test.previous.Message))
+			}
+		})
+	}
+}
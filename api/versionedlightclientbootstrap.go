@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientBootstrap contains a versioned light client bootstrap.
+// Light client data for bellatrix uses the altair structure.
+type VersionedLightClientBootstrap struct {
+	Version   spec.DataVersion
+	Altair    *apiv1altair.LightClientBootstrap
+	Bellatrix *apiv1altair.LightClientBootstrap
+	Capella   *apiv1capella.LightClientBootstrap
+	Deneb     *apiv1deneb.LightClientBootstrap
+	Electra   *apiv1electra.LightClientBootstrap
+}
+
+// IsEmpty returns true if there is no light client bootstrap.
+func (v *VersionedLightClientBootstrap) IsEmpty() bool {
+	return v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
+// Slot returns the slot of the header.
+func (v *VersionedLightClientBootstrap) Slot() (phase0.Slot, error) {
+	switch v.Version {
+	case spec.DataVersionAltair:
+		if v.Altair == nil || v.Altair.Header == nil || v.Altair.Header.Beacon == nil {
+			return 0, errors.New("no altair light client bootstrap")
+		}
+		return v.Altair.Header.Beacon.Slot, nil
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Header == nil || v.Bellatrix.Header.Beacon == nil {
+			return 0, errors.New("no bellatrix light client bootstrap")
+		}
+		return v.Bellatrix.Header.Beacon.Slot, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Header == nil || v.Capella.Header.Beacon == nil {
+			return 0, errors.New("no capella light client bootstrap")
+		}
+		return v.Capella.Header.Beacon.Slot, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Header == nil || v.Deneb.Header.Beacon == nil {
+			return 0, errors.New("no deneb light client bootstrap")
+		}
+		return v.Deneb.Header.Beacon.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Header == nil || v.Electra.Header.Beacon == nil {
+			return 0, errors.New("no electra light client bootstrap")
+		}
+		return v.Electra.Header.Beacon.Slot, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// CurrentSyncCommittee returns the current sync committee.
+func (v *VersionedLightClientBootstrap) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair light client bootstrap")
+		}
+		return v.Altair.CurrentSyncCommittee, nil
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix light client bootstrap")
+		}
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella light client bootstrap")
+		}
+		return v.Capella.CurrentSyncCommittee, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb light client bootstrap")
+		}
+		return v.Deneb.CurrentSyncCommittee, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra light client bootstrap")
+		}
+		return v.Electra.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
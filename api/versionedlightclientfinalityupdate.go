@@ -0,0 +1,140 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedLightClientFinalityUpdate contains a versioned light client finality update.
+// Light client data for bellatrix uses the altair structure.
+type VersionedLightClientFinalityUpdate struct {
+	Version   spec.DataVersion
+	Altair    *apiv1altair.LightClientFinalityUpdate
+	Bellatrix *apiv1altair.LightClientFinalityUpdate
+	Capella   *apiv1capella.LightClientFinalityUpdate
+	Deneb     *apiv1deneb.LightClientFinalityUpdate
+	Electra   *apiv1electra.LightClientFinalityUpdate
+}
+
+// IsEmpty returns true if there is no light client finality update.
+func (v *VersionedLightClientFinalityUpdate) IsEmpty() bool {
+	return v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
+}
+
+// AttestedSlot returns the slot of the attested header.
+func (v *VersionedLightClientFinalityUpdate) AttestedSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case spec.DataVersionAltair:
+		if v.Altair == nil || v.Altair.AttestedHeader == nil || v.Altair.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no altair light client finality update")
+		}
+		return v.Altair.AttestedHeader.Beacon.Slot, nil
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.AttestedHeader == nil || v.Bellatrix.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no bellatrix light client finality update")
+		}
+		return v.Bellatrix.AttestedHeader.Beacon.Slot, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.AttestedHeader == nil || v.Capella.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no capella light client finality update")
+		}
+		return v.Capella.AttestedHeader.Beacon.Slot, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.AttestedHeader == nil || v.Deneb.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no deneb light client finality update")
+		}
+		return v.Deneb.AttestedHeader.Beacon.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.AttestedHeader == nil || v.Electra.AttestedHeader.Beacon == nil {
+			return 0, errors.New("no electra light client finality update")
+		}
+		return v.Electra.AttestedHeader.Beacon.Slot, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// FinalizedSlot returns the slot of the finalized header.
+func (v *VersionedLightClientFinalityUpdate) FinalizedSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case spec.DataVersionAltair:
+		if v.Altair == nil || v.Altair.FinalizedHeader == nil || v.Altair.FinalizedHeader.Beacon == nil {
+			return 0, errors.New("no altair light client finality update")
+		}
+		return v.Altair.FinalizedHeader.Beacon.Slot, nil
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.FinalizedHeader == nil || v.Bellatrix.FinalizedHeader.Beacon == nil {
+			return 0, errors.New("no bellatrix light client finality update")
+		}
+		return v.Bellatrix.FinalizedHeader.Beacon.Slot, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.FinalizedHeader == nil || v.Capella.FinalizedHeader.Beacon == nil {
+			return 0, errors.New("no capella light client finality update")
+		}
+		return v.Capella.FinalizedHeader.Beacon.Slot, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.FinalizedHeader == nil || v.Deneb.FinalizedHeader.Beacon == nil {
+			return 0, errors.New("no deneb light client finality update")
+		}
+		return v.Deneb.FinalizedHeader.Beacon.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.FinalizedHeader == nil || v.Electra.FinalizedHeader.Beacon == nil {
+			return 0, errors.New("no electra light client finality update")
+		}
+		return v.Electra.FinalizedHeader.Beacon.Slot, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// SignatureSlot returns the slot at which the sync aggregate was signed.
+func (v *VersionedLightClientFinalityUpdate) SignatureSlot() (phase0.Slot, error) {
+	switch v.Version {
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair light client finality update")
+		}
+		return v.Altair.SignatureSlot, nil
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix light client finality update")
+		}
+		return v.Bellatrix.SignatureSlot, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella light client finality update")
+		}
+		return v.Capella.SignatureSlot, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb light client finality update")
+		}
+		return v.Deneb.SignatureSlot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra light client finality update")
+		}
+		return v.Electra.SignatureSlot, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
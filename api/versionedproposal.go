@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// VersionedProposal contains a versioned block proposal, which may be either
+// a full or a blinded beacon block, along with the values of the proposal.
+type VersionedProposal struct {
+	Version spec.DataVersion
+	// ExecutionPayloadBlinded is true if the proposal contains a blinded block.
+	ExecutionPayloadBlinded bool
+	// ExecutionPayloadValue is the value of the execution payload to the proposer, in Wei.
+	ExecutionPayloadValue *big.Int
+	// ConsensusBlockValue is the consensus rewards of the block to the proposer, in Wei.
+	ConsensusBlockValue *big.Int
+	// Block is the beacon block, if the proposal is not blinded.
+	Block *spec.VersionedBeaconBlock
+	// BlindedBlock is the blinded beacon block, if the proposal is blinded.
+	BlindedBlock *VersionedBlindedBeaconBlock
+}
+
+// IsEmpty returns true if there is no block.
+func (v *VersionedProposal) IsEmpty() bool {
+	if v.ExecutionPayloadBlinded {
+		return v.BlindedBlock == nil || v.BlindedBlock.IsEmpty()
+	}
+
+	return v.Block == nil || v.Block.IsEmpty()
+}
+
+// Blinded returns true if the proposal contains a blinded block.
+func (v *VersionedProposal) Blinded() bool {
+	return v.ExecutionPayloadBlinded
+}
+
+// ExecutionValue returns the value of the execution payload to the proposer, in Wei.
+// If no value was supplied with the proposal this returns 0.
+func (v *VersionedProposal) ExecutionValue() *big.Int {
+	if v.ExecutionPayloadValue == nil {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Set(v.ExecutionPayloadValue)
+}
+
+// ConsensusValue returns the consensus rewards of the block to the proposer, in Wei.
+// If no value was supplied with the proposal this returns 0.
+func (v *VersionedProposal) ConsensusValue() *big.Int {
+	if v.ConsensusBlockValue == nil {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Set(v.ConsensusBlockValue)
+}
+
+// Slot returns the slot of the proposal.
+func (v *VersionedProposal) Slot() (phase0.Slot, error) {
+	if v.ExecutionPayloadBlinded {
+		if v.BlindedBlock == nil {
+			return 0, errors.New("no blinded block")
+		}
+		return v.BlindedBlock.Slot()
+	}
+
+	if v.Block == nil {
+		return 0, errors.New("no block")
+	}
+	return v.Block.Slot()
+}
+
+// ProposerIndex returns the proposer index of the proposal.
+func (v *VersionedProposal) ProposerIndex() (phase0.ValidatorIndex, error) {
+	if v.ExecutionPayloadBlinded {
+		if v.BlindedBlock == nil {
+			return 0, errors.New("no blinded block")
+		}
+		return v.BlindedBlock.ProposerIndex()
+	}
+
+	if v.Block == nil {
+		return 0, errors.New("no block")
+	}
+	return v.Block.ProposerIndex()
+}
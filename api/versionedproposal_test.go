@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedProposalValues(t *testing.T) {
+	tests := []struct {
+		name           string
+		proposal       *api.VersionedProposal
+		blinded        bool
+		executionValue *big.Int
+		consensusValue *big.Int
+	}{
+		{
+			name:           "Empty",
+			proposal:       &api.VersionedProposal{},
+			executionValue: big.NewInt(0),
+			consensusValue: big.NewInt(0),
+		},
+		{
+			name: "Full",
+			proposal: &api.VersionedProposal{
+				ExecutionPayloadValue: big.NewInt(100),
+				ConsensusBlockValue:   big.NewInt(20),
+			},
+			executionValue: big.NewInt(100),
+			consensusValue: big.NewInt(20),
+		},
+		{
+			name: "Blinded",
+			proposal: &api.VersionedProposal{
+				ExecutionPayloadBlinded: true,
+				ExecutionPayloadValue:   big.NewInt(300),
+				ConsensusBlockValue:     big.NewInt(40),
+			},
+			blinded:        true,
+			executionValue: big.NewInt(300),
+			consensusValue: big.NewInt(40),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.blinded, test.proposal.Blinded())
+			require.Equal(t, 0, test.executionValue.Cmp(test.proposal.ExecutionValue()))
+			require.Equal(t, 0, test.consensusValue.Cmp(test.proposal.ConsensusValue()))
+		})
+	}
+}
+
+func TestVersionedProposalValuesCopied(t *testing.T) {
+	proposal := &api.VersionedProposal{
+		ExecutionPayloadValue: big.NewInt(1),
+		ConsensusBlockValue:   big.NewInt(2),
+	}
+
+	proposal.ExecutionValue().SetInt64(10)
+	proposal.ConsensusValue().SetInt64(20)
+	require.Equal(t, int64(1), proposal.ExecutionPayloadValue.Int64())
+	require.Equal(t, int64(2), proposal.ConsensusBlockValue.Int64())
+}
@@ -19,6 +19,7 @@ import (
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
 	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -29,6 +30,7 @@ type VersionedSignedBlindedBeaconBlock struct {
 	Bellatrix *apiv1bellatrix.SignedBlindedBeaconBlock
 	Capella   *apiv1capella.SignedBlindedBeaconBlock
 	Deneb     *apiv1deneb.SignedBlindedBeaconBlock
+	Electra   *apiv1electra.SignedBlindedBeaconBlock
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -49,6 +51,11 @@ func (v *VersionedSignedBlindedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Slot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, errors.New("unsupported version")
 	}
@@ -72,6 +79,8 @@ func (v *VersionedSignedBlindedBeaconBlock) Attestations() ([]*phase0.Attestatio
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.Attestations, nil
+	case spec.DataVersionElectra:
+		return nil, errors.New("electra block does not provide phase0 attestations")
 	default:
 		return nil, errors.New("unsupported version")
 	}
@@ -95,6 +104,11 @@ func (v *VersionedSignedBlindedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -118,6 +132,11 @@ func (v *VersionedSignedBlindedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -141,6 +160,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ParentRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -164,6 +188,11 @@ func (v *VersionedSignedBlindedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.StateRoot, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unsupported version")
 	}
@@ -187,6 +216,8 @@ func (v *VersionedSignedBlindedBeaconBlock) AttesterSlashings() ([]*phase0.Attes
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.AttesterSlashings, nil
+	case spec.DataVersionElectra:
+		return nil, errors.New("electra block does not provide phase0 attester slashings")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -210,6 +241,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerSlashings() ([]*phase0.Propo
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -233,6 +269,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ProposerIndex() (phase0.ValidatorInd
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ProposerIndex, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -256,6 +297,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockHash() (phase0.Hash32,
 			return phase0.Hash32{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockHash, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return phase0.Hash32{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayloadHeader.BlockHash, nil
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
@@ -279,6 +325,11 @@ func (v *VersionedSignedBlindedBeaconBlock) ExecutionBlockNumber() (uint64, erro
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ExecutionPayloadHeader.BlockNumber, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayloadHeader == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayloadHeader.BlockNumber, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -302,7 +353,93 @@ func (v *VersionedSignedBlindedBeaconBlock) Signature() (phase0.BLSSignature, er
 			return phase0.BLSSignature{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Signature, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.BLSSignature{}, errors.New("no electra block")
+		}
+		return v.Electra.Signature, nil
 	default:
 		return phase0.BLSSignature{}, errors.New("unknown version")
 	}
 }
+
+// SizeSSZ returns the size of the SSZ encoding of the signed blinded beacon block.
+func (v *VersionedSignedBlindedBeaconBlock) SizeSSZ() (int, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix signed blinded beacon block")
+		}
+		return v.Bellatrix.SizeSSZ(), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella signed blinded beacon block")
+		}
+		return v.Capella.SizeSSZ(), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb signed blinded beacon block")
+		}
+		return v.Deneb.SizeSSZ(), nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra signed blinded beacon block")
+		}
+		return v.Electra.SizeSSZ(), nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// Unblind returns the full signed beacon block obtained by combining the blinded
+// block with the execution payload returned by the builder.
+// Note that for deneb this returns the block alone; to also reconstruct the blob
+// sidecars use apiv1deneb.SignedBlindedBlockContents.Unblind().
+func (v *VersionedSignedBlindedBeaconBlock) Unblind(response *VersionedSubmitBlindedBlockResponse) (*spec.VersionedSignedBeaconBlock, error) {
+	if response == nil {
+		return nil, errors.New("no response supplied")
+	}
+	if response.Version != v.Version {
+		return nil, errors.New("response version does not match block version")
+	}
+
+	res := &spec.VersionedSignedBeaconBlock{
+		Version: v.Version,
+	}
+	var err error
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		res.Bellatrix, err = v.Bellatrix.Unblind(response.Bellatrix)
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		res.Capella, err = v.Capella.Unblind(response.Capella)
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		if response.Deneb == nil {
+			return nil, errors.New("no deneb response")
+		}
+		res.Deneb, err = v.Deneb.Unblind(response.Deneb.ExecutionPayload)
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		if response.Electra == nil {
+			return nil, errors.New("no electra response")
+		}
+		res.Electra, err = v.Electra.Unblind(response.Electra.ExecutionPayload)
+	default:
+		return nil, errors.New("unknown version")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -98,3 +98,25 @@ func (v *VersionedValidatorRegistration) Root() (phase0.Root, error) {
 		return phase0.Root{}, errors.New("unsupported version")
 	}
 }
+
+// RequiresSignature returns true if the registration cannot be served by the previously
+// signed registration, and so needs to be signed afresh.
+func (v *VersionedValidatorRegistration) RequiresSignature(previous *VersionedSignedValidatorRegistration,
+	window time.Duration,
+) (
+	bool,
+	error,
+) {
+	switch v.Version {
+	case spec.BuilderVersionV1:
+		if v.V1 == nil {
+			return false, errors.New("no validator registration")
+		}
+		if previous == nil || previous.Version != v.Version {
+			return true, nil
+		}
+		return v.V1.RequiresSignature(previous.V1, window), nil
+	default:
+		return false, errors.New("unsupported version")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builderclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BuilderBid obtains a bid from the builder for an execution payload for the given slot,
+// parent execution block hash and proposer public key.
+// If the builder does not have a bid this returns nil.
+func (s *Service) BuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubKey phase0.BLSPubKey,
+) (
+	*api.VersionedSignedBuilderBid,
+	error,
+) {
+	endpoint := fmt.Sprintf("/eth/v1/builder/header/%d/%#x/%#x", slot, parentHash, pubKey)
+	resp, err := s.do(ctx, http.MethodGet, endpoint, nil, map[string]string{
+		"Accept": acceptSSZOrJSON,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.statusCode == http.StatusNoContent || len(resp.body) == 0 {
+		// No bid.
+		return nil, nil
+	}
+
+	version, data, err := versionedData(resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse bid")
+	}
+
+	res := &api.VersionedSignedBuilderBid{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1bellatrix.SignedBuilderBid{}
+		err = unmarshal(resp, data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.SignedBuilderBid{}
+		err = unmarshal(resp, data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.SignedBuilderBid{}
+		err = unmarshal(resp, data, res.Deneb)
+	default:
+		return nil, errors.Errorf("unsupported bid version %v", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %v bid", version)
+	}
+
+	return res, nil
+}
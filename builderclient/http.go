@@ -0,0 +1,175 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builderclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+const (
+	contentTypeJSON = "application/json"
+	contentTypeSSZ  = "application/octet-stream"
+	// acceptSSZOrJSON prefers SSZ responses, falling back to JSON for builders that do not support SSZ.
+	acceptSSZOrJSON = "application/octet-stream;q=1.0,application/json;q=0.9"
+)
+
+// Error represents an http error.
+type Error struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Data       []byte
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+}
+
+// versionedJSON is the builder API representation of a versioned response.
+type versionedJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// unmarshaler is an object that can be decoded from either SSZ or JSON.
+type unmarshaler interface {
+	UnmarshalSSZ(data []byte) error
+	UnmarshalJSON(data []byte) error
+}
+
+type httpResponse struct {
+	statusCode       int
+	contentType      string
+	consensusVersion spec.DataVersion
+	body             []byte
+}
+
+// do sends a request to the builder and returns the response.
+// Responses with a status code other than 2xx are returned as an Error.
+func (s *Service) do(ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	headers map[string]string,
+) (
+	*httpResponse,
+	error,
+) {
+	log := s.log.With().Str("method", method).Str("endpoint", endpoint).Logger()
+	log.Trace().Msg("Sending request")
+
+	reference, err := url.Parse(strings.TrimPrefix(endpoint, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.base.ResolveReference(reference).String(), bodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Set(k, v)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", contentTypeJSON)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call builder")
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Int("size", len(data)).Msg("Received response")
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, Error{
+			Method:     method,
+			Endpoint:   endpoint,
+			StatusCode: resp.StatusCode,
+			Data:       data,
+		}
+	}
+
+	res := &httpResponse{
+		statusCode:  resp.StatusCode,
+		contentType: contentTypeJSON,
+		body:        data,
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid content type")
+		}
+		res.contentType = mediaType
+	}
+	if consensusVersion := resp.Header.Get("Eth-Consensus-Version"); consensusVersion != "" {
+		if err := res.consensusVersion.UnmarshalJSON([]byte(fmt.Sprintf("%q", consensusVersion))); err != nil {
+			return nil, errors.Wrap(err, "failed to parse consensus version")
+		}
+	}
+
+	return res, nil
+}
+
+// versionedData returns the version and data of a versioned response.
+func versionedData(resp *httpResponse) (spec.DataVersion, []byte, error) {
+	if resp.contentType == contentTypeSSZ {
+		if resp.consensusVersion == spec.DataVersionUnknown {
+			return spec.DataVersionUnknown, nil, errors.New("no consensus version supplied in response")
+		}
+
+		return resp.consensusVersion, resp.body, nil
+	}
+
+	var versioned versionedJSON
+	if err := json.Unmarshal(resp.body, &versioned); err != nil {
+		return spec.DataVersionUnknown, nil, errors.Wrap(err, "invalid JSON")
+	}
+
+	return versioned.Version, versioned.Data, nil
+}
+
+// unmarshal decodes data from a response in to the object, according to the content type of the response.
+func unmarshal(resp *httpResponse, data []byte, obj unmarshaler) error {
+	if resp.contentType == contentTypeSSZ {
+		return obj.UnmarshalSSZ(data)
+	}
+
+	return obj.UnmarshalJSON(data)
+}
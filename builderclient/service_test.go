@@ -0,0 +1,253 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builderclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/builderclient"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func capellaBid() *apiv1capella.SignedBuilderBid {
+	return &apiv1capella.SignedBuilderBid{
+		Message: &apiv1capella.BuilderBid{
+			Header: &capella.ExecutionPayloadHeader{
+				ParentHash: phase0.Hash32{0x01},
+				ExtraData:  []byte{},
+				BlockHash:  phase0.Hash32{0x02},
+			},
+			Value:  uint256.NewInt(1000),
+			Pubkey: phase0.BLSPubKey{0x03},
+		},
+		Signature: phase0.BLSSignature{0x04},
+	}
+}
+
+func denebBid() *apiv1deneb.SignedBuilderBid {
+	return &apiv1deneb.SignedBuilderBid{
+		Message: &apiv1deneb.BuilderBid{
+			Header: &deneb.ExecutionPayloadHeader{
+				ParentHash:    phase0.Hash32{0x01},
+				ExtraData:     []byte{},
+				BaseFeePerGas: uint256.NewInt(7),
+				BlockHash:     phase0.Hash32{0x05},
+			},
+			BlobKzgCommitments: []deneb.KzgCommitment{{0x06}},
+			Value:              uint256.NewInt(2000),
+			Pubkey:             phase0.BLSPubKey{0x03},
+		},
+		Signature: phase0.BLSSignature{0x04},
+	}
+}
+
+// relay is a fake builder.
+type relay struct {
+	mu            sync.Mutex
+	registrations []byte
+	headers       http.Header
+}
+
+func (r *relay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.headers = req.Header.Clone()
+	r.mu.Unlock()
+
+	switch {
+	case req.URL.Path == "/eth/v1/builder/status":
+		w.WriteHeader(http.StatusOK)
+	case req.URL.Path == "/eth/v1/builder/validators":
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.registrations = body
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	case strings.HasPrefix(req.URL.Path, "/eth/v1/builder/header/1/"):
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(req.URL.Path, "/eth/v1/builder/header/2/"):
+		data, _ := json.Marshal(capellaBid())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"version":"capella","data":%s}`, data)))
+	case strings.HasPrefix(req.URL.Path, "/eth/v1/builder/header/3/"):
+		data, _ := denebBid().MarshalSSZ()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Eth-Consensus-Version", "deneb")
+		_, _ = w.Write(data)
+	case req.URL.Path == "/eth/v1/builder/blinded_blocks":
+		payload, _ := json.Marshal(&capella.ExecutionPayload{
+			ParentHash:   phase0.Hash32{0x01},
+			ExtraData:    []byte{},
+			BlockHash:    phase0.Hash32{0x02},
+			Transactions: []bellatrix.Transaction{},
+			Withdrawals:  []*capella.Withdrawal{},
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"version":%q,"data":%s}`, req.Header.Get("Eth-Consensus-Version"), payload)))
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"code":500,"message":"internal error"}`))
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []builderclient.Parameter
+		err    string
+	}{
+		{
+			name: "AddressMissing",
+			params: []builderclient.Parameter{
+				builderclient.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no address specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []builderclient.Parameter{
+				builderclient.WithLogLevel(zerolog.Disabled),
+				builderclient.WithAddress("localhost:1"),
+				builderclient.WithTimeout(0),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "Good",
+			params: []builderclient.Parameter{
+				builderclient.WithLogLevel(zerolog.Disabled),
+				builderclient.WithAddress("localhost:1"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := builderclient.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	s, err := builderclient.New(context.Background(), builderclient.WithAddress("localhost:1"))
+	require.NoError(t, err)
+
+	require.Implements(t, (*consensusclient.Service)(nil), s)
+	require.Implements(t, (*consensusclient.BuilderStatusProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BuilderBidProvider)(nil), s)
+	require.Implements(t, (*consensusclient.BuilderBlindedBlockSubmitter)(nil), s)
+	require.Implements(t, (*consensusclient.ValidatorRegistrationsSubmitter)(nil), s)
+}
+
+func TestRequests(t *testing.T) {
+	ctx := context.Background()
+	fake := &relay{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s, err := builderclient.New(ctx,
+		builderclient.WithLogLevel(zerolog.Disabled),
+		builderclient.WithAddress(server.URL),
+		builderclient.WithExtraHeaders(map[string]string{"X-Test": "value"}),
+	)
+	require.NoError(t, err)
+
+	// Status.
+	require.NoError(t, s.Status(ctx))
+	require.Equal(t, "value", fake.headers.Get("X-Test"))
+
+	// Registrations.
+	require.EqualError(t, s.SubmitValidatorRegistrations(ctx, nil), "no registrations supplied")
+	require.NoError(t, s.SubmitValidatorRegistrations(ctx, []*api.VersionedSignedValidatorRegistration{
+		{
+			Version: spec.BuilderVersionV1,
+			V1: &apiv1.SignedValidatorRegistration{
+				Message: &apiv1.ValidatorRegistration{
+					GasLimit: 30000000,
+					Pubkey:   phase0.BLSPubKey{0x01},
+				},
+			},
+		},
+	}))
+	var registrations []*apiv1.SignedValidatorRegistration
+	require.NoError(t, json.Unmarshal(fake.registrations, &registrations))
+	require.Len(t, registrations, 1)
+	require.Equal(t, uint64(30000000), registrations[0].Message.GasLimit)
+
+	// No bid.
+	bid, err := s.BuilderBid(ctx, 1, phase0.Hash32{}, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Nil(t, bid)
+
+	// JSON bid.
+	bid, err = s.BuilderBid(ctx, 2, phase0.Hash32{}, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionCapella, bid.Version)
+	require.Equal(t, capellaBid(), bid.Capella)
+	value, err := bid.Value()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), value.Uint64())
+
+	// SSZ bid.
+	bid, err = s.BuilderBid(ctx, 3, phase0.Hash32{}, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionDeneb, bid.Version)
+	require.Equal(t, denebBid(), bid.Deneb)
+	commitments, err := bid.BlobKzgCommitments()
+	require.NoError(t, err)
+	require.Len(t, commitments, 1)
+
+	// Error.
+	_, err = s.BuilderBid(ctx, 4, phase0.Hash32{}, phase0.BLSPubKey{})
+	var builderErr builderclient.Error
+	require.ErrorAs(t, err, &builderErr)
+	require.Equal(t, http.StatusInternalServerError, builderErr.StatusCode)
+
+	// Blinded block.
+	res, err := s.SubmitBlindedBlock(ctx, &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBlindedBeaconBlock{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "capella", fake.headers.Get("Eth-Consensus-Version"))
+	blockHash, err := res.BlockHash()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Hash32{0x02}, blockHash)
+}
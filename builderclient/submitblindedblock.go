@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builderclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// SubmitBlindedBlock submits a signed blinded block to the builder, returning the
+// execution payload, and for deneb onwards the blobs bundle, that unblind it.
+func (s *Service) SubmitBlindedBlock(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+
+	var body []byte
+	var err error
+	switch block.Version {
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		body, err = json.Marshal(block.Bellatrix)
+	case spec.DataVersionCapella:
+		if block.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		body, err = json.Marshal(block.Capella)
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		body, err = json.Marshal(block.Deneb)
+	case spec.DataVersionElectra:
+		if block.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		body, err = json.Marshal(block.Electra)
+	default:
+		return nil, errors.Errorf("unsupported block version %v", block.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal block")
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, "/eth/v1/builder/blinded_blocks", body, map[string]string{
+		"Content-Type":          contentTypeJSON,
+		"Accept":                acceptSSZOrJSON,
+		"Eth-Consensus-Version": block.Version.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	version, data, err := versionedData(resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	if version != block.Version {
+		return nil, errors.Errorf("response version %v does not match block version %v", version, block.Version)
+	}
+
+	res := &api.VersionedSubmitBlindedBlockResponse{
+		Version: version,
+	}
+	switch version {
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.ExecutionPayload{}
+		err = unmarshal(resp, data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &capella.ExecutionPayload{}
+		err = unmarshal(resp, data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = unmarshal(resp, data, res.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra = &apiv1deneb.ExecutionPayloadAndBlobsBundle{}
+		err = unmarshal(resp, data, res.Electra)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %v response", version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builderclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// SubmitValidatorRegistrations submits validator registrations to the builder.
+func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	if len(registrations) == 0 {
+		return errors.New("no registrations supplied")
+	}
+
+	v1Registrations := make([]*apiv1.SignedValidatorRegistration, 0, len(registrations))
+	for _, registration := range registrations {
+		if registration == nil {
+			return errors.New("nil registration supplied")
+		}
+		switch registration.Version {
+		case spec.BuilderVersionV1:
+			if registration.V1 == nil {
+				return errors.New("no v1 registration")
+			}
+			v1Registrations = append(v1Registrations, registration.V1)
+		default:
+			return errors.Errorf("unsupported registration version %v", registration.Version)
+		}
+	}
+
+	body, err := json.Marshal(v1Registrations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal registrations")
+	}
+
+	_, err = s.do(ctx, http.MethodPost, "/eth/v1/builder/validators", body, map[string]string{
+		"Content-Type": contentTypeJSON,
+	})
+
+	return err
+}
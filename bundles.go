@@ -0,0 +1,130 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// The interfaces in this file bundle the fine-grained provider interfaces in
+// to groups suited to common types of consumer.  Consumers can declare the
+// bundle that they require, rather than checking for each provider in turn,
+// and implementations can be checked against the bundle at compile time.
+
+// ChainInfoClient is the interface for clients that provide basic information about the chain.
+type ChainInfoClient interface {
+	Service
+	FarFutureEpochProvider
+	ForkScheduleProvider
+	GenesisProvider
+	GenesisTimeProvider
+	NodeSyncingProvider
+	NodeVersionProvider
+	OptimisticHeadProvider
+	SlotDurationProvider
+	SlotsPerEpochProvider
+	SpecProvider
+}
+
+// ValidatorClient is the interface for clients that provide the functions
+// required to carry out validator duties.
+type ValidatorClient interface {
+	ChainInfoClient
+	AggregateAttestationProvider
+	AggregateAttestationsSubmitter
+	AttestationDataProvider
+	AttestationsSubmitter
+	AttesterDutiesProvider
+	BeaconBlockProposalProvider
+	BeaconBlockRootProvider
+	BeaconBlockSubmitter
+	BeaconCommitteeSubscriptionsSubmitter
+	BlindedBeaconBlockProposalProvider
+	BlindedBeaconBlockSubmitter
+	DomainProvider
+	EventsProvider
+	ProposalPreparationsSubmitter
+	ProposalProvider
+	ProposerDutiesProvider
+	SyncCommitteeContributionProvider
+	SyncCommitteeContributionsSubmitter
+	SyncCommitteeDutiesProvider
+	SyncCommitteeMessagesSubmitter
+	SyncCommitteeSubscriptionsSubmitter
+	TargetAggregatorsPerCommitteeProvider
+	ValidatorRegistrationsSubmitter
+	ValidatorsProvider
+	VoluntaryExitSubmitter
+}
+
+// IndexerClient is the interface for clients that provide the functions
+// required to index the contents of the chain.
+type IndexerClient interface {
+	ChainInfoClient
+	AttestationRewardsProvider
+	AttesterDutiesProvider
+	BeaconBlockBlobsProvider
+	BeaconBlockHeadersProvider
+	BeaconBlockRootProvider
+	BeaconCommitteesProvider
+	BeaconStateProvider
+	BeaconStateRandaoProvider
+	BeaconStateRootProvider
+	BlobSidecarsProvider
+	BlockRewardsProvider
+	DepositContractProvider
+	EventsProvider
+	FinalityProvider
+	ForkProvider
+	ProposerDutiesProvider
+	SignedBeaconBlockProvider
+	SyncCommitteeDutiesProvider
+	SyncCommitteeRewardsProvider
+	SyncCommitteesProvider
+	ValidatorBalancesProvider
+	ValidatorsProvider
+}
+
+// MonitoringClient is the interface for clients that provide the functions
+// required to monitor the state of a beacon node and its validators.
+type MonitoringClient interface {
+	ChainInfoClient
+	BeaconBlockHeadersProvider
+	EventsProvider
+	FinalityProvider
+	ForkChoiceProvider
+	NodeClientProvider
+	ProposerDutiesProvider
+	ValidatorBalancesProvider
+	ValidatorsProvider
+}
+
+// FullClient is the interface for clients that provide all of the functions
+// of the standard API, along with the local extensions.
+type FullClient interface {
+	ValidatorClient
+	IndexerClient
+	MonitoringClient
+	AttestationPoolProvider
+	AttestationPoolStreamer
+	BeaconBlockRawSubmitter
+	BLSToExecutionChangesSubmitter
+	BlindedBeaconBlockRawSubmitter
+	ExitEstimateProvider
+	LightClientBootstrapProvider
+	LightClientFinalityUpdateProvider
+	LightClientOptimisticUpdateProvider
+	LightClientUpdatesProvider
+	PendingConsolidationsProvider
+	PendingDepositsProvider
+	PendingPartialWithdrawalsProvider
+	ValidatorIdentitiesProvider
+	ValidatorsStreamer
+}
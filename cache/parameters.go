@@ -0,0 +1,112 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel   zerolog.Level
+	maxSize    int64
+	ttl        time.Duration
+	prefixTTLs map[string]time.Duration
+	directory  string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMaxSize sets the maximum total size in bytes of the values held in memory.
+// Values larger than this are not cached.
+func WithMaxSize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxSize = size
+	})
+}
+
+// WithTTL sets the time for which entries are held.  A TTL of 0, the default,
+// holds entries until they are evicted to make room for others.
+func WithTTL(ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.ttl = ttl
+	})
+}
+
+// WithPrefixTTL sets the time for which entries with keys that start with the
+// given prefix are held, overriding the TTL set with WithTTL().  This can be
+// supplied multiple times; if the key matches multiple prefixes the longest is used.
+func WithPrefixTTL(prefix string, ttl time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.prefixTTLs[prefix] = ttl
+	})
+}
+
+// WithDirectory sets a directory in which entries are also stored, allowing
+// them to be used across restarts.  Entries on disk are not bounded by the
+// maximum size, but are removed when they are found to have expired.
+func WithDirectory(directory string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.directory = directory
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:   zerolog.GlobalLevel(),
+		maxSize:    64 * 1024 * 1024,
+		prefixTTLs: make(map[string]time.Duration),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.maxSize <= 0 {
+		return nil, errors.New("max size must be positive")
+	}
+	if parameters.ttl < 0 {
+		return nil, errors.New("TTL cannot be negative")
+	}
+	for prefix, ttl := range parameters.prefixTTLs {
+		if prefix == "" {
+			return nil, errors.New("no TTL prefix specified")
+		}
+		if ttl < 0 {
+			return nil, errors.Errorf("TTL for prefix %s cannot be negative", prefix)
+		}
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,261 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache provides a size-bounded in-memory cache for values that do
+// not change, optionally backed by disk.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a cache of values keyed on strings.
+// Entries are evicted least recently used first when the cache is full.
+type Service struct {
+	log     zerolog.Logger
+	maxSize int64
+	ttl     time.Duration
+	// TTLs for prefixes of keys, longest prefix first.
+	prefixTTLs []prefixTTL
+	directory  string
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+}
+
+type prefixTTL struct {
+	prefix string
+	ttl    time.Duration
+}
+
+type entry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// New creates a new cache.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "cache").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if parameters.directory != "" {
+		if err := os.MkdirAll(parameters.directory, 0o700); err != nil {
+			return nil, errors.Wrap(err, "failed to create cache directory")
+		}
+	}
+
+	prefixTTLs := make([]prefixTTL, 0, len(parameters.prefixTTLs))
+	for prefix, ttl := range parameters.prefixTTLs {
+		prefixTTLs = append(prefixTTLs, prefixTTL{
+			prefix: prefix,
+			ttl:    ttl,
+		})
+	}
+	sort.Slice(prefixTTLs, func(i, j int) bool {
+		return len(prefixTTLs[i].prefix) > len(prefixTTLs[j].prefix)
+	})
+
+	return &Service{
+		log:        log,
+		maxSize:    parameters.maxSize,
+		ttl:        parameters.ttl,
+		prefixTTLs: prefixTTLs,
+		directory:  parameters.directory,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}, nil
+}
+
+// Get returns the value for the given key, if present and not expired.
+func (s *Service) Get(key string) ([]byte, bool) {
+	now := time.Now()
+
+	s.mu.Lock()
+	if element, exists := s.entries[key]; exists {
+		e := element.Value.(*entry)
+		if e.expires.IsZero() || now.Before(e.expires) {
+			s.lru.MoveToFront(element)
+			s.mu.Unlock()
+
+			return e.value, true
+		}
+		s.remove(element)
+	}
+	s.mu.Unlock()
+
+	if s.directory == "" {
+		return nil, false
+	}
+	value, expires, found := s.read(key, now)
+	if !found {
+		return nil, false
+	}
+	s.mu.Lock()
+	s.add(key, value, expires)
+	s.mu.Unlock()
+
+	return value, true
+}
+
+// Set sets the value for the given key.
+func (s *Service) Set(key string, value []byte) {
+	var expires time.Time
+	if ttl := s.ttlFor(key); ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	s.mu.Lock()
+	s.add(key, value, expires)
+	s.mu.Unlock()
+
+	if s.directory != "" {
+		if err := s.write(key, value, expires); err != nil {
+			s.log.Debug().Str("key", key).Err(err).Msg("Failed to write cache entry")
+		}
+	}
+}
+
+// Len returns the number of entries held in memory.
+func (s *Service) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lru.Len()
+}
+
+// ttlFor returns the TTL for the given key.
+func (s *Service) ttlFor(key string) time.Duration {
+	for _, prefixTTL := range s.prefixTTLs {
+		if strings.HasPrefix(key, prefixTTL.prefix) {
+			return prefixTTL.ttl
+		}
+	}
+
+	return s.ttl
+}
+
+// add adds an entry to memory; it must be called with the lock held.
+func (s *Service) add(key string, value []byte, expires time.Time) {
+	if element, exists := s.entries[key]; exists {
+		s.remove(element)
+	}
+	if int64(len(value)) > s.maxSize {
+		return
+	}
+	for s.size+int64(len(value)) > s.maxSize {
+		s.remove(s.lru.Back())
+	}
+	s.entries[key] = s.lru.PushFront(&entry{
+		key:     key,
+		value:   value,
+		expires: expires,
+	})
+	s.size += int64(len(value))
+}
+
+// remove removes an entry from memory; it must be called with the lock held.
+func (s *Service) remove(element *list.Element) {
+	e := s.lru.Remove(element).(*entry)
+	delete(s.entries, e.key)
+	s.size -= int64(len(e.value))
+}
+
+// path returns the path of the file for the given key.
+func (s *Service) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return filepath.Join(s.directory, hex.EncodeToString(hash[:]))
+}
+
+// read reads an entry from disk, removing it if it has expired.
+// The file contains the expiry time in nanoseconds, or 0 if the entry does
+// not expire, followed by the value.
+func (s *Service) read(key string, now time.Time) ([]byte, time.Time, bool) {
+	path := s.path(key)
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) < 8 {
+		return nil, time.Time{}, false
+	}
+
+	var expires time.Time
+	if nanos := int64(binary.BigEndian.Uint64(data[:8])); nanos != 0 {
+		expires = time.Unix(0, nanos)
+		if !now.Before(expires) {
+			if err := os.Remove(path); err != nil {
+				s.log.Debug().Str("key", key).Err(err).Msg("Failed to remove expired cache entry")
+			}
+
+			return nil, time.Time{}, false
+		}
+	}
+
+	return data[8:], expires, true
+}
+
+// write writes an entry to disk.
+func (s *Service) write(key string, value []byte, expires time.Time) error {
+	data := make([]byte, 8+len(value))
+	if !expires.IsZero() {
+		binary.BigEndian.PutUint64(data[:8], uint64(expires.UnixNano()))
+	}
+	copy(data[8:], value)
+
+	// Write to a temporary file and rename, so that readers never see a partial entry.
+	tmp, err := os.CreateTemp(s.directory, ".tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary file")
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return errors.Wrap(err, "failed to write temporary file")
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Wrap(err, "failed to close temporary file")
+	}
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return errors.Wrap(err, "failed to rename temporary file")
+	}
+
+	return nil
+}
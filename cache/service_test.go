@@ -0,0 +1,185 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []cache.Parameter
+		err    string
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name:   "MaxSizeZero",
+			params: []cache.Parameter{cache.WithMaxSize(0)},
+			err:    "problem with parameters: max size must be positive",
+		},
+		{
+			name:   "TTLNegative",
+			params: []cache.Parameter{cache.WithTTL(-time.Second)},
+			err:    "problem with parameters: TTL cannot be negative",
+		},
+		{
+			name:   "PrefixEmpty",
+			params: []cache.Parameter{cache.WithPrefixTTL("", time.Second)},
+			err:    "problem with parameters: no TTL prefix specified",
+		},
+		{
+			name:   "PrefixTTLNegative",
+			params: []cache.Parameter{cache.WithPrefixTTL("a", -time.Second)},
+			err:    "problem with parameters: TTL for prefix a cannot be negative",
+		},
+		{
+			name:   "Directory",
+			params: []cache.Parameter{cache.WithDirectory(t.TempDir())},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := append([]cache.Parameter{cache.WithLogLevel(zerolog.Disabled)}, test.params...)
+			_, err := cache.New(ctx, params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := cache.New(ctx, cache.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	_, found := s.Get("a")
+	require.False(t, found)
+
+	s.Set("a", []byte("value a"))
+	value, found := s.Get("a")
+	require.True(t, found)
+	require.Equal(t, []byte("value a"), value)
+
+	s.Set("a", []byte("new value a"))
+	value, found = s.Get("a")
+	require.True(t, found)
+	require.Equal(t, []byte("new value a"), value)
+	require.Equal(t, 1, s.Len())
+}
+
+func TestEviction(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithMaxSize(10),
+	)
+	require.NoError(t, err)
+
+	s.Set("a", []byte("aaaa"))
+	s.Set("b", []byte("bbbb"))
+	// Access a, so that b is the least recently used.
+	_, found := s.Get("a")
+	require.True(t, found)
+	s.Set("c", []byte("cccc"))
+
+	_, found = s.Get("b")
+	require.False(t, found)
+	_, found = s.Get("a")
+	require.True(t, found)
+	_, found = s.Get("c")
+	require.True(t, found)
+
+	// Values larger than the cache are not held.
+	s.Set("d", []byte("ddddddddddd"))
+	_, found = s.Get("d")
+	require.False(t, found)
+	require.Equal(t, 2, s.Len())
+}
+
+func TestTTL(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithTTL(50*time.Millisecond),
+		cache.WithPrefixTTL("/eth/v1/config/", 0),
+		cache.WithPrefixTTL("/eth/v1/config/spec", 25*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	s.Set("/eth/v1/beacon/genesis", []byte("genesis"))
+	s.Set("/eth/v1/config/deposit_contract", []byte("deposit contract"))
+	s.Set("/eth/v1/config/spec", []byte("spec"))
+
+	time.Sleep(30 * time.Millisecond)
+	_, found := s.Get("/eth/v1/beacon/genesis")
+	require.True(t, found)
+	_, found = s.Get("/eth/v1/config/deposit_contract")
+	require.True(t, found)
+	_, found = s.Get("/eth/v1/config/spec")
+	require.False(t, found)
+
+	time.Sleep(30 * time.Millisecond)
+	_, found = s.Get("/eth/v1/beacon/genesis")
+	require.False(t, found)
+	_, found = s.Get("/eth/v1/config/deposit_contract")
+	require.True(t, found)
+}
+
+func TestDirectory(t *testing.T) {
+	ctx := context.Background()
+	directory := t.TempDir()
+
+	s1, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithDirectory(directory),
+		cache.WithPrefixTTL("short", 10*time.Millisecond),
+	)
+	require.NoError(t, err)
+	s1.Set("a", []byte("value a"))
+	s1.Set("short", []byte("short value"))
+
+	// A second cache using the same directory obtains the values from disk.
+	s2, err := cache.New(ctx,
+		cache.WithLogLevel(zerolog.Disabled),
+		cache.WithDirectory(directory),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, s2.Len())
+	value, found := s2.Get("a")
+	require.True(t, found)
+	require.Equal(t, []byte("value a"), value)
+	require.Equal(t, 1, s2.Len())
+
+	time.Sleep(20 * time.Millisecond)
+	_, found = s2.Get("short")
+	require.False(t, found)
+}
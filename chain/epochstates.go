@@ -0,0 +1,302 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// EpochState is the state, or the parts of it obtained by a decoder, at the
+// first slot of an epoch.
+type EpochState struct {
+	Epoch phase0.Epoch
+	Slot  phase0.Slot
+	// StateID is the state ID with which the data was obtained.
+	StateID string
+	// Finalized is true if the epoch was finalized when the data was obtained,
+	// in which case the data will not change.
+	Finalized bool
+	// State is set by the FullState decoder.
+	State *spec.VersionedBeaconState
+	// Balances is set by the Balances decoder.
+	Balances map[phase0.ValidatorIndex]phase0.Gwei
+	// Validators is set by the Validators decoder.
+	Validators map[phase0.ValidatorIndex]*apiv1.Validator
+}
+
+// Decoder obtains data for the state in the epoch state from the client,
+// using its state ID, and sets it in the epoch state.
+// Decoders allow users to obtain only the data they require, rather than the full state.
+type Decoder func(ctx context.Context, client consensusclient.Service, state *EpochState) error
+
+// FullState is a decoder that obtains the full beacon state.
+// The client must provide beacon states.
+func FullState() Decoder {
+	return func(ctx context.Context, client consensusclient.Service, state *EpochState) error {
+		provider, isProvider := client.(consensusclient.BeaconStateProvider)
+		if !isProvider {
+			return errors.New("client does not provide beacon states")
+		}
+		res, err := provider.BeaconState(ctx, state.StateID)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			return errors.New("state not available")
+		}
+		state.State = res
+
+		return nil
+	}
+}
+
+// Balances is a decoder that obtains the balances of the given validators, or
+// all validators if none are supplied.
+// The client must provide validator balances.
+func Balances(indices []phase0.ValidatorIndex) Decoder {
+	return func(ctx context.Context, client consensusclient.Service, state *EpochState) error {
+		provider, isProvider := client.(consensusclient.ValidatorBalancesProvider)
+		if !isProvider {
+			return errors.New("client does not provide validator balances")
+		}
+		res, err := provider.ValidatorBalances(ctx, state.StateID, indices)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			return errors.New("balances not available")
+		}
+		state.Balances = res
+
+		return nil
+	}
+}
+
+// Validators is a decoder that obtains the given validators, or all validators
+// if none are supplied.
+// The client must provide validators.
+func Validators(indices []phase0.ValidatorIndex) Decoder {
+	return func(ctx context.Context, client consensusclient.Service, state *EpochState) error {
+		provider, isProvider := client.(consensusclient.ValidatorsProvider)
+		if !isProvider {
+			return errors.New("client does not provide validators")
+		}
+		res, err := provider.Validators(ctx, state.StateID, indices)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			return errors.New("validators not available")
+		}
+		state.Validators = res
+
+		return nil
+	}
+}
+
+// EpochStates iterates over the states at the first slot of each epoch in a
+// range, for example to backfill a database.
+type EpochStates struct {
+	client        consensusclient.Service
+	finality      consensusclient.FinalityProvider
+	decoders      []Decoder
+	slotsPerEpoch uint64
+	concurrency   int
+}
+
+// NewEpochStates creates an iterator over epoch states that obtains data with
+// the given decoders; if no decoders are supplied the full state is obtained.
+// Finalized epochs are obtained up to concurrency at a time.
+//
+// The client must provide finality and slots per epoch, as well as the data
+// required by the decoders.
+func NewEpochStates(ctx context.Context,
+	client consensusclient.Service,
+	concurrency int,
+	decoders ...Decoder,
+) (
+	*EpochStates,
+	error,
+) {
+	if concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+	finality, isProvider := client.(consensusclient.FinalityProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide finality")
+	}
+	slotsPerEpochProvider, isProvider := client.(consensusclient.SlotsPerEpochProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide slots per epoch")
+	}
+	slotsPerEpoch, err := slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if len(decoders) == 0 {
+		decoders = []Decoder{FullState()}
+	}
+
+	return &EpochStates{
+		client:        client,
+		finality:      finality,
+		decoders:      decoders,
+		slotsPerEpoch: slotsPerEpoch,
+		concurrency:   concurrency,
+	}, nil
+}
+
+// Iterate calls the handler with the epoch state for each epoch from the first
+// to the last inclusive, in order, until the handler returns false.
+//
+// States are referenced by slot, which archive nodes can serve without
+// replaying blocks from a recent state.  Finalized epochs cannot change, so
+// are obtained in parallel; epochs after the finalized epoch are obtained one
+// at a time, with finality rechecked before each.
+func (e *EpochStates) Iterate(ctx context.Context,
+	first phase0.Epoch,
+	last phase0.Epoch,
+	handler func(state *EpochState) (bool, error),
+) error {
+	if last < first {
+		return errors.New("last epoch cannot be before first epoch")
+	}
+
+	finalizedEpoch, err := e.finalizedEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	epoch := first
+	for {
+		if epoch <= finalizedEpoch {
+			// Fast path: obtain a batch of finalized epochs in parallel.
+			end := epoch + phase0.Epoch(e.concurrency) - 1
+			if end > finalizedEpoch {
+				end = finalizedEpoch
+			}
+			if end > last {
+				end = last
+			}
+			states, err := e.fetchRange(ctx, epoch, end, true)
+			if err != nil {
+				return err
+			}
+			for _, state := range states {
+				more, err := handler(state)
+				if err != nil {
+					return err
+				}
+				if !more {
+					return nil
+				}
+			}
+			epoch = end
+		} else {
+			state, err := e.fetch(ctx, epoch, false)
+			if err != nil {
+				return err
+			}
+			more, err := handler(state)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		if epoch == last {
+			return nil
+		}
+		epoch++
+
+		if epoch > finalizedEpoch {
+			// Finality may have moved on whilst we were iterating.
+			finalizedEpoch, err = e.finalizedEpoch(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// finalizedEpoch returns the current finalized epoch.
+func (e *EpochStates) finalizedEpoch(ctx context.Context) (phase0.Epoch, error) {
+	finality, err := e.finality.Finality(ctx, "head")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain finality")
+	}
+	if finality == nil || finality.Finalized == nil {
+		return 0, errors.New("finality not available")
+	}
+
+	return finality.Finalized.Epoch, nil
+}
+
+// fetchRange obtains the epoch states from the first to the last epoch inclusive in parallel.
+func (e *EpochStates) fetchRange(ctx context.Context,
+	first phase0.Epoch,
+	last phase0.Epoch,
+	finalized bool,
+) (
+	[]*EpochState,
+	error,
+) {
+	states := make([]*EpochState, int(last-first)+1)
+	errs := make([]error, len(states))
+	var wg sync.WaitGroup
+	for i := range states {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			states[i], errs[i] = e.fetch(ctx, first+phase0.Epoch(i), finalized)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return states, nil
+}
+
+// fetch obtains the epoch state for a single epoch.
+func (e *EpochStates) fetch(ctx context.Context, epoch phase0.Epoch, finalized bool) (*EpochState, error) {
+	slot := phase0.Slot(uint64(epoch) * e.slotsPerEpoch)
+	state := &EpochState{
+		Epoch:     epoch,
+		Slot:      slot,
+		StateID:   fmt.Sprintf("%d", slot),
+		Finalized: finalized,
+	}
+	for _, decoder := range e.decoders {
+		if err := decoder(ctx, e.client, state); err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain state for epoch %d", epoch)
+		}
+	}
+
+	return state, nil
+}
@@ -0,0 +1,256 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chain"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// balancesClient provides balances for states up to a head slot, with each
+// validator's balance equal to the slot of the state.
+type balancesClient struct {
+	headSlot       phase0.Slot
+	finalizedEpoch phase0.Epoch
+	// finalityStep is the number of epochs that finality advances on each request.
+	finalityStep phase0.Epoch
+
+	mu            sync.Mutex
+	stateIDs      []string
+	finalityCalls int
+}
+
+func (*balancesClient) Name() string { return "balances" }
+
+func (*balancesClient) Address() string { return "" }
+
+func (*balancesClient) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 4, nil
+}
+
+func (c *balancesClient) Finality(_ context.Context, _ string) (*apiv1.Finality, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.finalityCalls++
+	res := &apiv1.Finality{
+		Finalized: &phase0.Checkpoint{
+			Epoch: c.finalizedEpoch,
+		},
+	}
+	c.finalizedEpoch += c.finalityStep
+
+	return res, nil
+}
+
+func (c *balancesClient) ValidatorBalances(_ context.Context,
+	stateID string,
+	indices []phase0.ValidatorIndex,
+) (
+	map[phase0.ValidatorIndex]phase0.Gwei,
+	error,
+) {
+	c.mu.Lock()
+	c.stateIDs = append(c.stateIDs, stateID)
+	c.mu.Unlock()
+
+	slot, err := strconv.ParseUint(stateID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if phase0.Slot(slot) > c.headSlot {
+		return nil, nil
+	}
+	res := make(map[phase0.ValidatorIndex]phase0.Gwei, len(indices))
+	for _, index := range indices {
+		res[index] = phase0.Gwei(slot)
+	}
+
+	return res, nil
+}
+
+func TestNewEpochStates(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := chain.NewEpochStates(ctx, &balancesClient{}, 0)
+	require.EqualError(t, err, "concurrency must be at least 1")
+
+	_, err = chain.NewEpochStates(ctx, &treeClient{}, 1)
+	require.EqualError(t, err, "client does not provide finality")
+
+	_, err = chain.NewEpochStates(ctx, &balancesClient{}, 1)
+	require.NoError(t, err)
+}
+
+func TestEpochStatesIterate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		headSlot       phase0.Slot
+		finalizedEpoch phase0.Epoch
+		finalityStep   phase0.Epoch
+		first          phase0.Epoch
+		last           phase0.Epoch
+		stopAfter      int
+		epochs         []phase0.Epoch
+		finalized      []bool
+		finalityCalls  int
+		err            string
+	}{
+		{
+			name:  "Invalid",
+			first: 2,
+			last:  1,
+			err:   "last epoch cannot be before first epoch",
+		},
+		{
+			name:           "Finalized",
+			headSlot:       100,
+			finalizedEpoch: 20,
+			first:          2,
+			last:           8,
+			epochs:         []phase0.Epoch{2, 3, 4, 5, 6, 7, 8},
+			finalized:      []bool{true, true, true, true, true, true, true},
+			finalityCalls:  1,
+		},
+		{
+			name:           "Unfinalized",
+			headSlot:       100,
+			finalizedEpoch: 4,
+			first:          2,
+			last:           7,
+			epochs:         []phase0.Epoch{2, 3, 4, 5, 6, 7},
+			finalized:      []bool{true, true, true, false, false, false},
+			// Initial, then before each unfinalized epoch.
+			finalityCalls: 4,
+		},
+		{
+			name:           "FinalityAdvances",
+			headSlot:       100,
+			finalizedEpoch: 4,
+			finalityStep:   2,
+			first:          4,
+			last:           9,
+			epochs:         []phase0.Epoch{4, 5, 6, 7, 8, 9},
+			finalized:      []bool{true, true, true, true, true, true},
+			// Initial, then before each of epochs 5, 7 and 9.
+			finalityCalls: 4,
+		},
+		{
+			name:           "Stopped",
+			headSlot:       100,
+			finalizedEpoch: 20,
+			first:          0,
+			last:           10,
+			stopAfter:      2,
+			epochs:         []phase0.Epoch{0, 1},
+			finalized:      []bool{true, true},
+			finalityCalls:  1,
+		},
+		{
+			name:           "BeyondHead",
+			headSlot:       20,
+			finalizedEpoch: 2,
+			first:          4,
+			last:           6,
+			epochs:         []phase0.Epoch{4, 5},
+			finalized:      []bool{false, false},
+			finalityCalls:  3,
+			err:            "failed to obtain state for epoch 6: balances not available",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &balancesClient{
+				headSlot:       test.headSlot,
+				finalizedEpoch: test.finalizedEpoch,
+				finalityStep:   test.finalityStep,
+			}
+			iterator, err := chain.NewEpochStates(ctx, client, 3, chain.Balances([]phase0.ValidatorIndex{1}))
+			require.NoError(t, err)
+
+			epochs := make([]phase0.Epoch, 0)
+			finalized := make([]bool, 0)
+			err = iterator.Iterate(ctx, test.first, test.last, func(state *chain.EpochState) (bool, error) {
+				require.Equal(t, phase0.Slot(uint64(state.Epoch)*4), state.Slot)
+				require.Equal(t, phase0.Gwei(state.Slot), state.Balances[1])
+				epochs = append(epochs, state.Epoch)
+				finalized = append(finalized, state.Finalized)
+
+				return test.stopAfter == 0 || len(epochs) < test.stopAfter, nil
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			if test.epochs != nil {
+				require.Equal(t, test.epochs, epochs)
+				require.Equal(t, test.finalized, finalized)
+				require.Equal(t, test.finalityCalls, client.finalityCalls)
+			}
+		})
+	}
+}
+
+func TestEpochStatesHandlerError(t *testing.T) {
+	ctx := context.Background()
+
+	client := &balancesClient{headSlot: 100, finalizedEpoch: 20}
+	iterator, err := chain.NewEpochStates(ctx, client, 2, chain.Balances(nil))
+	require.NoError(t, err)
+
+	err = iterator.Iterate(ctx, 0, 5, func(_ *chain.EpochState) (bool, error) {
+		return false, errors.New("handler failed")
+	})
+	require.EqualError(t, err, "handler failed")
+}
+
+func TestEpochStatesDecoders(t *testing.T) {
+	ctx := context.Background()
+
+	client := &balancesClient{headSlot: 100, finalizedEpoch: 20}
+
+	// The full state is obtained by default, which this client does not provide.
+	iterator, err := chain.NewEpochStates(ctx, client, 1)
+	require.NoError(t, err)
+	err = iterator.Iterate(ctx, 0, 0, func(_ *chain.EpochState) (bool, error) { return true, nil })
+	require.EqualError(t, err, "failed to obtain state for epoch 0: client does not provide beacon states")
+
+	// Custom decoders can be supplied.
+	decoder := func(_ context.Context, _ consensusclient.Service, state *chain.EpochState) error {
+		state.Balances = map[phase0.ValidatorIndex]phase0.Gwei{0: phase0.Gwei(state.Epoch)}
+
+		return nil
+	}
+	iterator, err = chain.NewEpochStates(ctx, client, 1, decoder)
+	require.NoError(t, err)
+	err = iterator.Iterate(ctx, 3, 3, func(state *chain.EpochState) (bool, error) {
+		require.Equal(t, phase0.Gwei(3), state.Balances[0])
+		require.Equal(t, "12", state.StateID)
+
+		return true, nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,166 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chain provides walks of the ancestry of beacon blocks, and iteration
+// over the states at the start of each epoch.
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/analysis"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Walker walks the ancestry of beacon blocks by their parent roots, caching the
+// headers that it obtains.  It is safe for concurrent use.
+type Walker struct {
+	headers *analysis.HeaderFetcher
+}
+
+// NewWalker creates a walker that caches up to the given number of headers.
+//
+// The client must provide beacon block headers.
+func NewWalker(client consensusclient.Service, cacheSize int) (*Walker, error) {
+	headers, err := analysis.NewHeaderFetcher(client, cacheSize, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Walker{
+		headers: headers,
+	}, nil
+}
+
+// Header returns the header of the block with the given root.
+// It returns an error if the node does not have the block.
+func (w *Walker) Header(ctx context.Context, root phase0.Root) (*apiv1.BeaconBlockHeader, error) {
+	headers, err := w.headers.HeadersByRoots(ctx, []phase0.Root{root})
+	if err != nil {
+		return nil, err
+	}
+	header, exists := headers[root]
+	if !exists {
+		return nil, fmt.Errorf("block %#x not found", root)
+	}
+	if header.Header == nil || header.Header.Message == nil {
+		return nil, fmt.Errorf("header for block %#x is missing data", root)
+	}
+
+	return header, nil
+}
+
+// Walk calls the handler with the header of the block with the given root and then
+// with each of its ancestors in turn, until the handler returns false or genesis is reached.
+func (w *Walker) Walk(ctx context.Context,
+	root phase0.Root,
+	handler func(header *apiv1.BeaconBlockHeader) (bool, error),
+) error {
+	for {
+		header, err := w.Header(ctx, root)
+		if err != nil {
+			return err
+		}
+		more, err := handler(header)
+		if err != nil {
+			return err
+		}
+		if !more || header.Header.Message.Slot == 0 {
+			return nil
+		}
+		root = header.Header.Message.ParentRoot
+	}
+}
+
+// ForkPoint is the common ancestor of two blocks.
+type ForkPoint struct {
+	// Root is the root of the common ancestor.
+	Root phase0.Root
+	// Slot is the slot of the common ancestor.
+	Slot phase0.Slot
+	// DepthA is the number of blocks from the first block back to the common ancestor.
+	// It is 0 if the first block is the common ancestor.
+	DepthA int
+	// DepthB is the number of blocks from the second block back to the common ancestor.
+	// It is 0 if the second block is the common ancestor.
+	DepthB int
+}
+
+// String returns a string version of the structure.
+func (f *ForkPoint) String() string {
+	return fmt.Sprintf("block %#x at slot %d (depths %d and %d)", f.Root, f.Slot, f.DepthA, f.DepthB)
+}
+
+// CommonAncestor finds the most recent common ancestor of the blocks with the given roots,
+// walking back no more than maxDepth blocks on either branch.
+// It returns an error if the blocks have no common ancestor within that depth.
+func (w *Walker) CommonAncestor(ctx context.Context,
+	rootA phase0.Root,
+	rootB phase0.Root,
+	maxDepth int,
+) (
+	*ForkPoint,
+	error,
+) {
+	if maxDepth < 0 {
+		return nil, errors.New("max depth cannot be negative")
+	}
+
+	headerA, err := w.Header(ctx, rootA)
+	if err != nil {
+		return nil, err
+	}
+	headerB, err := w.Header(ctx, rootB)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ForkPoint{}
+	for headerA.Root != headerB.Root {
+		slotA := headerA.Header.Message.Slot
+		slotB := headerB.Header.Message.Slot
+		if slotA == 0 && slotB == 0 {
+			return nil, errors.New("blocks do not share a genesis")
+		}
+
+		// Step back the branch with the later block, or both if they are at the same slot.
+		if slotA >= slotB {
+			if res.DepthA == maxDepth {
+				return nil, fmt.Errorf("no common ancestor within %d blocks", maxDepth)
+			}
+			headerA, err = w.Header(ctx, headerA.Header.Message.ParentRoot)
+			if err != nil {
+				return nil, err
+			}
+			res.DepthA++
+		}
+		if slotB >= slotA {
+			if res.DepthB == maxDepth {
+				return nil, fmt.Errorf("no common ancestor within %d blocks", maxDepth)
+			}
+			headerB, err = w.Header(ctx, headerB.Header.Message.ParentRoot)
+			if err != nil {
+				return nil, err
+			}
+			res.DepthB++
+		}
+	}
+	res.Root = headerA.Root
+	res.Slot = headerA.Header.Message.Slot
+
+	return res, nil
+}
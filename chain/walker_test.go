@@ -0,0 +1,207 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chain"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// treeClient provides headers for a tree of blocks.
+type treeClient struct {
+	headers map[string]*apiv1.BeaconBlockHeader
+	calls   int
+}
+
+func (*treeClient) Name() string { return "tree" }
+
+func (*treeClient) Address() string { return "" }
+
+func (c *treeClient) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	c.calls++
+
+	return c.headers[blockID], nil
+}
+
+func (c *treeClient) add(root byte, slot phase0.Slot, parent byte) {
+	c.headers[fmt.Sprintf("%#x", phase0.Root{root})] = &apiv1.BeaconBlockHeader{
+		Root: phase0.Root{root},
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: phase0.Root{parent},
+			},
+		},
+	}
+}
+
+// newTreeClient creates the following tree of blocks, with slots in brackets:
+//
+//	1(0) - 2(1) - 3(2) - 4(3) - 5(5) - 6(6)
+//	              \- 7(4) - 8(7)
+//	9(0) - 10(1)
+func newTreeClient() *treeClient {
+	c := &treeClient{
+		headers: make(map[string]*apiv1.BeaconBlockHeader),
+	}
+	c.add(1, 0, 0)
+	c.add(2, 1, 1)
+	c.add(3, 2, 2)
+	c.add(4, 3, 3)
+	c.add(5, 5, 4)
+	c.add(6, 6, 5)
+	c.add(7, 4, 3)
+	c.add(8, 7, 7)
+	c.add(9, 0, 0)
+	c.add(10, 1, 9)
+
+	return c
+}
+
+func TestCommonAncestor(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		rootA    byte
+		rootB    byte
+		maxDepth int
+		res      *chain.ForkPoint
+		err      string
+	}{
+		{
+			name:     "Same",
+			rootA:    6,
+			rootB:    6,
+			maxDepth: 0,
+			res:      &chain.ForkPoint{Root: phase0.Root{6}, Slot: 6},
+		},
+		{
+			name:     "Ancestor",
+			rootA:    6,
+			rootB:    3,
+			maxDepth: 10,
+			res:      &chain.ForkPoint{Root: phase0.Root{3}, Slot: 2, DepthA: 3},
+		},
+		{
+			name:     "Descendant",
+			rootA:    2,
+			rootB:    8,
+			maxDepth: 10,
+			res:      &chain.ForkPoint{Root: phase0.Root{2}, Slot: 1, DepthB: 3},
+		},
+		{
+			name:     "Fork",
+			rootA:    6,
+			rootB:    8,
+			maxDepth: 10,
+			res:      &chain.ForkPoint{Root: phase0.Root{3}, Slot: 2, DepthA: 3, DepthB: 2},
+		},
+		{
+			name:     "ForkAtMaxDepth",
+			rootA:    8,
+			rootB:    6,
+			maxDepth: 3,
+			res:      &chain.ForkPoint{Root: phase0.Root{3}, Slot: 2, DepthA: 2, DepthB: 3},
+		},
+		{
+			name:     "MaxDepthExceeded",
+			rootA:    6,
+			rootB:    8,
+			maxDepth: 2,
+			err:      "no common ancestor within 2 blocks",
+		},
+		{
+			name:     "MaxDepthNegative",
+			rootA:    6,
+			rootB:    8,
+			maxDepth: -1,
+			err:      "max depth cannot be negative",
+		},
+		{
+			name:     "DifferentGenesis",
+			rootA:    6,
+			rootB:    10,
+			maxDepth: 10,
+			err:      "blocks do not share a genesis",
+		},
+		{
+			name:     "Unknown",
+			rootA:    6,
+			rootB:    0xff,
+			maxDepth: 10,
+			err:      "block 0xff00000000000000000000000000000000000000000000000000000000000000 not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			walker, err := chain.NewWalker(newTreeClient(), 100)
+			require.NoError(t, err)
+			res, err := walker.CommonAncestor(ctx, phase0.Root{test.rootA}, phase0.Root{test.rootB}, test.maxDepth)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestCommonAncestorCached(t *testing.T) {
+	ctx := context.Background()
+	client := newTreeClient()
+	walker, err := chain.NewWalker(client, 100)
+	require.NoError(t, err)
+
+	_, err = walker.CommonAncestor(ctx, phase0.Root{6}, phase0.Root{8}, 10)
+	require.NoError(t, err)
+	calls := client.calls
+
+	_, err = walker.CommonAncestor(ctx, phase0.Root{8}, phase0.Root{6}, 10)
+	require.NoError(t, err)
+	require.Equal(t, calls, client.calls)
+}
+
+func TestWalk(t *testing.T) {
+	ctx := context.Background()
+	walker, err := chain.NewWalker(newTreeClient(), 100)
+	require.NoError(t, err)
+
+	slots := make([]phase0.Slot, 0)
+	require.NoError(t, walker.Walk(ctx, phase0.Root{8}, func(header *apiv1.BeaconBlockHeader) (bool, error) {
+		slots = append(slots, header.Header.Message.Slot)
+
+		return true, nil
+	}))
+	require.Equal(t, []phase0.Slot{7, 4, 2, 1, 0}, slots)
+
+	slots = slots[:0]
+	require.NoError(t, walker.Walk(ctx, phase0.Root{6}, func(header *apiv1.BeaconBlockHeader) (bool, error) {
+		slots = append(slots, header.Header.Message.Slot)
+
+		return header.Header.Message.Slot > 3, nil
+	}))
+	require.Equal(t, []phase0.Slot{6, 5, 3}, slots)
+
+	_, err = chain.NewWalker(nil, 100)
+	require.Error(t, err)
+}
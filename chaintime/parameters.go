@@ -0,0 +1,109 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	client        consensusclient.Service
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	blockCutoff   time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithClient sets the client from which chain timing values that are not set
+// explicitly are obtained.  The client must provide any of the genesis time,
+// slot duration and slots per epoch that are not set explicitly.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithSlotDuration sets the duration of a slot of the chain.
+func WithSlotDuration(slotDuration time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotDuration = slotDuration
+	})
+}
+
+// WithSlotsPerEpoch sets the number of slots in an epoch of the chain.
+func WithSlotsPerEpoch(slotsPerEpoch uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpoch = slotsPerEpoch
+	})
+}
+
+// WithBlockCutoff sets the time from the start of a slot by which its block should be
+// published.  If not set the cutoff is one third of the way through the slot, when
+// attestations for the slot are made.
+func WithBlockCutoff(cutoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blockCutoff = cutoff
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		if parameters.genesisTime.IsZero() {
+			return nil, errors.New("no genesis time specified")
+		}
+		if parameters.slotDuration == 0 {
+			return nil, errors.New("no slot duration specified")
+		}
+		if parameters.slotsPerEpoch == 0 {
+			return nil, errors.New("no slots per epoch specified")
+		}
+	}
+	if parameters.slotDuration < 0 {
+		return nil, errors.New("slot duration cannot be negative")
+	}
+	if parameters.blockCutoff < 0 {
+		return nil, errors.New("block cutoff cannot be negative")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,198 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaintime converts between slots, epochs and wall-clock time for a chain,
+// and provides contexts bounded by the deadlines within a slot.
+package chaintime
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Service provides chain time.
+type Service struct {
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	blockCutoff   time.Duration
+}
+
+// New creates a new chain time service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	genesisTime := parameters.genesisTime
+	if genesisTime.IsZero() {
+		provider, isProvider := parameters.client.(consensusclient.GenesisTimeProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide genesis time")
+		}
+		genesisTime, err = provider.GenesisTime(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain genesis time")
+		}
+	}
+
+	slotDuration := parameters.slotDuration
+	if slotDuration == 0 {
+		provider, isProvider := parameters.client.(consensusclient.SlotDurationProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide slot duration")
+		}
+		slotDuration, err = provider.SlotDuration(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain slot duration")
+		}
+	}
+
+	slotsPerEpoch := parameters.slotsPerEpoch
+	if slotsPerEpoch == 0 {
+		provider, isProvider := parameters.client.(consensusclient.SlotsPerEpochProvider)
+		if !isProvider {
+			return nil, errors.New("client does not provide slots per epoch")
+		}
+		slotsPerEpoch, err = provider.SlotsPerEpoch(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+		}
+	}
+
+	blockCutoff := parameters.blockCutoff
+	if blockCutoff == 0 {
+		blockCutoff = slotDuration / 3
+	}
+
+	return &Service{
+		genesisTime:   genesisTime,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+		blockCutoff:   blockCutoff,
+	}, nil
+}
+
+// GenesisTime provides the time of genesis.
+func (s *Service) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// SlotDuration provides the duration of a slot.
+func (s *Service) SlotDuration() time.Duration {
+	return s.slotDuration
+}
+
+// SlotsPerEpoch provides the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// StartOfSlot provides the time at which a slot starts.
+func (s *Service) StartOfSlot(slot phase0.Slot) time.Time {
+	return s.genesisTime.Add(time.Duration(slot) * s.slotDuration)
+}
+
+// StartOfEpoch provides the time at which an epoch starts.
+func (s *Service) StartOfEpoch(epoch phase0.Epoch) time.Time {
+	return s.StartOfSlot(s.FirstSlotOfEpoch(epoch))
+}
+
+// CurrentSlot provides the current slot, or 0 before genesis.
+func (s *Service) CurrentSlot() phase0.Slot {
+	return s.TimeToSlot(time.Now())
+}
+
+// CurrentEpoch provides the current epoch, or 0 before genesis.
+func (s *Service) CurrentEpoch() phase0.Epoch {
+	return s.SlotToEpoch(s.CurrentSlot())
+}
+
+// TimeToSlot provides the slot at a given time, or 0 before genesis.
+func (s *Service) TimeToSlot(t time.Time) phase0.Slot {
+	if t.Before(s.genesisTime) {
+		return 0
+	}
+
+	return phase0.Slot(t.Sub(s.genesisTime) / s.slotDuration)
+}
+
+// SlotToEpoch provides the epoch of a slot.
+func (s *Service) SlotToEpoch(slot phase0.Slot) phase0.Epoch {
+	return ComputeEpochAtSlot(slot, s.slotsPerEpoch)
+}
+
+// FirstSlotOfEpoch provides the first slot of an epoch.
+func (s *Service) FirstSlotOfEpoch(epoch phase0.Epoch) phase0.Slot {
+	return ComputeStartSlotAtEpoch(epoch, s.slotsPerEpoch)
+}
+
+// ComputeEpochAtSlot provides the epoch of a slot, for callers without a chain time service.
+// slotsPerEpoch must not be 0.
+func ComputeEpochAtSlot(slot phase0.Slot, slotsPerEpoch uint64) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / slotsPerEpoch)
+}
+
+// ComputeStartSlotAtEpoch provides the first slot of an epoch, for callers without a chain
+// time service.
+func ComputeStartSlotAtEpoch(epoch phase0.Epoch, slotsPerEpoch uint64) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * slotsPerEpoch)
+}
+
+// BlockDeadline provides the time by which the block for a slot should be published.
+func (s *Service) BlockDeadline(slot phase0.Slot) time.Time {
+	return s.StartOfSlot(slot).Add(s.blockCutoff)
+}
+
+// AttestationDeadline provides the time by which attestations for a slot should be
+// published, one third of the way through the slot.
+func (s *Service) AttestationDeadline(slot phase0.Slot) time.Time {
+	return s.StartOfSlot(slot).Add(s.slotDuration / 3)
+}
+
+// AggregateDeadline provides the time by which aggregate attestations and sync committee
+// contributions for a slot should be published, two thirds of the way through the slot.
+func (s *Service) AggregateDeadline(slot phase0.Slot) time.Time {
+	return s.StartOfSlot(slot).Add(2 * s.slotDuration / 3)
+}
+
+// BlockContext returns a context that is cancelled at the block deadline for a slot.
+func (s *Service) BlockContext(ctx context.Context, slot phase0.Slot) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, s.BlockDeadline(slot))
+}
+
+// AttestationContext returns a context that is cancelled at the attestation deadline for a slot.
+func (s *Service) AttestationContext(ctx context.Context, slot phase0.Slot) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, s.AttestationDeadline(slot))
+}
+
+// AggregateContext returns a context that is cancelled at the aggregate deadline for a slot.
+func (s *Service) AggregateContext(ctx context.Context, slot phase0.Slot) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, s.AggregateDeadline(slot))
+}
+
+// SlotContext returns a context that is cancelled the given time after the start of a slot.
+func (s *Service) SlotContext(ctx context.Context,
+	slot phase0.Slot,
+	offset time.Duration,
+) (
+	context.Context,
+	context.CancelFunc,
+) {
+	return context.WithDeadline(ctx, s.StartOfSlot(slot).Add(offset))
+}
@@ -0,0 +1,249 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// timeClient provides chain timing values.
+type timeClient struct {
+	genesisTime time.Time
+	err         error
+}
+
+func (*timeClient) Name() string { return "time" }
+
+func (*timeClient) Address() string { return "" }
+
+func (c *timeClient) GenesisTime(_ context.Context) (time.Time, error) {
+	return c.genesisTime, c.err
+}
+
+func (*timeClient) SlotDuration(_ context.Context) (time.Duration, error) {
+	return 12 * time.Second, nil
+}
+
+func (*timeClient) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 32, nil
+}
+
+// nameClient provides no chain timing values.
+type nameClient struct{}
+
+func (*nameClient) Name() string { return "name" }
+
+func (*nameClient) Address() string { return "" }
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1606824023, 0)
+
+	tests := []struct {
+		name   string
+		params []chaintime.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisTimeMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithSlotDuration(12 * time.Second),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+			err: "problem with parameters: no genesis time specified",
+		},
+		{
+			name: "SlotDurationMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+			err: "problem with parameters: no slot duration specified",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotDuration(12 * time.Second),
+			},
+			err: "problem with parameters: no slots per epoch specified",
+		},
+		{
+			name: "BlockCutoffNegative",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotDuration(12 * time.Second),
+				chaintime.WithSlotsPerEpoch(32),
+				chaintime.WithBlockCutoff(-1),
+			},
+			err: "problem with parameters: block cutoff cannot be negative",
+		},
+		{
+			name: "ClientNotProvider",
+			params: []chaintime.Parameter{
+				chaintime.WithClient(&nameClient{}),
+			},
+			err: "client does not provide genesis time",
+		},
+		{
+			name: "ClientErrors",
+			params: []chaintime.Parameter{
+				chaintime.WithClient(&timeClient{err: errors.New("mock error")}),
+			},
+			err: "failed to obtain genesis time: mock error",
+		},
+		{
+			name: "ClientPartial",
+			params: []chaintime.Parameter{
+				chaintime.WithClient(&nameClient{}),
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotDuration(12 * time.Second),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+		},
+		{
+			name: "Client",
+			params: []chaintime.Parameter{
+				chaintime.WithClient(&timeClient{genesisTime: genesisTime}),
+			},
+		},
+		{
+			name: "Good",
+			params: []chaintime.Parameter{
+				chaintime.WithGenesisTime(genesisTime),
+				chaintime.WithSlotDuration(12 * time.Second),
+				chaintime.WithSlotsPerEpoch(32),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chaintime.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1606824023, 0)
+	s, err := chaintime.New(ctx, chaintime.WithClient(&timeClient{genesisTime: genesisTime}))
+	require.NoError(t, err)
+
+	require.Equal(t, genesisTime, s.GenesisTime())
+	require.Equal(t, 12*time.Second, s.SlotDuration())
+	require.Equal(t, uint64(32), s.SlotsPerEpoch())
+
+	require.Equal(t, genesisTime.Add(120*time.Second), s.StartOfSlot(10))
+	require.Equal(t, genesisTime.Add(2*384*time.Second), s.StartOfEpoch(2))
+	require.Equal(t, phase0.Slot(10), s.TimeToSlot(genesisTime.Add(131*time.Second)))
+	require.Equal(t, phase0.Slot(0), s.TimeToSlot(genesisTime.Add(-time.Hour)))
+	require.Equal(t, phase0.Epoch(2), s.SlotToEpoch(95))
+	require.Equal(t, phase0.Slot(96), s.FirstSlotOfEpoch(3))
+	require.Equal(t, s.TimeToSlot(time.Now()), s.CurrentSlot())
+	require.Equal(t, s.SlotToEpoch(s.CurrentSlot()), s.CurrentEpoch())
+
+	require.Equal(t, phase0.Epoch(0), chaintime.ComputeEpochAtSlot(31, 32))
+	require.Equal(t, phase0.Epoch(1), chaintime.ComputeEpochAtSlot(32, 32))
+	require.Equal(t, phase0.Epoch(12), chaintime.ComputeEpochAtSlot(100, 8))
+	require.Equal(t, phase0.Slot(64), chaintime.ComputeStartSlotAtEpoch(2, 32))
+	require.Equal(t, phase0.Slot(0), chaintime.ComputeStartSlotAtEpoch(0, 32))
+}
+
+func TestDeadlines(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1606824023, 0)
+
+	s, err := chaintime.New(ctx,
+		chaintime.WithGenesisTime(genesisTime),
+		chaintime.WithSlotDuration(12*time.Second),
+		chaintime.WithSlotsPerEpoch(32),
+	)
+	require.NoError(t, err)
+	start := s.StartOfSlot(100)
+	require.Equal(t, start.Add(4*time.Second), s.BlockDeadline(100))
+	require.Equal(t, start.Add(4*time.Second), s.AttestationDeadline(100))
+	require.Equal(t, start.Add(8*time.Second), s.AggregateDeadline(100))
+
+	s, err = chaintime.New(ctx,
+		chaintime.WithGenesisTime(genesisTime),
+		chaintime.WithSlotDuration(12*time.Second),
+		chaintime.WithSlotsPerEpoch(32),
+		chaintime.WithBlockCutoff(2*time.Second),
+	)
+	require.NoError(t, err)
+	require.Equal(t, start.Add(2*time.Second), s.BlockDeadline(100))
+
+	contexts := []struct {
+		name     string
+		fn       func(context.Context, phase0.Slot) (context.Context, context.CancelFunc)
+		deadline time.Time
+	}{
+		{
+			name:     "Block",
+			fn:       s.BlockContext,
+			deadline: start.Add(2 * time.Second),
+		},
+		{
+			name:     "Attestation",
+			fn:       s.AttestationContext,
+			deadline: start.Add(4 * time.Second),
+		},
+		{
+			name:     "Aggregate",
+			fn:       s.AggregateContext,
+			deadline: start.Add(8 * time.Second),
+		},
+	}
+	for _, test := range contexts {
+		t.Run(test.name, func(t *testing.T) {
+			opCtx, cancel := test.fn(ctx, 100)
+			defer cancel()
+			deadline, exists := opCtx.Deadline()
+			require.True(t, exists)
+			require.Equal(t, test.deadline, deadline)
+			// The slot is long past, so the context is already done.
+			require.ErrorIs(t, opCtx.Err(), context.DeadlineExceeded)
+		})
+	}
+
+	// Contexts for future slots are bounded by the deadline.
+	future := s.CurrentSlot() + 10
+	opCtx, cancel := s.SlotContext(ctx, future, time.Second)
+	defer cancel()
+	deadline, exists := opCtx.Deadline()
+	require.True(t, exists)
+	require.Equal(t, s.StartOfSlot(future).Add(time.Second), deadline)
+	require.NoError(t, opCtx.Err())
+
+	// An earlier parent deadline is retained.
+	parentCtx, parentCancel := context.WithTimeout(ctx, time.Millisecond)
+	defer parentCancel()
+	opCtx, cancel = s.AttestationContext(parentCtx, future)
+	defer cancel()
+	deadline, _ = opCtx.Deadline()
+	require.True(t, deadline.Before(s.StartOfSlot(future)))
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/pkg/errors"
+)
+
+// rewritePackage copies the non-test, non-generated Go files of a package to
+// a new directory under base, rewriting preset-dependent SSZ tags as it does so.
+// It returns the path of the new directory.
+func rewritePackage(path string, base string, name string, preset *presets.Preset) (string, error) {
+	dest := filepath.Join(base, name)
+	if err := os.MkdirAll(dest, 0o700); err != nil {
+		return "", err
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		data, err := rewriteFile(file, preset)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to rewrite %s", file)
+		}
+		if data == nil {
+			// Generated file.
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dest, filepath.Base(file)), data, 0o600); err != nil {
+			return "", err
+		}
+	}
+
+	return dest, nil
+}
+
+// rewriteFile returns the contents of a file with preset-dependent SSZ tags
+// rewritten.  It returns nil if the file contains generated code.
+func rewriteFile(file string, preset *presets.Preset) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Comments) > 0 && strings.HasPrefix(f.Comments[0].Text(), "Code generated") {
+		return nil, nil
+	}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		if err != nil {
+			return false
+		}
+		spec, isTypeSpec := node.(*ast.TypeSpec)
+		if !isTypeSpec {
+			return true
+		}
+		structType, isStruct := spec.Type.(*ast.StructType)
+		if !isStruct {
+			return false
+		}
+		for _, field := range structType.Fields.List {
+			if field.Tag == nil {
+				continue
+			}
+			for _, fieldName := range field.Names {
+				templates, exists := presets.FieldTemplates(f.Name.Name, spec.Name.Name, fieldName.Name)
+				if !exists {
+					continue
+				}
+				var tag string
+				tag, err = strconv.Unquote(field.Tag.Value)
+				if err != nil {
+					return false
+				}
+				tag, err = rewriteTag(tag, templates, preset)
+				if err != nil {
+					err = errors.Wrapf(err, "%s.%s", spec.Name.Name, fieldName.Name)
+					return false
+				}
+				field.Tag.Value = "`" + tag + "`"
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// copyGenerated copies generated files from the source directory to the
+// destination directory, removing any unused imports and adding the build
+// constraint if supplied.
+func copyGenerated(source string, dest string, suffix string, buildTag string) error {
+	files, err := filepath.Glob(filepath.Join(source, "*"+suffix))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := removeUnusedImports(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed to tidy %s", file)
+		}
+		if buildTag != "" {
+			data = addBuildConstraint(data, buildTag)
+		}
+		if err := os.WriteFile(filepath.Join(dest, filepath.Base(file)), data, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addBuildConstraint adds a build constraint to generated code, between the
+// header comment and the package clause.
+func addBuildConstraint(data []byte, constraint string) []byte {
+	header, body, found := bytes.Cut(data, []byte("\npackage "))
+	if !found {
+		return data
+	}
+
+	return []byte(fmt.Sprintf("%s\n\n//go:build %s\n\npackage %s", bytes.TrimRight(header, "\n"), constraint, body))
+}
+
+// removeUnusedImports removes imports that are not referenced by a file.
+func removeUnusedImports(file string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(f, func(node ast.Node) bool {
+		if selector, isSelector := node.(*ast.SelectorExpr); isSelector {
+			if ident, isIdent := selector.X.(*ast.Ident); isIdent {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	for _, decl := range f.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		specs := make([]ast.Spec, 0, len(genDecl.Specs))
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			path, err := strconv.Unquote(importSpec.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+			if importUsed(importSpec, path, used) {
+				specs = append(specs, spec)
+			}
+		}
+		genDecl.Specs = specs
+	}
+	f.Imports = nil
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// importUsed returns true if the import is referenced.  Package names are
+// inferred from import paths, so imports whose names cannot be inferred are
+// always considered to be used.
+func importUsed(importSpec *ast.ImportSpec, path string, used map[string]bool) bool {
+	if importSpec.Name != nil {
+		return importSpec.Name.Name == "_" || used[importSpec.Name.Name]
+	}
+	name := filepath.Base(path)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = filepath.Base(filepath.Dir(path))
+	}
+	name = strings.TrimPrefix(name, "go-")
+	if strings.ContainsAny(name, "-.") {
+		return true
+	}
+
+	return used[name]
+}
@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// presetsszgen generates SSZ encoding code for the types in a package, with
+// the sizes of preset-dependent fields taken from a chosen preset.
+//
+// It is a wrapper around sszgen from github.com/ferranbt/fastssz, which must be
+// installed, and takes the same arguments with the addition of --preset and --build-tag.  For example:
+//
+//	presetsszgen --preset=minimal --path . --include ../phase0 --objs BeaconBlock,BeaconState
+//
+// The preset can be "mainnet" (the default), "minimal", "gnosis", or the path to a preset
+// YAML file or directory in the format used by the consensus specifications.
+// Generated files are written alongside the source files.
+//
+// If --build-tag is supplied the generated files are given a build constraint,
+// which allows encodings for multiple presets to live in the same package, for example:
+//
+//	presetsszgen --preset=minimal --build-tag=minimal --suffix=encoding_minimal --path . --objs BeaconState
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/pkg/errors"
+)
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.preset, "preset", "", "preset name, file or directory (default mainnet)")
+	flag.StringVar(&opts.sszgen, "sszgen", "sszgen", "sszgen binary")
+	flag.StringVar(&opts.source, "path", "", "path to the package containing the types")
+	flag.StringVar(&opts.objs, "objs", "", "comma-separated list of types for which to generate code")
+	flag.StringVar(&opts.excludeObjs, "exclude-objs", "", "comma-separated list of types to exclude from output")
+	flag.StringVar(&opts.include, "include", "", "comma-separated list of paths to packages containing referenced types")
+	flag.StringVar(&opts.suffix, "suffix", "encoding", "suffix for generated files")
+	flag.StringVar(&opts.buildTag, "build-tag", "", "build constraint for generated files")
+	flag.Parse()
+
+	if err := run(&opts); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERR]: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	preset      string
+	sszgen      string
+	source      string
+	objs        string
+	excludeObjs string
+	include     string
+	suffix      string
+	buildTag    string
+}
+
+func run(opts *options) error {
+	if opts.source == "" {
+		return errors.New("no path supplied")
+	}
+	preset, err := presets.Load(opts.preset)
+	if err != nil {
+		return err
+	}
+
+	suffix := opts.suffix
+	if !strings.HasPrefix(suffix, "_") {
+		suffix = "_" + suffix
+	}
+	if !strings.HasSuffix(suffix, ".go") {
+		suffix += ".go"
+	}
+
+	tmpDir, err := os.MkdirTemp("", "presetsszgen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// sszgen obtains sizes from struct tags, so work on copies of the source
+	// and included packages with their tags rewritten for the preset.
+	source, err := rewritePackage(opts.source, tmpDir, "source", preset)
+	if err != nil {
+		return err
+	}
+	includes := make([]string, 0)
+	for i, include := range decodeList(opts.include) {
+		rewritten, err := rewritePackage(include, tmpDir, fmt.Sprintf("include%d", i), preset)
+		if err != nil {
+			return err
+		}
+		includes = append(includes, rewritten)
+	}
+
+	args := []string{
+		"--path", source,
+		"--suffix", opts.suffix,
+		"--objs", opts.objs,
+	}
+	if len(includes) > 0 {
+		args = append(args, "--include", strings.Join(includes, ","))
+	}
+	if opts.excludeObjs != "" {
+		args = append(args, "--exclude-objs", opts.excludeObjs)
+	}
+	// #nosec G204
+	cmd := exec.Command(opts.sszgen, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(err, "sszgen failed")
+	}
+
+	return copyGenerated(source, opts.source, suffix, opts.buildTag)
+}
+
+func decodeList(input string) []string {
+	if input == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSpace(input), ",")
+}
@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/pkg/errors"
+)
+
+// rewriteTag rewrites the preset-dependent values of the supplied struct tag.
+// Only tag keys that are already present in the tag are rewritten.
+func rewriteTag(tag string, templates map[string]string, preset *presets.Preset) (string, error) {
+	for key, template := range templates {
+		value, err := preset.EvaluateDimensions(template)
+		if err != nil {
+			return "", errors.Wrap(err, key)
+		}
+		re := regexp.MustCompile(regexp.QuoteMeta(key) + `:"[^"]*"`)
+		tag = re.ReplaceAllLiteralString(tag, fmt.Sprintf("%s:%q", key, value))
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteFile(t *testing.T) {
+	preset := presets.Minimal()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "beaconstate.go")
+	require.NoError(t, os.WriteFile(source, []byte("package test\n\ntype BeaconState struct {\n\tSlot       uint64\n\tBlockRoots [][32]byte `ssz-size:\"8192,32\"`\n\tSlashings  []uint64   `json:\"slashings\" ssz-size:\"8192\"`\n}\n"), 0o600))
+	generated := filepath.Join(dir, "beaconstate_encoding.go")
+	require.NoError(t, os.WriteFile(generated, []byte("// Code generated by fastssz. DO NOT EDIT.\npackage test\n"), 0o600))
+
+	res, err := rewriteFile(source, preset)
+	require.NoError(t, err)
+	require.Equal(t, "package test\n\ntype BeaconState struct {\n\tSlot       uint64\n\tBlockRoots [][32]byte `ssz-size:\"64,32\"`\n\tSlashings  []uint64   `json:\"slashings\" ssz-size:\"64\"`\n}\n", string(res))
+
+	res, err = rewriteFile(generated, preset)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestRewriteFileQualified(t *testing.T) {
+	preset := presets.Minimal()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "attestation.go")
+	require.NoError(t, os.WriteFile(source, []byte("package electra\n\ntype Attestation struct {\n\tAggregationBits []byte `ssz-max:\"131072\"`\n\tCommitteeBits   []byte `ssz-size:\"8\"`\n}\n"), 0o600))
+
+	res, err := rewriteFile(source, preset)
+	require.NoError(t, err)
+	require.Equal(t, "package electra\n\ntype Attestation struct {\n\tAggregationBits []byte `ssz-max:\"8192\"`\n\tCommitteeBits   []byte `ssz-size:\"1\"`\n}\n", string(res))
+}
+
+func TestAddBuildConstraint(t *testing.T) {
+	generated := "// Code generated by fastssz. DO NOT EDIT.\n// Hash: 00\npackage test\n\nimport (\n\tssz \"github.com/ferranbt/fastssz\"\n)\n"
+	require.Equal(t, "// Code generated by fastssz. DO NOT EDIT.\n// Hash: 00\n\n//go:build !minimal\n\npackage test\n\nimport (\n\tssz \"github.com/ferranbt/fastssz\"\n)\n", string(addBuildConstraint([]byte(generated), "!minimal")))
+	require.Equal(t, "no package clause", string(addBuildConstraint([]byte("no package clause"), "minimal")))
+}
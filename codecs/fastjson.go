@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"encoding/json"
+	"sync/atomic"
+)
+
+var fastJSON atomic.Bool
+
+// SetFastJSON enables or disables fast JSON decoding.
+// When enabled, RawJSON and RawJSONArray split their input with a scanner that
+// checks the structure of the input and returns values that reference the input,
+// rather than decoding with encoding/json and copying each value.  Input that the
+// scanner rejects is passed to encoding/json, so errors are unchanged.
+// Fast JSON decoding can also be enabled at build time with the fastjson build tag.
+func SetFastJSON(enabled bool) {
+	fastJSON.Store(enabled)
+}
+
+// FastJSON returns true if fast JSON decoding is enabled.
+func FastJSON() bool {
+	return fastJSON.Load()
+}
+
+// RawJSONArray generates raw JSON for the elements of an array.
+func RawJSONArray(input []byte) ([]json.RawMessage, error) {
+	if FastJSON() {
+		// Invalid input falls through to encoding/json, which provides the error.
+		if res, err := scanArray(input); err == nil {
+			return res, nil
+		}
+	}
+
+	// The error from encoding/json is returned as-is, to match callers that
+	// decoded arrays directly before this function existed.
+	res := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(input, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
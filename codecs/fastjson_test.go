@@ -0,0 +1,190 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/require"
+)
+
+type rawJSONTest struct {
+	A string `json:"a"`
+	B string `json:"b,allowempty"`
+}
+
+func TestRawJSONFast(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+		},
+		{
+			name:  "Null",
+			input: []byte("null"),
+		},
+		{
+			name:  "Array",
+			input: []byte(`["a"]`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"a":"1","b":"2"}`),
+		},
+		{
+			name:  "Whitespace",
+			input: []byte(" {\n \"a\" : \"1\" ,\t\"b\":[ 1, 2.5e3, -3 ] } "),
+		},
+		{
+			name:  "Nested",
+			input: []byte(`{"a":{"b":[{"c":true},{"d":false},null]},"b":"\"}\\"}`),
+		},
+		{
+			name:  "EscapedKey",
+			input: []byte(`{"\u0061":"1"}`),
+		},
+		{
+			name:  "Missing",
+			input: []byte(`{"b":"2"}`),
+		},
+		{
+			name:  "Trailing",
+			input: []byte(`{"a":"1"}x`),
+		},
+		{
+			name:  "TrailingComma",
+			input: []byte(`{"a":"1",}`),
+		},
+		{
+			name:  "Unterminated",
+			input: []byte(`{"a":"1"`),
+		},
+		{
+			name:  "BadLiteral",
+			input: []byte(`{"a":nul}`),
+		},
+		{
+			name:  "ControlCharacter",
+			input: []byte("{\"a\":\"\x01\"}"),
+		},
+		{
+			name:  "DeeplyNested",
+			input: []byte(`{"a":` + strings.Repeat("[", 20000) + strings.Repeat("]", 20000) + `}`),
+		},
+	}
+
+	defer codecs.SetFastJSON(codecs.FastJSON())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			codecs.SetFastJSON(false)
+			expected, expectedErr := codecs.RawJSON(&rawJSONTest{}, test.input)
+			codecs.SetFastJSON(true)
+			res, err := codecs.RawJSON(&rawJSONTest{}, test.input)
+			if expectedErr != nil {
+				require.EqualError(t, err, expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expected, res)
+			}
+		})
+	}
+}
+
+func TestRawJSONArrayFast(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+		},
+		{
+			name:  "Object",
+			input: []byte(`{"a":"1"}`),
+		},
+		{
+			name:  "EmptyArray",
+			input: []byte(` [ ] `),
+		},
+		{
+			name:  "Good",
+			input: []byte(`["0x01","0x0203"]`),
+		},
+		{
+			name:  "Mixed",
+			input: []byte(`[ "a" , {"b":["c"]}, [1,2], true, null, -1.5E-3 ]`),
+		},
+		{
+			name:  "Trailing",
+			input: []byte(`["a"]]`),
+		},
+		{
+			name:  "TrailingComma",
+			input: []byte(`["a",]`),
+		},
+		{
+			name:  "MissingComma",
+			input: []byte(`["a" "b"]`),
+		},
+		{
+			name:  "Unterminated",
+			input: []byte(`["a`),
+		},
+		{
+			name:  "Nested",
+			input: []byte(strings.Repeat("[", 100) + strings.Repeat("]", 100)),
+		},
+		{
+			name:  "DeeplyNested",
+			input: []byte(strings.Repeat("[", 20000) + strings.Repeat("]", 20000)),
+		},
+	}
+
+	defer codecs.SetFastJSON(codecs.FastJSON())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			codecs.SetFastJSON(false)
+			expected, expectedErr := codecs.RawJSONArray(test.input)
+			codecs.SetFastJSON(true)
+			res, err := codecs.RawJSONArray(test.input)
+			if expectedErr != nil {
+				require.EqualError(t, err, expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expected, res)
+			}
+		})
+	}
+}
+
+func TestRawJSONArrayError(t *testing.T) {
+	input := []byte(`true`)
+	var expected []json.RawMessage
+	expectedErr := json.Unmarshal(input, &expected)
+	require.Error(t, expectedErr)
+
+	defer codecs.SetFastJSON(codecs.FastJSON())
+	for _, fast := range []bool{false, true} {
+		codecs.SetFastJSON(fast)
+		_, err := codecs.RawJSONArray(input)
+		require.EqualError(t, err, expectedErr.Error())
+	}
+}
@@ -26,9 +26,16 @@ import (
 // ensuring that all values are present.
 func RawJSON(b any, input []byte) (map[string]json.RawMessage, error) {
 	// Make generic map from input.
-	base := make(map[string]json.RawMessage)
-	if err := json.Unmarshal(input, &base); err != nil {
-		return nil, errors.Wrap(err, "invalid JSON")
+	var base map[string]json.RawMessage
+	if FastJSON() {
+		// Invalid input falls through to encoding/json, which provides the error.
+		base, _ = scanObject(input)
+	}
+	if base == nil {
+		base = make(map[string]json.RawMessage)
+		if err := json.Unmarshal(input, &base); err != nil {
+			return nil, errors.Wrap(err, "invalid JSON")
+		}
 	}
 
 	// Ensure all values are present.
@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"encoding/json"
+)
+
+// JSONCodec is the interface for a JSON encoder and decoder.
+// Its methods match those of encoding/json, so alternative implementations
+// such as jsoniter's ConfigCompatibleWithStandardLibrary or goccy/go-json
+// can be supplied directly or with a minimal wrapper.
+type JSONCodec interface {
+	// Marshal returns the JSON encoding of v.
+	Marshal(v any) ([]byte, error)
+	// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
+	Unmarshal(data []byte, v any) error
+}
+
+// StdJSON is a JSON codec that uses encoding/json.
+var StdJSON JSONCodec = stdJSON{}
+
+type stdJSON struct{}
+
+// Marshal returns the JSON encoding of v.
+func (stdJSON) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
+func (stdJSON) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,31 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// DecodeUint64 decodes a JSON string containing an unsigned 64-bit integer.
+// The integer can be either decimal, as used by the consensus API, or a
+// 0x-prefixed hex quantity, as used by the execution engine API.
+func DecodeUint64(input []byte) (uint64, error) {
+	value := bytes.Trim(input, `"`)
+	if bytes.HasPrefix(value, []byte{'0', 'x'}) {
+		return strconv.ParseUint(string(value[2:]), 16, 64)
+	}
+
+	return strconv.ParseUint(string(value), 10, 64)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected uint64
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: []byte(`""`),
+			err:   `strconv.ParseUint: parsing "": invalid syntax`,
+		},
+		{
+			name:     "Decimal",
+			input:    []byte(`"12345"`),
+			expected: 12345,
+		},
+		{
+			name:  "DecimalNegative",
+			input: []byte(`"-1"`),
+			err:   `strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		{
+			name:     "DecimalMax",
+			input:    []byte(`"18446744073709551615"`),
+			expected: 18446744073709551615,
+		},
+		{
+			name:  "DecimalOverflow",
+			input: []byte(`"18446744073709551616"`),
+			err:   `strconv.ParseUint: parsing "18446744073709551616": value out of range`,
+		},
+		{
+			name:     "Hex",
+			input:    []byte(`"0x3039"`),
+			expected: 12345,
+		},
+		{
+			name:     "HexZero",
+			input:    []byte(`"0x0"`),
+			expected: 0,
+		},
+		{
+			name:  "HexEmpty",
+			input: []byte(`"0x"`),
+			err:   `strconv.ParseUint: parsing "": invalid syntax`,
+		},
+		{
+			name:  "HexInvalid",
+			input: []byte(`"0xg"`),
+			err:   `strconv.ParseUint: parsing "g": invalid syntax`,
+		},
+		{
+			name:     "HexMax",
+			input:    []byte(`"0xffffffffffffffff"`),
+			expected: 18446744073709551615,
+		},
+		{
+			name:  "HexOverflow",
+			input: []byte(`"0x10000000000000000"`),
+			err:   `strconv.ParseUint: parsing "10000000000000000": value out of range`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := codecs.DecodeUint64(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
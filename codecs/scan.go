@@ -0,0 +1,254 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecs
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// maxScanDepth is the maximum nesting depth of objects and arrays that the scanner
+// accepts.  It matches the limit of encoding/json, to which deeper input falls back.
+const maxScanDepth = 10000
+
+// scanObject splits a JSON object in to its members.
+// The returned values reference the input.
+func scanObject(input []byte) (map[string]json.RawMessage, error) {
+	i := skipWhitespace(input, 0)
+	if i == len(input) || input[i] != '{' {
+		return nil, errors.New("expected object")
+	}
+
+	res := make(map[string]json.RawMessage)
+	end, err := scanMembers(input, i, 1, func(key []byte, value []byte) error {
+		name, err := unquote(key)
+		if err != nil {
+			return err
+		}
+		res[name] = value
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if skipWhitespace(input, end) != len(input) {
+		return nil, errors.Errorf("unexpected data at offset %d", end)
+	}
+
+	return res, nil
+}
+
+// scanArray splits a JSON array in to its elements.
+// The returned elements reference the input.
+func scanArray(input []byte) ([]json.RawMessage, error) {
+	i := skipWhitespace(input, 0)
+	if i == len(input) || input[i] != '[' {
+		return nil, errors.New("expected array")
+	}
+
+	res := make([]json.RawMessage, 0)
+	end, err := scanElements(input, i, 1, func(value []byte) {
+		res = append(res, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if skipWhitespace(input, end) != len(input) {
+		return nil, errors.Errorf("unexpected data at offset %d", end)
+	}
+
+	return res, nil
+}
+
+// scanMembers scans the object starting at input[start], calling fn for each member.
+// depth is the nesting depth of the object.
+// It returns the offset after the object.
+func scanMembers(input []byte, start int, depth int, fn func(key []byte, value []byte) error) (int, error) {
+	if depth > maxScanDepth {
+		return 0, errors.Errorf("maximum depth exceeded at offset %d", start)
+	}
+	i := skipWhitespace(input, start+1)
+	if i < len(input) && input[i] == '}' {
+		return i + 1, nil
+	}
+	for {
+		if i == len(input) || input[i] != '"' {
+			return 0, errors.Errorf("expected key at offset %d", i)
+		}
+		keyEnd, err := skipString(input, i)
+		if err != nil {
+			return 0, err
+		}
+		key := input[i:keyEnd]
+
+		i = skipWhitespace(input, keyEnd)
+		if i == len(input) || input[i] != ':' {
+			return 0, errors.Errorf("expected colon at offset %d", i)
+		}
+		i = skipWhitespace(input, i+1)
+		valueEnd, err := skipValue(input, i, depth)
+		if err != nil {
+			return 0, err
+		}
+		if fn != nil {
+			if err := fn(key, input[i:valueEnd]); err != nil {
+				return 0, err
+			}
+		}
+
+		i = skipWhitespace(input, valueEnd)
+		if i == len(input) {
+			return 0, errors.New("unexpected end of object")
+		}
+		switch input[i] {
+		case ',':
+			i = skipWhitespace(input, i+1)
+		case '}':
+			return i + 1, nil
+		default:
+			return 0, errors.Errorf("expected comma or end of object at offset %d", i)
+		}
+	}
+}
+
+// scanElements scans the array starting at input[start], calling fn for each element.
+// depth is the nesting depth of the array.
+// It returns the offset after the array.
+func scanElements(input []byte, start int, depth int, fn func(value []byte)) (int, error) {
+	if depth > maxScanDepth {
+		return 0, errors.Errorf("maximum depth exceeded at offset %d", start)
+	}
+	i := skipWhitespace(input, start+1)
+	if i < len(input) && input[i] == ']' {
+		return i + 1, nil
+	}
+	for {
+		valueEnd, err := skipValue(input, i, depth)
+		if err != nil {
+			return 0, err
+		}
+		if fn != nil {
+			fn(input[i:valueEnd])
+		}
+
+		i = skipWhitespace(input, valueEnd)
+		if i == len(input) {
+			return 0, errors.New("unexpected end of array")
+		}
+		switch input[i] {
+		case ',':
+			i = skipWhitespace(input, i+1)
+		case ']':
+			return i + 1, nil
+		default:
+			return 0, errors.Errorf("expected comma or end of array at offset %d", i)
+		}
+	}
+}
+
+// skipValue returns the offset after the value starting at input[i].
+// depth is the nesting depth of the enclosing object or array.
+func skipValue(input []byte, i int, depth int) (int, error) {
+	if i == len(input) {
+		return 0, errors.New("unexpected end of input")
+	}
+	switch input[i] {
+	case '"':
+		return skipString(input, i)
+	case '{':
+		return scanMembers(input, i, depth+1, nil)
+	case '[':
+		return scanElements(input, i, depth+1, nil)
+	case 't':
+		return skipLiteral(input, i, "true")
+	case 'f':
+		return skipLiteral(input, i, "false")
+	case 'n':
+		return skipLiteral(input, i, "null")
+	default:
+		return skipNumber(input, i)
+	}
+}
+
+// skipString returns the offset after the string starting at input[i].
+func skipString(input []byte, i int) (int, error) {
+	for j := i + 1; j < len(input); j++ {
+		switch {
+		case input[j] == '"':
+			return j + 1, nil
+		case input[j] == '\\':
+			j++
+		case input[j] < 0x20:
+			return 0, errors.Errorf("invalid character in string at offset %d", j)
+		}
+	}
+
+	return 0, errors.New("unexpected end of string")
+}
+
+// skipLiteral returns the offset after the literal starting at input[i].
+func skipLiteral(input []byte, i int, literal string) (int, error) {
+	if !bytes.HasPrefix(input[i:], []byte(literal)) {
+		return 0, errors.Errorf("invalid literal at offset %d", i)
+	}
+
+	return i + len(literal), nil
+}
+
+// skipNumber returns the offset after the number starting at input[i].
+// The number is checked only for valid characters; it is fully validated when decoded.
+func skipNumber(input []byte, i int) (int, error) {
+	j := i
+	for ; j < len(input); j++ {
+		c := input[j]
+		if (c < '0' || c > '9') && c != '-' && c != '+' && c != '.' && c != 'e' && c != 'E' {
+			break
+		}
+	}
+	if j == i {
+		return 0, errors.Errorf("invalid character at offset %d", i)
+	}
+
+	return j, nil
+}
+
+// skipWhitespace returns the offset of the first non-whitespace character at or after input[i].
+func skipWhitespace(input []byte, i int) int {
+	for ; i < len(input); i++ {
+		switch input[i] {
+		case ' ', '\t', '\n', '\r':
+		default:
+			return i
+		}
+	}
+
+	return i
+}
+
+// unquote returns the value of a quoted string.
+func unquote(input []byte) (string, error) {
+	if bytes.IndexByte(input, '\\') == -1 {
+		return string(input[1 : len(input)-1]), nil
+	}
+
+	var res string
+	if err := json.Unmarshal(input, &res); err != nil {
+		return "", err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,37 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codectest provides verification of the JSON, YAML and SSZ encodings and
+// hash tree roots of consensus types, for use in tests.
+//
+// It is used to test the types in this module, and can be used to test types that are
+// defined elsewhere, such as custom types in forks of this module, to the same standard.
+package codectest
+
+import (
+	"reflect"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Container is an SSZ container.
+type Container interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// newContainer returns a new zero-valued container of the same type as the prototype.
+func newContainer(prototype Container) Container {
+	return reflect.New(reflect.TypeOf(prototype).Elem()).Interface().(Container)
+}
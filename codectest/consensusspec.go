@@ -0,0 +1,93 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codectest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/spectests"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+)
+
+// ConsensusSpec checks containers of the same type as the prototype against the test
+// vectors in the given directory of the Ethereum consensus spec tests, for example
+// tests/mainnet/deneb/ssz_static/BeaconBlock/ssz_random.
+//
+// Each subdirectory is run as a subtest, and must contain the files value.yaml,
+// serialized.ssz_snappy and roots.yaml.  The YAML value of each container must match
+// that supplied, and the SSZ encoding and hash tree root are checked with
+// spectests.RunCase.
+func ConsensusSpec(t *testing.T, dir string, prototype Container) {
+	t.Helper()
+
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if path == dir {
+			// Only interested in subdirectories.
+			return nil
+		}
+		require.NoError(t, err)
+		if info.IsDir() {
+			t.Run(fmt.Sprintf("%s/%s", filepath.Base(filepath.Dir(dir)), info.Name()), func(t *testing.T) {
+				consensusSpecCase(t, path, prototype)
+			})
+		}
+
+		return nil
+	}))
+}
+
+func consensusSpecCase(t *testing.T, path string, prototype Container) {
+	t.Helper()
+
+	// Obtain the container from the YAML.
+	container := newContainer(prototype)
+	specYAML, err := os.ReadFile(filepath.Join(path, "value.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(specYAML, container))
+	// Confirm we can return to the YAML.
+	remarshalledSpecYAML, err := yaml.Marshal(container)
+	require.NoError(t, err)
+	require.Equal(t, YAMLFormat(specYAML), YAMLFormat(remarshalledSpecYAML))
+
+	// Check the SSZ and hash tree root against the test case.
+	require.NoError(t, spectests.RunCase(newContainer(prototype), path))
+}
+
+// YAMLFormat returns the YAML input in a canonical form, allowing YAML from different
+// sources to be compared.
+func YAMLFormat(input []byte) string {
+	val := make(map[string]any)
+	if err := yaml.UnmarshalWithOptions(input, &val, yaml.UseOrderedMap()); err != nil {
+		panic(err)
+	}
+
+	res, err := yaml.MarshalWithOptions(val, yaml.Flow(true))
+	if err != nil {
+		panic(err)
+	}
+
+	replacements := [][][]byte{
+		{[]byte(`"`), []byte(`'`)},
+	}
+	for _, replacement := range replacements {
+		res = bytes.ReplaceAll(res, replacement[0], replacement[1])
+	}
+
+	return string(bytes.ToLower(res))
+}
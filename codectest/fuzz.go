@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codectest
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Fuzz fuzzes decoding of containers of the same type as the prototype.
+// Any input that decodes successfully must re-encode to the same bytes, and
+// must hash to the same root before and after re-encoding.
+func Fuzz(f *testing.F, prototype Container) {
+	f.Helper()
+
+	f.Add([]byte{})
+	// A zeroed buffer covers the fixed part of the container, which is enough to
+	// decode fixed-size containers and to reach the offset checks of others.
+	f.Add(make([]byte, newContainer(prototype).SizeSSZ()))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		container := newContainer(prototype)
+		if err := container.UnmarshalSSZ(data); err != nil {
+			return
+		}
+
+		encoded, err := container.MarshalSSZ()
+		if err != nil {
+			t.Fatalf("failed to encode decoded container: %v", err)
+		}
+		if !bytes.Equal(data, encoded) {
+			t.Fatalf("encoded container does not match input")
+		}
+
+		root, err := container.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("failed to hash decoded container: %v", err)
+		}
+		repeatRoot, err := container.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("failed to rehash decoded container: %v", err)
+		}
+		if root != repeatRoot {
+			t.Fatalf("hash of container is not stable")
+		}
+
+		redecoded := newContainer(prototype)
+		if err := redecoded.UnmarshalSSZ(encoded); err != nil {
+			t.Fatalf("failed to decode encoded container: %v", err)
+		}
+		redecodedRoot, err := redecoded.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("failed to hash redecoded container: %v", err)
+		}
+		if root != redecodedRoot {
+			t.Fatalf("hash of redecoded container does not match")
+		}
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codectest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Golden checks containers of the same type as the prototype against the golden files
+// in the given directory.
+//
+// Each subdirectory is run as a subtest, and must contain the files value.json,
+// serialized.ssz and root.  The JSON value must pass RoundTrip, and the SSZ encoding
+// and hash tree root of the container must match those in serialized.ssz and root.
+//
+// If the environment variable CODECTEST_UPDATE_GOLDEN is set then serialized.ssz and
+// root are written from the JSON value rather than checked, allowing golden files to be
+// created for new types and updated for intentional changes in encoding.
+func Golden(t *testing.T, dir string, prototype Container) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		t.Run(fmt.Sprintf("%s/%s", filepath.Base(dir), entry.Name()), func(t *testing.T) {
+			goldenCase(t, path, prototype)
+		})
+	}
+}
+
+func goldenCase(t *testing.T, path string, prototype Container) {
+	t.Helper()
+
+	input, err := os.ReadFile(filepath.Join(path, "value.json"))
+	require.NoError(t, err)
+	container := RoundTrip(t, prototype, input)
+
+	encoded, err := container.MarshalSSZ()
+	require.NoError(t, err)
+	root, err := container.HashTreeRoot()
+	require.NoError(t, err)
+	rootStr := fmt.Sprintf("%#x\n", root)
+
+	if os.Getenv("CODECTEST_UPDATE_GOLDEN") != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(path, "serialized.ssz"), encoded, 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(path, "root"), []byte(rootStr), 0o600))
+
+		return
+	}
+
+	goldenSSZ, err := os.ReadFile(filepath.Join(path, "serialized.ssz"))
+	require.NoError(t, err)
+	require.Equal(t, goldenSSZ, encoded, "SSZ does not match golden file")
+	goldenRoot, err := os.ReadFile(filepath.Join(path, "root"))
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSpace(string(goldenRoot)), strings.TrimSpace(rootStr), "hash tree root does not match golden file")
+}
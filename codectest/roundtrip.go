@@ -0,0 +1,76 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codectest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RoundTrip checks that the JSON input decodes to a container of the same type as the
+// prototype, and that the container encodes back to the same JSON.  It then checks that
+// the container encodes to SSZ that decodes to a container with the same JSON, SSZ and
+// hash tree root.
+//
+// The decoded container is returned for further checks.
+func RoundTrip(t *testing.T, prototype Container, input []byte) Container {
+	t.Helper()
+
+	container := newContainer(prototype)
+	require.NoError(t, json.Unmarshal(input, container), "failed to decode JSON")
+	remarshalledJSON, err := json.Marshal(container)
+	require.NoError(t, err, "failed to encode JSON")
+	require.JSONEq(t, string(input), string(remarshalledJSON), "encoded JSON does not match input")
+
+	encoded, err := container.MarshalSSZ()
+	require.NoError(t, err, "failed to encode SSZ")
+	root, err := container.HashTreeRoot()
+	require.NoError(t, err, "failed to hash container")
+
+	decoded := SSZRoundTrip(t, prototype, encoded)
+	decodedJSON, err := json.Marshal(decoded)
+	require.NoError(t, err, "failed to encode JSON of decoded container")
+	require.JSONEq(t, string(input), string(decodedJSON), "JSON of SSZ-decoded container does not match input")
+	decodedRoot, err := decoded.HashTreeRoot()
+	require.NoError(t, err, "failed to hash decoded container")
+	require.Equal(t, root, decodedRoot, "hash tree root of SSZ-decoded container does not match")
+
+	return container
+}
+
+// SSZRoundTrip checks that the SSZ input decodes to a container of the same type as the
+// prototype, that the container encodes back to the same SSZ, and that its hash tree root
+// is stable.
+//
+// The decoded container is returned for further checks.
+func SSZRoundTrip(t *testing.T, prototype Container, input []byte) Container {
+	t.Helper()
+
+	container := newContainer(prototype)
+	require.NoError(t, container.UnmarshalSSZ(input), "failed to decode SSZ")
+	encoded, err := container.MarshalSSZ()
+	require.NoError(t, err, "failed to encode SSZ")
+	require.Equal(t, input, encoded, "encoded SSZ does not match input")
+	require.Equal(t, len(input), container.SizeSSZ(), "SSZ size does not match input")
+
+	root, err := container.HashTreeRoot()
+	require.NoError(t, err, "failed to hash container")
+	repeatRoot, err := container.HashTreeRoot()
+	require.NoError(t, err, "failed to rehash container")
+	require.Equal(t, root, repeatRoot, "hash tree root is not stable")
+
+	return container
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codectest_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	input := []byte(`{"slot":"100","index":"1","beacon_block_root":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","source":{"epoch":"1","root":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"},"target":{"epoch":"2","root":"0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"}}`)
+
+	container := codectest.RoundTrip(t, &phase0.AttestationData{}, input)
+	data, isAttestationData := container.(*phase0.AttestationData)
+	require.True(t, isAttestationData)
+	require.Equal(t, phase0.Slot(100), data.Slot)
+	require.Equal(t, phase0.Epoch(2), data.Target.Epoch)
+}
+
+func TestSSZRoundTrip(t *testing.T) {
+	input := make([]byte, 40)
+	input[0] = 0x05
+
+	container := codectest.SSZRoundTrip(t, &phase0.Checkpoint{}, input)
+	checkpoint, isCheckpoint := container.(*phase0.Checkpoint)
+	require.True(t, isCheckpoint)
+	require.Equal(t, phase0.Epoch(5), checkpoint.Epoch)
+}
@@ -0,0 +1,38 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crypto provides the BLS signature verification interface used by the
+// verification helpers in this module.  Users can supply their own implementation,
+// or build with the "herumi" tag to obtain a default implementation backed by
+// github.com/herumi/bls-eth-go-binary.
+package crypto
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BLSVerifier verifies BLS signatures.
+type BLSVerifier interface {
+	// VerifySignature returns true if the signature is a valid signature of the message by the public key.
+	VerifySignature(pubKey phase0.BLSPubKey, message []byte, signature phase0.BLSSignature) (bool, error)
+
+	// FastAggregateVerify returns true if the signature is a valid aggregate signature
+	// of the message by all of the public keys.
+	FastAggregateVerify(pubKeys []phase0.BLSPubKey, message []byte, signature phase0.BLSSignature) (bool, error)
+}
+
+// DefaultBLSVerifier returns the default BLS verifier.
+// This returns an error unless the module is built with the "herumi" tag.
+func DefaultBLSVerifier() (BLSVerifier, error) {
+	return defaultBLSVerifier()
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build herumi
+
+package crypto
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
+var (
+	herumiInit    sync.Once
+	herumiInitErr error
+)
+
+// herumiVerifier is a BLS verifier backed by the herumi library.
+type herumiVerifier struct{}
+
+func defaultBLSVerifier() (BLSVerifier, error) {
+	herumiInit.Do(func() {
+		if err := bls.Init(bls.BLS12_381); err != nil {
+			herumiInitErr = errors.Wrap(err, "failed to initialise BLS library")
+
+			return
+		}
+		if err := bls.SetETHmode(bls.EthModeDraft07); err != nil {
+			herumiInitErr = errors.Wrap(err, "failed to set BLS library to Ethereum mode")
+		}
+	})
+	if herumiInitErr != nil {
+		return nil, herumiInitErr
+	}
+
+	return &herumiVerifier{}, nil
+}
+
+// VerifySignature returns true if the signature is a valid signature of the message by the public key.
+func (*herumiVerifier) VerifySignature(pubKey phase0.BLSPubKey,
+	message []byte,
+	signature phase0.BLSSignature,
+) (
+	bool,
+	error,
+) {
+	var key bls.PublicKey
+	if err := key.Deserialize(pubKey[:]); err != nil {
+		return false, errors.Wrap(err, "invalid public key")
+	}
+	var sig bls.Sign
+	if err := sig.Deserialize(signature[:]); err != nil {
+		return false, errors.Wrap(err, "invalid signature")
+	}
+
+	return sig.VerifyByte(&key, message), nil
+}
+
+// FastAggregateVerify returns true if the signature is a valid aggregate signature
+// of the message by all of the public keys.
+func (*herumiVerifier) FastAggregateVerify(pubKeys []phase0.BLSPubKey,
+	message []byte,
+	signature phase0.BLSSignature,
+) (
+	bool,
+	error,
+) {
+	if len(pubKeys) == 0 {
+		return false, errors.New("no public keys supplied")
+	}
+	keys := make([]bls.PublicKey, len(pubKeys))
+	for i := range pubKeys {
+		if err := keys[i].Deserialize(pubKeys[i][:]); err != nil {
+			return false, errors.Wrapf(err, "invalid public key %d", i)
+		}
+	}
+	var sig bls.Sign
+	if err := sig.Deserialize(signature[:]); err != nil {
+		return false, errors.Wrap(err, "invalid signature")
+	}
+
+	return sig.FastAggregateVerify(keys, message), nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build herumi
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/crypto"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHerumiBLSVerifier(t *testing.T) {
+	// Obtaining the verifier initialises the library, so must come before key generation.
+	verifier, err := crypto.DefaultBLSVerifier()
+	require.NoError(t, err)
+
+	message := []byte("message")
+	pubKeys := make([]phase0.BLSPubKey, 3)
+	sigs := make([]bls.Sign, 3)
+	for i := range pubKeys {
+		var key bls.SecretKey
+		key.SetByCSPRNG()
+		copy(pubKeys[i][:], key.GetPublicKey().Serialize())
+		sigs[i] = *key.SignByte(message)
+	}
+
+	var signature phase0.BLSSignature
+	copy(signature[:], sigs[0].Serialize())
+	valid, err := verifier.VerifySignature(pubKeys[0], message, signature)
+	require.NoError(t, err)
+	require.True(t, valid)
+	valid, err = verifier.VerifySignature(pubKeys[1], message, signature)
+	require.NoError(t, err)
+	require.False(t, valid)
+	valid, err = verifier.VerifySignature(pubKeys[0], []byte("other"), signature)
+	require.NoError(t, err)
+	require.False(t, valid)
+	_, err = verifier.VerifySignature(phase0.BLSPubKey{0x01}, message, signature)
+	require.ErrorContains(t, err, "invalid public key")
+
+	var aggregate bls.Sign
+	aggregate.Aggregate(sigs)
+	var aggregateSignature phase0.BLSSignature
+	copy(aggregateSignature[:], aggregate.Serialize())
+	valid, err = verifier.FastAggregateVerify(pubKeys, message, aggregateSignature)
+	require.NoError(t, err)
+	require.True(t, valid)
+	valid, err = verifier.FastAggregateVerify(pubKeys[:2], message, aggregateSignature)
+	require.NoError(t, err)
+	require.False(t, valid)
+	_, err = verifier.FastAggregateVerify(nil, message, aggregateSignature)
+	require.EqualError(t, err, "no public keys supplied")
+}
@@ -0,0 +1,28 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !herumi
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultBLSVerifier(t *testing.T) {
+	_, err := crypto.DefaultBLSVerifier()
+	require.EqualError(t, err, "no default BLS verifier; build with the herumi tag or supply a verifier")
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dutytracker
+
+import (
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel    zerolog.Level
+	client      consensusclient.Service
+	chainTime   *chaintime.Service
+	validators  []phase0.ValidatorIndex
+	channelSize int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which duties and events are obtained.  The client must
+// provide attester, proposer and sync committee duties, and events.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithChainTime sets the chain time service.  If not set, one is created from the client.
+func WithChainTime(chainTime *chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = chainTime
+	})
+}
+
+// WithValidators sets the initial validators for which duties are tracked.
+func WithValidators(validators []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validators = validators
+	})
+}
+
+// WithChannelSize sets the size of the buffer of the updates channel.
+func WithChannelSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.channelSize = size
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:    zerolog.GlobalLevel(),
+		channelSize: 16,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.AttesterDutiesProvider); !isProvider {
+		return nil, errors.New("client does not provide attester duties")
+	}
+	if _, isProvider := parameters.client.(consensusclient.ProposerDutiesProvider); !isProvider {
+		return nil, errors.New("client does not provide proposer duties")
+	}
+	if _, isProvider := parameters.client.(consensusclient.SyncCommitteeDutiesProvider); !isProvider {
+		return nil, errors.New("client does not provide sync committee duties")
+	}
+	if _, isProvider := parameters.client.(consensusclient.EventsProvider); !isProvider {
+		return nil, errors.New("client does not provide events")
+	}
+	if parameters.channelSize < 0 {
+		return nil, errors.New("channel size cannot be negative")
+	}
+
+	return &parameters, nil
+}
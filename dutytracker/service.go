@@ -0,0 +1,394 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dutytracker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Reason is the reason that duties were obtained.
+type Reason string
+
+const (
+	// ReasonStart is for duties obtained when the tracker starts.
+	ReasonStart Reason = "start"
+	// ReasonEpoch is for duties obtained at the start of a new epoch.
+	ReasonEpoch Reason = "epoch"
+	// ReasonDependentRoot is for duties obtained because their dependent root changed.
+	ReasonDependentRoot Reason = "dependent_root"
+	// ReasonReorg is for duties obtained because of a chain reorganization.
+	ReasonReorg Reason = "reorg"
+	// ReasonValidators is for duties obtained because the tracked validators changed.
+	ReasonValidators Reason = "validators"
+	// ReasonRetry is for duties obtained because an earlier attempt to obtain them failed.
+	ReasonRetry Reason = "retry"
+)
+
+// Update contains the duties obtained for an epoch.  Duties of a kind that were not
+// obtained are nil; an empty slice means that the validators have no duties of that kind.
+// Duties in an update replace any previously received duties of the same kind for the epoch.
+type Update struct {
+	Epoch               phase0.Epoch
+	Reason              Reason
+	AttesterDuties      []*apiv1.AttesterDuty
+	ProposerDuties      []*apiv1.ProposerDuty
+	SyncCommitteeDuties []*apiv1.SyncCommitteeDuty
+}
+
+// dutyKinds is a set of kinds of duty.
+type dutyKinds uint8
+
+const (
+	attesterDuties dutyKinds = 1 << iota
+	proposerDuties
+	syncCommitteeDuties
+	allDuties = attesterDuties | proposerDuties | syncCommitteeDuties
+)
+
+// Service tracks the duties of a set of validators, obtaining them for the current and
+// next epochs and obtaining them again when they change.
+type Service struct {
+	log                         zerolog.Logger
+	chainTime                   *chaintime.Service
+	attesterDutiesProvider      consensusclient.AttesterDutiesProvider
+	proposerDutiesProvider      consensusclient.ProposerDutiesProvider
+	syncCommitteeDutiesProvider consensusclient.SyncCommitteeDutiesProvider
+	updates                     chan *Update
+	heads                       chan *apiv1.HeadEvent
+	reorgs                      chan *apiv1.ChainReorgEvent
+	validatorsChanged           chan struct{}
+
+	mu         sync.RWMutex
+	validators []phase0.ValidatorIndex
+
+	// The following are only accessed by the run loop.
+	started       bool
+	epoch         phase0.Epoch
+	attesterRoots map[phase0.Epoch]phase0.Root
+	proposerRoots map[phase0.Epoch]phase0.Root
+	missing       map[phase0.Epoch]dutyKinds
+}
+
+// New creates a new duty tracker.
+// The tracker runs until the context is cancelled, at which point the updates channel is closed.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "dutytracker").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	chainTime := parameters.chainTime
+	if chainTime == nil {
+		chainTime, err = chaintime.New(ctx, chaintime.WithClient(parameters.client))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create chain time service")
+		}
+	}
+
+	validators := make([]phase0.ValidatorIndex, len(parameters.validators))
+	copy(validators, parameters.validators)
+
+	s := &Service{
+		log:                         log,
+		chainTime:                   chainTime,
+		attesterDutiesProvider:      parameters.client.(consensusclient.AttesterDutiesProvider),
+		proposerDutiesProvider:      parameters.client.(consensusclient.ProposerDutiesProvider),
+		syncCommitteeDutiesProvider: parameters.client.(consensusclient.SyncCommitteeDutiesProvider),
+		updates:                     make(chan *Update, parameters.channelSize),
+		heads:                       make(chan *apiv1.HeadEvent, 16),
+		reorgs:                      make(chan *apiv1.ChainReorgEvent, 16),
+		validatorsChanged:           make(chan struct{}, 1),
+		validators:                  validators,
+		attesterRoots:               make(map[phase0.Epoch]phase0.Root),
+		proposerRoots:               make(map[phase0.Epoch]phase0.Root),
+		missing:                     make(map[phase0.Epoch]dutyKinds),
+	}
+
+	handlers := &consensusclient.EventHandlerFuncs{
+		OnHead: func(event *apiv1.HeadEvent) {
+			select {
+			case s.heads <- event:
+			case <-ctx.Done():
+			}
+		},
+		OnChainReorg: func(event *apiv1.ChainReorgEvent) {
+			select {
+			case s.reorgs <- event:
+			case <-ctx.Done():
+			}
+		},
+	}
+	if err := handlers.Subscribe(ctx, parameters.client.(consensusclient.EventsProvider)); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to events")
+	}
+
+	go s.run(ctx)
+
+	return s, nil
+}
+
+// Updates returns the channel on which duty updates are sent.
+func (s *Service) Updates() <-chan *Update {
+	return s.updates
+}
+
+// SetValidators sets the validators for which duties are tracked, replacing any that were
+// previously set.  Duties for the new validators are obtained for the current and next epochs.
+func (s *Service) SetValidators(validators []phase0.ValidatorIndex) {
+	indices := make([]phase0.ValidatorIndex, len(validators))
+	copy(indices, validators)
+
+	s.mu.Lock()
+	s.validators = indices
+	s.mu.Unlock()
+
+	select {
+	case s.validatorsChanged <- struct{}{}:
+	default:
+		// A change is already pending, and will pick up these validators.
+	}
+}
+
+// run obtains duties as epochs start and events are received.
+func (s *Service) run(ctx context.Context) {
+	defer close(s.updates)
+
+	s.advance(ctx, s.chainTime.CurrentEpoch(), ReasonStart)
+	for {
+		timer := time.NewTimer(time.Until(s.nextWake()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.log.Trace().Msg("Context done; stopping")
+			return
+		case <-timer.C:
+			if epoch := s.chainTime.CurrentEpoch(); epoch > s.epoch {
+				s.advance(ctx, epoch, ReasonEpoch)
+			} else {
+				s.retryMissing(ctx)
+			}
+		case event := <-s.heads:
+			timer.Stop()
+			s.handleHead(ctx, event)
+		case event := <-s.reorgs:
+			timer.Stop()
+			s.log.Trace().Uint64("slot", uint64(event.Slot)).Uint64("depth", event.Depth).Msg("Chain reorganization")
+			// The next head event provides the new dependent roots.
+			delete(s.attesterRoots, s.epoch)
+			delete(s.attesterRoots, s.epoch+1)
+			delete(s.proposerRoots, s.epoch)
+			s.fetchCurrent(ctx, ReasonReorg)
+		case <-s.validatorsChanged:
+			timer.Stop()
+			s.fetchCurrent(ctx, ReasonValidators)
+		}
+	}
+}
+
+// nextWake returns the time at which the run loop should wake if no events are received.
+// This is the start of the next epoch, or the start of the next slot if there are duties
+// to retry.
+func (s *Service) nextWake() time.Time {
+	nextEpoch := s.chainTime.StartOfEpoch(s.epoch + 1)
+	if len(s.missing) == 0 {
+		return nextEpoch
+	}
+	nextSlot := s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1)
+	if nextSlot.Before(nextEpoch) {
+		return nextSlot
+	}
+
+	return nextEpoch
+}
+
+// advance moves the tracker to the given epoch, obtaining the duties that become available.
+func (s *Service) advance(ctx context.Context, epoch phase0.Epoch, reason Reason) {
+	if s.started && epoch <= s.epoch {
+		return
+	}
+	contiguous := s.started && epoch == s.epoch+1
+	s.started = true
+	s.epoch = epoch
+
+	for rootsEpoch := range s.attesterRoots {
+		if rootsEpoch < epoch {
+			delete(s.attesterRoots, rootsEpoch)
+		}
+	}
+	for rootsEpoch := range s.proposerRoots {
+		if rootsEpoch < epoch {
+			delete(s.proposerRoots, rootsEpoch)
+		}
+	}
+	for missingEpoch := range s.missing {
+		if missingEpoch < epoch {
+			delete(s.missing, missingEpoch)
+		}
+	}
+
+	if contiguous {
+		// Attester and sync committee duties for this epoch were obtained as those of the next
+		// epoch, other than any that could not be obtained at the time.
+		s.fetch(ctx, epoch, proposerDuties|s.missing[epoch], reason)
+	} else {
+		s.fetch(ctx, epoch, allDuties, reason)
+	}
+	s.fetch(ctx, epoch+1, attesterDuties|syncCommitteeDuties, reason)
+}
+
+// fetchCurrent obtains all duties for the current and next epochs.
+func (s *Service) fetchCurrent(ctx context.Context, reason Reason) {
+	s.fetch(ctx, s.epoch, allDuties, reason)
+	s.fetch(ctx, s.epoch+1, attesterDuties|syncCommitteeDuties, reason)
+}
+
+// handleHead obtains duties whose dependent roots have changed with the new head.
+func (s *Service) handleHead(ctx context.Context, event *apiv1.HeadEvent) {
+	epoch := s.chainTime.SlotToEpoch(event.Slot)
+	switch {
+	case epoch < s.epoch:
+		// Event is for an earlier epoch.
+		return
+	case epoch == s.epoch:
+		s.retryMissing(ctx)
+	default:
+		s.advance(ctx, epoch, ReasonEpoch)
+	}
+
+	// Attester duties for the current epoch depend on the previous duty dependent root, and
+	// proposer duties for the current epoch and attester duties for the next epoch depend on
+	// the current duty dependent root.
+	if rootChanged(s.attesterRoots, epoch, event.PreviousDutyDependentRoot) {
+		s.fetch(ctx, epoch, attesterDuties, ReasonDependentRoot)
+	}
+	if rootChanged(s.proposerRoots, epoch, event.CurrentDutyDependentRoot) {
+		s.fetch(ctx, epoch, proposerDuties, ReasonDependentRoot)
+	}
+	if rootChanged(s.attesterRoots, epoch+1, event.CurrentDutyDependentRoot) {
+		s.fetch(ctx, epoch+1, attesterDuties, ReasonDependentRoot)
+	}
+}
+
+// retryMissing obtains duties for the current and next epochs that could not be obtained
+// when they were last requested.
+func (s *Service) retryMissing(ctx context.Context) {
+	for _, epoch := range []phase0.Epoch{s.epoch, s.epoch + 1} {
+		if kinds, exists := s.missing[epoch]; exists {
+			s.fetch(ctx, epoch, kinds, ReasonRetry)
+		}
+	}
+}
+
+// rootChanged records the dependent root for an epoch, returning true if it differs from
+// a previously recorded root.
+func rootChanged(roots map[phase0.Epoch]phase0.Root, epoch phase0.Epoch, root phase0.Root) bool {
+	existing, exists := roots[epoch]
+	roots[epoch] = root
+
+	return exists && existing != root
+}
+
+// fetch obtains the given kinds of duties for an epoch and sends them as an update.
+// Kinds of duty that cannot be obtained are left out of the update, and recorded so
+// that they are obtained again by retryMissing.
+func (s *Service) fetch(ctx context.Context, epoch phase0.Epoch, kinds dutyKinds, reason Reason) {
+	s.mu.RLock()
+	validators := s.validators
+	s.mu.RUnlock()
+
+	update := &Update{
+		Epoch:  epoch,
+		Reason: reason,
+	}
+	if kinds&attesterDuties != 0 {
+		update.AttesterDuties = make([]*apiv1.AttesterDuty, 0)
+	}
+	if kinds&proposerDuties != 0 {
+		update.ProposerDuties = make([]*apiv1.ProposerDuty, 0)
+	}
+	if kinds&syncCommitteeDuties != 0 {
+		update.SyncCommitteeDuties = make([]*apiv1.SyncCommitteeDuty, 0)
+	}
+
+	// Providers return duties for all validators if none are supplied, so only call them
+	// if there are validators.
+	failed := dutyKinds(0)
+	if len(validators) > 0 {
+		if kinds&attesterDuties != 0 {
+			duties, err := s.attesterDutiesProvider.AttesterDuties(ctx, epoch, validators)
+			switch {
+			case err != nil:
+				s.log.Warn().Uint64("epoch", uint64(epoch)).Err(err).Msg("Failed to obtain attester duties")
+				update.AttesterDuties = nil
+				failed |= attesterDuties
+			case duties != nil:
+				update.AttesterDuties = duties
+			}
+		}
+		if kinds&proposerDuties != 0 {
+			duties, err := s.proposerDutiesProvider.ProposerDuties(ctx, epoch, validators)
+			switch {
+			case err != nil:
+				s.log.Warn().Uint64("epoch", uint64(epoch)).Err(err).Msg("Failed to obtain proposer duties")
+				update.ProposerDuties = nil
+				failed |= proposerDuties
+			case duties != nil:
+				update.ProposerDuties = duties
+			}
+		}
+		if kinds&syncCommitteeDuties != 0 {
+			duties, err := s.syncCommitteeDutiesProvider.SyncCommitteeDuties(ctx, epoch, validators)
+			switch {
+			case err != nil:
+				s.log.Warn().Uint64("epoch", uint64(epoch)).Err(err).Msg("Failed to obtain sync committee duties")
+				update.SyncCommitteeDuties = nil
+				failed |= syncCommitteeDuties
+			case duties != nil:
+				update.SyncCommitteeDuties = duties
+			}
+		}
+	}
+
+	if missing := s.missing[epoch]&^kinds | failed; missing != 0 {
+		s.missing[epoch] = missing
+	} else {
+		delete(s.missing, epoch)
+	}
+	if failed == kinds {
+		// Nothing was obtained.
+		return
+	}
+
+	s.log.Trace().Uint64("epoch", uint64(epoch)).Str("reason", string(reason)).Msg("Obtained duties")
+	select {
+	case s.updates <- update:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dutytracker_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/attestantio/go-eth2-client/dutytracker"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// dutiesClient provides a duty of each kind for each validator, and records the events handler.
+// Requests for proposer duties fail until proposerFailures requests have been made.
+type dutiesClient struct {
+	mu               sync.Mutex
+	topics           []string
+	handler          consensusclient.EventHandlerFunc
+	proposerFailures int
+}
+
+func (*dutiesClient) Name() string { return "duties" }
+
+func (*dutiesClient) Address() string { return "" }
+
+func (*dutiesClient) AttesterDuties(_ context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+	res := make([]*apiv1.AttesterDuty, 0, len(indices))
+	for _, index := range indices {
+		res = append(res, &apiv1.AttesterDuty{ValidatorIndex: index, Slot: phase0.Slot(uint64(epoch) * 32)})
+	}
+
+	return res, nil
+}
+
+func (c *dutiesClient) ProposerDuties(_ context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.proposerFailures > 0 {
+		c.proposerFailures--
+		return nil, errors.New("unavailable")
+	}
+
+	res := make([]*apiv1.ProposerDuty, 0, len(indices))
+	for _, index := range indices {
+		res = append(res, &apiv1.ProposerDuty{ValidatorIndex: index, Slot: phase0.Slot(uint64(epoch) * 32)})
+	}
+
+	return res, nil
+}
+
+func (*dutiesClient) SyncCommitteeDuties(_ context.Context, _ phase0.Epoch, _ []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error) {
+	// Validators are not in the sync committee.
+	return nil, nil
+}
+
+func (c *dutiesClient) Events(_ context.Context, topics []string, handler consensusclient.EventHandlerFunc) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.topics = topics
+	c.handler = handler
+
+	return nil
+}
+
+func (c *dutiesClient) send(event *apiv1.Event) {
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	handler(event)
+}
+
+// nextUpdate returns the next update, failing if there is none.
+func nextUpdate(t *testing.T, s *dutytracker.Service) *dutytracker.Update {
+	t.Helper()
+	select {
+	case update := <-s.Updates():
+		return update
+	case <-time.After(time.Second):
+		require.FailNow(t, "no update received")
+		return nil
+	}
+}
+
+// noUpdate fails if an update is received.
+func noUpdate(t *testing.T, s *dutytracker.Service) {
+	t.Helper()
+	select {
+	case update := <-s.Updates():
+		require.FailNow(t, "unexpected update", "epoch %d reason %s", update.Epoch, update.Reason)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func testChainTime(t *testing.T) *chaintime.Service {
+	t.Helper()
+	// Place the current time a few seconds in to epoch 10.
+	chainTime, err := chaintime.New(context.Background(),
+		chaintime.WithGenesisTime(time.Now().Add(-(10*32*12+5)*time.Second)),
+		chaintime.WithSlotDuration(12*time.Second),
+		chaintime.WithSlotsPerEpoch(32),
+	)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		params []dutytracker.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []dutytracker.Parameter{
+				dutytracker.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "ChannelSizeNegative",
+			params: []dutytracker.Parameter{
+				dutytracker.WithLogLevel(zerolog.Disabled),
+				dutytracker.WithClient(&dutiesClient{}),
+				dutytracker.WithChannelSize(-1),
+			},
+			err: "problem with parameters: channel size cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []dutytracker.Parameter{
+				dutytracker.WithLogLevel(zerolog.Disabled),
+				dutytracker.WithClient(&dutiesClient{}),
+				dutytracker.WithChainTime(testChainTime(t)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := dutytracker.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	client := &dutiesClient{}
+	s, err := dutytracker.New(ctx,
+		dutytracker.WithLogLevel(zerolog.Disabled),
+		dutytracker.WithClient(client),
+		dutytracker.WithChainTime(testChainTime(t)),
+		dutytracker.WithValidators([]phase0.ValidatorIndex{1, 2}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"head", "chain_reorg"}, client.topics)
+
+	// Start obtains all duties for the current epoch, and attester and sync committee duties for the next.
+	update := nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(10), update.Epoch)
+	require.Equal(t, dutytracker.ReasonStart, update.Reason)
+	require.Len(t, update.AttesterDuties, 2)
+	require.Len(t, update.ProposerDuties, 2)
+	require.NotNil(t, update.SyncCommitteeDuties)
+	require.Empty(t, update.SyncCommitteeDuties)
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(11), update.Epoch)
+	require.Len(t, update.AttesterDuties, 2)
+	require.Nil(t, update.ProposerDuties)
+	noUpdate(t, s)
+
+	// The first head event records dependent roots.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{
+		Slot:                      321,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x02},
+	}})
+	noUpdate(t, s)
+
+	// A change in the current duty dependent root obtains proposer duties for this epoch
+	// and attester duties for the next.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{
+		Slot:                      322,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x03},
+	}})
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(10), update.Epoch)
+	require.Equal(t, dutytracker.ReasonDependentRoot, update.Reason)
+	require.Nil(t, update.AttesterDuties)
+	require.Len(t, update.ProposerDuties, 2)
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(11), update.Epoch)
+	require.Len(t, update.AttesterDuties, 2)
+	require.Nil(t, update.SyncCommitteeDuties)
+	noUpdate(t, s)
+
+	// A head in the next epoch obtains its proposer duties, and the duties for the epoch after.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{
+		Slot:                      352,
+		PreviousDutyDependentRoot: phase0.Root{0x03},
+		CurrentDutyDependentRoot:  phase0.Root{0x04},
+	}})
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(11), update.Epoch)
+	require.Equal(t, dutytracker.ReasonEpoch, update.Reason)
+	require.Nil(t, update.AttesterDuties)
+	require.Len(t, update.ProposerDuties, 2)
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(12), update.Epoch)
+	require.Len(t, update.AttesterDuties, 2)
+	noUpdate(t, s)
+
+	// A reorganization obtains all duties.
+	client.send(&apiv1.Event{Topic: "chain_reorg", Data: &apiv1.ChainReorgEvent{Slot: 353, Depth: 1}})
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(11), update.Epoch)
+	require.Equal(t, dutytracker.ReasonReorg, update.Reason)
+	require.Len(t, update.AttesterDuties, 2)
+	require.Len(t, update.ProposerDuties, 2)
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(12), update.Epoch)
+	noUpdate(t, s)
+
+	// Changing validators obtains all duties.
+	s.SetValidators([]phase0.ValidatorIndex{3})
+	update = nextUpdate(t, s)
+	require.Equal(t, dutytracker.ReasonValidators, update.Reason)
+	require.Len(t, update.AttesterDuties, 1)
+	require.Equal(t, phase0.ValidatorIndex(3), update.AttesterDuties[0].ValidatorIndex)
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(12), update.Epoch)
+
+	// Cancelling the context closes the updates channel.
+	cancel()
+	for range s.Updates() {
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &dutiesClient{proposerFailures: 2}
+	s, err := dutytracker.New(ctx,
+		dutytracker.WithLogLevel(zerolog.Disabled),
+		dutytracker.WithClient(client),
+		dutytracker.WithChainTime(testChainTime(t)),
+		dutytracker.WithValidators([]phase0.ValidatorIndex{1, 2}),
+	)
+	require.NoError(t, err)
+
+	// Duties that are obtained are sent, without those that failed.
+	update := nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(10), update.Epoch)
+	require.Equal(t, dutytracker.ReasonStart, update.Reason)
+	require.Len(t, update.AttesterDuties, 2)
+	require.Nil(t, update.ProposerDuties)
+	require.NotNil(t, update.SyncCommitteeDuties)
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(11), update.Epoch)
+	noUpdate(t, s)
+
+	// A head event retries the missing duties; a failure leaves them missing.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 321}})
+	noUpdate(t, s)
+
+	// A later head event obtains them.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 322}})
+	update = nextUpdate(t, s)
+	require.Equal(t, phase0.Epoch(10), update.Epoch)
+	require.Equal(t, dutytracker.ReasonRetry, update.Reason)
+	require.Nil(t, update.AttesterDuties)
+	require.Len(t, update.ProposerDuties, 2)
+	require.Nil(t, update.SyncCommitteeDuties)
+
+	// Once obtained they are not requested again.
+	client.send(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: 323}})
+	noUpdate(t, s)
+}
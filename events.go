@@ -0,0 +1,152 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// EventHandlerFuncs are typed handlers for events, one per topic.  Handlers that are
+// not set are not subscribed to.
+type EventHandlerFuncs struct {
+	OnHead                 func(*apiv1.HeadEvent)
+	OnBlock                func(*apiv1.BlockEvent)
+	OnAttestation          func(*phase0.Attestation)
+	OnVoluntaryExit        func(*phase0.SignedVoluntaryExit)
+	OnFinalizedCheckpoint  func(*apiv1.FinalizedCheckpointEvent)
+	OnChainReorg           func(*apiv1.ChainReorgEvent)
+	OnContributionAndProof func(*altair.SignedContributionAndProof)
+	OnPayloadAttributes    func(*apiv1.PayloadAttributesEvent)
+	OnBlobSidecar          func(*apiv1.BlobSidecarEvent)
+	OnProposerSlashing     func(*phase0.ProposerSlashing)
+	OnAttesterSlashing     func(*phase0.AttesterSlashing)
+	OnBLSToExecutionChange func(*capella.SignedBLSToExecutionChange)
+	// OnUnhandled is called with events that do not have a typed handler, or whose
+	// data is not of the expected type.
+	OnUnhandled EventHandlerFunc
+}
+
+// Topics returns the topics for which handlers are set.
+func (h *EventHandlerFuncs) Topics() []string {
+	topics := make([]string, 0)
+	for _, entry := range []struct {
+		topic string
+		set   bool
+	}{
+		{"head", h.OnHead != nil},
+		{"block", h.OnBlock != nil},
+		{"attestation", h.OnAttestation != nil},
+		{"voluntary_exit", h.OnVoluntaryExit != nil},
+		{"finalized_checkpoint", h.OnFinalizedCheckpoint != nil},
+		{"chain_reorg", h.OnChainReorg != nil},
+		{"contribution_and_proof", h.OnContributionAndProof != nil},
+		{"payload_attributes", h.OnPayloadAttributes != nil},
+		{"blob_sidecar", h.OnBlobSidecar != nil},
+		{"proposer_slashing", h.OnProposerSlashing != nil},
+		{"attester_slashing", h.OnAttesterSlashing != nil},
+		{"bls_to_execution_change", h.OnBLSToExecutionChange != nil},
+	} {
+		if entry.set {
+			topics = append(topics, entry.topic)
+		}
+	}
+
+	return topics
+}
+
+// Handler returns an event handler that dispatches each event to its typed handler.
+func (h *EventHandlerFuncs) Handler() EventHandlerFunc {
+	return h.dispatch
+}
+
+// Subscribe subscribes to the topics for which handlers are set.
+func (h *EventHandlerFuncs) Subscribe(ctx context.Context, provider EventsProvider) error {
+	topics := h.Topics()
+	if len(topics) == 0 {
+		return errors.New("no handlers set")
+	}
+
+	return provider.Events(ctx, topics, h.dispatch)
+}
+
+// dispatch passes an event to its typed handler.
+//
+//nolint:gocyclo
+func (h *EventHandlerFuncs) dispatch(event *apiv1.Event) {
+	if event == nil {
+		return
+	}
+
+	handled := false
+	switch data := event.Data.(type) {
+	case *apiv1.HeadEvent:
+		if handled = h.OnHead != nil && event.Topic == "head"; handled {
+			h.OnHead(data)
+		}
+	case *apiv1.BlockEvent:
+		if handled = h.OnBlock != nil && event.Topic == "block"; handled {
+			h.OnBlock(data)
+		}
+	case *phase0.Attestation:
+		if handled = h.OnAttestation != nil && event.Topic == "attestation"; handled {
+			h.OnAttestation(data)
+		}
+	case *phase0.SignedVoluntaryExit:
+		if handled = h.OnVoluntaryExit != nil && event.Topic == "voluntary_exit"; handled {
+			h.OnVoluntaryExit(data)
+		}
+	case *apiv1.FinalizedCheckpointEvent:
+		if handled = h.OnFinalizedCheckpoint != nil && event.Topic == "finalized_checkpoint"; handled {
+			h.OnFinalizedCheckpoint(data)
+		}
+	case *apiv1.ChainReorgEvent:
+		if handled = h.OnChainReorg != nil && event.Topic == "chain_reorg"; handled {
+			h.OnChainReorg(data)
+		}
+	case *altair.SignedContributionAndProof:
+		if handled = h.OnContributionAndProof != nil && event.Topic == "contribution_and_proof"; handled {
+			h.OnContributionAndProof(data)
+		}
+	case *apiv1.PayloadAttributesEvent:
+		if handled = h.OnPayloadAttributes != nil && event.Topic == "payload_attributes"; handled {
+			h.OnPayloadAttributes(data)
+		}
+	case *apiv1.BlobSidecarEvent:
+		if handled = h.OnBlobSidecar != nil && event.Topic == "blob_sidecar"; handled {
+			h.OnBlobSidecar(data)
+		}
+	case *phase0.ProposerSlashing:
+		if handled = h.OnProposerSlashing != nil && event.Topic == "proposer_slashing"; handled {
+			h.OnProposerSlashing(data)
+		}
+	case *phase0.AttesterSlashing:
+		if handled = h.OnAttesterSlashing != nil && event.Topic == "attester_slashing"; handled {
+			h.OnAttesterSlashing(data)
+		}
+	case *capella.SignedBLSToExecutionChange:
+		if handled = h.OnBLSToExecutionChange != nil && event.Topic == "bls_to_execution_change"; handled {
+			h.OnBLSToExecutionChange(data)
+		}
+	}
+
+	if !handled && h.OnUnhandled != nil {
+		h.OnUnhandled(event)
+	}
+}
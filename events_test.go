@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingEventsProvider records the topics and handler with which it is called.
+type recordingEventsProvider struct {
+	topics  []string
+	handler client.EventHandlerFunc
+}
+
+func (p *recordingEventsProvider) Events(_ context.Context, topics []string, handler client.EventHandlerFunc) error {
+	p.topics = topics
+	p.handler = handler
+
+	return nil
+}
+
+func TestEventHandlerFuncs(t *testing.T) {
+	ctx := context.Background()
+
+	var heads []*apiv1.HeadEvent
+	var attestations []*phase0.Attestation
+	var unhandled []*apiv1.Event
+	handlers := &client.EventHandlerFuncs{
+		OnHead: func(event *apiv1.HeadEvent) {
+			heads = append(heads, event)
+		},
+		OnAttestation: func(attestation *phase0.Attestation) {
+			attestations = append(attestations, attestation)
+		},
+		OnUnhandled: func(event *apiv1.Event) {
+			unhandled = append(unhandled, event)
+		},
+	}
+	require.Equal(t, []string{"head", "attestation"}, handlers.Topics())
+
+	provider := &recordingEventsProvider{}
+	require.NoError(t, handlers.Subscribe(ctx, provider))
+	require.Equal(t, []string{"head", "attestation"}, provider.topics)
+	require.NotNil(t, provider.handler)
+
+	tests := []struct {
+		name         string
+		event        *apiv1.Event
+		heads        int
+		attestations int
+		unhandled    int
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "Head",
+			event: &apiv1.Event{
+				Topic: "head",
+				Data:  &apiv1.HeadEvent{Slot: 1},
+			},
+			heads: 1,
+		},
+		{
+			name: "Attestation",
+			event: &apiv1.Event{
+				Topic: "attestation",
+				Data:  &phase0.Attestation{},
+			},
+			attestations: 1,
+		},
+		{
+			name: "NoHandler",
+			event: &apiv1.Event{
+				Topic: "block",
+				Data:  &apiv1.BlockEvent{},
+			},
+			unhandled: 1,
+		},
+		{
+			name: "TopicMismatch",
+			event: &apiv1.Event{
+				Topic: "block",
+				Data:  &apiv1.HeadEvent{},
+			},
+			unhandled: 1,
+		},
+		{
+			name: "UnknownData",
+			event: &apiv1.Event{
+				Topic: "head",
+				Data:  "head",
+			},
+			unhandled: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			heads = nil
+			attestations = nil
+			unhandled = nil
+			provider.handler(test.event)
+			require.Len(t, heads, test.heads)
+			require.Len(t, attestations, test.attestations)
+			require.Len(t, unhandled, test.unhandled)
+		})
+	}
+}
+
+func TestEventHandlerFuncsNoHandlers(t *testing.T) {
+	handlers := &client.EventHandlerFuncs{}
+	require.Empty(t, handlers.Topics())
+	require.EqualError(t, handlers.Subscribe(context.Background(), &recordingEventsProvider{}), "no handlers set")
+
+	// Dispatching without handlers does nothing.
+	handlers.Handler()(&apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{}})
+}
@@ -0,0 +1,287 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explorer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// Block is a human-friendly view of a signed beacon block, for display and debugging.
+// Its JSON representation uses decoded text and hashes in place of raw bytes, and ETH
+// and Gwei in place of integer values.
+type Block struct {
+	Version              string            `json:"version"`
+	Slot                 uint64            `json:"slot"`
+	Root                 string            `json:"root"`
+	ParentRoot           string            `json:"parent_root"`
+	StateRoot            string            `json:"state_root"`
+	Proposer             *Proposer         `json:"proposer"`
+	Graffiti             string            `json:"graffiti"`
+	Attestations         int               `json:"attestations"`
+	Deposits             int               `json:"deposits"`
+	VoluntaryExits       int               `json:"voluntary_exits"`
+	ProposerSlashings    int               `json:"proposer_slashings"`
+	AttesterSlashings    int               `json:"attester_slashings"`
+	SyncAggregate        *SyncAggregate    `json:"sync_aggregate,omitempty"`
+	ExecutionPayload     *ExecutionPayload `json:"execution_payload,omitempty"`
+	BLSToExecutionChange int               `json:"bls_to_execution_changes,omitempty"`
+	BlobKZGCommitments   int               `json:"blob_kzg_commitments,omitempty"`
+}
+
+// Proposer is the proposer of a block.
+type Proposer struct {
+	Index uint64 `json:"index"`
+	// PubKey is the public key of the proposer, if resolved.
+	PubKey string `json:"pubkey,omitempty"`
+}
+
+// SyncAggregate is a summary of the sync aggregate of a block.
+type SyncAggregate struct {
+	Participants  int    `json:"participants"`
+	Size          int    `json:"size"`
+	Participation string `json:"participation"`
+}
+
+// ExecutionPayload is a view of the execution payload of a block.
+type ExecutionPayload struct {
+	BlockNumber   uint64        `json:"block_number"`
+	BlockHash     string        `json:"block_hash"`
+	ParentHash    string        `json:"parent_hash"`
+	FeeRecipient  string        `json:"fee_recipient"`
+	Timestamp     string        `json:"timestamp"`
+	GasUsed       uint64        `json:"gas_used"`
+	GasLimit      uint64        `json:"gas_limit"`
+	BaseFeePerGas string        `json:"base_fee_per_gas"`
+	ExtraData     string        `json:"extra_data"`
+	Transactions  []string      `json:"transactions"`
+	Withdrawals   []*Withdrawal `json:"withdrawals,omitempty"`
+	BlobGasUsed   *uint64       `json:"blob_gas_used,omitempty"`
+	ExcessBlobGas *uint64       `json:"excess_blob_gas,omitempty"`
+}
+
+// Withdrawal is a view of a withdrawal.
+type Withdrawal struct {
+	Index          uint64 `json:"index"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
+
+// String returns a string version of the structure.
+func (b *Block) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// blockParts are the parts of a block, common across versions.
+type blockParts struct {
+	slot              phase0.Slot
+	proposerIndex     phase0.ValidatorIndex
+	parentRoot        phase0.Root
+	stateRoot         phase0.Root
+	graffiti          [32]byte
+	attestations      int
+	deposits          int
+	voluntaryExits    int
+	proposerSlashings int
+	attesterSlashings int
+	syncAggregate     *altair.SyncAggregate
+	payload           *payloadParts
+	blsChanges        int
+	blobCommitments   int
+}
+
+// payloadParts are the parts of an execution payload, common across versions.
+type payloadParts struct {
+	blockNumber   uint64
+	blockHash     phase0.Hash32
+	parentHash    phase0.Hash32
+	feeRecipient  bellatrix.ExecutionAddress
+	timestamp     uint64
+	gasUsed       uint64
+	gasLimit      uint64
+	baseFeePerGas *uint256.Int
+	extraData     []byte
+	transactions  []bellatrix.Transaction
+	withdrawals   []*capella.Withdrawal
+	blobGasUsed   *uint64
+	excessBlobGas *uint64
+}
+
+// NewBlock creates a view of the given block.  The proposer public key is not resolved.
+func NewBlock(block *spec.VersionedSignedBeaconBlock) (*Block, error) {
+	if block == nil {
+		return nil, errors.New("no block supplied")
+	}
+
+	parts, err := partsOf(block)
+	if err != nil {
+		return nil, err
+	}
+	root, err := block.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block root")
+	}
+
+	res := &Block{
+		Version:              block.Version.String(),
+		Slot:                 uint64(parts.slot),
+		Root:                 fmt.Sprintf("%#x", root),
+		ParentRoot:           fmt.Sprintf("%#x", parts.parentRoot),
+		StateRoot:            fmt.Sprintf("%#x", parts.stateRoot),
+		Proposer:             &Proposer{Index: uint64(parts.proposerIndex)},
+		Graffiti:             DecodeGraffiti(parts.graffiti),
+		Attestations:         parts.attestations,
+		Deposits:             parts.deposits,
+		VoluntaryExits:       parts.voluntaryExits,
+		ProposerSlashings:    parts.proposerSlashings,
+		AttesterSlashings:    parts.attesterSlashings,
+		BLSToExecutionChange: parts.blsChanges,
+		BlobKZGCommitments:   parts.blobCommitments,
+	}
+
+	if parts.syncAggregate != nil {
+		participants := int(parts.syncAggregate.SyncCommitteeBits.Count())
+		// The size is taken from the bits rather than Len, which is fixed at the mainnet size.
+		size := len(parts.syncAggregate.SyncCommitteeBits) * 8
+		participation := 0.0
+		if size > 0 {
+			participation = float64(participants) * 100 / float64(size)
+		}
+		res.SyncAggregate = &SyncAggregate{
+			Participants:  participants,
+			Size:          size,
+			Participation: fmt.Sprintf("%.1f%%", participation),
+		}
+	}
+
+	if parts.payload != nil {
+		payload := parts.payload
+		transactions := make([]string, len(payload.transactions))
+		for i := range payload.transactions {
+			transactions[i] = TransactionHash(payload.transactions[i])
+		}
+		res.ExecutionPayload = &ExecutionPayload{
+			BlockNumber:   payload.blockNumber,
+			BlockHash:     fmt.Sprintf("%#x", payload.blockHash),
+			ParentHash:    fmt.Sprintf("%#x", payload.parentHash),
+			FeeRecipient:  payload.feeRecipient.String(),
+			Timestamp:     time.Unix(int64(payload.timestamp), 0).UTC().Format(time.RFC3339),
+			GasUsed:       payload.gasUsed,
+			GasLimit:      payload.gasLimit,
+			BaseFeePerGas: FormatWei(payload.baseFeePerGas),
+			ExtraData:     decodeExtraData(payload.extraData),
+			Transactions:  transactions,
+			BlobGasUsed:   payload.blobGasUsed,
+			ExcessBlobGas: payload.excessBlobGas,
+		}
+		if payload.withdrawals != nil {
+			res.ExecutionPayload.Withdrawals = make([]*Withdrawal, len(payload.withdrawals))
+			for i, withdrawal := range payload.withdrawals {
+				res.ExecutionPayload.Withdrawals[i] = &Withdrawal{
+					Index:          uint64(withdrawal.Index),
+					ValidatorIndex: uint64(withdrawal.ValidatorIndex),
+					Address:        withdrawal.Address.String(),
+					Amount:         FormatGwei(withdrawal.Amount),
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// FetchBlock obtains the block with the given ID and creates a view of it, resolving the
+// public key of the proposer if the client provides validators.  It returns nil if the
+// block is not found.
+//
+// The client must provide signed beacon blocks.
+func FetchBlock(ctx context.Context,
+	client consensusclient.Service,
+	blockID string,
+) (
+	*Block,
+	error,
+) {
+	blockProvider, isProvider := client.(consensusclient.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, errors.New("client does not provide signed beacon blocks")
+	}
+
+	block, err := blockProvider.SignedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block")
+	}
+	if block == nil {
+		return nil, nil
+	}
+
+	res, err := NewBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsProvider, isProvider := client.(consensusclient.ValidatorsProvider)
+	if !isProvider {
+		return res, nil
+	}
+	index := phase0.ValidatorIndex(res.Proposer.Index)
+	validators, err := validatorsProvider.Validators(ctx, fmt.Sprintf("%d", res.Slot), []phase0.ValidatorIndex{index})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposer")
+	}
+	if validator, exists := validators[index]; exists && validator != nil && validator.Validator != nil {
+		res.Proposer.PubKey = fmt.Sprintf("%#x", validator.Validator.PublicKey)
+	}
+
+	return res, nil
+}
+
+// decodeExtraData decodes execution payload extra data as text if it is printable,
+// otherwise it is returned as hex.
+func decodeExtraData(extraData []byte) string {
+	for _, b := range extraData {
+		if b < 0x20 || b > 0x7e {
+			return fmt.Sprintf("%#x", extraData)
+		}
+	}
+
+	return string(extraData)
+}
+
+// leBytesToUint256 converts a little-endian byte array to an integer.
+func leBytesToUint256(input [32]byte) *uint256.Int {
+	beBytes := make([]byte, len(input))
+	for i := range input {
+		beBytes[i] = input[len(input)-1-i]
+	}
+
+	return new(uint256.Int).SetBytes(beBytes)
+}
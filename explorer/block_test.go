@@ -0,0 +1,211 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explorer_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/explorer"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testAttestation() *phase0.Attestation {
+	return &phase0.Attestation{
+		AggregationBits: bitfield.NewBitlist(8),
+		Data: &phase0.AttestationData{
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+}
+
+func capellaBlock() *spec.VersionedSignedBeaconBlock {
+	graffiti := [32]byte{}
+	copy(graffiti[:], "hello world")
+	// Base fee of 12.5 Gwei, little-endian.
+	baseFee := [32]byte{0x00, 0xdd, 0x0e, 0xe9, 0x02}
+	// Three quarters of the sync committee participate.
+	syncBits := make(bitfield.Bitvector512, syncCommitteeSize/8)
+	for i := 0; i < len(syncBits)*3/4; i++ {
+		syncBits[i] = 0xff
+	}
+
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{
+			Message: &capella.BeaconBlock{
+				Slot:          100,
+				ProposerIndex: 5,
+				ParentRoot:    phase0.Root{0x01},
+				StateRoot:     phase0.Root{0x02},
+				Body: &capella.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+					Graffiti: graffiti,
+					Attestations: []*phase0.Attestation{
+						testAttestation(),
+						testAttestation(),
+					},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: syncBits,
+					},
+					ExecutionPayload: &capella.ExecutionPayload{
+						BlockNumber:   1000,
+						BlockHash:     phase0.Hash32{0x03},
+						FeeRecipient:  bellatrix.ExecutionAddress{0x04},
+						Timestamp:     1700000000,
+						GasUsed:       15000000,
+						GasLimit:      30000000,
+						BaseFeePerGas: baseFee,
+						ExtraData:     []byte("builder"),
+						Transactions:  []bellatrix.Transaction{{}},
+						Withdrawals: []*capella.Withdrawal{
+							{
+								Index:          7,
+								ValidatorIndex: 8,
+								Address:        bellatrix.ExecutionAddress{0x05},
+								Amount:         12345678,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewBlock(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *spec.VersionedSignedBeaconBlock
+		err   string
+	}{
+		{
+			name: "Nil",
+			err:  "no block supplied",
+		},
+		{
+			name: "Empty",
+			block: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionCapella,
+			},
+			err: "no capella block",
+		},
+		{
+			name:  "Good",
+			block: capellaBlock(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := explorer.NewBlock(test.block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, "capella", res.Version)
+			require.Equal(t, uint64(100), res.Slot)
+			require.Equal(t, "hello world", res.Graffiti)
+			require.Equal(t, uint64(5), res.Proposer.Index)
+			require.Empty(t, res.Proposer.PubKey)
+			require.Equal(t, 2, res.Attestations)
+			require.Equal(t, syncCommitteeSize, res.SyncAggregate.Size)
+			require.Equal(t, "75.0%", res.SyncAggregate.Participation)
+			require.Equal(t, "12.5 Gwei", res.ExecutionPayload.BaseFeePerGas)
+			require.Equal(t, "builder", res.ExecutionPayload.ExtraData)
+			require.Equal(t, "2023-11-14T22:13:20Z", res.ExecutionPayload.Timestamp)
+			require.Equal(t, []string{"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"}, res.ExecutionPayload.Transactions)
+			require.Equal(t, "0.012345678 ETH", res.ExecutionPayload.Withdrawals[0].Amount)
+
+			data, err := json.Marshal(res)
+			require.NoError(t, err)
+			require.Equal(t, string(data), res.String())
+			require.Contains(t, string(data), `"graffiti":"hello world"`)
+			require.NotContains(t, string(data), `"blob_gas_used"`)
+		})
+	}
+}
+
+// blockClient provides a single block and its proposer.
+type blockClient struct {
+	block *spec.VersionedSignedBeaconBlock
+}
+
+func (*blockClient) Name() string { return "block" }
+
+func (*blockClient) Address() string { return "" }
+
+func (c *blockClient) SignedBeaconBlock(_ context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	if blockID != "head" {
+		return nil, nil
+	}
+
+	return c.block, nil
+}
+
+func (*blockClient) Validators(_ context.Context, stateID string, indices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	if stateID != "100" || len(indices) != 1 {
+		return nil, nil
+	}
+
+	return map[phase0.ValidatorIndex]*apiv1.Validator{
+		indices[0]: {
+			Index:     indices[0],
+			Validator: &phase0.Validator{PublicKey: phase0.BLSPubKey{0xaa}},
+		},
+	}, nil
+}
+
+func (*blockClient) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error) {
+	return nil, nil
+}
+
+func TestFetchBlock(t *testing.T) {
+	ctx := context.Background()
+	client := &blockClient{block: capellaBlock()}
+
+	res, err := explorer.FetchBlock(ctx, client, "head")
+	require.NoError(t, err)
+	require.Equal(t, "0xaa0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", res.Proposer.PubKey)
+
+	res, err = explorer.FetchBlock(ctx, client, "0x01")
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestFormat(t *testing.T) {
+	require.Equal(t, "0 ETH", explorer.FormatGwei(0))
+	require.Equal(t, "32 ETH", explorer.FormatGwei(32000000000))
+	require.Equal(t, "32.000000001 ETH", explorer.FormatGwei(32000000001))
+	require.Equal(t, "0.000000001 ETH", explorer.FormatGwei(1))
+	require.Equal(t, "7 Gwei", explorer.FormatWei(uint256.NewInt(7000000000)))
+	require.Equal(t, "0.000000007 Gwei", explorer.FormatWei(uint256.NewInt(7)))
+
+	require.Equal(t, "", explorer.DecodeGraffiti([32]byte{}))
+	require.Equal(t, "0xff00000000000000000000000000000000000000000000000000000000000000", explorer.DecodeGraffiti([32]byte{0xff}))
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explorer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"golang.org/x/crypto/sha3"
+)
+
+// FormatGwei formats a value in Gwei as ETH, for example "32.000000001 ETH".
+func FormatGwei(value phase0.Gwei) string {
+	return formatDecimal(new(uint256.Int).SetUint64(uint64(value)), 9) + " ETH"
+}
+
+// FormatWei formats a value in Wei as Gwei, for example "12.5 Gwei".
+func FormatWei(value *uint256.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return formatDecimal(value, 9) + " Gwei"
+}
+
+// formatDecimal formats an integer as a decimal with the given number of decimal places,
+// removing trailing zeros.
+func formatDecimal(value *uint256.Int, decimals int) string {
+	digits := value.Dec()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole := digits[:len(digits)-decimals]
+	fraction := strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if fraction == "" {
+		return whole
+	}
+
+	return whole + "." + fraction
+}
+
+// DecodeGraffiti decodes graffiti as text if it is valid UTF-8, otherwise it is returned as hex.
+func DecodeGraffiti(graffiti [32]byte) string {
+	trimmed := bytes.TrimRight(graffiti[:], "\x00")
+	if utf8.Valid(trimmed) {
+		return string(trimmed)
+	}
+
+	return fmt.Sprintf("%#x", graffiti)
+}
+
+// TransactionHash returns the hash of an execution transaction.
+func TransactionHash(tx bellatrix.Transaction) string {
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write(tx)
+
+	return fmt.Sprintf("%#x", keccak.Sum(nil))
+}
@@ -0,0 +1,212 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explorer
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// partsOf obtains the parts of a block.
+//
+//nolint:gocyclo
+func partsOf(block *spec.VersionedSignedBeaconBlock) (*blockParts, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		if block.Phase0 == nil || block.Phase0.Message == nil || block.Phase0.Message.Body == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		msg := block.Phase0.Message
+
+		return &blockParts{
+			slot:              msg.Slot,
+			proposerIndex:     msg.ProposerIndex,
+			parentRoot:        msg.ParentRoot,
+			stateRoot:         msg.StateRoot,
+			graffiti:          msg.Body.Graffiti,
+			attestations:      len(msg.Body.Attestations),
+			deposits:          len(msg.Body.Deposits),
+			voluntaryExits:    len(msg.Body.VoluntaryExits),
+			proposerSlashings: len(msg.Body.ProposerSlashings),
+			attesterSlashings: len(msg.Body.AttesterSlashings),
+		}, nil
+	case spec.DataVersionAltair:
+		if block.Altair == nil || block.Altair.Message == nil || block.Altair.Message.Body == nil {
+			return nil, errors.New("no altair block")
+		}
+		msg := block.Altair.Message
+
+		return &blockParts{
+			slot:              msg.Slot,
+			proposerIndex:     msg.ProposerIndex,
+			parentRoot:        msg.ParentRoot,
+			stateRoot:         msg.StateRoot,
+			graffiti:          msg.Body.Graffiti,
+			attestations:      len(msg.Body.Attestations),
+			deposits:          len(msg.Body.Deposits),
+			voluntaryExits:    len(msg.Body.VoluntaryExits),
+			proposerSlashings: len(msg.Body.ProposerSlashings),
+			attesterSlashings: len(msg.Body.AttesterSlashings),
+			syncAggregate:     msg.Body.SyncAggregate,
+		}, nil
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil || block.Bellatrix.Message == nil || block.Bellatrix.Message.Body == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		msg := block.Bellatrix.Message
+		parts := &blockParts{
+			slot:              msg.Slot,
+			proposerIndex:     msg.ProposerIndex,
+			parentRoot:        msg.ParentRoot,
+			stateRoot:         msg.StateRoot,
+			graffiti:          msg.Body.Graffiti,
+			attestations:      len(msg.Body.Attestations),
+			deposits:          len(msg.Body.Deposits),
+			voluntaryExits:    len(msg.Body.VoluntaryExits),
+			proposerSlashings: len(msg.Body.ProposerSlashings),
+			attesterSlashings: len(msg.Body.AttesterSlashings),
+			syncAggregate:     msg.Body.SyncAggregate,
+		}
+		if payload := msg.Body.ExecutionPayload; payload != nil {
+			parts.payload = &payloadParts{
+				blockNumber:   payload.BlockNumber,
+				blockHash:     payload.BlockHash,
+				parentHash:    payload.ParentHash,
+				feeRecipient:  payload.FeeRecipient,
+				timestamp:     payload.Timestamp,
+				gasUsed:       payload.GasUsed,
+				gasLimit:      payload.GasLimit,
+				baseFeePerGas: leBytesToUint256(payload.BaseFeePerGas),
+				extraData:     payload.ExtraData,
+				transactions:  payload.Transactions,
+			}
+		}
+
+		return parts, nil
+	case spec.DataVersionCapella:
+		if block.Capella == nil || block.Capella.Message == nil || block.Capella.Message.Body == nil {
+			return nil, errors.New("no capella block")
+		}
+		msg := block.Capella.Message
+		parts := &blockParts{
+			slot:              msg.Slot,
+			proposerIndex:     msg.ProposerIndex,
+			parentRoot:        msg.ParentRoot,
+			stateRoot:         msg.StateRoot,
+			graffiti:          msg.Body.Graffiti,
+			attestations:      len(msg.Body.Attestations),
+			deposits:          len(msg.Body.Deposits),
+			voluntaryExits:    len(msg.Body.VoluntaryExits),
+			proposerSlashings: len(msg.Body.ProposerSlashings),
+			attesterSlashings: len(msg.Body.AttesterSlashings),
+			syncAggregate:     msg.Body.SyncAggregate,
+			blsChanges:        len(msg.Body.BLSToExecutionChanges),
+		}
+		if payload := msg.Body.ExecutionPayload; payload != nil {
+			parts.payload = &payloadParts{
+				blockNumber:   payload.BlockNumber,
+				blockHash:     payload.BlockHash,
+				parentHash:    payload.ParentHash,
+				feeRecipient:  payload.FeeRecipient,
+				timestamp:     payload.Timestamp,
+				gasUsed:       payload.GasUsed,
+				gasLimit:      payload.GasLimit,
+				baseFeePerGas: leBytesToUint256(payload.BaseFeePerGas),
+				extraData:     payload.ExtraData,
+				transactions:  payload.Transactions,
+				withdrawals:   payload.Withdrawals,
+			}
+		}
+
+		return parts, nil
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil {
+			return nil, errors.New("no deneb block")
+		}
+		msg := block.Deneb.Message
+
+		return &blockParts{
+			slot:              msg.Slot,
+			proposerIndex:     msg.ProposerIndex,
+			parentRoot:        msg.ParentRoot,
+			stateRoot:         msg.StateRoot,
+			graffiti:          msg.Body.Graffiti,
+			attestations:      len(msg.Body.Attestations),
+			deposits:          len(msg.Body.Deposits),
+			voluntaryExits:    len(msg.Body.VoluntaryExits),
+			proposerSlashings: len(msg.Body.ProposerSlashings),
+			attesterSlashings: len(msg.Body.AttesterSlashings),
+			syncAggregate:     msg.Body.SyncAggregate,
+			blsChanges:        len(msg.Body.BLSToExecutionChanges),
+			blobCommitments:   len(msg.Body.BlobKzgCommitments),
+			payload:           denebPayloadParts(msg.Body.ExecutionPayload),
+		}, nil
+	case spec.DataVersionElectra:
+		if block.Electra == nil || block.Electra.Message == nil || block.Electra.Message.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		msg := block.Electra.Message
+
+		return &blockParts{
+			slot:              msg.Slot,
+			proposerIndex:     msg.ProposerIndex,
+			parentRoot:        msg.ParentRoot,
+			stateRoot:         msg.StateRoot,
+			graffiti:          msg.Body.Graffiti,
+			attestations:      len(msg.Body.Attestations),
+			deposits:          len(msg.Body.Deposits),
+			voluntaryExits:    len(msg.Body.VoluntaryExits),
+			proposerSlashings: len(msg.Body.ProposerSlashings),
+			attesterSlashings: len(msg.Body.AttesterSlashings),
+			syncAggregate:     msg.Body.SyncAggregate,
+			blsChanges:        len(msg.Body.BLSToExecutionChanges),
+			blobCommitments:   len(msg.Body.BlobKzgCommitments),
+			payload:           denebPayloadParts(msg.Body.ExecutionPayload),
+		}, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// denebPayloadParts obtains the parts of a deneb execution payload.
+func denebPayloadParts(payload *deneb.ExecutionPayload) *payloadParts {
+	if payload == nil {
+		return nil
+	}
+
+	var baseFeePerGas *uint256.Int
+	if payload.BaseFeePerGas != nil {
+		baseFeePerGas = new(uint256.Int).Set(payload.BaseFeePerGas)
+	}
+	blobGasUsed := payload.BlobGasUsed
+	excessBlobGas := payload.ExcessBlobGas
+
+	return &payloadParts{
+		blockNumber:   payload.BlockNumber,
+		blockHash:     payload.BlockHash,
+		parentHash:    payload.ParentHash,
+		feeRecipient:  payload.FeeRecipient,
+		timestamp:     payload.Timestamp,
+		gasUsed:       payload.GasUsed,
+		gasLimit:      payload.GasLimit,
+		baseFeePerGas: baseFeePerGas,
+		extraData:     payload.ExtraData,
+		transactions:  payload.Transactions,
+		withdrawals:   payload.Withdrawals,
+		blobGasUsed:   &blobGasUsed,
+		excessBlobGas: &excessBlobGas,
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	batchSize     int
+	concurrency   int
+	retries       int
+	retryInterval time.Duration
+}
+
+// Parameter is the interface for fan-out parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithBatchSize sets the maximum number of items in each batch.
+func WithBatchSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.batchSize = size
+	})
+}
+
+// WithConcurrency sets the maximum number of batches in flight at any time.
+func WithConcurrency(concurrency int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.concurrency = concurrency
+	})
+}
+
+// WithRetries sets the number of times a failed batch is retried in full
+// before it is split to find the items that cause the failure.
+func WithRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retries = retries
+	})
+}
+
+// WithRetryInterval sets the time to wait before retrying a failed batch.
+func WithRetryInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retryInterval = interval
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		batchSize:     100,
+		concurrency:   4,
+		retries:       2,
+		retryInterval: time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.batchSize < 1 {
+		return nil, errors.New("batch size must be at least 1")
+	}
+	if parameters.concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+	if parameters.retries < 0 {
+		return nil, errors.New("retries cannot be negative")
+	}
+	if parameters.retryInterval < 0 {
+		return nil, errors.New("retry interval cannot be negative")
+	}
+
+	return &parameters, nil
+}
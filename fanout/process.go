@@ -0,0 +1,214 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fanout coalesces per-validator calls for large numbers of validators
+// into batches, bounding the number of batches in flight and isolating the
+// items responsible when a batch fails.
+package fanout
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Func is a function that processes a batch of items.
+type Func[T any] func(ctx context.Context, items []T) error
+
+// Process calls fn for the supplied items in batches.
+//
+// Items are split into the fewest batches that respect the batch size, with
+// the items spread evenly between them, so 101 items with a batch size of 100
+// results in batches of 51 and 50 rather than 100 and 1.
+//
+// A batch that fails is retried in full, and if it continues to fail it is
+// split in half and each half processed separately, down to individual items.
+// This allows the items that were accepted to be separated from those that were
+// rejected, at the cost of additional calls: a batch of n items that fails
+// entirely requires up to 2n-1 calls beyond its retries.
+//
+// The returned errors match the supplied items by index, with a nil entry for
+// each item that was processed successfully.  The error is set only if the
+// parameters are invalid or the context is done before all items are processed,
+// in which case the unprocessed items have the context's error.
+func Process[T any](ctx context.Context, items []T, fn Func[T], params ...Parameter) ([]error, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+	if fn == nil {
+		return nil, errors.New("no function specified")
+	}
+
+	p := &processor[T]{
+		parameters: parameters,
+		items:      items,
+		fn:         fn,
+		errs:       make([]error, len(items)),
+		sem:        make(chan struct{}, parameters.concurrency),
+	}
+
+	var wg sync.WaitGroup
+	for _, span := range batches(len(items), parameters.batchSize) {
+		wg.Add(1)
+		go p.process(ctx, &wg, span, 0)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return p.errs, err
+	}
+
+	return p.errs, nil
+}
+
+// Fetch calls fn for the supplied items in batches, returning the combined
+// results of all successful calls.  Batching and retries are as for Process.
+func Fetch[T any, R any](ctx context.Context,
+	items []T,
+	fn func(ctx context.Context, items []T) ([]R, error),
+	params ...Parameter,
+) ([]R, []error, error) {
+	if fn == nil {
+		return nil, nil, errors.New("no function specified")
+	}
+
+	var mu sync.Mutex
+	res := make([]R, 0, len(items))
+	errs, err := Process(ctx, items, func(ctx context.Context, batch []T) error {
+		batchRes, err := fn(ctx, batch)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		res = append(res, batchRes...)
+		mu.Unlock()
+
+		return nil
+	}, params...)
+	if errs == nil {
+		return nil, nil, err
+	}
+
+	return res, errs, err
+}
+
+// span is a contiguous range of items.
+type span struct {
+	start int
+	end   int
+}
+
+// batches splits n items into the fewest evenly-sized spans no larger than size.
+func batches(n int, size int) []span {
+	if n == 0 {
+		return nil
+	}
+	count := (n + size - 1) / size
+	base := n / count
+	extra := n % count
+
+	res := make([]span, count)
+	start := 0
+	for i := range res {
+		end := start + base
+		if i < extra {
+			end++
+		}
+		res[i] = span{start: start, end: end}
+		start = end
+	}
+
+	return res
+}
+
+type processor[T any] struct {
+	parameters *parameters
+	items      []T
+	fn         Func[T]
+	// errs is written at distinct indices by each span, so requires no lock.
+	errs []error
+	sem  chan struct{}
+}
+
+// process processes a single span, retrying and splitting it on failure.
+func (p *processor[T]) process(ctx context.Context, wg *sync.WaitGroup, s span, attempt int) {
+	defer wg.Done()
+
+	if ctx.Err() != nil {
+		p.fail(s, ctx.Err())
+		return
+	}
+	select {
+	case <-ctx.Done():
+		p.fail(s, ctx.Err())
+		return
+	case p.sem <- struct{}{}:
+	}
+	err := p.fn(ctx, p.items[s.start:s.end])
+	<-p.sem
+	if err == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		p.fail(s, ctx.Err())
+		return
+	}
+
+	if attempt < p.parameters.retries {
+		if !p.wait(ctx) {
+			p.fail(s, ctx.Err())
+			return
+		}
+		wg.Add(1)
+		p.process(ctx, wg, s, attempt+1)
+
+		return
+	}
+
+	if s.end-s.start == 1 {
+		p.errs[s.start] = err
+		return
+	}
+
+	// Split the span to isolate the failing items.  The halves are not
+	// retried, as the full span has already been retried.
+	mid := s.start + (s.end-s.start)/2
+	wg.Add(2)
+	go p.process(ctx, wg, span{start: s.start, end: mid}, attempt)
+	go p.process(ctx, wg, span{start: mid, end: s.end}, attempt)
+}
+
+// wait waits for the retry interval, returning false if the context is done first.
+func (p *processor[T]) wait(ctx context.Context) bool {
+	if p.parameters.retryInterval == 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(p.parameters.retryInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// fail marks all items in the span as failed with the given error.
+func (p *processor[T]) fail(s span, err error) {
+	for i := s.start; i < s.end; i++ {
+		p.errs[i] = err
+	}
+}
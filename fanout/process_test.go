@@ -0,0 +1,244 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/fanout"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the batches it is called with.
+type recorder struct {
+	mu       sync.Mutex
+	batches  [][]int
+	inFlight int
+	maxIn    int
+	// bad items cause any batch containing them to fail.
+	bad map[int]bool
+	// failures is the number of times each batch fails before succeeding.
+	failures int
+}
+
+func (r *recorder) process(_ context.Context, items []int) error {
+	r.mu.Lock()
+	r.batches = append(r.batches, items)
+	r.inFlight++
+	if r.inFlight > r.maxIn {
+		r.maxIn = r.inFlight
+	}
+	fail := r.failures > 0
+	if fail {
+		r.failures--
+	}
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.inFlight--
+		r.mu.Unlock()
+	}()
+
+	if fail {
+		return errors.New("transient failure")
+	}
+	for _, item := range items {
+		if r.bad[item] {
+			return errors.New("bad item")
+		}
+	}
+
+	return nil
+}
+
+func (r *recorder) sizes() []int {
+	sizes := make([]int, len(r.batches))
+	for i := range r.batches {
+		sizes[i] = len(r.batches[i])
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+
+	return sizes
+}
+
+func items(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i
+	}
+
+	return res
+}
+
+func TestProcess(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		items    []int
+		params   []fanout.Parameter
+		bad      map[int]bool
+		failures int
+		sizes    []int
+		failed   []int
+		err      string
+	}{
+		{
+			name:   "BatchSizeZero",
+			items:  items(10),
+			params: []fanout.Parameter{fanout.WithBatchSize(0)},
+			err:    "problem with parameters: batch size must be at least 1",
+		},
+		{
+			name:   "ConcurrencyZero",
+			items:  items(10),
+			params: []fanout.Parameter{fanout.WithConcurrency(0)},
+			err:    "problem with parameters: concurrency must be at least 1",
+		},
+		{
+			name:   "RetriesNegative",
+			items:  items(10),
+			params: []fanout.Parameter{fanout.WithRetries(-1)},
+			err:    "problem with parameters: retries cannot be negative",
+		},
+		{
+			name:  "Empty",
+			items: []int{},
+			sizes: []int{},
+		},
+		{
+			name:   "Single",
+			items:  items(10),
+			params: []fanout.Parameter{fanout.WithBatchSize(10)},
+			sizes:  []int{10},
+		},
+		{
+			name:   "Even",
+			items:  items(101),
+			params: []fanout.Parameter{fanout.WithBatchSize(100)},
+			sizes:  []int{51, 50},
+		},
+		{
+			name:   "Many",
+			items:  items(1000),
+			params: []fanout.Parameter{fanout.WithBatchSize(300)},
+			sizes:  []int{250, 250, 250, 250},
+		},
+		{
+			name:     "TransientFailure",
+			items:    items(10),
+			params:   []fanout.Parameter{fanout.WithBatchSize(10), fanout.WithRetryInterval(0)},
+			failures: 2,
+			sizes:    []int{10, 10, 10},
+		},
+		{
+			name:   "BadItem",
+			items:  items(8),
+			params: []fanout.Parameter{fanout.WithBatchSize(8), fanout.WithRetries(0)},
+			bad:    map[int]bool{5: true},
+			// 8 fails, 4 succeeds and 4 fails, 2 succeeds and 2 fails, 1 succeeds and 1 fails.
+			sizes:  []int{8, 4, 4, 2, 2, 1, 1},
+			failed: []int{5},
+		},
+		{
+			name:   "BadItems",
+			items:  items(4),
+			params: []fanout.Parameter{fanout.WithBatchSize(4), fanout.WithRetries(1), fanout.WithRetryInterval(0)},
+			bad:    map[int]bool{0: true, 3: true},
+			sizes:  []int{4, 4, 2, 2, 1, 1, 1, 1},
+			failed: []int{0, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &recorder{
+				bad:      test.bad,
+				failures: test.failures,
+			}
+			errs, err := fanout.Process(ctx, test.items, r.process, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, errs, len(test.items))
+			require.Equal(t, test.sizes, r.sizes())
+			failed := make([]int, 0)
+			for i := range errs {
+				if errs[i] != nil {
+					failed = append(failed, i)
+				}
+			}
+			if test.failed == nil {
+				test.failed = []int{}
+			}
+			require.Equal(t, test.failed, failed)
+		})
+	}
+}
+
+func TestProcessConcurrency(t *testing.T) {
+	ctx := context.Background()
+
+	r := &recorder{}
+	errs, err := fanout.Process(ctx, items(1000), r.process,
+		fanout.WithBatchSize(10),
+		fanout.WithConcurrency(3),
+	)
+	require.NoError(t, err)
+	require.Len(t, errs, 1000)
+	require.Len(t, r.batches, 100)
+	require.LessOrEqual(t, r.maxIn, 3)
+}
+
+func TestProcessContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := &recorder{}
+	errs, err := fanout.Process(ctx, items(10), r.process, fanout.WithBatchSize(5))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, errs, 10)
+	for i := range errs {
+		require.ErrorIs(t, errs[i], context.Canceled)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+
+	res, errs, err := fanout.Fetch(ctx, items(10), func(_ context.Context, batch []int) ([]int, error) {
+		for _, item := range batch {
+			if item == 7 {
+				return nil, errors.New("bad item")
+			}
+		}
+		res := make([]int, len(batch))
+		for i := range batch {
+			res[i] = batch[i] * 2
+		}
+
+		return res, nil
+	}, fanout.WithBatchSize(4), fanout.WithRetries(0))
+	require.NoError(t, err)
+	require.Error(t, errs[7])
+	sort.Ints(res)
+	require.Equal(t, []int{0, 2, 4, 6, 8, 10, 12, 16, 18}, res)
+}
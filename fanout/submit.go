@@ -0,0 +1,51 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// SubmitValidatorRegistrations submits validator registrations in batches.
+// The returned errors match the supplied registrations by index.
+func SubmitValidatorRegistrations(ctx context.Context,
+	submitter consensusclient.ValidatorRegistrationsSubmitter,
+	registrations []*api.VersionedSignedValidatorRegistration,
+	params ...Parameter,
+) ([]error, error) {
+	if submitter == nil {
+		return nil, errors.New("no submitter supplied")
+	}
+
+	return Process(ctx, registrations, submitter.SubmitValidatorRegistrations, params...)
+}
+
+// SubmitProposalPreparations submits proposal preparations in batches.
+// The returned errors match the supplied preparations by index.
+func SubmitProposalPreparations(ctx context.Context,
+	submitter consensusclient.ProposalPreparationsSubmitter,
+	preparations []*apiv1.ProposalPreparation,
+	params ...Parameter,
+) ([]error, error) {
+	if submitter == nil {
+		return nil, errors.New("no submitter supplied")
+	}
+
+	return Process(ctx, preparations, submitter.SubmitProposalPreparations, params...)
+}
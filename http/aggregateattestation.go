@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -39,7 +38,7 @@ func (s *Service) AggregateAttestation(ctx context.Context, slot phase0.Slot, at
 	}
 
 	var aggregateAttestationDataJSON aggregateAttestationDataJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&aggregateAttestationDataJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &aggregateAttestationDataJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse aggregate attestation")
 	}
 	if aggregateAttestationDataJSON.Data == nil {
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -37,7 +36,7 @@ func (s *Service) AttestationData(ctx context.Context, slot phase0.Slot, committ
 	}
 
 	var attestationDataJSON attestationDataJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&attestationDataJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &attestationDataJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse attestation data")
 	}
 
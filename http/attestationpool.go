@@ -15,9 +15,9 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -37,7 +37,7 @@ func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*pha
 	}
 
 	var attestationPoolJSON attestationPoolJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&attestationPoolJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &attestationPoolJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse attestation pool")
 	}
 
@@ -53,3 +53,34 @@ func (s *Service) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*pha
 
 	return attestationPoolJSON.Data, nil
 }
+
+// AttestationPoolPage obtains a single page of the attestation pool for a given slot.
+func (s *Service) AttestationPoolPage(ctx context.Context,
+	slot phase0.Slot,
+	opts *api.PageOpts,
+) (
+	*api.Page[*phase0.Attestation],
+	error,
+) {
+	page, err := getPage[*phase0.Attestation](ctx, s, "/eth/v1/beacon/pool/attestations", []string{fmt.Sprintf("slot=%d", slot)}, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain attestation pool page")
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	for i := range page.Data {
+		if page.Data[i].Data.Slot != slot {
+			return nil, errors.New("attestation pool entry not for requested slot")
+		}
+	}
+
+	return page, nil
+}
+
+// AttestationPoolIterator returns an iterator over the attestation pool for a given slot,
+// fetching up to limit attestations at a time.
+func (s *Service) AttestationPoolIterator(slot phase0.Slot, limit uint64) *api.PageIterator[*phase0.Attestation] {
+	return api.NewPageIterator(func(ctx context.Context, opts *api.PageOpts) (*api.Page[*phase0.Attestation], error) {
+		return s.AttestationPoolPage(ctx, slot, opts)
+	}, limit)
+}
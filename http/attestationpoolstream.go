@@ -0,0 +1,221 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// StreamAttestationPool fetches the attestation pool, optionally filtered by slot and
+// committee index, and passes each attestation to the handler as it is decoded.
+// If the handler returns an error the stream is stopped and the error returned.
+func (s *Service) StreamAttestationPool(ctx context.Context,
+	slot *phase0.Slot,
+	committeeIndex *phase0.CommitteeIndex,
+	handler func(*phase0.Attestation) error,
+) error {
+	if handler == nil {
+		return errors.New("no handler supplied")
+	}
+
+	filters := make([]string, 0, 2)
+	if slot != nil {
+		filters = append(filters, fmt.Sprintf("slot=%d", *slot))
+	}
+	if committeeIndex != nil {
+		filters = append(filters, fmt.Sprintf("committee_index=%d", *committeeIndex))
+	}
+	url := "/eth/v1/beacon/pool/attestations"
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	checkedHandler := func(attestation *phase0.Attestation) error {
+		if attestation.Data == nil {
+			return errors.New("attestation pool entry missing data")
+		}
+		if slot != nil && attestation.Data.Slot != *slot {
+			return errors.New("attestation pool entry not for requested slot")
+		}
+		if committeeIndex != nil && attestation.Data.Index != *committeeIndex {
+			return errors.New("attestation pool entry not for requested committee index")
+		}
+		return handler(attestation)
+	}
+
+	res, err := s.getStream2(ctx, url, func(res *httpResponse, body io.Reader) error {
+		switch res.contentType {
+		case ContentTypeSSZ:
+			return streamAttestationPoolFromSSZ(body, checkedHandler)
+		case ContentTypeJSON:
+			return s.streamAttestationPoolFromJSON(body, checkedHandler)
+		default:
+			return fmt.Errorf("unhandled content type %v", res.contentType)
+		}
+	})
+	if err != nil {
+		// Errors from the handler are returned as-is.
+		return err
+	}
+	if res.statusCode == http.StatusNotFound {
+		return errors.New("failed to obtain attestation pool")
+	}
+
+	return nil
+}
+
+// maxAttestationPoolEntrySize is the largest SSZ-encoded attestation pool entry that will be
+// accepted, to avoid large allocations as a result of invalid offsets.
+const maxAttestationPoolEntrySize = 1024 * 1024
+
+// streamAttestationPoolFromSSZ decodes an SSZ list of attestations one at a time as it is read.
+func streamAttestationPoolFromSSZ(body io.Reader, handler func(*phase0.Attestation) error) error {
+	// Attestations are variable-sized, so the list starts with an offset for each entry.
+	first := make([]byte, 4)
+	n, err := io.ReadFull(body, first)
+	switch {
+	case n == 0 && errors.Is(err, io.EOF):
+		// Empty list.
+		return nil
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return errors.New("attestation pool too short")
+	case err != nil:
+		return errors.Wrap(err, "failed to read attestation pool")
+	}
+	firstOffset := binary.LittleEndian.Uint32(first)
+	if firstOffset == 0 || firstOffset%4 != 0 {
+		return errors.New("invalid attestation pool offset")
+	}
+	entries := int(firstOffset / 4)
+
+	offsets := make([]byte, firstOffset)
+	copy(offsets, first)
+	if _, err := io.ReadFull(body, offsets[4:]); err != nil {
+		return errors.New("invalid attestation pool offset")
+	}
+
+	for i := 0; i < entries; i++ {
+		start := binary.LittleEndian.Uint32(offsets[i*4 : i*4+4])
+		if start < firstOffset {
+			return fmt.Errorf("invalid attestation pool offset for entry %d", i)
+		}
+
+		var entry []byte
+		if i < entries-1 {
+			end := binary.LittleEndian.Uint32(offsets[(i+1)*4 : (i+1)*4+4])
+			if start > end || end-start > maxAttestationPoolEntrySize {
+				return fmt.Errorf("invalid attestation pool offset for entry %d", i)
+			}
+			entry = make([]byte, end-start)
+			if _, err := io.ReadFull(body, entry); err != nil {
+				return fmt.Errorf("invalid attestation pool offset for entry %d", i)
+			}
+		} else {
+			// The final entry runs to the end of the body.
+			entry, err = io.ReadAll(io.LimitReader(body, maxAttestationPoolEntrySize+1))
+			if err != nil {
+				return errors.Wrap(err, "failed to read attestation pool")
+			}
+			if len(entry) > maxAttestationPoolEntrySize {
+				return fmt.Errorf("invalid attestation pool offset for entry %d", i)
+			}
+		}
+
+		attestation := &phase0.Attestation{}
+		if err := attestation.UnmarshalSSZ(entry); err != nil {
+			return errors.Wrap(err, "failed to decode attestation pool entry")
+		}
+		if err := handler(attestation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamAttestationPoolFromJSON decodes the data array of a JSON attestation
+// pool response one attestation at a time.
+func (s *Service) streamAttestationPoolFromJSON(body io.Reader, handler func(*phase0.Attestation) error) error {
+	decoder := json.NewDecoder(body)
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return errors.Wrap(err, "failed to parse attestation pool")
+	}
+
+	foundData := false
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return errors.Wrap(err, "failed to parse attestation pool")
+		}
+		if key, isString := token.(string); !isString || key != "data" {
+			// Not the data we are after; skip the value.
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return errors.Wrap(err, "failed to parse attestation pool")
+			}
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return errors.Wrap(err, "failed to parse attestation pool")
+		}
+		for decoder.More() {
+			var data json.RawMessage
+			if err := decoder.Decode(&data); err != nil {
+				return errors.Wrap(err, "failed to parse attestation pool entry")
+			}
+			attestation := &phase0.Attestation{}
+			if err := s.jsonCodec.Unmarshal(data, attestation); err != nil {
+				return errors.Wrap(err, "failed to parse attestation pool entry")
+			}
+			if err := handler(attestation); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return errors.Wrap(err, "failed to parse attestation pool")
+		}
+		foundData = true
+	}
+
+	if !foundData {
+		return errors.New("attestation pool not returned")
+	}
+
+	return nil
+}
+
+// expectDelim reads the next token from the decoder and ensures that it is the given delimiter.
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, found %v", delim, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testAttestations(n int) []*phase0.Attestation {
+	attestations := make([]*phase0.Attestation, n)
+	for i := range attestations {
+		attestations[i] = &phase0.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(8 * (i + 1))),
+			Data: &phase0.AttestationData{
+				Slot:   phase0.Slot(100 + i),
+				Index:  phase0.CommitteeIndex(i),
+				Source: &phase0.Checkpoint{},
+				Target: &phase0.Checkpoint{},
+			},
+		}
+	}
+
+	return attestations
+}
+
+func TestStreamAttestationPoolFromJSON(t *testing.T) {
+	attestations := testAttestations(3)
+	data, err := json.Marshal(&attestationPoolJSON{Data: attestations})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    []byte
+		expected int
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: []byte(``),
+			err:   "failed to parse attestation pool: EOF",
+		},
+		{
+			name:  "DataMissing",
+			input: []byte(`{}`),
+			err:   "attestation pool not returned",
+		},
+		{
+			name:  "DataWrongType",
+			input: []byte(`{"data":{}}`),
+			err:   "failed to parse attestation pool: expected [, found {",
+		},
+		{
+			name:     "DataEmpty",
+			input:    []byte(`{"data":[]}`),
+			expected: 0,
+		},
+		{
+			name:     "ExtraFields",
+			input:    []byte(`{"execution_optimistic":false,"data":[]}`),
+			expected: 0,
+		},
+		{
+			name:     "Good",
+			input:    data,
+			expected: 3,
+		},
+	}
+
+	s := &Service{jsonCodec: codecs.StdJSON}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received := make([]*phase0.Attestation, 0)
+			err := s.streamAttestationPoolFromJSON(bytes.NewReader(test.input), func(attestation *phase0.Attestation) error {
+				received = append(received, attestation)
+				return nil
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, received, test.expected)
+				for i := range received {
+					require.Equal(t, attestations[i], received[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStreamAttestationPoolFromSSZ(t *testing.T) {
+	attestations := testAttestations(3)
+	offsets := make([]byte, 4*len(attestations))
+	data := make([]byte, 0)
+	for i := range attestations {
+		binary.LittleEndian.PutUint32(offsets[i*4:], uint32(len(offsets)+len(data)))
+		encoded, err := attestations[i].MarshalSSZ()
+		require.NoError(t, err)
+		data = append(data, encoded...)
+	}
+	data = append(offsets, data...)
+
+	tests := []struct {
+		name     string
+		input    []byte
+		expected int
+		err      string
+	}{
+		{
+			name:     "Empty",
+			input:    []byte{},
+			expected: 0,
+		},
+		{
+			name:  "Short",
+			input: []byte{0x01, 0x02},
+			err:   "attestation pool too short",
+		},
+		{
+			name:  "OffsetInvalid",
+			input: []byte{0x03, 0x00, 0x00, 0x00},
+			err:   "invalid attestation pool offset",
+		},
+		{
+			name:  "OffsetOutOfRange",
+			input: []byte{0x08, 0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00},
+			err:   "invalid attestation pool offset for entry 0",
+		},
+		{
+			name:     "Good",
+			input:    data,
+			expected: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received := make([]*phase0.Attestation, 0)
+			err := streamAttestationPoolFromSSZ(bytes.NewReader(test.input), func(attestation *phase0.Attestation) error {
+				received = append(received, attestation)
+				return nil
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, received, test.expected)
+				for i := range received {
+					require.Equal(t, attestations[i], received[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStreamAttestationPoolHandlerError(t *testing.T) {
+	data, err := json.Marshal(&attestationPoolJSON{Data: testAttestations(3)})
+	require.NoError(t, err)
+
+	s := &Service{jsonCodec: codecs.StdJSON}
+	calls := 0
+	err = s.streamAttestationPoolFromJSON(bytes.NewReader(data), func(_ *phase0.Attestation) error {
+		calls++
+		return errors.New("stop")
+	})
+	require.EqualError(t, err, "stop")
+	require.Equal(t, 1, calls)
+}
+
+func TestStreamAttestationPoolIncremental(t *testing.T) {
+	attestations := testAttestations(2)
+	first, err := json.Marshal(attestations[0])
+	require.NoError(t, err)
+	second, err := json.Marshal(attestations[1])
+	require.NoError(t, err)
+
+	// The server does not send the second attestation until the first has been handled,
+	// so this only succeeds if the response is decoded as it is received.
+	handled := make(chan struct{})
+	s := testNodeService(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[`))
+		_, _ = w.Write(first)
+		w.(http.Flusher).Flush()
+		select {
+		case <-handled:
+		case <-time.After(time.Second):
+			return
+		}
+		_, _ = w.Write([]byte(`,`))
+		_, _ = w.Write(second)
+		_, _ = w.Write([]byte(`]}`))
+	})
+
+	received := make([]*phase0.Attestation, 0)
+	err = s.StreamAttestationPool(context.Background(), nil, nil, func(attestation *phase0.Attestation) error {
+		received = append(received, attestation)
+		if len(received) == 1 {
+			close(handled)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, attestations, received)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type attestationRewardsJSON struct {
+	Data *api.AttestationRewards `json:"data"`
+}
+
+// AttestationRewards provides the ideal and actual attestation rewards for the given epoch
+// and validator indices.  If no indices are supplied rewards are returned for all validators.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	var reqBodyReader bytes.Buffer
+	if _, err := reqBodyReader.WriteString(`[`); err != nil {
+		return nil, errors.Wrap(err, "failed to write validator index array start")
+	}
+	for i := range indices {
+		if _, err := reqBodyReader.WriteString(fmt.Sprintf(`"%d"`, indices[i])); err != nil {
+			return nil, errors.Wrap(err, "failed to write index")
+		}
+		if i != len(indices)-1 {
+			if _, err := reqBodyReader.WriteString(`,`); err != nil {
+				return nil, errors.Wrap(err, "failed to write separator")
+			}
+		}
+	}
+	if _, err := reqBodyReader.WriteString(`]`); err != nil {
+		return nil, errors.Wrap(err, "failed to write end of validator index array")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch), &reqBodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request attestation rewards")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain attestation rewards")
+	}
+
+	var resp attestationRewardsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse attestation rewards")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationRewards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service, err := http.New(ctx,
+		http.WithTimeout(timeout),
+		http.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	// Rewards are only available for epochs that are complete.
+	finality, err := service.(client.FinalityProvider).Finality(ctx, "head")
+	require.NoError(t, err)
+	epoch := finality.Finalized.Epoch
+
+	tests := []struct {
+		name    string
+		indices []phase0.ValidatorIndex
+	}{
+		{
+			name:    "Single",
+			indices: []phase0.ValidatorIndex{1},
+		},
+		{
+			name:    "Multiple",
+			indices: []phase0.ValidatorIndex{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rewards, err := service.(client.AttestationRewardsProvider).AttestationRewards(ctx, epoch, test.indices)
+			require.NoError(t, err)
+			require.NotNil(t, rewards)
+			require.NotEmpty(t, rewards.IdealRewards)
+			require.Len(t, rewards.TotalRewards, len(test.indices))
+		})
+	}
+}
@@ -15,37 +15,11 @@ package http
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"sort"
 
 	"github.com/attestantio/go-eth2-client/spec/deneb"
-	"github.com/pkg/errors"
 )
 
-type beaconBlockBlobsJSON struct {
-	Data []*deneb.BlobSidecar `json:"data"`
-}
-
 // BeaconBlockBlobs fetches the blobs given a block ID.
 func (s *Service) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error) {
-	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to request blobs")
-	}
-	if respBodyReader == nil {
-		return nil, nil
-	}
-
-	var resp beaconBlockBlobsJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
-		return nil, errors.Wrap(err, "failed to parse blobs")
-	}
-
-	// Data is not guaranteed to be returned in indx order, so fix that.
-	sort.Slice(resp.Data, func(i int, j int) bool {
-		return resp.Data[i].Index < resp.Data[j].Index
-	})
-
-	return resp.Data, nil
+	return s.BlobSidecars(ctx, blockID, nil)
 }
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -37,7 +36,7 @@ func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.B
 	}
 
 	var resp beaconBlockHeaderJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon block header")
 	}
 
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -72,7 +71,7 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 	var dataBodyReader bytes.Buffer
 	metadataReader := io.TeeReader(respBodyReader, &dataBodyReader)
 	var metadata responseMetadata
-	if err := json.NewDecoder(metadataReader).Decode(&metadata); err != nil {
+	if err := s.decodeJSON(metadataReader, &metadata); err != nil {
 		return nil, errors.Wrap(err, "failed to parse response")
 	}
 	res := &spec.VersionedBeaconBlock{
@@ -82,7 +81,7 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 	switch metadata.Version {
 	case spec.DataVersionPhase0:
 		var resp phase0BeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(&dataBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse phase 0 beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
@@ -102,7 +101,7 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 		res.Phase0 = resp.Data
 	case spec.DataVersionAltair:
 		var resp altairBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(&dataBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse altair beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
@@ -122,7 +121,7 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 		res.Altair = resp.Data
 	case spec.DataVersionBellatrix:
 		var resp bellatrixBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(&dataBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse bellatrix beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
@@ -142,7 +141,7 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 		res.Bellatrix = resp.Data
 	case spec.DataVersionCapella:
 		var resp capellaBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(&dataBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse capella beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
@@ -162,7 +161,7 @@ func (s *Service) beaconBlockProposal(ctx context.Context, slot phase0.Slot, ran
 		res.Capella = resp.Data
 	case spec.DataVersionDeneb:
 		var resp denebBeaconBlockProposalJSON
-		if err := json.NewDecoder(&dataBodyReader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(&dataBodyReader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse deneb beacon block proposal")
 		}
 		// Ensure the data returned to us is as expected given our input.
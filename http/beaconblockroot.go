@@ -16,7 +16,6 @@ package http
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -44,7 +43,7 @@ func (s *Service) BeaconBlockRoot(ctx context.Context, blockID string) (*phase0.
 	}
 
 	var beaconBlockRootJSON beaconBlockRootJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&beaconBlockRootJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &beaconBlockRootJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon block root")
 	}
 
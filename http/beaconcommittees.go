@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -39,7 +38,7 @@ func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.
 	}
 
 	var resp beaconCommitteesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon committees")
 	}
 
@@ -58,7 +57,7 @@ func (s *Service) BeaconCommitteesAtEpoch(ctx context.Context, stateID string, e
 	}
 
 	var resp beaconCommitteesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse beacon committees")
 	}
 
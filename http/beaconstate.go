@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -25,6 +24,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -49,9 +49,22 @@ type denebBeaconStateJSON struct {
 	Data *deneb.BeaconState `json:"data"`
 }
 
+type electraBeaconStateJSON struct {
+	Data *electra.BeaconState `json:"data"`
+}
+
 // BeaconState fetches a beacon state.
 // N.B if the requested beacon state is not available this will return nil without an error.
 func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	if s.verifyRoots {
+		return s.verifiedBeaconState(ctx, stateID)
+	}
+
+	return s.beaconState(ctx, stateID)
+}
+
+// beaconState fetches a beacon state without verification.
+func (s *Service) beaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
 	res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon state")
@@ -60,6 +73,18 @@ func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.Versio
 		return nil, nil
 	}
 
+	if res.unknownConsensusVersion != "" {
+		data, err := s.unknownVersionData(res)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain beacon state of unknown version")
+		}
+
+		return &spec.VersionedBeaconState{
+			Version: spec.DataVersionUnknown,
+			Unknown: data,
+		}, nil
+	}
+
 	switch res.contentType {
 	case ContentTypeSSZ:
 		return s.beaconStateFromSSZ(res)
@@ -101,6 +126,11 @@ func (s *Service) beaconStateFromSSZ(res *httpResponse) (*spec.VersionedBeaconSt
 		if err := state.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb beacon state")
 		}
+	case spec.DataVersionElectra:
+		state.Electra = &electra.BeaconState{}
+		if err := state.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra beacon state")
+		}
 	default:
 		return nil, fmt.Errorf("unhandled state version %s", res.consensusVersion)
 	}
@@ -118,34 +148,40 @@ func (s *Service) beaconStateFromJSON(res *httpResponse) (*spec.VersionedBeaconS
 	switch state.Version {
 	case spec.DataVersionPhase0:
 		var resp phase0BeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse phase 0 beacon state")
 		}
 		state.Phase0 = resp.Data
 	case spec.DataVersionAltair:
 		var resp altairBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse altair beacon state")
 		}
 		state.Altair = resp.Data
 	case spec.DataVersionBellatrix:
 		var resp bellatrixBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse bellatrix beacon state")
 		}
 		state.Bellatrix = resp.Data
 	case spec.DataVersionCapella:
 		var resp capellaBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse capella beacon state")
 		}
 		state.Capella = resp.Data
 	case spec.DataVersionDeneb:
 		var resp denebBeaconStateJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse deneb beacon state")
 		}
 		state.Deneb = resp.Data
+	case spec.DataVersionElectra:
+		var resp electraBeaconStateJSON
+		if err := s.decodeJSON(reader, &resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra beacon state")
+		}
+		state.Electra = resp.Data
 	}
 
 	return state, nil
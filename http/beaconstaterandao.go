@@ -16,7 +16,6 @@ package http
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -47,7 +46,7 @@ func (s *Service) BeaconStateRandao(ctx context.Context, stateID string) (*phase
 	}
 
 	var data stateRandaoJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&data); err != nil {
+	if err := s.decodeJSON(respBodyReader, &data); err != nil {
 		return nil, errors.Wrap(err, "failed to parse state RANDAO")
 	}
 
@@ -16,7 +16,6 @@ package http
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -47,7 +46,7 @@ func (s *Service) BeaconStateRoot(ctx context.Context, stateID string) (*spec.Ro
 	}
 
 	var stateRootJSON stateRootJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&stateRootJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &stateRootJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse state root")
 	}
 
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -142,19 +141,19 @@ func (s *Service) blindedBeaconBlockProposalFromJSON(res *httpResponse) (*api.Ve
 	switch block.Version {
 	case spec.DataVersionBellatrix:
 		var resp bellatrixBlindedBeaconBlockProposalJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse bellatrix blinded beacon block proposal")
 		}
 		block.Bellatrix = resp.Data
 	case spec.DataVersionCapella:
 		var resp capellaBlindedBeaconBlockProposalJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse capella blinded beacon block proposal")
 		}
 		block.Capella = resp.Data
 	case spec.DataVersionDeneb:
 		var resp denebBlindedBeaconBlockProposalJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse deneb blinded beacon block proposal")
 		}
 		block.Deneb = resp.Data
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/pkg/errors"
+)
+
+type blobSidecarsJSON struct {
+	Data []*deneb.BlobSidecar `json:"data"`
+}
+
+// BlobSidecars fetches the blob sidecars given a block ID.
+// indices is a list of blob indices to restrict the returned values.  If no indices are supplied
+// all blob sidecars for the block are returned.
+// N.B if the block for the block ID is not available this will return nil without an error.
+func (s *Service) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	url := fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID)
+	if len(indices) > 0 {
+		ids := make([]string, len(indices))
+		for i := range indices {
+			ids[i] = fmt.Sprintf("%d", indices[i])
+		}
+		url = fmt.Sprintf("%s?indices=%s", url, strings.Join(ids, "&indices="))
+	}
+
+	res, err := s.get2(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request blob sidecars")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var blobSidecars []*deneb.BlobSidecar
+	switch res.contentType {
+	case ContentTypeSSZ:
+		blobSidecars, err = s.blobSidecarsFromSSZ(res.body)
+	case ContentTypeJSON:
+		blobSidecars, err = s.blobSidecarsFromJSON(res.body)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Data is not guaranteed to be returned in index order, so fix that.
+	sort.Slice(blobSidecars, func(i int, j int) bool {
+		return blobSidecars[i].Index < blobSidecars[j].Index
+	})
+
+	return blobSidecars, nil
+}
+
+func (s *Service) blobSidecarsFromSSZ(data []byte) ([]*deneb.BlobSidecar, error) {
+	// Blob sidecars have a fixed size, so the list is a simple concatenation.
+	size := (&deneb.BlobSidecar{}).SizeSSZ()
+	if len(data)%size != 0 {
+		return nil, fmt.Errorf("invalid blob sidecars data length %d", len(data))
+	}
+
+	res := make([]*deneb.BlobSidecar, len(data)/size)
+	for i := range res {
+		res[i] = &deneb.BlobSidecar{}
+		if err := res[i].UnmarshalSSZ(data[i*size : (i+1)*size]); err != nil {
+			return nil, errors.Wrap(err, "failed to decode blob sidecar")
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) blobSidecarsFromJSON(data []byte) ([]*deneb.BlobSidecar, error) {
+	var resp blobSidecarsJSON
+	if err := s.jsonCodec.Unmarshal(data, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse blob sidecars")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no blob sidecars returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobSidecars(t *testing.T) {
+	sidecar0 := &deneb.BlobSidecar{Index: 0, Slot: 5}
+	sidecar1 := &deneb.BlobSidecar{Index: 1, Slot: 5}
+	sidecar0SSZ, err := sidecar0.MarshalSSZ()
+	require.NoError(t, err)
+	sidecar1SSZ, err := sidecar1.MarshalSSZ()
+	require.NoError(t, err)
+	sidecarsJSON, err := json.Marshal(&blobSidecarsJSON{Data: []*deneb.BlobSidecar{sidecar1, sidecar0}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		indices     []deneb.BlobIndex
+		statusCode  int
+		contentType string
+		body        []byte
+		query       string
+		expected    []*deneb.BlobSidecar
+		err         string
+	}{
+		{
+			name:        "JSON",
+			contentType: "application/json",
+			body:        sidecarsJSON,
+			expected:    []*deneb.BlobSidecar{sidecar0, sidecar1},
+		},
+		{
+			name:        "SSZ",
+			contentType: "application/octet-stream",
+			body:        append(append([]byte{}, sidecar1SSZ...), sidecar0SSZ...),
+			expected:    []*deneb.BlobSidecar{sidecar0, sidecar1},
+		},
+		{
+			name:        "Indices",
+			indices:     []deneb.BlobIndex{1, 3},
+			contentType: "application/octet-stream",
+			body:        sidecar1SSZ,
+			query:       "indices=1&indices=3",
+			expected:    []*deneb.BlobSidecar{sidecar1},
+		},
+		{
+			name:       "NotFound",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:        "SSZInvalid",
+			contentType: "application/octet-stream",
+			body:        sidecar0SSZ[:100],
+			err:         "invalid blob sidecars data length 100",
+		},
+		{
+			name:        "JSONNoData",
+			contentType: "application/json",
+			body:        []byte(`{}`),
+			err:         "no blob sidecars returned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/beacon/blob_sidecars/head", r.URL.Path)
+				require.Equal(t, test.query, r.URL.RawQuery)
+				if test.statusCode != 0 {
+					w.WriteHeader(test.statusCode)
+					return
+				}
+				w.Header().Set("Content-Type", test.contentType)
+				_, _ = w.Write(test.body)
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec:    codecs.StdJSON,
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      srv.URL,
+				client:       srv.Client(),
+				timeout:      time.Second,
+				limiter:      newLimiter(0, nil, 0),
+				deprecations: make(map[string]*EndpointDeprecation),
+			}
+
+			res, err := s.BlobSidecars(context.Background(), "head", test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
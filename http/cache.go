@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+)
+
+// Cache is the interface for a cache of responses.
+type Cache interface {
+	// Get returns the value for the given key, if present.
+	Get(key string) ([]byte, bool)
+	// Set sets the value for the given key.
+	Set(key string, value []byte)
+}
+
+// immutableEndpoints are endpoints that always return the same data.
+var immutableEndpoints = map[string]bool{
+	"/eth/v1/beacon/genesis":          true,
+	"/eth/v1/config/deposit_contract": true,
+	"/eth/v1/config/spec":             true,
+}
+
+// immutableEndpointPrefixes are prefixes of endpoints that return the same
+// data because they reference blocks or states by root.
+var immutableEndpointPrefixes = []string{
+	"/eth/v1/beacon/blob_sidecars/0x",
+	"/eth/v1/beacon/blocks/0x",
+	"/eth/v1/beacon/rewards/blocks/0x",
+	"/eth/v1/beacon/states/0x",
+	"/eth/v2/beacon/blocks/0x",
+	"/eth/v2/debug/beacon/states/0x",
+}
+
+// cachedResponse is the form in which a response is held in the cache.
+type cachedResponse struct {
+	ContentType             int    `json:"content_type"`
+	ConsensusVersion        uint64 `json:"consensus_version"`
+	UnknownConsensusVersion string `json:"unknown_consensus_version,omitempty"`
+	Body                    []byte `json:"body"`
+}
+
+// cacheable returns true if the response for the endpoint can be cached.
+func cacheable(endpoint string) bool {
+	path := endpoint
+	if idx := strings.Index(path, "?"); idx != -1 {
+		path = path[:idx]
+	}
+	if immutableEndpoints[path] {
+		return true
+	}
+	for _, prefix := range immutableEndpointPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheKey returns the cache key for the response for an endpoint, including
+// its parameters, in the given content type.
+func cacheKey(endpoint string, contentType ContentType) string {
+	return fmt.Sprintf("%s;%s", endpoint, contentType.MediaType())
+}
+
+// cachedResponse returns the cached response for the endpoint, if present.
+func (s *Service) cachedResponse(endpoint string, accept ContentType) (*httpResponse, bool) {
+	if s.cache == nil || !cacheable(endpoint) {
+		return nil, false
+	}
+	data, found := s.cache.Get(cacheKey(endpoint, accept))
+	if !found {
+		return nil, false
+	}
+
+	cached := &cachedResponse{}
+	if err := json.Unmarshal(data, cached); err != nil {
+		s.log.Debug().Str("endpoint", endpoint).Err(err).Msg("Invalid cached response; ignoring")
+		return nil, false
+	}
+
+	return &httpResponse{
+		statusCode:              http.StatusOK,
+		contentType:             ContentType(cached.ContentType),
+		consensusVersion:        spec.DataVersion(cached.ConsensusVersion),
+		unknownConsensusVersion: cached.UnknownConsensusVersion,
+		body:                    cached.Body,
+	}, true
+}
+
+// cacheResponse caches the response for the endpoint, if it can be cached.
+func (s *Service) cacheResponse(endpoint string, accept ContentType, res *httpResponse) {
+	if s.cache == nil || res.statusCode != http.StatusOK || !cacheable(endpoint) {
+		return
+	}
+
+	data, err := json.Marshal(&cachedResponse{
+		ContentType:             int(res.contentType),
+		ConsensusVersion:        uint64(res.consensusVersion),
+		UnknownConsensusVersion: res.unknownConsensusVersion,
+		Body:                    res.body,
+	})
+	if err != nil {
+		s.log.Debug().Str("endpoint", endpoint).Err(err).Msg("Failed to encode response for cache")
+		return
+	}
+	s.cache.Set(cacheKey(endpoint, accept), data)
+}
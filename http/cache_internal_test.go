@@ -0,0 +1,123 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/cache"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheable(t *testing.T) {
+	tests := []struct {
+		endpoint  string
+		cacheable bool
+	}{
+		{endpoint: "/eth/v1/beacon/genesis", cacheable: true},
+		{endpoint: "/eth/v1/config/spec", cacheable: true},
+		{endpoint: "/eth/v1/config/deposit_contract", cacheable: true},
+		{endpoint: "/eth/v1/config/fork_schedule", cacheable: false},
+		{endpoint: "/eth/v1/node/version", cacheable: false},
+		{endpoint: "/eth/v2/beacon/blocks/0x0102", cacheable: true},
+		{endpoint: "/eth/v2/beacon/blocks/head", cacheable: false},
+		{endpoint: "/eth/v2/beacon/blocks/123", cacheable: false},
+		{endpoint: "/eth/v1/beacon/states/0x0102/validators?id=1,2", cacheable: true},
+		{endpoint: "/eth/v1/beacon/states/finalized/validators", cacheable: false},
+		{endpoint: "/eth/v1/beacon/genesis?x=y", cacheable: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			require.Equal(t, test.cacheable, cacheable(test.endpoint))
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.RequestURI()]++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Eth-Consensus-Version", "deneb")
+		_, _ = w.Write([]byte(`{"data":{"path":"` + r.URL.Path + `"}}`))
+	}))
+	defer srv.Close()
+
+	c, err := cache.New(ctx, cache.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec:    codecs.StdJSON,
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      srv.URL,
+		client:       srv.Client(),
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+		cache:        c,
+	}
+
+	// Immutable data is fetched once.
+	for i := 0; i < 3; i++ {
+		res, err := s.get(ctx, "/eth/v1/beacon/genesis")
+		require.NoError(t, err)
+		data, err := io.ReadAll(res)
+		require.NoError(t, err)
+		require.Equal(t, `{"data":{"path":"/eth/v1/beacon/genesis"}}`, string(data))
+	}
+	require.Equal(t, 1, requests["/eth/v1/beacon/genesis"])
+
+	// Metadata of the response is retained.
+	for i := 0; i < 3; i++ {
+		res, err := s.getWithAccept(ctx, "/eth/v2/beacon/blocks/0x0102", ContentTypeJSON)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.statusCode)
+		require.Equal(t, spec.DataVersionDeneb, res.consensusVersion)
+		require.Equal(t, ContentTypeJSON, res.contentType)
+		require.Equal(t, `{"data":{"path":"/eth/v2/beacon/blocks/0x0102"}}`, string(res.body))
+	}
+	require.Equal(t, 1, requests["/eth/v2/beacon/blocks/0x0102"])
+
+	// Parameters form part of the key.
+	_, err = s.get(ctx, "/eth/v1/beacon/states/0x0102/validators?id=1")
+	require.NoError(t, err)
+	_, err = s.get(ctx, "/eth/v1/beacon/states/0x0102/validators?id=2")
+	require.NoError(t, err)
+	_, err = s.get(ctx, "/eth/v1/beacon/states/0x0102/validators?id=1")
+	require.NoError(t, err)
+	require.Equal(t, 1, requests["/eth/v1/beacon/states/0x0102/validators?id=1"])
+	require.Equal(t, 1, requests["/eth/v1/beacon/states/0x0102/validators?id=2"])
+
+	// Mutable data is always fetched.
+	for i := 0; i < 3; i++ {
+		_, err := s.get(ctx, "/eth/v2/beacon/blocks/head")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, requests["/eth/v2/beacon/blocks/head"])
+}
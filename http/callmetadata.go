@@ -0,0 +1,134 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// CallMetadata is metadata about calls to the beacon node, supplied through the
+// context.  It is included in logs, metrics and request hooks, allowing services
+// with multiple internal consumers to attribute load to each of them.
+type CallMetadata struct {
+	// Caller identifies the consumer making the call, for example the name
+	// of an internal service.  It is used as a metrics label, so should have
+	// a small number of distinct values.
+	Caller string
+	// Fields are additional fields, such as trace identifiers, that are included
+	// in logs and passed to request hooks.
+	Fields map[string]string
+}
+
+type callMetadataContextKey struct{}
+
+// WithCallMetadata returns a context that attaches the given metadata to requests made with it.
+func WithCallMetadata(ctx context.Context, metadata *CallMetadata) context.Context {
+	return context.WithValue(ctx, callMetadataContextKey{}, metadata)
+}
+
+// CallMetadataFromContext returns the call metadata attached to the context, or nil if there is none.
+func CallMetadataFromContext(ctx context.Context) *CallMetadata {
+	metadata, _ := ctx.Value(callMetadataContextKey{}).(*CallMetadata)
+
+	return metadata
+}
+
+// caller returns the caller from the call metadata attached to the context.
+func caller(ctx context.Context) string {
+	if metadata := CallMetadataFromContext(ctx); metadata != nil {
+		return metadata.Caller
+	}
+
+	return ""
+}
+
+// RequestInfo is information about a request to the beacon node, passed to request hooks.
+type RequestInfo struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint of the request, including any query parameters.
+	Endpoint string
+	// Priority is the priority of the request.
+	Priority Priority
+	// Metadata is the call metadata attached to the context of the request, if any.
+	Metadata *CallMetadata
+	// StatusCode is the status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the duration of the request, including any time spent queued.
+	Duration time.Duration
+	// Err is the error returned by the request, if any.
+	Err error
+}
+
+// RequestHook is a function that is called when a request to the beacon node completes.
+// Hooks are called synchronously, so should return quickly.
+type RequestHook func(ctx context.Context, info *RequestInfo)
+
+// requestLog returns a logger for a request, with fields for the call metadata
+// attached to the context.
+func (s *Service) requestLog(ctx context.Context, endpoint string, priority Priority) zerolog.Logger {
+	// #nosec G404
+	logCtx := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Stringer("priority", priority)
+	if metadata := CallMetadataFromContext(ctx); metadata != nil {
+		if metadata.Caller != "" {
+			logCtx = logCtx.Str("caller", metadata.Caller)
+		}
+		keys := make([]string, 0, len(metadata.Fields))
+		for k := range metadata.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			logCtx = logCtx.Str(k, metadata.Fields[k])
+		}
+	}
+
+	return logCtx.Logger()
+}
+
+// requestDone records the completion of a request in metrics and calls the request hooks.
+func (s *Service) requestDone(ctx context.Context,
+	method string,
+	endpoint string,
+	priority Priority,
+	started time.Time,
+	statusCode int,
+	size int,
+	err error,
+) {
+	duration := time.Since(started)
+	s.monitorRequest(ctx, method, endpoint, duration, statusCode, size, err)
+
+	if len(s.requestHooks) == 0 {
+		return
+	}
+	info := &RequestInfo{
+		Method:     method,
+		Endpoint:   endpoint,
+		Priority:   priority,
+		Metadata:   CallMetadataFromContext(ctx),
+		StatusCode: statusCode,
+		Duration:   duration,
+		Err:        err,
+	}
+	for _, hook := range s.requestHooks {
+		hook(ctx, info)
+	}
+}
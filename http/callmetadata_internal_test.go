@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallMetadata(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":400,"message":"bad"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	// The global level may be disabled for tests.
+	globalLevel := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	defer zerolog.SetGlobalLevel(globalLevel)
+	logs := &bytes.Buffer{}
+	infos := make([]*RequestInfo, 0)
+	s := &Service{
+		jsonCodec:    codecs.StdJSON,
+		log:          zerolog.New(logs).Level(zerolog.TraceLevel),
+		base:         base,
+		address:      srv.URL,
+		client:       srv.Client(),
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+		requestHooks: []RequestHook{
+			func(_ context.Context, info *RequestInfo) {
+				infos = append(infos, info)
+			},
+		},
+	}
+
+	metadata := &CallMetadata{
+		Caller: "indexer",
+		Fields: map[string]string{"trace_id": "abc"},
+	}
+	ctx := WithPriority(WithCallMetadata(context.Background(), metadata), PriorityLow)
+	require.Equal(t, metadata, CallMetadataFromContext(ctx))
+	require.Nil(t, CallMetadataFromContext(context.Background()))
+
+	_, err = s.getJSON(ctx, "/eth/v1/node/version")
+	require.NoError(t, err)
+	_, err = s.post(context.Background(), "/eth/v1/beacon/pool/voluntary_exits", strings.NewReader("{}"))
+	require.Error(t, err)
+
+	require.Len(t, infos, 2)
+	require.Equal(t, http.MethodGet, infos[0].Method)
+	require.Equal(t, "/eth/v1/node/version", infos[0].Endpoint)
+	require.Equal(t, PriorityLow, infos[0].Priority)
+	require.Equal(t, metadata, infos[0].Metadata)
+	require.Equal(t, http.StatusOK, infos[0].StatusCode)
+	require.NoError(t, infos[0].Err)
+	require.Equal(t, http.MethodPost, infos[1].Method)
+	require.Equal(t, PriorityNormal, infos[1].Priority)
+	require.Nil(t, infos[1].Metadata)
+	require.Equal(t, http.StatusBadRequest, infos[1].StatusCode)
+	require.Equal(t, err, infos[1].Err)
+
+	require.Contains(t, logs.String(), `"caller":"indexer","trace_id":"abc","message":"GET request"`)
+}
+
+func TestRequestHookParameter(t *testing.T) {
+	_, err := parseAndCheckParameters(WithAddress("localhost:5052"), WithRequestHook(nil))
+	require.EqualError(t, err, "nil request hook specified")
+
+	params, err := parseAndCheckParameters(WithAddress("localhost:5052"),
+		WithRequestHook(func(context.Context, *RequestInfo) {}),
+		WithRequestHook(func(context.Context, *RequestInfo) {}),
+	)
+	require.NoError(t, err)
+	require.Len(t, params.requestHooks, 2)
+}
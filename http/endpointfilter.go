@@ -0,0 +1,43 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrEndpointDisabled is returned for requests to endpoints that have been disabled
+// for the client with WithEnabledEndpoints or WithDisabledEndpoints.
+var ErrEndpointDisabled = errors.New("endpoint disabled")
+
+// checkEndpointEnabled returns an error if requests to the endpoint are not allowed.
+func (s *Service) checkEndpointEnabled(endpoint string) error {
+	for _, prefix := range s.disabledEndpoints {
+		if strings.HasPrefix(endpoint, prefix) {
+			return errors.Wrap(ErrEndpointDisabled, endpoint)
+		}
+	}
+	if len(s.enabledEndpoints) == 0 {
+		return nil
+	}
+	for _, prefix := range s.enabledEndpoints {
+		if strings.HasPrefix(endpoint, prefix) {
+			return nil
+		}
+	}
+
+	return errors.Wrap(ErrEndpointDisabled, endpoint)
+}
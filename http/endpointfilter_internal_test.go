@@ -0,0 +1,115 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEndpointEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  []string
+		disabled []string
+		endpoint string
+		err      string
+	}{
+		{
+			name:     "NoLists",
+			endpoint: "/eth/v2/debug/beacon/states/head",
+		},
+		{
+			name:     "Disabled",
+			disabled: []string{"/eth/v2/debug/beacon/states"},
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			err:      "/eth/v2/debug/beacon/states/head: endpoint disabled",
+		},
+		{
+			name:     "NotDisabled",
+			disabled: []string{"/eth/v2/debug/beacon/states"},
+			endpoint: "/eth/v1/beacon/genesis",
+		},
+		{
+			name:     "Enabled",
+			enabled:  []string{"/eth/v1/validator", "/eth/v1/node"},
+			endpoint: "/eth/v1/node/syncing",
+		},
+		{
+			name:     "NotEnabled",
+			enabled:  []string{"/eth/v1/validator", "/eth/v1/node"},
+			endpoint: "/eth/v1/beacon/genesis",
+			err:      "/eth/v1/beacon/genesis: endpoint disabled",
+		},
+		{
+			name:     "DisabledOverridesEnabled",
+			enabled:  []string{"/eth/v1/validator"},
+			disabled: []string{"/eth/v1/validator/blinded_blocks"},
+			endpoint: "/eth/v1/validator/blinded_blocks/1",
+			err:      "/eth/v1/validator/blinded_blocks/1: endpoint disabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				jsonCodec:         codecs.StdJSON,
+				enabledEndpoints:  test.enabled,
+				disabledEndpoints: test.disabled,
+			}
+			err := s.checkEndpointEnabled(test.endpoint)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, ErrEndpointDisabled)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEndpointsParameters(t *testing.T) {
+	params, err := parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithEnabledEndpoints([]string{"/eth/v1/validator"}),
+		WithEnabledEndpoints([]string{"/eth/v1/node"}),
+		WithDisabledEndpoints([]string{"/eth/v2/debug/beacon/states"}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/eth/v1/validator", "/eth/v1/node"}, params.enabledEndpoints)
+	require.Equal(t, []string{"/eth/v2/debug/beacon/states"}, params.disabledEndpoints)
+
+	_, err = parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithDisabledEndpoints([]string{"eth/v1/events"}),
+	)
+	require.EqualError(t, err, "endpoint eth/v1/events must start with /")
+}
+
+func TestEventsDisabled(t *testing.T) {
+	s := &Service{
+		jsonCodec:         codecs.StdJSON,
+		log:               zerolog.Nop(),
+		disabledEndpoints: []string{"/eth/v1/events"},
+	}
+
+	err := s.Events(context.Background(), []string{"head"}, nil)
+	require.ErrorIs(t, err, ErrEndpointDisabled)
+	_, err = s.EventTopics(context.Background())
+	require.ErrorIs(t, err, ErrEndpointDisabled)
+}
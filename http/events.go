@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net"
@@ -27,6 +26,7 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/r3labs/sse/v2"
@@ -50,11 +50,23 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 		}
 	}
 
-	reference, err := url.Parse(fmt.Sprintf("eth/v1/events?topics=%s", strings.Join(topics, "&topics=")))
+	if err := s.checkEndpointEnabled("/eth/v1/events"); err != nil {
+		return err
+	}
+
+	// Drop any topics that the node is known to reject, rather than failing the whole stream.
+	topics, unsupported := s.knownSupportedEventTopics(topics)
+	if len(unsupported) > 0 {
+		log.Warn().Strs("topics", unsupported).Msg("Node does not support event topics; ignoring")
+	}
+	if len(topics) == 0 {
+		return errors.New("no supported event topics")
+	}
+
+	url, err := s.eventsURL(topics)
 	if err != nil {
-		return errors.Wrap(err, "invalid endpoint")
+		return err
 	}
-	url := s.base.ResolveReference(reference).String()
 	log.Trace().Str("url", url).Msg("GET request to events stream")
 
 	client := sse.NewClient(url)
@@ -65,27 +77,28 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 		}).Dial,
 	}
 
-	go func() {
-		for {
-			select {
-			case <-time.After(time.Second):
-				log.Trace().Msg("Connecting to events stream")
-				if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-					s.handleEvent(ctx, msg, handler)
-				}); err != nil {
-					log.Error().Err(err).Msg("Failed to subscribe to event stream")
-				}
-				log.Trace().Msg("Events stream disconnected")
-			case <-ctx.Done():
-				log.Debug().Msg("Context done")
-				return
-			}
-		}
-	}()
+	// Reconnection is handled by runEvents rather than the SSE client.
+	client.ReconnectStrategy = noReconnect{}
+
+	if s.eventsDeduplication && handler != nil {
+		handler = newEventDeduplicator().wrap(handler)
+	}
+
+	go s.runEvents(ctx, client, topics, handler)
 
 	return nil
 }
 
+// eventsURL returns the URL of the events stream for the given topics.
+func (s *Service) eventsURL(topics []string) (string, error) {
+	reference, err := url.Parse(fmt.Sprintf("eth/v1/events?topics=%s", strings.Join(topics, "&topics=")))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid endpoint")
+	}
+
+	return s.base.ResolveReference(reference).String(), nil
+}
+
 // handleEvent parses an event and passes it on to the handler.
 func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler client.EventHandlerFunc) {
 	log := zerolog.Ctx(ctx)
@@ -105,7 +118,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 	switch string(msg.Event) {
 	case "head":
 		headEvent := &api.HeadEvent{}
-		err := json.Unmarshal(msg.Data, headEvent)
+		err := s.jsonCodec.Unmarshal(msg.Data, headEvent)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse head event")
 			return
@@ -113,7 +126,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		event.Data = headEvent
 	case "block":
 		blockEvent := &api.BlockEvent{}
-		err := json.Unmarshal(msg.Data, blockEvent)
+		err := s.jsonCodec.Unmarshal(msg.Data, blockEvent)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse block event")
 			return
@@ -121,7 +134,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		event.Data = blockEvent
 	case "attestation":
 		attestation := &phase0.Attestation{}
-		err := json.Unmarshal(msg.Data, attestation)
+		err := s.jsonCodec.Unmarshal(msg.Data, attestation)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attestation")
 			return
@@ -129,7 +142,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		event.Data = attestation
 	case "voluntary_exit":
 		voluntaryExit := &phase0.SignedVoluntaryExit{}
-		err := json.Unmarshal(msg.Data, voluntaryExit)
+		err := s.jsonCodec.Unmarshal(msg.Data, voluntaryExit)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse voluntary exit")
 			return
@@ -137,7 +150,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		event.Data = voluntaryExit
 	case "finalized_checkpoint":
 		finalizedCheckpointEvent := &api.FinalizedCheckpointEvent{}
-		err := json.Unmarshal(msg.Data, finalizedCheckpointEvent)
+		err := s.jsonCodec.Unmarshal(msg.Data, finalizedCheckpointEvent)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse finalized checkpoint event")
 			return
@@ -145,7 +158,7 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		event.Data = finalizedCheckpointEvent
 	case "chain_reorg":
 		chainReorgEvent := &api.ChainReorgEvent{}
-		err := json.Unmarshal(msg.Data, chainReorgEvent)
+		err := s.jsonCodec.Unmarshal(msg.Data, chainReorgEvent)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse chain reorg event")
 			return
@@ -153,12 +166,52 @@ func (s *Service) handleEvent(ctx context.Context, msg *sse.Event, handler clien
 		event.Data = chainReorgEvent
 	case "contribution_and_proof":
 		contributionAndProofEvent := &altair.SignedContributionAndProof{}
-		err := json.Unmarshal(msg.Data, contributionAndProofEvent)
+		err := s.jsonCodec.Unmarshal(msg.Data, contributionAndProofEvent)
 		if err != nil {
 			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse contribution and proof event")
 			return
 		}
 		event.Data = contributionAndProofEvent
+	case "payload_attributes":
+		payloadAttributesEvent := &api.PayloadAttributesEvent{}
+		err := s.jsonCodec.Unmarshal(msg.Data, payloadAttributesEvent)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse payload attributes event")
+			return
+		}
+		event.Data = payloadAttributesEvent
+	case "blob_sidecar":
+		blobSidecarEvent := &api.BlobSidecarEvent{}
+		err := s.jsonCodec.Unmarshal(msg.Data, blobSidecarEvent)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse blob sidecar event")
+			return
+		}
+		event.Data = blobSidecarEvent
+	case "proposer_slashing":
+		proposerSlashing := &phase0.ProposerSlashing{}
+		err := s.jsonCodec.Unmarshal(msg.Data, proposerSlashing)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse proposer slashing")
+			return
+		}
+		event.Data = proposerSlashing
+	case "attester_slashing":
+		attesterSlashing := &phase0.AttesterSlashing{}
+		err := s.jsonCodec.Unmarshal(msg.Data, attesterSlashing)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse attester slashing")
+			return
+		}
+		event.Data = attesterSlashing
+	case "bls_to_execution_change":
+		blsToExecutionChange := &capella.SignedBLSToExecutionChange{}
+		err := s.jsonCodec.Unmarshal(msg.Data, blsToExecutionChange)
+		if err != nil {
+			log.Error().Err(err).RawJSON("data", msg.Data).Msg("Failed to parse BLS to execution change")
+			return
+		}
+		event.Data = blsToExecutionChange
 	case "":
 		// Used as keepalive.  Ignore.
 		return
@@ -0,0 +1,256 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/r3labs/sse/v2"
+	"github.com/rs/zerolog"
+)
+
+// EventsConnectionState is the state of the connection of an events stream.
+type EventsConnectionState int
+
+const (
+	// EventsConnected is the state of an events stream that has connected.
+	EventsConnected EventsConnectionState = iota
+	// EventsDisconnected is the state of an events stream that has disconnected.
+	// The stream reconnects unless its context is done.
+	EventsDisconnected
+)
+
+var eventsConnectionStateStrings = [...]string{
+	"connected",
+	"disconnected",
+}
+
+// String returns a string representation of the state.
+func (s EventsConnectionState) String() string {
+	if int(s) < 0 || int(s) >= len(eventsConnectionStateStrings) {
+		return "unknown"
+	}
+
+	return eventsConnectionStateStrings[s]
+}
+
+// EventsConnectionHandlerFunc is the handler for changes to the connection of an
+// events stream, called with the topics of the stream.  The error is the reason
+// for disconnection, if known.
+type EventsConnectionHandlerFunc func(topics []string, state EventsConnectionState, err error)
+
+// noReconnect is a reconnect strategy for the SSE client that does not reconnect.
+type noReconnect struct{}
+
+// NextBackOff returns the value that instructs the SSE client to stop.
+func (noReconnect) NextBackOff() time.Duration { return -1 }
+
+// Reset does nothing.
+func (noReconnect) Reset() {}
+
+// runEvents subscribes to the events stream, resubscribing when it disconnects
+// until the context is done.
+func (s *Service) runEvents(ctx context.Context,
+	sseClient *sse.Client,
+	topics []string,
+	handler client.EventHandlerFunc,
+) {
+	log := zerolog.Ctx(ctx)
+
+	for attempt := 0; ; attempt++ {
+		connected := false
+		rejected := false
+		sseClient.ResponseValidator = func(_ *sse.Client, resp *http.Response) error {
+			if resp.StatusCode != http.StatusOK {
+				rejected = resp.StatusCode == http.StatusBadRequest
+				resp.Body.Close()
+				return fmt.Errorf("could not connect to stream: %s", http.StatusText(resp.StatusCode))
+			}
+			connected = true
+			log.Trace().Msg("Events stream connected")
+			s.eventsConnectionChanged(topics, EventsConnected, nil)
+
+			return nil
+		}
+
+		log.Trace().Int("attempt", attempt).Msg("Connecting to events stream")
+		err := sseClient.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+			s.handleEvent(ctx, msg, handler)
+		})
+		if err == nil {
+			err = errors.New("events stream closed by server")
+		}
+		if connected {
+			log.Debug().Err(err).Msg("Events stream disconnected")
+			s.eventsConnectionChanged(topics, EventsDisconnected, err)
+			// Backoff restarts after a successful connection.
+			attempt = 0
+		} else if ctx.Err() == nil {
+			log.Error().Err(err).Msg("Failed to subscribe to event stream")
+		}
+
+		if rejected && ctx.Err() == nil {
+			// The node rejected the request, which can be due to a topic that it
+			// does not support.  Find out which, and resubscribe without them.
+			supported, unsupported, err := s.filterEventTopics(ctx, topics)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to probe event topics")
+			} else if len(unsupported) > 0 {
+				log.Warn().Strs("topics", unsupported).Msg("Node does not support event topics; ignoring")
+				if len(supported) == 0 {
+					log.Error().Msg("Node does not support any requested event topics; stopping events stream")
+					s.eventsConnectionChanged(topics, EventsDisconnected, errors.New("no supported event topics"))
+
+					return
+				}
+				topics = supported
+				url, err := s.eventsURL(topics)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to create events stream URL")
+
+					return
+				}
+				sseClient.URL = url
+				attempt = -1
+
+				continue
+			}
+		}
+
+		delay := s.eventsReconnectDelay(attempt)
+		log.Trace().Dur("delay", delay).Msg("Waiting to reconnect to events stream")
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Context done")
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// eventsConnectionChanged calls the events connection handler, if present.
+func (s *Service) eventsConnectionChanged(topics []string, state EventsConnectionState, err error) {
+	if s.eventsConnectionHandler != nil {
+		s.eventsConnectionHandler(topics, state, err)
+	}
+}
+
+// eventsReconnectDelay returns the time to wait before the given attempt to reconnect.
+// The delay is chosen at random between half and all of the backoff for the attempt.
+func (s *Service) eventsReconnectDelay(attempt int) time.Duration {
+	delay := s.eventsMinBackoff
+	for i := 0; i < attempt && delay < s.eventsMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.eventsMaxBackoff {
+		delay = s.eventsMaxBackoff
+	}
+
+	// #nosec G404
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// eventDeduplicationWindow is the number of slots, or epochs for checkpoints,
+// for which events are tracked.
+const eventDeduplicationWindow = 64
+
+// eventDeduplicator drops events that it has already seen, identifying them
+// by topic, slot or epoch, and block root.
+type eventDeduplicator struct {
+	mu     sync.Mutex
+	topics map[string]*seenEvents
+}
+
+// seenEvents are the events seen for a topic, with their slot or epoch.
+type seenEvents struct {
+	events  map[string]uint64
+	highest uint64
+}
+
+func newEventDeduplicator() *eventDeduplicator {
+	return &eventDeduplicator{
+		topics: make(map[string]*seenEvents),
+	}
+}
+
+// wrap returns a handler that passes events that have not been seen to the given handler.
+func (d *eventDeduplicator) wrap(handler client.EventHandlerFunc) client.EventHandlerFunc {
+	return func(event *api.Event) {
+		if d.seenBefore(event) {
+			return
+		}
+		handler(event)
+	}
+}
+
+// seenBefore returns true if the event has been seen before, recording it if not.
+func (d *eventDeduplicator) seenBefore(event *api.Event) bool {
+	var position uint64
+	var root phase0.Root
+	switch data := event.Data.(type) {
+	case *api.HeadEvent:
+		position, root = uint64(data.Slot), data.Block
+	case *api.BlockEvent:
+		position, root = uint64(data.Slot), data.Block
+	case *api.ChainReorgEvent:
+		position, root = uint64(data.Slot), data.NewHeadBlock
+	case *api.FinalizedCheckpointEvent:
+		position, root = uint64(data.Epoch), data.Block
+	default:
+		return false
+	}
+	key := fmt.Sprintf("%d:%#x", position, root)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	seen, exists := d.topics[event.Topic]
+	if !exists {
+		seen = &seenEvents{
+			events: make(map[string]uint64),
+		}
+		d.topics[event.Topic] = seen
+	}
+	if _, exists := seen.events[key]; exists {
+		return true
+	}
+	seen.events[key] = position
+	if position > seen.highest {
+		seen.highest = position
+		seen.prune()
+	}
+
+	return false
+}
+
+// prune removes events outside of the window.
+func (s *seenEvents) prune() {
+	if s.highest < eventDeduplicationWindow {
+		return
+	}
+	lowest := s.highest - eventDeduplicationWindow
+	for key, position := range s.events {
+		if position < lowest {
+			delete(s.events, key)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func headEventData(slot int) string {
+	return fmt.Sprintf(`{"slot":"%d","block":"0x%064x","state":"0x%064x","epoch_transition":false,"previous_duty_dependent_root":"0x%064x","current_duty_dependent_root":"0x%064x","execution_optimistic":false}`, slot, slot, slot, 0, 0)
+}
+
+func TestEventsReconnect(t *testing.T) {
+	tests := []struct {
+		name          string
+		deduplication bool
+		slots         []phase0.Slot
+	}{
+		{
+			name:  "Replayed",
+			slots: []phase0.Slot{1, 2, 2, 3},
+		},
+		{
+			name:          "Deduplicated",
+			deduplication: true,
+			slots:         []phase0.Slot{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// The server fails the first connection, closes the second after
+			// two events, and replays the last of those on the third.
+			var mu sync.Mutex
+			connections := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				connections++
+				connection := connections
+				mu.Unlock()
+
+				if connection == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.WriteHeader(http.StatusOK)
+				slots := []int{1, 2}
+				if connection > 2 {
+					slots = []int{2, 3}
+				}
+				for _, slot := range slots {
+					fmt.Fprintf(w, "event: head\ndata: %s\n\n", headEventData(slot))
+				}
+				w.(http.Flusher).Flush()
+				if connection > 2 {
+					<-r.Context().Done()
+				}
+			}))
+			defer func() {
+				// Close the stream before the server, which waits for it.
+				cancel()
+				srv.Close()
+			}()
+
+			var states []EventsConnectionState
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec: codecs.StdJSON,
+				log:       zerolog.Nop(),
+				base:      base,
+				address:   srv.URL,
+				eventsConnectionHandler: func(topics []string, state EventsConnectionState, _ error) {
+					require.Equal(t, []string{"head"}, topics)
+					mu.Lock()
+					states = append(states, state)
+					mu.Unlock()
+				},
+				eventsMinBackoff:    time.Millisecond,
+				eventsMaxBackoff:    10 * time.Millisecond,
+				eventsDeduplication: test.deduplication,
+			}
+
+			var slots []phase0.Slot
+			require.NoError(t, s.Events(ctx, []string{"head"}, func(event *api.Event) {
+				mu.Lock()
+				slots = append(slots, event.Data.(*api.HeadEvent).Slot)
+				mu.Unlock()
+			}))
+
+			require.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return len(slots) == len(test.slots)
+			}, 5*time.Second, 10*time.Millisecond)
+			mu.Lock()
+			require.Equal(t, test.slots, slots)
+			require.Equal(t, []EventsConnectionState{EventsConnected, EventsDisconnected, EventsConnected}, states)
+			mu.Unlock()
+		})
+	}
+}
+
+func TestEventsReconnectDelay(t *testing.T) {
+	s := &Service{
+		jsonCodec:        codecs.StdJSON,
+		eventsMinBackoff: 100 * time.Millisecond,
+		eventsMaxBackoff: time.Second,
+	}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 0, max: 100 * time.Millisecond},
+		{attempt: 1, max: 200 * time.Millisecond},
+		{attempt: 3, max: 800 * time.Millisecond},
+		{attempt: 4, max: time.Second},
+		{attempt: 100, max: time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d", test.attempt), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				delay := s.eventsReconnectDelay(test.attempt)
+				require.GreaterOrEqual(t, delay, test.max/2)
+				require.LessOrEqual(t, delay, test.max)
+			}
+		})
+	}
+}
+
+func TestEventDeduplicator(t *testing.T) {
+	d := newEventDeduplicator()
+
+	head := func(slot phase0.Slot, root byte) *api.Event {
+		return &api.Event{Topic: "head", Data: &api.HeadEvent{Slot: slot, Block: phase0.Root{root}}}
+	}
+	block := func(slot phase0.Slot, root byte) *api.Event {
+		return &api.Event{Topic: "block", Data: &api.BlockEvent{Slot: slot, Block: phase0.Root{root}}}
+	}
+
+	require.False(t, d.seenBefore(head(1, 1)))
+	require.True(t, d.seenBefore(head(1, 1)))
+	// Different root at the same slot.
+	require.False(t, d.seenBefore(head(1, 2)))
+	// Different topic.
+	require.False(t, d.seenBefore(block(1, 1)))
+	// Events without slots are never duplicates.
+	exit := &api.Event{Topic: "voluntary_exit", Data: &phase0.SignedVoluntaryExit{}}
+	require.False(t, d.seenBefore(exit))
+	require.False(t, d.seenBefore(exit))
+	// Finalized checkpoints are tracked by epoch.
+	checkpoint := &api.Event{Topic: "finalized_checkpoint", Data: &api.FinalizedCheckpointEvent{Epoch: 5, Block: phase0.Root{1}}}
+	require.False(t, d.seenBefore(checkpoint))
+	require.True(t, d.seenBefore(checkpoint))
+
+	// Old events are pruned.
+	require.False(t, d.seenBefore(head(100, 1)))
+	require.Len(t, d.topics["head"].events, 1)
+	require.Len(t, d.topics["block"].events, 1)
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// EventTopics returns the event topics known to this library that the node supports.
+// Nodes do not advertise the topics that they support, so each topic is probed with a
+// short-lived subscription the first time it is required and the result remembered.
+func (s *Service) EventTopics(ctx context.Context) ([]string, error) {
+	if err := s.checkEndpointEnabled("/eth/v1/events"); err != nil {
+		return nil, err
+	}
+
+	topics := make([]string, 0, len(api.SupportedEventTopics))
+	for topic := range api.SupportedEventTopics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	supported, _, err := s.filterEventTopics(ctx, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	return supported, nil
+}
+
+// knownSupportedEventTopics splits the topics in to those that are not known to be
+// unsupported by the node and those that are, without probing the node.
+func (s *Service) knownSupportedEventTopics(topics []string) ([]string, []string) {
+	s.eventTopicsMu.RLock()
+	defer s.eventTopicsMu.RUnlock()
+
+	supported := make([]string, 0, len(topics))
+	unsupported := make([]string, 0)
+	for _, topic := range topics {
+		if isSupported, exists := s.eventTopics[topic]; exists && !isSupported {
+			unsupported = append(unsupported, topic)
+		} else {
+			supported = append(supported, topic)
+		}
+	}
+
+	return supported, unsupported
+}
+
+// filterEventTopics splits the topics in to those that the node supports and those
+// that it does not, probing the node for topics that have not been seen before.
+func (s *Service) filterEventTopics(ctx context.Context, topics []string) ([]string, []string, error) {
+	supported := make([]string, 0, len(topics))
+	unsupported := make([]string, 0)
+	for _, topic := range topics {
+		isSupported, err := s.eventTopicSupported(ctx, topic)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isSupported {
+			supported = append(supported, topic)
+		} else {
+			unsupported = append(unsupported, topic)
+		}
+	}
+
+	return supported, unsupported, nil
+}
+
+// eventTopicSupported returns true if the node supports the topic.
+func (s *Service) eventTopicSupported(ctx context.Context, topic string) (bool, error) {
+	s.eventTopicsMu.RLock()
+	isSupported, exists := s.eventTopics[topic]
+	s.eventTopicsMu.RUnlock()
+	if exists {
+		return isSupported, nil
+	}
+
+	isSupported, err := s.probeEventTopic(ctx, topic)
+	if err != nil {
+		return false, err
+	}
+
+	s.eventTopicsMu.Lock()
+	if s.eventTopics == nil {
+		s.eventTopics = make(map[string]bool)
+	}
+	s.eventTopics[topic] = isSupported
+	s.eventTopicsMu.Unlock()
+
+	return isSupported, nil
+}
+
+// probeEventTopic subscribes to the events stream for a single topic, returning
+// true if the node accepts the subscription.  The subscription is closed as soon
+// as the response headers are received.
+func (s *Service) probeEventTopic(ctx context.Context, topic string) (bool, error) {
+	if _, exists := api.SupportedEventTopics[topic]; !exists {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if s.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	url, err := s.eventsURL([]string{topic})
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create request")
+	}
+	s.addExtraHeaders(req)
+	req.Header.Set("Accept", "text/event-stream")
+
+	client := s.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to probe event topic")
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusBadRequest:
+		return false, nil
+	default:
+		return false, errors.Errorf("unexpected status %d probing event topic %s", resp.StatusCode, topic)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// eventTopicsServer returns a server that rejects subscriptions including any of the
+// unsupported topics, and otherwise streams a head event before waiting.
+func eventTopicsServer(unsupported map[string]bool, requests *[][]string, mu *sync.Mutex) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		topics := r.URL.Query()["topics"]
+		mu.Lock()
+		*requests = append(*requests, topics)
+		mu.Unlock()
+		for _, topic := range topics {
+			if unsupported[topic] {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "event: head\ndata: %s\n\n", headEventData(1))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+}
+
+func TestEventTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var requests [][]string
+	srv := eventTopicsServer(map[string]bool{"blob_sidecar": true, "payload_attributes": true}, &requests, &mu)
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec: codecs.StdJSON,
+		log:       zerolog.Nop(),
+		base:      base,
+		address:   srv.URL,
+		client:    srv.Client(),
+	}
+
+	topics, err := s.EventTopics(ctx)
+	require.NoError(t, err)
+	require.Len(t, topics, len(api.SupportedEventTopics)-2)
+	require.NotContains(t, topics, "blob_sidecar")
+	require.NotContains(t, topics, "payload_attributes")
+	mu.Lock()
+	probes := len(requests)
+	mu.Unlock()
+	require.Equal(t, len(api.SupportedEventTopics), probes)
+
+	// Results are remembered.
+	_, err = s.EventTopics(ctx)
+	require.NoError(t, err)
+	mu.Lock()
+	require.Len(t, requests, probes)
+	mu.Unlock()
+
+	// Known unsupported topics are dropped when subscribing.
+	supported, unsupported := s.knownSupportedEventTopics([]string{"head", "blob_sidecar"})
+	require.Equal(t, []string{"head"}, supported)
+	require.Equal(t, []string{"blob_sidecar"}, unsupported)
+	require.EqualError(t, s.Events(ctx, []string{"blob_sidecar"}, nil), "no supported event topics")
+}
+
+func TestEventsUnsupportedTopic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var mu sync.Mutex
+	var requests [][]string
+	srv := eventTopicsServer(map[string]bool{"blob_sidecar": true}, &requests, &mu)
+	defer func() {
+		// Close the stream before the server, which waits for it.
+		cancel()
+		srv.Close()
+	}()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	var connectedTopics []string
+	s := &Service{
+		jsonCodec: codecs.StdJSON,
+		log:       zerolog.Nop(),
+		base:      base,
+		address:   srv.URL,
+		client:    srv.Client(),
+		eventsConnectionHandler: func(topics []string, state EventsConnectionState, _ error) {
+			if state == EventsConnected {
+				mu.Lock()
+				connectedTopics = topics
+				mu.Unlock()
+			}
+		},
+		eventsMinBackoff: time.Millisecond,
+		eventsMaxBackoff: 10 * time.Millisecond,
+	}
+
+	var slots []phase0.Slot
+	require.NoError(t, s.Events(ctx, []string{"head", "blob_sidecar"}, func(event *api.Event) {
+		mu.Lock()
+		slots = append(slots, event.Data.(*api.HeadEvent).Slot)
+		mu.Unlock()
+	}))
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(slots) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	require.Equal(t, []string{"head"}, connectedTopics)
+	// The combined subscription, a probe for each topic, and the reduced subscription.
+	require.Equal(t, [][]string{{"head", "blob_sidecar"}, {"head"}, {"blob_sidecar"}, {"head"}}, requests)
+	mu.Unlock()
+}
+
+func TestEventsNoSupportedTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var mu sync.Mutex
+	var requests [][]string
+	srv := eventTopicsServer(map[string]bool{"blob_sidecar": true}, &requests, &mu)
+	defer func() {
+		cancel()
+		srv.Close()
+	}()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	errCh := make(chan error, 1)
+	s := &Service{
+		jsonCodec: codecs.StdJSON,
+		log:       zerolog.Nop(),
+		base:      base,
+		address:   srv.URL,
+		client:    srv.Client(),
+		eventsConnectionHandler: func(_ []string, _ EventsConnectionState, err error) {
+			errCh <- err
+		},
+		eventsMinBackoff: time.Millisecond,
+		eventsMaxBackoff: 10 * time.Millisecond,
+	}
+
+	require.NoError(t, s.Events(ctx, []string{"blob_sidecar"}, nil))
+	select {
+	case err := <-errCh:
+		require.EqualError(t, err, "no supported event topics")
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "stream not stopped")
+	}
+}
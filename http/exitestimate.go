@@ -0,0 +1,272 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// exitParameters are the chain parameters required to estimate exits.
+type exitParameters struct {
+	minPerEpochChurnLimit            uint64
+	churnLimitQuotient               uint64
+	maxSeedLookahead                 phase0.Epoch
+	minValidatorWithdrawabilityDelay phase0.Epoch
+	farFutureEpoch                   phase0.Epoch
+
+	// Parameters for the balance-based exit queue from electra.
+	minPerEpochChurnLimitElectra        phase0.Gwei
+	maxPerEpochActivationExitChurnLimit phase0.Gwei
+	effectiveBalanceIncrement           phase0.Gwei
+}
+
+// EstimateExit estimates the exit and withdrawable epochs, and their associated times,
+// for a validator if it were to request a voluntary exit now.
+// If the validator has already requested an exit then its actual exit and withdrawable
+// epochs are returned.
+func (s *Service) EstimateExit(ctx context.Context, validatorIndex phase0.ValidatorIndex) (*api.ExitEstimate, error) {
+	chainSpec, err := s.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+	params := &exitParameters{}
+	if err := specValues(chainSpec, map[string]*uint64{
+		"MIN_PER_EPOCH_CHURN_LIMIT":           &params.minPerEpochChurnLimit,
+		"CHURN_LIMIT_QUOTIENT":                &params.churnLimitQuotient,
+		"MAX_SEED_LOOKAHEAD":                  (*uint64)(&params.maxSeedLookahead),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": (*uint64)(&params.minValidatorWithdrawabilityDelay),
+	}); err != nil {
+		return nil, err
+	}
+	if params.churnLimitQuotient == 0 {
+		return nil, errors.New("CHURN_LIMIT_QUOTIENT cannot be 0")
+	}
+	params.farFutureEpoch, err = s.FarFutureEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain far future epoch")
+	}
+
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	epochDuration := slotDuration * time.Duration(slotsPerEpoch)
+	genesisTime, err := s.GenesisTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().Before(genesisTime) {
+		return nil, errors.New("chain has not yet started")
+	}
+	currentEpoch := phase0.Epoch(time.Since(genesisTime) / epochDuration)
+
+	// The exit queue is determined by the state at the head of the chain.
+	state, err := s.BeaconState(ctx, "head")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain head state")
+	}
+	if state == nil {
+		return nil, errors.New("no head state returned")
+	}
+
+	var exitEpoch phase0.Epoch
+	var withdrawableEpoch phase0.Epoch
+	if state.Version >= spec.DataVersionElectra {
+		// From electra the exit queue is limited by balance rather than by validator count.
+		if err := specValues(chainSpec, map[string]*uint64{
+			"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         (*uint64)(&params.minPerEpochChurnLimitElectra),
+			"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": (*uint64)(&params.maxPerEpochActivationExitChurnLimit),
+			"EFFECTIVE_BALANCE_INCREMENT":               (*uint64)(&params.effectiveBalanceIncrement),
+		}); err != nil {
+			return nil, err
+		}
+		if params.effectiveBalanceIncrement == 0 {
+			return nil, errors.New("EFFECTIVE_BALANCE_INCREMENT cannot be 0")
+		}
+		exitEpoch, withdrawableEpoch, err = estimateExitElectra(state.Electra, validatorIndex, currentEpoch, params)
+	} else {
+		var stateValidators []*phase0.Validator
+		stateValidators, err = state.Validators()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain validators")
+		}
+		validators := make(map[phase0.ValidatorIndex]*api.Validator, len(stateValidators))
+		for i, validator := range stateValidators {
+			validators[phase0.ValidatorIndex(i)] = &api.Validator{
+				Index:     phase0.ValidatorIndex(i),
+				Validator: validator,
+			}
+		}
+		exitEpoch, withdrawableEpoch, err = estimateExit(validators, validatorIndex, currentEpoch, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ExitEstimate{
+		ValidatorIndex:    validatorIndex,
+		ExitEpoch:         exitEpoch,
+		WithdrawableEpoch: withdrawableEpoch,
+		ExitTime:          genesisTime.Add(time.Duration(exitEpoch) * epochDuration),
+		WithdrawableTime:  genesisTime.Add(time.Duration(withdrawableEpoch) * epochDuration),
+	}, nil
+}
+
+// estimateExit calculates the exit and withdrawable epochs for a validator that requests an
+// exit at the current epoch, following the logic of initiate_validator_exit() in the spec.
+func estimateExit(validators map[phase0.ValidatorIndex]*api.Validator,
+	validatorIndex phase0.ValidatorIndex,
+	currentEpoch phase0.Epoch,
+	params *exitParameters,
+) (
+	phase0.Epoch,
+	phase0.Epoch,
+	error,
+) {
+	validator, exists := validators[validatorIndex]
+	if !exists || validator.Validator == nil {
+		return 0, 0, fmt.Errorf("validator %d not found", validatorIndex)
+	}
+	if validator.Validator.ExitEpoch != params.farFutureEpoch {
+		// Validator has already initiated its exit.
+		return validator.Validator.ExitEpoch, validator.Validator.WithdrawableEpoch, nil
+	}
+	if validator.Validator.ActivationEpoch > currentEpoch {
+		return 0, 0, fmt.Errorf("validator %d is not active", validatorIndex)
+	}
+
+	// Exit queue epoch is the later of the furthest existing exit and the earliest possible exit.
+	exitQueueEpoch := currentEpoch + 1 + params.maxSeedLookahead
+	activeValidators := uint64(0)
+	for _, v := range validators {
+		if v.Validator == nil {
+			continue
+		}
+		if v.Validator.ExitEpoch != params.farFutureEpoch && v.Validator.ExitEpoch > exitQueueEpoch {
+			exitQueueEpoch = v.Validator.ExitEpoch
+		}
+		if v.Validator.ActivationEpoch <= currentEpoch && currentEpoch < v.Validator.ExitEpoch {
+			activeValidators++
+		}
+	}
+	exitQueueChurn := uint64(0)
+	for _, v := range validators {
+		if v.Validator != nil && v.Validator.ExitEpoch == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+
+	churnLimit := activeValidators / params.churnLimitQuotient
+	if churnLimit < params.minPerEpochChurnLimit {
+		churnLimit = params.minPerEpochChurnLimit
+	}
+	if exitQueueChurn >= churnLimit {
+		exitQueueEpoch++
+	}
+
+	return exitQueueEpoch, exitQueueEpoch + params.minValidatorWithdrawabilityDelay, nil
+}
+
+// estimateExitElectra calculates the exit and withdrawable epochs for a validator that requests
+// an exit at the current epoch, following the logic of initiate_validator_exit() in the electra
+// spec, where exits consume a per-epoch churn of balance.
+func estimateExitElectra(state *electra.BeaconState,
+	validatorIndex phase0.ValidatorIndex,
+	currentEpoch phase0.Epoch,
+	params *exitParameters,
+) (
+	phase0.Epoch,
+	phase0.Epoch,
+	error,
+) {
+	if state == nil {
+		return 0, 0, errors.New("no electra state")
+	}
+	if uint64(validatorIndex) >= uint64(len(state.Validators)) || state.Validators[validatorIndex] == nil {
+		return 0, 0, fmt.Errorf("validator %d not found", validatorIndex)
+	}
+	validator := state.Validators[validatorIndex]
+	if validator.ExitEpoch != params.farFutureEpoch {
+		// Validator has already initiated its exit.
+		return validator.ExitEpoch, validator.WithdrawableEpoch, nil
+	}
+	if validator.ActivationEpoch > currentEpoch {
+		return 0, 0, fmt.Errorf("validator %d is not active", validatorIndex)
+	}
+
+	// Churn is a fraction of the total active balance, rounded down to the balance increment.
+	totalActiveBalance := phase0.Gwei(0)
+	for _, v := range state.Validators {
+		if v != nil && v.ActivationEpoch <= currentEpoch && currentEpoch < v.ExitEpoch {
+			totalActiveBalance += v.EffectiveBalance
+		}
+	}
+	if totalActiveBalance < params.effectiveBalanceIncrement {
+		totalActiveBalance = params.effectiveBalanceIncrement
+	}
+	churn := totalActiveBalance / phase0.Gwei(params.churnLimitQuotient)
+	if churn < params.minPerEpochChurnLimitElectra {
+		churn = params.minPerEpochChurnLimitElectra
+	}
+	churn -= churn % params.effectiveBalanceIncrement
+	if churn > params.maxPerEpochActivationExitChurnLimit {
+		churn = params.maxPerEpochActivationExitChurnLimit
+	}
+	if churn == 0 {
+		return 0, 0, errors.New("no exit churn available")
+	}
+
+	// Exit epoch is the earliest epoch with sufficient balance left to consume.
+	exitEpoch := currentEpoch + 1 + params.maxSeedLookahead
+	exitBalanceToConsume := churn
+	if state.EarliestExitEpoch >= exitEpoch {
+		exitEpoch = state.EarliestExitEpoch
+		exitBalanceToConsume = state.ExitBalanceToConsume
+	}
+	if validator.EffectiveBalance > exitBalanceToConsume {
+		balanceToProcess := validator.EffectiveBalance - exitBalanceToConsume
+		exitEpoch += phase0.Epoch((balanceToProcess-1)/churn + 1)
+	}
+
+	return exitEpoch, exitEpoch + params.minValidatorWithdrawabilityDelay, nil
+}
+
+// specValues obtains the given integer values from the spec.
+func specValues(chainSpec map[string]any, values map[string]*uint64) error {
+	for k, v := range values {
+		val, exists := chainSpec[k]
+		if !exists {
+			return fmt.Errorf("%s not found in spec", k)
+		}
+		intVal, isInt := val.(uint64)
+		if !isInt {
+			return fmt.Errorf("%s of unexpected type", k)
+		}
+		*v = intVal
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateExitCalculation(t *testing.T) {
+	farFutureEpoch := phase0.Epoch(0xffffffffffffffff)
+	params := &exitParameters{
+		minPerEpochChurnLimit:            2,
+		churnLimitQuotient:               65536,
+		maxSeedLookahead:                 4,
+		minValidatorWithdrawabilityDelay: 256,
+		farFutureEpoch:                   farFutureEpoch,
+	}
+
+	validator := func(activationEpoch phase0.Epoch, exitEpoch phase0.Epoch) *api.Validator {
+		withdrawableEpoch := farFutureEpoch
+		if exitEpoch != farFutureEpoch {
+			withdrawableEpoch = exitEpoch + 256
+		}
+		return &api.Validator{
+			Validator: &phase0.Validator{
+				ActivationEpoch:   activationEpoch,
+				ExitEpoch:         exitEpoch,
+				WithdrawableEpoch: withdrawableEpoch,
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		validators        map[phase0.ValidatorIndex]*api.Validator
+		validatorIndex    phase0.ValidatorIndex
+		exitEpoch         phase0.Epoch
+		withdrawableEpoch phase0.Epoch
+		err               string
+	}{
+		{
+			name: "Missing",
+			validators: map[phase0.ValidatorIndex]*api.Validator{
+				0: validator(0, farFutureEpoch),
+			},
+			validatorIndex: 1,
+			err:            "validator 1 not found",
+		},
+		{
+			name: "NotActive",
+			validators: map[phase0.ValidatorIndex]*api.Validator{
+				0: validator(0, farFutureEpoch),
+				1: validator(101, farFutureEpoch),
+			},
+			validatorIndex: 1,
+			err:            "validator 1 is not active",
+		},
+		{
+			name: "AlreadyExiting",
+			validators: map[phase0.ValidatorIndex]*api.Validator{
+				0: validator(0, farFutureEpoch),
+				1: validator(0, 120),
+			},
+			validatorIndex:    1,
+			exitEpoch:         120,
+			withdrawableEpoch: 376,
+		},
+		{
+			name: "EmptyQueue",
+			validators: map[phase0.ValidatorIndex]*api.Validator{
+				0: validator(0, farFutureEpoch),
+				1: validator(0, farFutureEpoch),
+				2: validator(0, 50),
+			},
+			validatorIndex:    1,
+			exitEpoch:         105,
+			withdrawableEpoch: 361,
+		},
+		{
+			name: "QueueNotFull",
+			validators: map[phase0.ValidatorIndex]*api.Validator{
+				0: validator(0, farFutureEpoch),
+				1: validator(0, farFutureEpoch),
+				2: validator(0, 110),
+			},
+			validatorIndex:    1,
+			exitEpoch:         110,
+			withdrawableEpoch: 366,
+		},
+		{
+			name: "QueueFull",
+			validators: map[phase0.ValidatorIndex]*api.Validator{
+				0: validator(0, farFutureEpoch),
+				1: validator(0, farFutureEpoch),
+				2: validator(0, 110),
+				3: validator(0, 110),
+			},
+			validatorIndex:    1,
+			exitEpoch:         111,
+			withdrawableEpoch: 367,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exitEpoch, withdrawableEpoch, err := estimateExit(test.validators, test.validatorIndex, 100, params)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.exitEpoch, exitEpoch)
+			require.Equal(t, test.withdrawableEpoch, withdrawableEpoch)
+		})
+	}
+}
+
+func TestEstimateExitElectraCalculation(t *testing.T) {
+	farFutureEpoch := phase0.Epoch(0xffffffffffffffff)
+	params := &exitParameters{
+		churnLimitQuotient:                  65536,
+		maxSeedLookahead:                    4,
+		minValidatorWithdrawabilityDelay:    256,
+		farFutureEpoch:                      farFutureEpoch,
+		minPerEpochChurnLimitElectra:        128000000000,
+		maxPerEpochActivationExitChurnLimit: 256000000000,
+		effectiveBalanceIncrement:           1000000000,
+	}
+
+	validators := func(balance phase0.Gwei) []*phase0.Validator {
+		res := make([]*phase0.Validator, 0)
+		for i := 0; i < 10; i++ {
+			res = append(res, &phase0.Validator{
+				EffectiveBalance:  32000000000,
+				ExitEpoch:         farFutureEpoch,
+				WithdrawableEpoch: farFutureEpoch,
+			})
+		}
+		res[1].EffectiveBalance = balance
+		res[2].ActivationEpoch = 101
+		res[3].ExitEpoch = 120
+		res[3].WithdrawableEpoch = 376
+
+		return res
+	}
+
+	tests := []struct {
+		name                 string
+		validators           []*phase0.Validator
+		earliestExitEpoch    phase0.Epoch
+		exitBalanceToConsume phase0.Gwei
+		validatorIndex       phase0.ValidatorIndex
+		exitEpoch            phase0.Epoch
+		withdrawableEpoch    phase0.Epoch
+		err                  string
+	}{
+		{
+			name:           "Missing",
+			validators:     validators(32000000000),
+			validatorIndex: 10,
+			err:            "validator 10 not found",
+		},
+		{
+			name:           "NotActive",
+			validators:     validators(32000000000),
+			validatorIndex: 2,
+			err:            "validator 2 is not active",
+		},
+		{
+			name:              "AlreadyExiting",
+			validators:        validators(32000000000),
+			validatorIndex:    3,
+			exitEpoch:         120,
+			withdrawableEpoch: 376,
+		},
+		{
+			name:              "EmptyQueue",
+			validators:        validators(32000000000),
+			earliestExitEpoch: 50,
+			validatorIndex:    1,
+			exitEpoch:         105,
+			withdrawableEpoch: 361,
+		},
+		{
+			name:                 "QueueNotFull",
+			validators:           validators(32000000000),
+			earliestExitEpoch:    110,
+			exitBalanceToConsume: 64000000000,
+			validatorIndex:       1,
+			exitEpoch:            110,
+			withdrawableEpoch:    366,
+		},
+		{
+			name:                 "QueueFull",
+			validators:           validators(32000000000),
+			earliestExitEpoch:    110,
+			exitBalanceToConsume: 16000000000,
+			validatorIndex:       1,
+			exitEpoch:            111,
+			withdrawableEpoch:    367,
+		},
+		{
+			name:              "LargeBalance",
+			validators:        validators(2048000000000),
+			validatorIndex:    1,
+			exitEpoch:         120,
+			withdrawableEpoch: 376,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := &electra.BeaconState{
+				Validators:           test.validators,
+				EarliestExitEpoch:    test.earliestExitEpoch,
+				ExitBalanceToConsume: test.exitBalanceToConsume,
+			}
+			exitEpoch, withdrawableEpoch, err := estimateExitElectra(state, test.validatorIndex, 100, params)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.exitEpoch, exitEpoch)
+			require.Equal(t, test.withdrawableEpoch, withdrawableEpoch)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// extensionPrefixes are the path prefixes of the non-standard endpoints
+// that can be accessed for each type of node.
+var extensionPrefixes = map[string][]string{
+	"lighthouse": {"/lighthouse/"},
+	"lodestar":   {"/eth/v1/lodestar/"},
+	"nimbus":     {"/nimbus/"},
+	"prysm":      {"/prysm/"},
+	"teku":       {"/teku/"},
+}
+
+// ExtensionGet sends a GET request to a non-standard endpoint of the node
+// and returns the raw response body.
+// Extensions must be enabled with WithExtensions, and the endpoint must be
+// one supported by the type of node to which the service is connected.
+func (s *Service) ExtensionGet(ctx context.Context, endpoint string) ([]byte, error) {
+	if err := s.checkExtension(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	respBodyReader, err := s.get(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request extension endpoint")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("extension endpoint not found")
+	}
+
+	return io.ReadAll(respBodyReader)
+}
+
+// ExtensionPost sends a POST request with a JSON body to a non-standard endpoint
+// of the node and returns the raw response body.
+// Extensions must be enabled with WithExtensions, and the endpoint must be
+// one supported by the type of node to which the service is connected.
+func (s *Service) ExtensionPost(ctx context.Context, endpoint string, body []byte) ([]byte, error) {
+	return s.extensionSend(ctx, http.MethodPost, endpoint, body)
+}
+
+// ExtensionPut sends a PUT request with a JSON body to a non-standard endpoint
+// of the node and returns the raw response body.
+// Extensions must be enabled with WithExtensions, and the endpoint must be
+// one supported by the type of node to which the service is connected.
+func (s *Service) ExtensionPut(ctx context.Context, endpoint string, body []byte) ([]byte, error) {
+	return s.extensionSend(ctx, http.MethodPut, endpoint, body)
+}
+
+// NodeHealth returns the health information of the node, as provided by
+// its non-standard health endpoint.
+// This is currently supported by Lighthouse.
+func (s *Service) NodeHealth(ctx context.Context) ([]byte, error) {
+	client, err := s.NodeClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain node client")
+	}
+
+	switch client {
+	case "lighthouse":
+		return s.ExtensionGet(ctx, "/lighthouse/health")
+	default:
+		return nil, fmt.Errorf("node health not supported by %s node", client)
+	}
+}
+
+// SetNodeLogLevel sets the log level of the node, optionally restricted to the
+// supplied log filters.
+// This is currently supported by Teku.
+func (s *Service) SetNodeLogLevel(ctx context.Context, level string, filters []string) error {
+	if level == "" {
+		return errors.New("no log level supplied")
+	}
+
+	client, err := s.NodeClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain node client")
+	}
+
+	switch client {
+	case "teku":
+		req := struct {
+			Level     string   `json:"level"`
+			LogFilter []string `json:"log_filter,omitempty"`
+		}{
+			Level:     strings.ToUpper(level),
+			LogFilter: filters,
+		}
+		reqBody, err := s.jsonCodec.Marshal(req)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode log level")
+		}
+		if _, err := s.ExtensionPut(ctx, "/teku/v1/admin/log_level", reqBody); err != nil {
+			return err
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("setting log level not supported by %s node", client)
+	}
+}
+
+// extensionSend sends a request with a body to a non-standard endpoint of the node.
+func (s *Service) extensionSend(ctx context.Context, method string, endpoint string, body []byte) ([]byte, error) {
+	if err := s.checkExtension(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	respBodyReader, err := s.send(ctx, method, endpoint, bytes.NewReader(body), ContentTypeJSON, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send to extension endpoint")
+	}
+
+	return io.ReadAll(respBodyReader)
+}
+
+// checkExtension checks that extensions are enabled and that the endpoint is
+// supported by the node.
+func (s *Service) checkExtension(ctx context.Context, endpoint string) error {
+	if !s.extensions {
+		return errors.New("extensions not enabled")
+	}
+
+	client, err := s.NodeClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain node client")
+	}
+
+	for _, prefix := range extensionPrefixes[client] {
+		if strings.HasPrefix(endpoint, prefix) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("endpoint %s not supported by %s node", endpoint, client)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckExtension(t *testing.T) {
+	tests := []struct {
+		name        string
+		extensions  bool
+		nodeVersion string
+		endpoint    string
+		err         string
+	}{
+		{
+			name:        "Disabled",
+			nodeVersion: "Lighthouse/v4.5.0",
+			endpoint:    "/lighthouse/health",
+			err:         "extensions not enabled",
+		},
+		{
+			name:        "WrongClient",
+			extensions:  true,
+			nodeVersion: "teku/v23.10.0",
+			endpoint:    "/lighthouse/health",
+			err:         "endpoint /lighthouse/health not supported by teku node",
+		},
+		{
+			name:        "StandardEndpoint",
+			extensions:  true,
+			nodeVersion: "Lighthouse/v4.5.0",
+			endpoint:    "/eth/v1/node/health",
+			err:         "endpoint /eth/v1/node/health not supported by lighthouse node",
+		},
+		{
+			name:        "UnknownClient",
+			extensions:  true,
+			nodeVersion: "Other/v1.0.0",
+			endpoint:    "/lighthouse/health",
+			err:         "endpoint /lighthouse/health not supported by other/v1.0.0 node",
+		},
+		{
+			name:        "Good",
+			extensions:  true,
+			nodeVersion: "Lighthouse/v4.5.0",
+			endpoint:    "/lighthouse/health",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				jsonCodec:   codecs.StdJSON,
+				extensions:  test.extensions,
+				nodeVersion: test.nodeVersion,
+			}
+			err := s.checkExtension(context.Background(), test.endpoint)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -41,7 +40,7 @@ func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality,
 	}
 
 	var finalityJSON finalityJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&finalityJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &finalityJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse finality")
 	}
 	if finalityJSON.Data == nil {
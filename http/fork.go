@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -41,7 +40,7 @@ func (s *Service) Fork(ctx context.Context, stateID string) (*phase0.Fork, error
 	}
 
 	var forkJSON forkJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&forkJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &forkJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse fork")
 	}
 	if forkJSON.Data == nil {
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -32,7 +31,7 @@ func (s *Service) ForkChoice(ctx context.Context) (*api.ForkChoice, error) {
 	}
 
 	var forkChoice *api.ForkChoice
-	if err := json.NewDecoder(respBodyReader).Decode(&forkChoice); err != nil {
+	if err := s.decodeJSON(respBodyReader, &forkChoice); err != nil {
 		return nil, errors.Wrap(err, "failed to parse fork choice")
 	}
 
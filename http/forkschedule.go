@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -51,7 +50,7 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*phase0.Fork, error) {
 	}
 
 	var resp forkScheduleJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse fork schedule")
 	}
 	s.forkSchedule = resp.Data
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -51,7 +50,7 @@ func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
 	}
 
 	var resp genesisJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse genesis")
 	}
 	s.genesis = resp.Data
@@ -18,15 +18,13 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/codes"
 )
 
 // Error represents an http error.
@@ -43,47 +41,63 @@ func (e Error) Error() string {
 
 // get sends an HTTP get request and returns the body.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
-func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+func (s *Service) get(ctx context.Context, endpoint string) (_ io.Reader, err error) {
+	ctx, span := s.startSpan(ctx, http.MethodGet, endpoint)
+	priority := requestPriority(ctx, http.MethodGet, endpoint)
+	started := time.Now()
+	statusCode := 0
+	size := 0
+	defer func() {
+		s.requestDone(ctx, http.MethodGet, endpoint, priority, started, statusCode, size, err)
+		endSpan(span, statusCode, size, err)
+	}()
+
+	log := s.requestLog(ctx, endpoint, priority)
 	log.Trace().Msg("GET request")
 
+	if err := s.checkEndpointEnabled(endpoint); err != nil {
+		return nil, err
+	}
+
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
-	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
+	if res, found := s.cachedResponse(endpoint, ContentTypeJSON); found {
+		log.Trace().Msg("GET response from cache")
+		span.AddEvent("Cache hit")
+		statusCode = res.statusCode
+		size = len(res.body)
+
+		return bytes.NewReader(res.body), nil
+	}
+
+	if err := s.slotScheduler.wait(ctx, priority); err != nil {
+		return nil, err
+	}
+
+	release, err := s.limiter.acquire(ctx, endpoint, priority)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to create GET request")
+		return nil, err
 	}
-	s.addExtraHeaders(req)
-	req.Header.Set("Accept", "application/json")
+	defer release()
 
-	resp, err := s.client.Do(req)
+	resp, data, err := s.doGet(ctx, log, endpoint, url.String(), ContentTypeJSON.MediaType())
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to call GET endpoint")
+		return nil, err
 	}
-	defer resp.Body.Close()
+	statusCode = resp.StatusCode
+	size = len(data)
+	s.checkDeprecation(log, endpoint, resp.Header)
 
 	if resp.StatusCode == http.StatusNotFound {
 		// Nothing found.  This is not an error, so we return nil on both counts.
-		cancel()
 		return nil, nil
 	}
 
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to read GET response")
-	}
-
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
-		cancel()
 		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("GET failed")
 		return nil, Error{
 			Method:     http.MethodGet,
@@ -92,17 +106,102 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 			Data:       data,
 		}
 	}
-	cancel()
 
 	log.Trace().Str("response", string(data)).Msg("GET response")
+	cached := &httpResponse{
+		statusCode:  resp.StatusCode,
+		contentType: ContentTypeJSON,
+		body:        data,
+	}
+	if consensusVersion, err := consensusVersionFromResp(resp); err == nil {
+		cached.consensusVersion = consensusVersion
+	}
+	s.cacheResponse(endpoint, ContentTypeJSON, cached)
 
 	return bytes.NewReader(data), nil
 }
 
-// post sends an HTTP post request and returns the body.
+// post sends an HTTP post request with a JSON body and returns the body.
 func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+	return s.post2(ctx, endpoint, body, ContentTypeJSON, nil)
+}
+
+// post2 sends an HTTP post request with the given content type and additional
+// headers, and returns the body.
+func (s *Service) post2(ctx context.Context,
+	endpoint string,
+	body io.Reader,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	io.Reader,
+	error,
+) {
+	return s.send(ctx, http.MethodPost, endpoint, body, contentType, headers)
+}
+
+// postSSZ sends an HTTP post request with a JSON body to an endpoint that can respond
+// with SSZ, and returns the response.
+// SSZ is requested if the service prefers SSZ, falling back to JSON if the server
+// cannot provide it.
+func (s *Service) postSSZ(ctx context.Context, endpoint string, body []byte) (*httpResponse, error) {
+	if !s.preferSSZ {
+		return s.sendWithAccept(ctx, http.MethodPost, endpoint, bytes.NewReader(body), ContentTypeJSON, ContentTypeJSON, nil)
+	}
+
+	res, err := s.sendWithAccept(ctx, http.MethodPost, endpoint, bytes.NewReader(body), ContentTypeJSON, ContentTypeSSZ, nil)
+	if err != nil && contentNotAcceptable(err) {
+		s.log.Debug().Str("endpoint", endpoint).Msg("SSZ not available from node; falling back to JSON")
+		return s.sendWithAccept(ctx, http.MethodPost, endpoint, bytes.NewReader(body), ContentTypeJSON, ContentTypeJSON, nil)
+	}
+
+	return res, err
+}
+
+// send sends an HTTP request with a body using the given method, content type
+// and additional headers, and returns the body.
+func (s *Service) send(ctx context.Context,
+	method string,
+	endpoint string,
+	body io.Reader,
+	contentType ContentType,
+	headers map[string]string,
+) (
+	io.Reader,
+	error,
+) {
+	res, err := s.sendWithAccept(ctx, method, endpoint, body, contentType, ContentTypeJSON, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(res.body), nil
+}
+
+// sendWithAccept sends an HTTP request with a body using the given method, content type
+// and additional headers, accepting the given content type, and returns the response.
+func (s *Service) sendWithAccept(ctx context.Context,
+	method string,
+	endpoint string,
+	body io.Reader,
+	contentType ContentType,
+	accept ContentType,
+	headers map[string]string,
+) (
+	_ *httpResponse,
+	err error,
+) {
+	ctx, span := s.startSpan(ctx, method, endpoint)
+	priority := requestPriority(ctx, method, endpoint)
+	started := time.Now()
+	statusCode := 0
+	size := 0
+	defer func() {
+		s.requestDone(ctx, method, endpoint, priority, started, statusCode, size, err)
+		endSpan(span, statusCode, size, err)
+	}()
+
+	log := s.requestLog(ctx, endpoint, priority)
 	if e := log.Trace(); e.Enabled() {
 		bodyBytes, err := io.ReadAll(body)
 		if err != nil {
@@ -110,7 +209,11 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		}
 		body = bytes.NewReader(bodyBytes)
 
-		e.Str("body", string(bodyBytes)).Msg("POST request")
+		e.Str("body", string(bodyBytes)).Msg(method + " request")
+	}
+
+	if err := s.checkEndpointEnabled(endpoint); err != nil {
+		return nil, err
 	}
 
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
@@ -118,15 +221,29 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
-	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url.String(), body)
+	if err := s.slotScheduler.wait(ctx, priority); err != nil {
+		return nil, err
+	}
+
+	release, err := s.limiter.acquire(ctx, endpoint, priority)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	opCtx, cancel := context.WithTimeout(ctx, s.endpointTimeout(endpoint))
+	req, err := http.NewRequestWithContext(opCtx, method, url.String(), body)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to create POST request")
+		return nil, errors.Wrapf(err, "failed to create %s request", method)
 	}
 	s.addExtraHeaders(req)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	injectTraceContext(req)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", contentType.MediaType())
+	req.Header.Set("Accept", accept.MediaType())
 	if req.Header.Get("User-Agent") == "" {
 		req.Header.Set("User-Agent", "go-eth2-client/0.18.1")
 	}
@@ -134,22 +251,25 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 	resp, err := s.client.Do(req)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to call POST endpoint")
+		return nil, errors.Wrapf(err, "failed to call %s endpoint", method)
 	}
 	defer resp.Body.Close()
+	statusCode = resp.StatusCode
+	s.checkDeprecation(log, endpoint, resp.Header)
 
 	data, err := io.ReadAll(resp.Body)
 	if err != nil {
 		cancel()
-		return nil, errors.Wrap(err, "failed to read POST response")
+		return nil, errors.Wrapf(err, "failed to read %s response", method)
 	}
+	size = len(data)
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
-		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg("POST failed")
+		log.Trace().Int("status_code", resp.StatusCode).Str("data", string(data)).Msg(method + " failed")
 		cancel()
 		return nil, Error{
-			Method:     http.MethodPost,
+			Method:     method,
 			StatusCode: resp.StatusCode,
 			Endpoint:   endpoint,
 			Data:       data,
@@ -157,9 +277,21 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 	}
 	cancel()
 
-	log.Trace().Str("response", string(data)).Msg("POST response")
+	log.Trace().Str("response", string(data)).Msg(method + " response")
 
-	return bytes.NewReader(data), nil
+	res := &httpResponse{
+		statusCode: resp.StatusCode,
+		headers:    resp.Header,
+		body:       data,
+	}
+	res.contentType, err = contentTypeFromResp(resp)
+	if err != nil {
+		// For now, assume that unknown type is JSON.
+		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
+		res.contentType = ContentTypeJSON
+	}
+
+	return res, nil
 }
 
 func (s *Service) addExtraHeaders(req *http.Request) {
@@ -177,46 +309,105 @@ type httpResponse struct {
 	statusCode       int
 	contentType      ContentType
 	consensusVersion spec.DataVersion
-	body             []byte
+	// unknownConsensusVersion is the consensus version supplied by the server
+	// if it is not known, and unknown version passthrough is enabled.
+	unknownConsensusVersion string
+	body                    []byte
+	// headers are the headers of the response, for endpoints that return metadata in them.
+	headers http.Header
 }
 
-// get2 sends an HTTP get request and returns the body.
-// If the response from the server is a 404 this will return nil for both the reader and the error.
+// get2 sends an HTTP get request to an endpoint that can serve SSZ, and returns the response.
+// SSZ is requested if the service prefers SSZ, falling back to JSON if the server
+// cannot provide it.
+// If the response from the server is a 404 this will return a response with a nil body and no error.
 func (s *Service) get2(ctx context.Context, endpoint string) (*httpResponse, error) {
-	ctx, span := otel.Tracer("attestantio.go-eth2-client.http").Start(ctx, "get2")
-	defer span.End()
+	if !s.preferSSZ {
+		return s.getWithAccept(ctx, endpoint, ContentTypeJSON)
+	}
+
+	res, err := s.getWithAccept(ctx, endpoint, ContentTypeSSZ)
+	if err != nil && contentNotAcceptable(err) {
+		s.log.Debug().Str("endpoint", endpoint).Msg("SSZ not available from node; falling back to JSON")
+		return s.getWithAccept(ctx, endpoint, ContentTypeJSON)
+	}
+
+	return res, err
+}
 
-	// #nosec G404
-	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Str("endpoint", endpoint).Logger()
+// getJSON sends an HTTP get request to an endpoint that serves only JSON, and returns the response.
+// If the response from the server is a 404 this will return a response with a nil body and no error.
+func (s *Service) getJSON(ctx context.Context, endpoint string) (*httpResponse, error) {
+	return s.getWithAccept(ctx, endpoint, ContentTypeJSON)
+}
+
+// decodeJSON decodes JSON from the reader into v using the configured JSON codec.
+func (s *Service) decodeJSON(reader io.Reader, v any) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to read JSON")
+	}
+
+	return s.jsonCodec.Unmarshal(data, v)
+}
+
+// getWithAccept sends an HTTP get request accepting the given content type, and returns the response.
+// If the response from the server is a 404 this will return a response with a nil body and no error.
+func (s *Service) getWithAccept(ctx context.Context, endpoint string, accept ContentType) (_ *httpResponse, err error) {
+	ctx, span := s.startSpan(ctx, http.MethodGet, endpoint)
+	priority := requestPriority(ctx, http.MethodGet, endpoint)
+	started := time.Now()
+	statusCode := 0
+	size := 0
+	defer func() {
+		s.requestDone(ctx, http.MethodGet, endpoint, priority, started, statusCode, size, err)
+		endSpan(span, statusCode, size, err)
+	}()
+
+	log := s.requestLog(ctx, endpoint, priority)
 	log.Trace().Msg("GET request")
 
+	if err := s.checkEndpointEnabled(endpoint); err != nil {
+		return nil, err
+	}
+
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
-	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
-	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
+	if res, found := s.cachedResponse(endpoint, accept); found {
+		log.Trace().Msg("GET response from cache")
+		span.AddEvent("Cache hit")
+		statusCode = res.statusCode
+		size = len(res.body)
+
+		return res, nil
+	}
+
+	if err := s.slotScheduler.wait(ctx, priority); err != nil {
+		return nil, err
+	}
+
+	release, err := s.limiter.acquire(ctx, endpoint, priority)
 	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "failed to create GET request")
+		return nil, err
 	}
-	s.addExtraHeaders(req)
-	// Prefer SSZ, JSON if not.
-	req.Header.Set("Accept", "application/octet-stream;q=1,application/json;q=0.9")
-	span.AddEvent("Sending request")
+	defer release()
 
-	resp, err := s.client.Do(req)
+	span.AddEvent("Sending request")
+	resp, body, err := s.doGet(ctx, log, endpoint, url.String(), accept.MediaType())
 	if err != nil {
-		span.RecordError(errors.New("Request failed"))
-		return nil, errors.Wrap(err, "failed to call GET endpoint")
+		return nil, err
 	}
-	defer resp.Body.Close()
+	statusCode = resp.StatusCode
+	size = len(body)
+	s.checkDeprecation(log, endpoint, resp.Header)
 	log = log.With().Int("status_code", resp.StatusCode).Logger()
 
 	res := &httpResponse{
 		statusCode: resp.StatusCode,
+		headers:    resp.Header,
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -233,16 +424,10 @@ func (s *Service) get2(ctx context.Context, endpoint string) (*httpResponse, err
 		return res, nil
 	}
 
-	res.body, err = io.ReadAll(resp.Body)
-	if err != nil {
-		span.RecordError(err)
-		log.Warn().Err(err).Msg("Failed to read body")
-		return nil, errors.Wrap(err, "failed to read body")
-	}
+	res.body = body
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
-		span.SetStatus(codes.Error, fmt.Sprintf("Status code %d", resp.StatusCode))
 		trimmedResponse := bytes.ReplaceAll(bytes.ReplaceAll(res.body, []byte{0x0a}, []byte{}), []byte{0x0d}, []byte{})
 		log.Debug().Int("status_code", resp.StatusCode).RawJSON("response", trimmedResponse).Msg("GET failed")
 		return nil, Error{
@@ -253,9 +438,160 @@ func (s *Service) get2(ctx context.Context, endpoint string) (*httpResponse, err
 		}
 	}
 
-	res.consensusVersion, err = consensusVersionFromResp(resp)
+	// Not all endpoints are versioned, so the consensus version is only
+	// obtained if present; callers that require it must check it.
+	if _, exists := resp.Header["Eth-Consensus-Version"]; exists {
+		res.consensusVersion, err = consensusVersionFromResp(resp)
+		if err != nil {
+			if !s.unknownVersionPassthrough || len(resp.Header["Eth-Consensus-Version"]) != 1 {
+				return nil, errors.Wrap(err, "failed to parse consensus version")
+			}
+			log.Debug().Str("consensus_version", resp.Header.Get("Eth-Consensus-Version")).Msg("Unknown consensus version; passing through")
+			res.unknownConsensusVersion = resp.Header.Get("Eth-Consensus-Version")
+		}
+	}
+
+	res.contentType, err = contentTypeFromResp(resp)
+	if err != nil {
+		// For now, assume that unknown type is JSON.
+		log.Debug().Err(err).Msg("Failed to obtain content type; assuming JSON")
+		res.contentType = ContentTypeJSON
+	}
+	s.cacheResponse(endpoint, accept, res)
+
+	return res, nil
+}
+
+// getStream2 sends an HTTP get request to an endpoint that can serve SSZ, and streams
+// the body of the response to the supplied function.
+// SSZ is requested if the service prefers SSZ, falling back to JSON if the server
+// cannot provide it.
+func (s *Service) getStream2(ctx context.Context,
+	endpoint string,
+	fn func(res *httpResponse, body io.Reader) error,
+) (
+	*httpResponse,
+	error,
+) {
+	if !s.preferSSZ {
+		return s.getStream(ctx, endpoint, ContentTypeJSON, fn)
+	}
+
+	res, err := s.getStream(ctx, endpoint, ContentTypeSSZ, fn)
+	if err != nil && contentNotAcceptable(err) {
+		s.log.Debug().Str("endpoint", endpoint).Msg("SSZ not available from node; falling back to JSON")
+		return s.getStream(ctx, endpoint, ContentTypeJSON, fn)
+	}
+
+	return res, err
+}
+
+// getStream sends an HTTP get request accepting the given content type, and passes the body of
+// a successful response to the supplied function as it is received rather than first reading it
+// in to memory.  The response passed to the function carries the metadata of the response but no body.
+// If the response from the server is a 404 or 204 the function is not called, and the returned
+// response can be checked for its status code.
+// Streamed responses are neither cached nor retried, as their bodies are consumed as they are read.
+func (s *Service) getStream(ctx context.Context,
+	endpoint string,
+	accept ContentType,
+	fn func(res *httpResponse, body io.Reader) error,
+) (
+	_ *httpResponse,
+	err error,
+) {
+	ctx, span := s.startSpan(ctx, http.MethodGet, endpoint)
+	priority := requestPriority(ctx, http.MethodGet, endpoint)
+	started := time.Now()
+	statusCode := 0
+	body := &countingReader{}
+	defer func() {
+		s.requestDone(ctx, http.MethodGet, endpoint, priority, started, statusCode, body.read, err)
+		endSpan(span, statusCode, body.read, err)
+	}()
+
+	log := s.requestLog(ctx, endpoint, priority)
+	log.Trace().Msg("GET stream request")
+
+	if err := s.checkEndpointEnabled(endpoint); err != nil {
+		return nil, err
+	}
+
+	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	if err := s.slotScheduler.wait(ctx, priority); err != nil {
+		return nil, err
+	}
+
+	release, err := s.limiter.acquire(ctx, endpoint, priority)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// The timeout covers the entire stream, including the consumption of the body.
+	opCtx, cancel := context.WithTimeout(ctx, s.endpointTimeout(endpoint))
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GET request")
+	}
+	s.addExtraHeaders(req)
+	injectTraceContext(req)
+	req.Header.Set("Accept", accept.MediaType())
+
+	span.AddEvent("Sending request")
+	resp, err := s.client.Do(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse consensus version")
+		return nil, errors.Wrap(err, "failed to call GET endpoint")
+	}
+	defer resp.Body.Close()
+	body.reader = resp.Body
+	statusCode = resp.StatusCode
+	s.checkDeprecation(log, endpoint, resp.Header)
+	log = log.With().Int("status_code", resp.StatusCode).Logger()
+
+	res := &httpResponse{
+		statusCode: resp.StatusCode,
+		headers:    resp.Header,
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		// Nothing found.  Note that this is not considered an error.
+		span.RecordError(errors.New("endpoint not found"))
+		log.Debug().Msg("Endpoint not found")
+		return res, nil
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		// Nothing returned.  Note that this is not considered an error.
+		span.AddEvent("Received empty response")
+		log.Trace().Msg("Endpoint returned no content")
+		return res, nil
+	}
+
+	if resp.StatusCode/100 != 2 {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read GET response")
+		}
+		log.Debug().Str("response", string(data)).Msg("GET failed")
+		return nil, Error{
+			Method:     http.MethodGet,
+			StatusCode: resp.StatusCode,
+			Endpoint:   endpoint,
+			Data:       data,
+		}
+	}
+
+	if _, exists := resp.Header["Eth-Consensus-Version"]; exists {
+		res.consensusVersion, err = consensusVersionFromResp(resp)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse consensus version")
+		}
 	}
 
 	res.contentType, err = contentTypeFromResp(resp)
@@ -265,9 +601,27 @@ func (s *Service) get2(ctx context.Context, endpoint string) (*httpResponse, err
 		res.contentType = ContentTypeJSON
 	}
 
+	if err := fn(res, body); err != nil {
+		return nil, err
+	}
+
 	return res, nil
 }
 
+// countingReader is a reader that counts the bytes read through it.
+type countingReader struct {
+	reader io.Reader
+	read   int
+}
+
+// Read reads from the underlying reader.
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += n
+
+	return n, err
+}
+
 func consensusVersionFromResp(resp *http.Response) (spec.DataVersion, error) {
 	respConsensusVersions, exists := resp.Header["Eth-Consensus-Version"]
 	if !exists {
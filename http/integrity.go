@@ -0,0 +1,131 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// IntegrityError is returned when a response is found to be truncated or corrupted.
+type IntegrityError struct {
+	Endpoint string
+	Reason   string
+}
+
+func (e IntegrityError) Error() string {
+	return fmt.Sprintf("response from %s failed integrity check: %s", e.Endpoint, e.Reason)
+}
+
+// checkContentLength checks that the body of a response has the length declared by the server.
+func checkContentLength(endpoint string, resp *http.Response, body []byte) error {
+	if resp.ContentLength < 0 {
+		// Length not declared.
+		return nil
+	}
+	if int64(len(body)) != resp.ContentLength {
+		return IntegrityError{
+			Endpoint: endpoint,
+			Reason:   fmt.Sprintf("received %d of %d bytes", len(body), resp.ContentLength),
+		}
+	}
+
+	return nil
+}
+
+// isRoot returns true if the block or state ID is a root.
+func isRoot(id string) bool {
+	return strings.HasPrefix(id, "0x")
+}
+
+// verifiedBeaconState fetches a beacon state and verifies its root against the
+// state root supplied by the node.
+func (s *Service) verifiedBeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	root, err := s.BeaconStateRoot(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, nil
+	}
+	if !isRoot(stateID) {
+		// Fetch by root, as the state with the original ID may change.
+		stateID = fmt.Sprintf("%#x", *root)
+	}
+
+	state, err := s.beaconState(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || state.Version == spec.DataVersionUnknown {
+		// Nothing to verify.
+		return state, nil
+	}
+
+	computed, err := state.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate state root")
+	}
+	if computed != *root {
+		return nil, IntegrityError{
+			Endpoint: fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID),
+			Reason:   fmt.Sprintf("state root %#x does not match expected root %#x", computed, *root),
+		}
+	}
+
+	return state, nil
+}
+
+// verifiedSignedBeaconBlock fetches a signed beacon block and verifies its root
+// against the block root supplied by the node.
+func (s *Service) verifiedSignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	root, err := s.BeaconBlockRoot(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, nil
+	}
+	if !isRoot(blockID) {
+		// Fetch by root, as the block with the original ID may change.
+		blockID = fmt.Sprintf("%#x", *root)
+	}
+
+	block, err := s.signedBeaconBlock(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Version == spec.DataVersionUnknown {
+		// Nothing to verify.
+		return block, nil
+	}
+
+	computed, err := block.Root()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate block root")
+	}
+	if computed != *root {
+		return nil, IntegrityError{
+			Endpoint: fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID),
+			Reason:   fmt.Sprintf("block root %#x does not match expected root %#x", computed, *root),
+		}
+	}
+
+	return block, nil
+}
@@ -0,0 +1,166 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckContentLength(t *testing.T) {
+	tests := []struct {
+		name          string
+		contentLength int64
+		body          []byte
+		err           string
+	}{
+		{
+			name:          "Undeclared",
+			contentLength: -1,
+			body:          []byte("data"),
+		},
+		{
+			name:          "Match",
+			contentLength: 4,
+			body:          []byte("data"),
+		},
+		{
+			name:          "Truncated",
+			contentLength: 10,
+			body:          []byte("data"),
+			err:           "response from /eth/v2/beacon/blocks/head failed integrity check: received 4 of 10 bytes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkContentLength("/eth/v2/beacon/blocks/head", &http.Response{ContentLength: test.contentLength}, test.body)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.True(t, retryable(context.Background(), nil, err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifiedSignedBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+
+	block := &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Slot:          1,
+			ProposerIndex: 2,
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				ProposerSlashings: []*phase0.ProposerSlashing{},
+				AttesterSlashings: []*phase0.AttesterSlashing{},
+				Attestations:      []*phase0.Attestation{},
+				Deposits:          []*phase0.Deposit{},
+				VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			},
+		},
+	}
+	root, err := block.Message.HashTreeRoot()
+	require.NoError(t, err)
+	blockRoot := phase0.Root(root)
+	blockData, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		blockID  string
+		root     phase0.Root
+		requests []string
+		err      string
+	}{
+		{
+			name:     "Good",
+			blockID:  "head",
+			root:     blockRoot,
+			requests: []string{"/eth/v1/beacon/blocks/head/root", fmt.Sprintf("/eth/v2/beacon/blocks/%#x", blockRoot)},
+		},
+		{
+			name:     "ByRoot",
+			blockID:  fmt.Sprintf("%#x", blockRoot),
+			root:     blockRoot,
+			requests: []string{fmt.Sprintf("/eth/v1/beacon/blocks/%#x/root", blockRoot), fmt.Sprintf("/eth/v2/beacon/blocks/%#x", blockRoot)},
+		},
+		{
+			name:     "Corrupted",
+			blockID:  "head",
+			root:     phase0.Root{0x01},
+			requests: []string{"/eth/v1/beacon/blocks/head/root", fmt.Sprintf("/eth/v2/beacon/blocks/%#x", phase0.Root{0x01})},
+			err:      fmt.Sprintf("response from /eth/v2/beacon/blocks/%#x failed integrity check: block root %#x does not match expected root %#x", phase0.Root{0x01}, blockRoot, phase0.Root{0x01}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == fmt.Sprintf("/eth/v1/beacon/blocks/%s/root", test.blockID) {
+					_, _ = fmt.Fprintf(w, `{"data":{"root":"%#x"}}`, test.root)
+					return
+				}
+				w.Header().Set("Eth-Consensus-Version", "phase0")
+				_, _ = fmt.Fprintf(w, `{"version":"phase0","data":%s}`, string(blockData))
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec:    codecs.StdJSON,
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      srv.URL,
+				client:       srv.Client(),
+				timeout:      time.Second,
+				limiter:      newLimiter(0, nil, 0),
+				deprecations: make(map[string]*EndpointDeprecation),
+				verifyRoots:  true,
+			}
+
+			res, err := s.SignedBeaconBlock(ctx, test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var integrityErr IntegrityError
+				require.ErrorAs(t, err, &integrityErr)
+			} else {
+				require.NoError(t, err)
+				root, err := res.Root()
+				require.NoError(t, err)
+				require.Equal(t, blockRoot, root)
+			}
+			require.Equal(t, test.requests, requests)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	order := make([]string, 0)
+	logger := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "logger request")
+			res, err := next.RoundTrip(req)
+			order = append(order, "logger response")
+
+			return res, err
+		})
+	}
+	auth := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "auth request")
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer secret")
+			res, err := next.RoundTrip(req)
+			order = append(order, "auth response")
+
+			return res, err
+		})
+	}
+	failing := true
+	chaos := func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if failing {
+				return nil, errors.New("injected fault")
+			}
+
+			return next.RoundTrip(req)
+		})
+	}
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec: codecs.StdJSON,
+		log:       zerolog.Nop(),
+		base:      base,
+		address:   srv.URL,
+		client: &http.Client{
+			Transport: intercept(srv.Client().Transport, []Interceptor{logger, auth, chaos}),
+		},
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+	}
+
+	_, err = s.get(context.Background(), "/eth/v1/node/version")
+	require.ErrorContains(t, err, "injected fault")
+	require.Equal(t, []string{"logger request", "auth request", "auth response", "logger response"}, order)
+
+	failing = false
+	_, err = s.get(context.Background(), "/eth/v1/node/version")
+	require.NoError(t, err)
+
+	require.Equal(t, srv.Client().Transport, intercept(srv.Client().Transport, nil))
+}
+
+func TestInterceptorParameter(t *testing.T) {
+	params, err := parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithInterceptor(func(next http.RoundTripper) http.RoundTripper { return next }),
+		WithInterceptor(func(next http.RoundTripper) http.RoundTripper { return next }),
+	)
+	require.NoError(t, err)
+	require.Len(t, params.interceptors, 2)
+
+	_, err = parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithInterceptor(nil),
+	)
+	require.EqualError(t, err, "nil interceptor specified")
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/stretchr/testify/require"
+)
+
+// countingCodec is a JSON codec that counts its calls.
+type countingCodec struct {
+	marshals   atomic.Int32
+	unmarshals atomic.Int32
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.marshals.Add(1)
+
+	return codecs.StdJSON.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.unmarshals.Add(1)
+
+	return codecs.StdJSON.Unmarshal(data, v)
+}
+
+func TestJSONCodecResponses(t *testing.T) {
+	ctx := context.Background()
+
+	s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/node/peer_count":
+			_, _ = w.Write([]byte(`{"data":{"disconnected":"1","connecting":"2","connected":"3","disconnecting":"4"}}`))
+		case "/eth/v1/beacon/states/head/fork":
+			_, _ = w.Write([]byte(`{"data":{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	codec := &countingCodec{}
+	s.jsonCodec = codec
+
+	peerCount, err := s.PeerCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), peerCount.Connected)
+	require.Equal(t, int32(1), codec.unmarshals.Load())
+
+	fork, err := s.Fork(ctx, "head")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, fork.Epoch)
+	require.Equal(t, int32(2), codec.unmarshals.Load())
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// lightClientDataJSON is the JSON envelope of versioned light client data.
+type lightClientDataJSON struct {
+	Version spec.DataVersion `json:"version"`
+	Data    json.RawMessage  `json:"data"`
+}
+
+// sszUnmarshaler is implemented by types that can be decoded from SSZ.
+type sszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// lightClientData returns the version and the encoded data of a light client response.
+func (s *Service) lightClientData(res *httpResponse) (spec.DataVersion, []byte, error) {
+	switch res.contentType {
+	case ContentTypeSSZ:
+		if res.consensusVersion == spec.DataVersionUnknown {
+			return spec.DataVersionUnknown, nil, errors.New("no consensus version supplied in response")
+		}
+
+		return res.consensusVersion, res.body, nil
+	case ContentTypeJSON:
+		var data lightClientDataJSON
+		if err := s.jsonCodec.Unmarshal(res.body, &data); err != nil {
+			return spec.DataVersionUnknown, nil, errors.Wrap(err, "failed to parse response")
+		}
+		if data.Data == nil {
+			return spec.DataVersionUnknown, nil, errors.New("no data in response")
+		}
+		// The header takes precedence over the version in the body.
+		version := data.Version
+		if res.consensusVersion != spec.DataVersionUnknown {
+			version = res.consensusVersion
+		}
+
+		return version, data.Data, nil
+	default:
+		return spec.DataVersionUnknown, nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+}
+
+// decodeLightClientData decodes light client data of the given content type in to the supplied object.
+func (s *Service) decodeLightClientData(contentType ContentType, data []byte, obj sszUnmarshaler) error {
+	if contentType == ContentTypeSSZ {
+		return obj.UnmarshalSSZ(data)
+	}
+
+	return s.jsonCodec.Unmarshal(data, obj)
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// LightClientBootstrap fetches the light client bootstrap for the given trusted block root.
+// N.B if a bootstrap for the block root is not available this will return nil without an error.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*api.VersionedLightClientBootstrap, error) {
+	res, err := s.get2(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", blockRoot))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client bootstrap")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	version, data, err := s.lightClientData(res)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain light client bootstrap")
+	}
+
+	return s.decodeLightClientBootstrap(version, res.contentType, data)
+}
+
+// decodeLightClientBootstrap decodes a light client bootstrap of the given version and content type.
+func (s *Service) decodeLightClientBootstrap(version spec.DataVersion, contentType ContentType, data []byte) (*api.VersionedLightClientBootstrap, error) {
+	res := &api.VersionedLightClientBootstrap{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &apiv1altair.LightClientBootstrap{}
+		err = s.decodeLightClientData(contentType, data, res.Altair)
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1altair.LightClientBootstrap{}
+		err = s.decodeLightClientData(contentType, data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.LightClientBootstrap{}
+		err = s.decodeLightClientData(contentType, data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.LightClientBootstrap{}
+		err = s.decodeLightClientData(contentType, data, res.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra = &apiv1electra.LightClientBootstrap{}
+		err = s.decodeLightClientData(contentType, data, res.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled light client bootstrap version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s light client bootstrap", version)
+	}
+
+	return res, nil
+}
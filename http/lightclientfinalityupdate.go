@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// LightClientFinalityUpdate fetches the latest light client finality update known to the node.
+// N.B if no finality update is available this will return nil without an error.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context) (*api.VersionedLightClientFinalityUpdate, error) {
+	res, err := s.get2(ctx, "/eth/v1/beacon/light_client/finality_update")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client finality update")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	version, data, err := s.lightClientData(res)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain light client finality update")
+	}
+
+	return s.decodeLightClientFinalityUpdate(version, res.contentType, data)
+}
+
+// decodeLightClientFinalityUpdate decodes a light client finality update of the given version and content type.
+func (s *Service) decodeLightClientFinalityUpdate(version spec.DataVersion, contentType ContentType, data []byte) (*api.VersionedLightClientFinalityUpdate, error) {
+	res := &api.VersionedLightClientFinalityUpdate{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &apiv1altair.LightClientFinalityUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Altair)
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1altair.LightClientFinalityUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.LightClientFinalityUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.LightClientFinalityUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra = &apiv1electra.LightClientFinalityUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled light client finality update version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s light client finality update", version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+// LightClientUpdates fetches the light client updates for the sync committee periods
+// starting at the given period.
+// The node can return fewer updates than requested, for example if it does not hold
+// updates for some of the periods.
+func (s *Service) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*api.VersionedLightClientUpdate, error) {
+	if count == 0 {
+		return nil, errors.New("no count specified")
+	}
+
+	// The SSZ response identifies each update by fork digest rather than version,
+	// so always request JSON.
+	res, err := s.getJSON(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/updates?start_period=%d&count=%d", startPeriod, count))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request light client updates")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return []*api.VersionedLightClientUpdate{}, nil
+	}
+
+	var items []*lightClientDataJSON
+	if err := s.jsonCodec.Unmarshal(res.body, &items); err != nil {
+		return nil, errors.Wrap(err, "failed to parse light client updates")
+	}
+
+	updates := make([]*api.VersionedLightClientUpdate, 0, len(items))
+	for i, item := range items {
+		if item == nil || item.Data == nil {
+			return nil, fmt.Errorf("no data for light client update %d", i)
+		}
+		update, err := s.decodeLightClientUpdate(item.Version, ContentTypeJSON, item.Data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "light client update %d", i)
+		}
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// decodeLightClientUpdate decodes a light client update of the given version and content type.
+func (s *Service) decodeLightClientUpdate(version spec.DataVersion, contentType ContentType, data []byte) (*api.VersionedLightClientUpdate, error) {
+	res := &api.VersionedLightClientUpdate{
+		Version: version,
+	}
+
+	var err error
+	switch version {
+	case spec.DataVersionAltair:
+		res.Altair = &apiv1altair.LightClientUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Altair)
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &apiv1altair.LightClientUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Bellatrix)
+	case spec.DataVersionCapella:
+		res.Capella = &apiv1capella.LightClientUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Capella)
+	case spec.DataVersionDeneb:
+		res.Deneb = &apiv1deneb.LightClientUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Deneb)
+	case spec.DataVersionElectra:
+		res.Electra = &apiv1electra.LightClientUpdate{}
+		err = s.decodeLightClientData(contentType, data, res.Electra)
+	default:
+		return nil, fmt.Errorf("unhandled light client update version %s", version)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s light client update", version)
+	}
+
+	return res, nil
+}
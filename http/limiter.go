@@ -0,0 +1,205 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// limiter limits the number of concurrent in-flight requests, both globally
+// and for classes of endpoint defined by path prefixes.
+type limiter struct {
+	global       *semaphore
+	prefixes     []string
+	classes      map[string]*semaphore
+	queueTimeout time.Duration
+}
+
+// newLimiter creates a new limiter.  It returns nil if there are no limits.
+func newLimiter(global int, endpoints map[string]int, queueTimeout time.Duration) *limiter {
+	if global == 0 && len(endpoints) == 0 {
+		return nil
+	}
+
+	l := &limiter{
+		prefixes:     make([]string, 0, len(endpoints)),
+		classes:      make(map[string]*semaphore, len(endpoints)),
+		queueTimeout: queueTimeout,
+	}
+	if global > 0 {
+		l.global = newSemaphore(global)
+	}
+	for prefix, limit := range endpoints {
+		l.prefixes = append(l.prefixes, prefix)
+		l.classes[prefix] = newSemaphore(limit)
+	}
+	// Sort prefixes longest first, so that the most specific class matches.
+	sort.Slice(l.prefixes, func(i, j int) bool {
+		if len(l.prefixes[i]) != len(l.prefixes[j]) {
+			return len(l.prefixes[i]) > len(l.prefixes[j])
+		}
+		return l.prefixes[i] < l.prefixes[j]
+	})
+
+	return l
+}
+
+// class returns the semaphore for the class of the given endpoint, or nil if
+// the endpoint does not belong to a class.
+func (l *limiter) class(endpoint string) *semaphore {
+	for _, prefix := range l.prefixes {
+		if strings.HasPrefix(endpoint, prefix) {
+			return l.classes[prefix]
+		}
+	}
+
+	return nil
+}
+
+// acquire waits for a slot to become available for the given endpoint,
+// returning a function to release the slot once the request has completed.
+// Waiting is abandoned if the context is done or the queue timeout passes.
+func (l *limiter) acquire(ctx context.Context, endpoint string, priority Priority) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.queueTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.queueTimeout)
+		defer cancel()
+	}
+
+	// Semaphores are always acquired class first, then global, to avoid deadlocks.
+	semaphores := make([]*semaphore, 0, 2)
+	if class := l.class(endpoint); class != nil {
+		semaphores = append(semaphores, class)
+	}
+	if l.global != nil {
+		semaphores = append(semaphores, l.global)
+	}
+
+	release := func(acquired []*semaphore) {
+		for i := len(acquired) - 1; i >= 0; i-- {
+			acquired[i].release()
+		}
+	}
+	for i, semaphore := range semaphores {
+		if err := semaphore.acquire(ctx, priority); err != nil {
+			release(semaphores[:i])
+			return nil, errors.Wrap(err, "timed out waiting for request slot")
+		}
+	}
+
+	return func() { release(semaphores) }, nil
+}
+
+// semaphore is a counting semaphore that serves waiters in order of priority.
+// High priority acquisitions do not wait, although they count towards the limit.
+type semaphore struct {
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	// waiters are queued by priority, lowest first.
+	waiters [PriorityHigh][]chan struct{}
+}
+
+func newSemaphore(limit int) *semaphore {
+	return &semaphore{
+		limit: limit,
+	}
+}
+
+// acquire acquires a slot, waiting if required.
+func (s *semaphore) acquire(ctx context.Context, priority Priority) error {
+	s.mu.Lock()
+	if priority >= PriorityHigh || (s.inFlight < s.limit && !s.hasWaiters(priority)) {
+		s.inFlight++
+		s.mu.Unlock()
+		return nil
+	}
+	if priority < PriorityLow {
+		priority = PriorityLow
+	}
+	ready := make(chan struct{})
+	s.waiters[priority] = append(s.waiters[priority], ready)
+	s.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		select {
+		case <-ready:
+			// Granted whilst we were giving up; hand the slot on.
+			s.inFlight--
+			s.grant()
+		default:
+			s.removeWaiter(priority, ready)
+		}
+		return ctx.Err()
+	}
+}
+
+// release releases a slot.
+func (s *semaphore) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+	s.grant()
+}
+
+// hasWaiters returns true if there are waiters of at least the given priority.
+// Must be called with the lock held.
+func (s *semaphore) hasWaiters(priority Priority) bool {
+	for p := int(PriorityHigh) - 1; p >= int(priority) && p >= 0; p-- {
+		if len(s.waiters[p]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// grant grants slots to waiters, highest priority first, whilst slots are available.
+// Must be called with the lock held.
+func (s *semaphore) grant() {
+	for p := len(s.waiters) - 1; p >= 0 && s.inFlight < s.limit; p-- {
+		for len(s.waiters[p]) > 0 && s.inFlight < s.limit {
+			ready := s.waiters[p][0]
+			s.waiters[p] = s.waiters[p][1:]
+			s.inFlight++
+			close(ready)
+		}
+	}
+}
+
+// removeWaiter removes a waiter from the queue.
+// Must be called with the lock held.
+func (s *semaphore) removeWaiter(priority Priority, ready chan struct{}) {
+	for i, waiter := range s.waiters[priority] {
+		if waiter == ready {
+			s.waiters[priority] = append(s.waiters[priority][:i], s.waiters[priority][i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterNil(t *testing.T) {
+	l := newLimiter(0, nil, 0)
+	require.Nil(t, l)
+	release, err := l.acquire(context.Background(), "/eth/v1/node/version", PriorityNormal)
+	require.NoError(t, err)
+	release()
+}
+
+func TestLimiterClass(t *testing.T) {
+	l := newLimiter(0, map[string]int{
+		"/eth/v1/beacon":        2,
+		"/eth/v1/beacon/states": 1,
+	}, 0)
+
+	require.Equal(t, l.classes["/eth/v1/beacon/states"], l.class("/eth/v1/beacon/states/head/validators"))
+	require.Equal(t, l.classes["/eth/v1/beacon"], l.class("/eth/v1/beacon/genesis"))
+	require.Nil(t, l.class("/eth/v1/node/version"))
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	l := newLimiter(3, map[string]int{
+		"/eth/v2/debug/beacon/states": 1,
+	}, 0)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		max      int32
+	}{
+		{
+			name:     "Global",
+			endpoint: "/eth/v1/node/version",
+			max:      3,
+		},
+		{
+			name:     "Class",
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			max:      1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var inFlight int32
+			var maxInFlight int32
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					release, err := l.acquire(context.Background(), test.endpoint, PriorityNormal)
+					require.NoError(t, err)
+					current := atomic.AddInt32(&inFlight, 1)
+					for {
+						highest := atomic.LoadInt32(&maxInFlight)
+						if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+							break
+						}
+					}
+					time.Sleep(5 * time.Millisecond)
+					atomic.AddInt32(&inFlight, -1)
+					release()
+				}()
+			}
+			wg.Wait()
+			require.Equal(t, test.max, maxInFlight)
+		})
+	}
+}
+
+func TestLimiterTimeout(t *testing.T) {
+	l := newLimiter(1, nil, 10*time.Millisecond)
+
+	release, err := l.acquire(context.Background(), "/eth/v1/node/version", PriorityNormal)
+	require.NoError(t, err)
+
+	// Queue timeout.
+	_, err = l.acquire(context.Background(), "/eth/v1/node/version", PriorityNormal)
+	require.EqualError(t, err, "timed out waiting for request slot: context deadline exceeded")
+
+	// Context cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.acquire(ctx, "/eth/v1/node/version", PriorityNormal)
+	require.EqualError(t, err, "timed out waiting for request slot: context canceled")
+
+	// Slot available after release.
+	release()
+	release, err = l.acquire(context.Background(), "/eth/v1/node/version", PriorityNormal)
+	require.NoError(t, err)
+	release()
+}
+
+func TestLimiterClassReleasedOnTimeout(t *testing.T) {
+	l := newLimiter(1, map[string]int{"/eth/v1/beacon": 1}, 10*time.Millisecond)
+
+	// Hold the global slot with an endpoint outside the class.
+	release, err := l.acquire(context.Background(), "/eth/v1/node/version", PriorityNormal)
+	require.NoError(t, err)
+
+	// The class slot is acquired but the global slot is not, so the class slot must be released.
+	_, err = l.acquire(context.Background(), "/eth/v1/beacon/genesis", PriorityNormal)
+	require.Error(t, err)
+	require.Equal(t, 0, l.classes["/eth/v1/beacon"].inFlight)
+
+	release()
+}
+
+func TestLimiterPriority(t *testing.T) {
+	l := newLimiter(1, nil, 0)
+
+	// Take the only slot.
+	release, err := l.acquire(context.Background(), "/eth/v2/debug/beacon/states/head", PriorityLow)
+	require.NoError(t, err)
+
+	// High priority requests are not held up.
+	highRelease, err := l.acquire(context.Background(), "/eth/v1/validator/attestation_data", PriorityHigh)
+	require.NoError(t, err)
+	highRelease()
+
+	// Queue a low priority request, then a normal priority request.
+	order := make(chan Priority, 2)
+	var wg sync.WaitGroup
+	for _, priority := range []Priority{PriorityLow, PriorityNormal} {
+		wg.Add(1)
+		go func(priority Priority) {
+			defer wg.Done()
+			release, err := l.acquire(context.Background(), "/eth/v1/node/version", priority)
+			require.NoError(t, err)
+			order <- priority
+			release()
+		}(priority)
+		// Ensure that the request is queued before continuing.
+		require.Eventually(t, func() bool {
+			l.global.mu.Lock()
+			defer l.global.mu.Unlock()
+			return len(l.global.waiters[priority]) == 1
+		}, time.Second, time.Millisecond)
+	}
+
+	// Releasing the slot should serve the normal priority request first.
+	release()
+	wg.Wait()
+	require.Equal(t, PriorityNormal, <-order)
+	require.Equal(t, PriorityLow, <-order)
+}
+
+func TestRequestPriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		endpoint string
+		priority Priority
+	}{
+		{
+			name:     "Default",
+			ctx:      context.Background(),
+			method:   "GET",
+			endpoint: "/eth/v1/node/version",
+			priority: PriorityNormal,
+		},
+		{
+			name:     "AttestationData",
+			ctx:      context.Background(),
+			method:   "GET",
+			endpoint: "/eth/v1/validator/attestation_data?slot=1&committee_index=2",
+			priority: PriorityHigh,
+		},
+		{
+			name:     "SubmitBlock",
+			ctx:      context.Background(),
+			method:   "POST",
+			endpoint: "/eth/v1/beacon/blocks",
+			priority: PriorityHigh,
+		},
+		{
+			name:     "FetchBlock",
+			ctx:      context.Background(),
+			method:   "GET",
+			endpoint: "/eth/v2/beacon/blocks/head",
+			priority: PriorityNormal,
+		},
+		{
+			name:     "State",
+			ctx:      context.Background(),
+			method:   "GET",
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			priority: PriorityLow,
+		},
+		{
+			name:     "Override",
+			ctx:      WithPriority(context.Background(), PriorityHigh),
+			method:   "GET",
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			priority: PriorityHigh,
+		},
+		{
+			name:     "OverrideUnknown",
+			ctx:      WithPriority(context.Background(), PriorityUnknown),
+			method:   "GET",
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			priority: PriorityLow,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.priority, requestPriority(test.ctx, test.method, test.endpoint))
+		})
+	}
+}
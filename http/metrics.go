@@ -0,0 +1,201 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestsMetric        *prometheus.CounterVec
+	requestDurationMetric *prometheus.HistogramVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if requestsMetric != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	requestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of requests",
+	}, []string{"method", "caller", "result"})
+	if err := prometheus.Register(requestsMetric); err != nil {
+		return errors.Wrap(err, "failed to register requests_total")
+	}
+	requestDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of requests",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"method", "caller"})
+	if err := prometheus.Register(requestDurationMetric); err != nil {
+		return errors.Wrap(err, "failed to register request_duration_seconds")
+	}
+
+	return nil
+}
+
+// monitorRequest records a completed request in the metrics.
+func (s *Service) monitorRequest(ctx context.Context,
+	method string,
+	endpoint string,
+	duration time.Duration,
+	statusCode int,
+	size int,
+	err error,
+) {
+	if requestsMetric != nil {
+		result := "succeeded"
+		if err != nil {
+			result = "failed"
+		}
+		requestsMetric.WithLabelValues(method, caller(ctx), result).Inc()
+	}
+	if requestDurationMetric != nil {
+		requestDurationMetric.WithLabelValues(method, caller(ctx)).Observe(duration.Seconds())
+	}
+	s.endpointMetrics.observe(method, endpoint, duration, statusCode, size, err)
+}
+
+// endpointMetrics are the metrics for requests to a beacon node, labelled by node and endpoint.
+type endpointMetrics struct {
+	address       string
+	requests      *prometheus.CounterVec
+	errors        *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	responseSizes *prometheus.HistogramVec
+}
+
+// newEndpointMetrics creates and registers endpoint metrics.
+// Metrics that are already registered, for example by a service for another beacon
+// node using the same registerer, are shared.
+func newEndpointMetrics(registerer prometheus.Registerer, address string) (*endpointMetrics, error) {
+	if registerer == nil {
+		// No registerer.
+		return nil, nil
+	}
+
+	labels := []string{"address", "endpoint", "method"}
+	requests, err := registerCollector(registerer, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http_endpoint",
+		Name:      "requests_total",
+		Help:      "Number of requests, by status code.",
+	}, append(labels, "status_code")))
+	if err != nil {
+		return nil, err
+	}
+	errs, err := registerCollector(registerer, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http_endpoint",
+		Name:      "errors_total",
+		Help:      "Number of requests that failed.",
+	}, labels))
+	if err != nil {
+		return nil, err
+	}
+	duration, err := registerCollector(registerer, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http_endpoint",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of requests.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, labels))
+	if err != nil {
+		return nil, err
+	}
+	responseSizes, err := registerCollector(registerer, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "consensusclient",
+		Subsystem: "http_endpoint",
+		Name:      "response_size_bytes",
+		Help:      "Size of response bodies.",
+		Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+	}, labels))
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpointMetrics{
+		address:       address,
+		requests:      requests,
+		errors:        errs,
+		duration:      duration,
+		responseSizes: responseSizes,
+	}, nil
+}
+
+// registerCollector registers a collector, returning the existing collector if
+// an identical one is already registered.
+func registerCollector[T prometheus.Collector](registerer prometheus.Registerer, collector T) (T, error) {
+	if err := registerer.Register(collector); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if errors.As(err, &alreadyRegistered) {
+			if existing, isT := alreadyRegistered.ExistingCollector.(T); isT {
+				return existing, nil
+			}
+		}
+
+		return collector, errors.Wrap(err, "failed to register metric")
+	}
+
+	return collector, nil
+}
+
+// observe records a completed request.
+func (m *endpointMetrics) observe(method string,
+	endpoint string,
+	duration time.Duration,
+	statusCode int,
+	size int,
+	err error,
+) {
+	if m == nil {
+		return
+	}
+
+	template := normaliseEndpoint(endpoint)
+	status := "none"
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	m.requests.WithLabelValues(m.address, template, method, status).Inc()
+	if err != nil {
+		m.errors.WithLabelValues(m.address, template, method).Inc()
+	}
+	m.duration.WithLabelValues(m.address, template, method).Observe(duration.Seconds())
+	if err == nil {
+		m.responseSizes.WithLabelValues(m.address, template, method).Observe(float64(size))
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// metricValues returns the values of the metrics in the registry, keyed by name
+// and then by the value of the given label.
+func metricValues(t *testing.T, registry *prometheus.Registry, label string) map[string]map[string]float64 {
+	t.Helper()
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	res := make(map[string]map[string]float64)
+	for _, family := range families {
+		values := make(map[string]float64)
+		for _, metric := range family.GetMetric() {
+			key := ""
+			for _, pair := range metric.GetLabel() {
+				if pair.GetName() == label {
+					key = pair.GetValue()
+				}
+			}
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				values[key] += metric.GetCounter().GetValue()
+			case dto.MetricType_HISTOGRAM:
+				values[key] += metric.GetHistogram().GetSampleSum()
+			default:
+			}
+		}
+		res[family.GetName()] = values
+	}
+
+	return res
+}
+
+func TestEndpointMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	registry := prometheus.NewRegistry()
+	endpointMetrics, err := newEndpointMetrics(registry, srv.URL)
+	require.NoError(t, err)
+	// A second set of metrics for another node shares the registered collectors.
+	otherMetrics, err := newEndpointMetrics(registry, "other")
+	require.NoError(t, err)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec:       codecs.StdJSON,
+		log:             zerolog.Nop(),
+		base:            base,
+		address:         srv.URL,
+		client:          srv.Client(),
+		timeout:         time.Second,
+		limiter:         newLimiter(0, nil, 0),
+		deprecations:    make(map[string]*EndpointDeprecation),
+		endpointMetrics: endpointMetrics,
+	}
+
+	ctx := context.Background()
+	_, err = s.get(ctx, "/eth/v2/beacon/blocks/1")
+	require.NoError(t, err)
+	_, err = s.get(ctx, "/eth/v2/beacon/blocks/2")
+	require.NoError(t, err)
+	_, err = s.post(ctx, "/eth/v1/fail", nil)
+	require.Error(t, err)
+	otherMetrics.observe(http.MethodGet, "/eth/v1/node/version", time.Second, http.StatusOK, 10, nil)
+
+	endpoints := metricValues(t, registry, "endpoint")
+	require.Equal(t, map[string]float64{
+		"/eth/v2/beacon/blocks/{id}": 2,
+		"/eth/v1/fail":               1,
+		"/eth/v1/node/version":       1,
+	}, endpoints["consensusclient_http_endpoint_requests_total"])
+	require.Equal(t, map[string]float64{
+		"/eth/v1/fail": 1,
+	}, endpoints["consensusclient_http_endpoint_errors_total"])
+	require.Equal(t, map[string]float64{
+		"/eth/v2/beacon/blocks/{id}": 22,
+		"/eth/v1/node/version":       10,
+	}, endpoints["consensusclient_http_endpoint_response_size_bytes"])
+
+	statuses := metricValues(t, registry, "status_code")
+	require.Equal(t, map[string]float64{
+		"200": 3,
+		"500": 1,
+	}, statuses["consensusclient_http_endpoint_requests_total"])
+
+	addresses := metricValues(t, registry, "address")
+	require.Equal(t, map[string]float64{
+		srv.URL: 3,
+		"other": 1,
+	}, addresses["consensusclient_http_endpoint_requests_total"])
+
+	// Metrics are optional.
+	noMetrics, err := newEndpointMetrics(nil, srv.URL)
+	require.NoError(t, err)
+	require.Nil(t, noMetrics)
+	noMetrics.observe(http.MethodGet, "/eth/v1/node/version", time.Second, http.StatusOK, 10, nil)
+
+	// Conflicting metrics are rejected.
+	conflicting := prometheus.NewRegistry()
+	require.NoError(t, conflicting.Register(prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "consensusclient_http_endpoint_requests_total",
+		Help: "Conflicting.",
+	})))
+	_, err = newEndpointMetrics(conflicting, srv.URL)
+	require.ErrorContains(t, err, "failed to register metric")
+}
@@ -30,6 +30,8 @@ func (s *Service) NodeClient(ctx context.Context) (string, error) {
 	switch {
 	case strings.HasPrefix(nodeVersion, "lighthouse"):
 		return "lighthouse", nil
+	case strings.HasPrefix(nodeVersion, "lodestar"):
+		return "lodestar", nil
 	case strings.HasPrefix(nodeVersion, "nimbus"):
 		return "nimbus", nil
 	case strings.HasPrefix(nodeVersion, "prysm"):
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/pkg/errors"
 )
@@ -54,7 +53,7 @@ func (s *Service) NodeVersion(ctx context.Context) (string, error) {
 	}
 
 	var resp nodeVersionJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return "", errors.Wrap(err, "failed to parse node version")
 	}
 	s.nodeVersion = resp.Data.Version
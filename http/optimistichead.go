@@ -0,0 +1,51 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type optimisticHeadJSON struct {
+	ExecutionOptimistic *bool `json:"execution_optimistic"`
+}
+
+// IsOptimisticHead returns true if the node's head block has not been fully verified by an execution client.
+func (s *Service) IsOptimisticHead(ctx context.Context) (bool, error) {
+	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/headers/head")
+	if err != nil {
+		return false, errors.Wrap(err, "failed to request head header")
+	}
+	if respBodyReader == nil {
+		return false, errors.New("failed to obtain head header")
+	}
+
+	var resp optimisticHeadJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return false, errors.Wrap(err, "failed to parse head header")
+	}
+	if resp.ExecutionOptimistic != nil {
+		return *resp.ExecutionOptimistic, nil
+	}
+
+	// Older nodes do not return the execution status with the header; fall back to the sync state.
+	syncState, err := s.NodeSyncing(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return syncState.IsOptimistic, nil
+}
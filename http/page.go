@@ -0,0 +1,85 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+type pageMetaJSON struct {
+	NextCursor string `json:"next_cursor"`
+}
+
+type pageJSON[T any] struct {
+	Data []T           `json:"data"`
+	Meta *pageMetaJSON `json:"meta,omitempty"`
+}
+
+// getPage fetches a single page of items from a paged endpoint.
+// The cursor and limit are passed as query parameters alongside those supplied, and the
+// cursor for the next page is read from the metadata of the response.  Nodes that do not
+// support paging ignore the parameters and return all items without a next cursor, which
+// is a single page.
+func getPage[T any](ctx context.Context,
+	s *Service,
+	endpoint string,
+	query []string,
+	opts *api.PageOpts,
+) (
+	*api.Page[T],
+	error,
+) {
+	if opts != nil {
+		if opts.Cursor != "" {
+			query = append(query, fmt.Sprintf("cursor=%s", url.QueryEscape(opts.Cursor)))
+		}
+		if opts.Limit > 0 {
+			query = append(query, fmt.Sprintf("limit=%d", opts.Limit))
+		}
+	}
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, strings.Join(query, "&"))
+	}
+
+	res, err := s.getJSON(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if res.body == nil {
+		return nil, errors.New("no page returned")
+	}
+
+	var resp pageJSON[T]
+	if err := s.jsonCodec.Unmarshal(res.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse page")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no data in page")
+	}
+
+	page := &api.Page[T]{
+		Data: resp.Data,
+	}
+	if resp.Meta != nil {
+		page.NextCursor = resp.Meta.NextCursor
+	}
+
+	return page, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeersPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *api.PageOpts
+		body     string
+		query    string
+		expected *api.Page[*apiv1.Peer]
+		err      string
+	}{
+		{
+			name:  "Paged",
+			opts:  &api.PageOpts{Cursor: "a b", Limit: 1},
+			body:  `{"data":[{"peer_id":"a","last_seen_p2p_address":"/ip4/1.2.3.4/tcp/9000","state":"connected","direction":"inbound"}],"meta":{"count":1,"next_cursor":"c"}}`,
+			query: "state=connected&cursor=a+b&limit=1",
+			expected: &api.Page[*apiv1.Peer]{
+				Data:       []*apiv1.Peer{{PeerID: "a", LastSeenP2PAddress: "/ip4/1.2.3.4/tcp/9000", State: "connected", Direction: "inbound"}},
+				NextCursor: "c",
+			},
+		},
+		{
+			name:  "Unpaged",
+			body:  `{"data":[],"meta":{"count":0}}`,
+			query: "state=connected",
+			expected: &api.Page[*apiv1.Peer]{
+				Data: []*apiv1.Peer{},
+			},
+		},
+		{
+			name:  "NoData",
+			body:  `{}`,
+			query: "state=connected",
+			err:   "failed to obtain peers page: no data in page",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/node/peers", r.URL.Path)
+				require.Equal(t, test.query, r.URL.RawQuery)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			res, err := s.PeersPage(context.Background(), []string{"connected"}, nil, test.opts)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestPeersIterator(t *testing.T) {
+	// Serve 5 peers, 2 at a time, using the index of the next peer as the cursor.
+	s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "2", r.URL.Query().Get("limit"))
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			_, err := fmt.Sscanf(cursor, "%d", &start)
+			require.NoError(t, err)
+		}
+		end := start + 2
+		meta := ""
+		if end < 5 {
+			meta = fmt.Sprintf(`,"meta":{"next_cursor":"%d"}`, end)
+		} else {
+			end = 5
+		}
+		peers := ""
+		for i := start; i < end; i++ {
+			if i > start {
+				peers += ","
+			}
+			peers += fmt.Sprintf(`{"peer_id":"%d","last_seen_p2p_address":"","state":"connected","direction":"inbound"}`, i)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s]%s}`, peers, meta)))
+	})
+
+	iterator := s.PeersIterator(nil, nil, 2)
+	peerIDs := make([]string, 0)
+	for iterator.Next(context.Background()) {
+		peerIDs = append(peerIDs, iterator.Item().PeerID)
+	}
+	require.NoError(t, iterator.Err())
+	require.Equal(t, []string{"0", "1", "2", "3", "4"}, peerIDs)
+}
+
+func TestAttestationPoolPage(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		cursor string
+		err    string
+	}{
+		{
+			name:   "Good",
+			body:   `{"data":[{"aggregation_bits":"0x01","data":{"slot":"5","index":"0","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}],"meta":{"next_cursor":"x"}}`,
+			cursor: "x",
+		},
+		{
+			name: "WrongSlot",
+			body: `{"data":[{"aggregation_bits":"0x01","data":{"slot":"6","index":"0","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}]}`,
+			err:  "attestation pool entry not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/beacon/pool/attestations", r.URL.Path)
+				require.Equal(t, "slot=5&limit=10", r.URL.RawQuery)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			res, err := s.AttestationPoolPage(context.Background(), 5, &api.PageOpts{Limit: 10})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, res.Data, 1)
+				require.Equal(t, test.cursor, res.NextCursor)
+			}
+		})
+	}
+}
@@ -14,19 +14,68 @@
 package http
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 type parameters struct {
 	logLevel        zerolog.Level
+	monitor         metrics.Service
+	registerer      prometheus.Registerer
+	tracerProvider  trace.TracerProvider
 	address         string
 	timeout         time.Duration
 	indexChunkSize  int
 	pubKeyChunkSize int
 	extraHeaders    map[string]string
+	specOverrides   map[string]string
+	jsonCodec       codecs.JSONCodec
+	extensions      bool
+	// Passthrough of data for unknown consensus versions.
+	unknownVersionPassthrough bool
+	// Concurrency limits.
+	maxConcurrentRequests int
+	endpointConcurrency   map[string]int
+	queueTimeout          time.Duration
+	// Number of chunks of a chunked validators request fetched in parallel.
+	chunkConcurrency int
+	// Hooks called on completion of requests.
+	requestHooks []RequestHook
+	// Interceptors wrapping the transport.
+	interceptors []Interceptor
+	// Submission of blocks as SSZ.
+	sszSubmission bool
+	// Preference for SSZ responses.
+	preferSSZ bool
+	// Periods at the start of slots in which requests are not started, by priority.
+	slotGuards map[Priority]time.Duration
+	// Cache of responses for immutable data.
+	cache Cache
+	// Events stream connections.
+	eventsConnectionHandler EventsConnectionHandlerFunc
+	eventsMinBackoff        time.Duration
+	eventsMaxBackoff        time.Duration
+	eventsDeduplication     bool
+	// Verification of the roots of downloaded blocks and states.
+	verifyRoots bool
+	// Timeouts for classes of endpoint.
+	endpointTimeouts map[string]time.Duration
+	// Endpoints to which requests may and may not be made.
+	enabledEndpoints  []string
+	disabledEndpoints []string
+	// Retry policy.
+	retries    int
+	minBackoff time.Duration
+	maxBackoff time.Duration
 }
 
 // Parameter is the interface for service parameters.
@@ -47,6 +96,32 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithMonitor sets the monitor for the service.
+// Request metrics are labelled with the caller from the call metadata of each
+// request (see WithCallMetadata).
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithMetrics sets the registerer for per-endpoint request metrics: request counts,
+// error counts, latencies and response sizes, labelled by beacon node address and
+// endpoint.  Services for multiple beacon nodes can share the same registerer.
+func WithMetrics(registerer prometheus.Registerer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.registerer = registerer
+	})
+}
+
+// WithTracerProvider sets the provider of the tracer used to create a span for each
+// request to the endpoint.  If not set no spans are created.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tracerProvider = tracerProvider
+	})
+}
+
 // WithAddress provides the address for the endpoint.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -54,13 +129,69 @@ func WithAddress(address string) Parameter {
 	})
 }
 
-// WithTimeout sets the maximum duration for all requests to the endpoint.
+// WithTimeout sets the maximum duration for requests to the endpoint.
+// This can be overridden for classes of endpoint with WithEndpointTimeout.
 func WithTimeout(timeout time.Duration) Parameter {
 	return parameterFunc(func(p *parameters) {
 		p.timeout = timeout
 	})
 }
 
+// WithEndpointTimeout sets the maximum duration for requests to a class of endpoint,
+// keyed by path prefix (for example "/eth/v2/debug/beacon/states"), overriding the
+// timeout set by WithTimeout.  If an endpoint matches multiple prefixes the longest is used.
+// This can be supplied multiple times to set timeouts for multiple classes of endpoint.
+func WithEndpointTimeout(endpoint string, timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.endpointTimeouts[endpoint] = timeout
+	})
+}
+
+// WithEnabledEndpoints restricts requests to endpoints matching one of the given path
+// prefixes (for example "/eth/v1/validator").  Requests to other endpoints fail with
+// ErrEndpointDisabled.  This can be supplied multiple times, adding to the list.
+func WithEnabledEndpoints(endpoints []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.enabledEndpoints = append(p.enabledEndpoints, endpoints...)
+	})
+}
+
+// WithDisabledEndpoints prevents requests to endpoints matching one of the given path
+// prefixes (for example "/eth/v2/debug/beacon/states"), allowing weak nodes to be protected
+// from expensive requests.  Requests to these endpoints fail with ErrEndpointDisabled,
+// which the multi client treats as a reason to use another client.  Disabled endpoints
+// take precedence over enabled endpoints.  This can be supplied multiple times, adding to the list.
+func WithDisabledEndpoints(endpoints []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.disabledEndpoints = append(p.disabledEndpoints, endpoints...)
+	})
+}
+
+// WithSlotGuard delays requests of the given priority that would start within the
+// given period after the start of a slot, when latency-sensitive requests such as
+// block proposals are made, until that period has passed.  Delayed requests are
+// spread over the time that follows to avoid creating a burst of requests.
+// Priorities are set per endpoint or per call (see WithPriority), so this can be
+// used to move non-urgent periodic requests away from slot boundaries.
+// This can be supplied multiple times to set guards for multiple priorities.
+// High priority requests cannot be delayed.
+func WithSlotGuard(priority Priority, guard time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotGuards[priority] = guard
+	})
+}
+
+// WithCache sets a cache for responses to requests for data that does not
+// change, such as the genesis, the spec, and blocks and states referenced by
+// root.  The TTLs and maximum size of the cache are set when it is created,
+// for example with cache.New().  A cache can be shared between clients, as
+// long as they are all connected to the same chain.
+func WithCache(cache Cache) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.cache = cache
+	})
+}
+
 // WithIndexChunkSize sets the maximum number of indices to send for individual validator requests.
 func WithIndexChunkSize(indexChunkSize int) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -75,6 +206,14 @@ func WithPubKeyChunkSize(pubKeyChunkSize int) Parameter {
 	})
 }
 
+// WithChunkConcurrency sets the maximum number of chunks of a chunked validators request
+// that are fetched in parallel.
+func WithChunkConcurrency(concurrency int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chunkConcurrency = concurrency
+	})
+}
+
 // WithExtraHeaders sets additional headers to be sent with each HTTP request.
 func WithExtraHeaders(headers map[string]string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -82,14 +221,187 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithSpecOverrides sets values that override those in the spec provided by the
+// node, for example to correct wrong or missing values on devnets.  Values are
+// supplied in the format returned by the node's spec endpoint, and are converted
+// to their types in the same way, so apply to all accessors that use the spec.
+func WithSpecOverrides(overrides map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specOverrides = overrides
+	})
+}
+
+// WithMaxConcurrentRequests sets the maximum number of requests that can be in flight
+// to the endpoint at any one time.  Further requests are queued until a slot is available,
+// and are served in order of priority (see WithPriority).  A value of 0 means no limit.
+func WithMaxConcurrentRequests(maxConcurrentRequests int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxConcurrentRequests = maxConcurrentRequests
+	})
+}
+
+// WithEndpointConcurrency sets the maximum number of requests that can be in flight
+// for classes of endpoint, keyed by path prefix (for example "/eth/v2/debug/beacon/states").
+// If an endpoint matches multiple prefixes the longest is used.  These limits apply in
+// addition to the limit set by WithMaxConcurrentRequests.
+func WithEndpointConcurrency(endpointConcurrency map[string]int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.endpointConcurrency = endpointConcurrency
+	})
+}
+
+// WithQueueTimeout sets the maximum duration for which a request will wait for a
+// slot when concurrency is limited.  A value of 0 means that the request will wait
+// until its context is done.
+func WithQueueTimeout(queueTimeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.queueTimeout = queueTimeout
+	})
+}
+
+// WithJSONCodec sets the codec used to encode JSON request bodies and decode JSON responses.
+// The default is codecs.StdJSON, which uses encoding/json.
+func WithJSONCodec(jsonCodec codecs.JSONCodec) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.jsonCodec = jsonCodec
+	})
+}
+
+// WithExtensions enables access to non-standard endpoints provided by
+// specific types of node, such as Lighthouse's health endpoint.
+func WithExtensions(extensions bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.extensions = extensions
+	})
+}
+
+// WithUnknownVersionPassthrough enables passthrough of data with a consensus
+// version that is not known to this library.  If enabled, versioned data with
+// an unknown version is returned in its raw form in the Unknown field of the
+// versioned container rather than causing an error.
+func WithUnknownVersionPassthrough(unknownVersionPassthrough bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.unknownVersionPassthrough = unknownVersionPassthrough
+	})
+}
+
+// WithRequestHook adds a hook that is called when each request to the endpoint completes,
+// with information about the request including its call metadata (see WithCallMetadata).
+// This can be supplied multiple times to add multiple hooks.
+func WithRequestHook(hook RequestHook) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.requestHooks = append(p.requestHooks, hook)
+	})
+}
+
+// WithInterceptor adds an interceptor that wraps every request to the endpoint.
+// This can be supplied multiple times to add multiple interceptors, in which case
+// the first supplied is outermost: it sees each request first and each response last.
+func WithInterceptor(interceptor Interceptor) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.interceptors = append(p.interceptors, interceptor)
+	})
+}
+
+// WithSSZSubmission sets if blocks are submitted to the node as SSZ rather than JSON.
+// SSZ is considerably faster to encode and decode, especially for blocks carrying blobs.
+// If the node does not accept SSZ the block is submitted as JSON.
+// This can be overridden for individual calls with WithSSZSubmissionOverride.
+func WithSSZSubmission(sszSubmission bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.sszSubmission = sszSubmission
+	})
+}
+
+// WithPreferSSZ sets if responses from endpoints that can serve SSZ, such as beacon
+// states, blocks and blob sidecars, are requested as SSZ rather than JSON.
+// SSZ is considerably faster to decode and smaller to transfer, especially for beacon states.
+// If the node cannot provide SSZ for an endpoint the response is requested as JSON.
+// Defaults to true.
+func WithPreferSSZ(preferSSZ bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.preferSSZ = preferSSZ
+	})
+}
+
+// WithRetries sets the number of times a GET request is retried after a transient failure,
+// such as the connection being reset or the server responding with 429, 502, 503 or 504.
+// Defaults to 0, which disables retries.
+func WithRetries(retries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retries = retries
+	})
+}
+
+// WithBackoff sets the minimum and maximum time to wait before retrying a GET request.
+// The wait starts at the minimum and doubles with each retry, up to the maximum.
+// If the server supplies a Retry-After header its value is used instead.
+func WithBackoff(minBackoff time.Duration, maxBackoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.minBackoff = minBackoff
+		p.maxBackoff = maxBackoff
+	})
+}
+
+// WithEventsConnectionHandler sets a handler that is called when an events
+// stream connects and disconnects.
+func WithEventsConnectionHandler(handler EventsConnectionHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsConnectionHandler = handler
+	})
+}
+
+// WithEventsBackoff sets the minimum and maximum time to wait before reconnecting
+// a dropped events stream.  The wait starts at the minimum and doubles with each
+// failed attempt, up to the maximum, with jitter to avoid many clients
+// reconnecting at the same time.
+func WithEventsBackoff(minBackoff time.Duration, maxBackoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsMinBackoff = minBackoff
+		p.eventsMaxBackoff = maxBackoff
+	})
+}
+
+// WithEventsDeduplication drops events that have already been passed to the
+// handler of an events stream, as can happen when a node replays recent events
+// after the stream reconnects.  Events are identified by their slot or epoch
+// and block root; events without these, such as attestations, are not affected.
+func WithEventsDeduplication(deduplication bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsDeduplication = deduplication
+	})
+}
+
+// WithVerifyRoots verifies downloaded blocks and states by recomputing their
+// roots and comparing them with the roots supplied by the node in a separate
+// request, returning an IntegrityError if they differ.  The data is then
+// requested by root, so that it cannot change between the requests.
+// This is expensive for states, so is disabled by default.
+func WithVerifyRoots(verifyRoots bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.verifyRoots = verifyRoots
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:        zerolog.GlobalLevel(),
-		timeout:         2 * time.Second,
-		indexChunkSize:  -1,
-		pubKeyChunkSize: -1,
-		extraHeaders:    make(map[string]string),
+		logLevel:         zerolog.GlobalLevel(),
+		timeout:          2 * time.Second,
+		indexChunkSize:   -1,
+		pubKeyChunkSize:  -1,
+		chunkConcurrency: 1,
+		extraHeaders:     make(map[string]string),
+		specOverrides:    make(map[string]string),
+		endpointTimeouts: make(map[string]time.Duration),
+		slotGuards:       make(map[Priority]time.Duration),
+		jsonCodec:        codecs.StdJSON,
+		preferSSZ:        true,
+		minBackoff:       100 * time.Millisecond,
+		maxBackoff:       5 * time.Second,
+		eventsMinBackoff: time.Second,
+		eventsMaxBackoff: 30 * time.Second,
+		tracerProvider:   noop.NewTracerProvider(),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -103,12 +415,75 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	for prefix, timeout := range parameters.endpointTimeouts {
+		if timeout <= 0 {
+			return nil, fmt.Errorf("timeout for %s must be positive", prefix)
+		}
+	}
+	for _, endpoint := range append(append([]string{}, parameters.enabledEndpoints...), parameters.disabledEndpoints...) {
+		if !strings.HasPrefix(endpoint, "/") {
+			return nil, fmt.Errorf("endpoint %s must start with /", endpoint)
+		}
+	}
+	for priority, guard := range parameters.slotGuards {
+		if priority == PriorityHigh {
+			return nil, errors.New("slot guard cannot be set for high priority requests")
+		}
+		if guard <= 0 {
+			return nil, fmt.Errorf("slot guard for %s priority must be positive", priority)
+		}
+	}
+	if parameters.tracerProvider == nil {
+		return nil, errors.New("no tracer provider specified")
+	}
+	if parameters.jsonCodec == nil {
+		return nil, errors.New("no JSON codec specified")
+	}
 	if parameters.indexChunkSize == 0 {
 		return nil, errors.New("no index chunk size specified")
 	}
 	if parameters.pubKeyChunkSize == 0 {
 		return nil, errors.New("no public key chunk size specified")
 	}
+	if parameters.chunkConcurrency < 1 {
+		return nil, errors.New("chunk concurrency must be at least 1")
+	}
+	if parameters.maxConcurrentRequests < 0 {
+		return nil, errors.New("max concurrent requests cannot be negative")
+	}
+	for prefix, limit := range parameters.endpointConcurrency {
+		if limit <= 0 {
+			return nil, fmt.Errorf("endpoint concurrency for %s must be positive", prefix)
+		}
+	}
+	if parameters.queueTimeout < 0 {
+		return nil, errors.New("queue timeout cannot be negative")
+	}
+	if parameters.retries < 0 {
+		return nil, errors.New("retries cannot be negative")
+	}
+	if parameters.minBackoff <= 0 {
+		return nil, errors.New("minimum backoff must be positive")
+	}
+	if parameters.maxBackoff < parameters.minBackoff {
+		return nil, errors.New("maximum backoff cannot be less than minimum backoff")
+	}
+	if parameters.eventsMinBackoff <= 0 {
+		return nil, errors.New("minimum events backoff must be positive")
+	}
+	if parameters.eventsMaxBackoff < parameters.eventsMinBackoff {
+		return nil, errors.New("maximum events backoff cannot be less than minimum events backoff")
+	}
+	for _, hook := range parameters.requestHooks {
+		if hook == nil {
+			return nil, errors.New("nil request hook specified")
+		}
+	}
+	for _, interceptor := range parameters.interceptors {
+		if interceptor == nil {
+			return nil, errors.New("nil interceptor specified")
+		}
+	}
 
 	return &parameters, nil
 }
@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type peerJSON struct {
+	Data *apiv1.Peer `json:"data"`
+}
+
+// Peer fetches the peer of the node with the given peer ID.
+// N.B if the peer is not known to the node this will return nil without an error.
+func (s *Service) Peer(ctx context.Context, peerID string) (*apiv1.Peer, error) {
+	if peerID == "" {
+		return nil, errors.New("no peer ID specified")
+	}
+
+	res, err := s.getJSON(ctx, fmt.Sprintf("/eth/v1/node/peers/%s", peerID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request peer")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var resp peerJSON
+	if err := s.jsonCodec.Unmarshal(res.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse peer")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no peer returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testNodeService(t *testing.T, handler http.HandlerFunc) *Service {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &Service{
+		jsonCodec:    codecs.StdJSON,
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      srv.URL,
+		client:       srv.Client(),
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+	}
+}
+
+func TestPeer(t *testing.T) {
+	tests := []struct {
+		name     string
+		peerID   string
+		status   int
+		body     string
+		expected *apiv1.Peer
+		err      string
+	}{
+		{
+			name: "NoPeerID",
+			err:  "no peer ID specified",
+		},
+		{
+			name:   "Good",
+			peerID: "a",
+			status: http.StatusOK,
+			body:   `{"data":{"peer_id":"a","enr":"enr:-a","last_seen_p2p_address":"/ip4/1.2.3.4/tcp/9000","state":"connected","direction":"inbound"}}`,
+			expected: &apiv1.Peer{
+				PeerID:             "a",
+				ENR:                "enr:-a",
+				LastSeenP2PAddress: "/ip4/1.2.3.4/tcp/9000",
+				State:              "connected",
+				Direction:          "inbound",
+			},
+		},
+		{
+			name:   "Unknown",
+			peerID: "b",
+			status: http.StatusNotFound,
+			body:   `{"code":404,"message":"Peer not found"}`,
+		},
+		{
+			name:   "NoData",
+			peerID: "a",
+			status: http.StatusOK,
+			body:   `{}`,
+			err:    "no peer returned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/node/peers/"+test.peerID, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			res, err := s.Peer(context.Background(), test.peerID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestPeerCount(t *testing.T) {
+	s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/node/peer_count", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"disconnected":"10","connecting":"2","connected":"50","disconnecting":"1"}}`))
+	})
+
+	res, err := s.PeerCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, &apiv1.PeerCount{Disconnected: 10, Connecting: 2, Connected: 50, Disconnecting: 1}, res)
+}
+
+func TestNodeIdentity(t *testing.T) {
+	s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/node/identity", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"peer_id":"a","enr":"enr:-a","p2p_addresses":["/ip4/1.2.3.4/tcp/9000"],"discovery_addresses":["/ip4/1.2.3.4/udp/9000"],"metadata":{"seq_number":"5","attnets":"0x0300000000000000","syncnets":"0x01"}}}`))
+	})
+
+	res, err := s.NodeIdentity(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "a", res.PeerID)
+	require.Equal(t, []string{"/ip4/1.2.3.4/tcp/9000"}, res.P2PAddresses)
+	require.Equal(t, uint64(5), res.Metadata.SeqNumber)
+	require.True(t, res.Metadata.Attnets.BitAt(0))
+	require.True(t, res.Metadata.Attnets.BitAt(1))
+	require.False(t, res.Metadata.Attnets.BitAt(2))
+}
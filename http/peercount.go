@@ -0,0 +1,46 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type peerCountJSON struct {
+	Data *apiv1.PeerCount `json:"data"`
+}
+
+// PeerCount fetches the number of peers of the node in each connection state.
+func (s *Service) PeerCount(ctx context.Context) (*apiv1.PeerCount, error) {
+	res, err := s.getJSON(ctx, "/eth/v1/node/peer_count")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request peer count")
+	}
+	if res.body == nil {
+		return nil, errors.New("failed to obtain peer count")
+	}
+
+	var resp peerCountJSON
+	if err := s.jsonCodec.Unmarshal(res.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse peer count")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no peer count returned")
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+type peersJSON struct {
+	Data []*apiv1.Peer `json:"data"`
+}
+
+// Peers fetches the peers of the node.
+// states and directions restrict the returned peers to those with the given connection
+// states and directions respectively.  If either is empty no filter is applied for it.
+func (s *Service) Peers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	filters := peersFilters(states, directions)
+	url := "/eth/v1/node/peers"
+	if len(filters) > 0 {
+		url = fmt.Sprintf("%s?%s", url, strings.Join(filters, "&"))
+	}
+
+	res, err := s.getJSON(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request peers")
+	}
+	if res.body == nil {
+		return nil, errors.New("failed to obtain peers")
+	}
+
+	var resp peersJSON
+	if err := s.jsonCodec.Unmarshal(res.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse peers")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no peers returned")
+	}
+
+	return resp.Data, nil
+}
+
+// PeersPage fetches a single page of the peers of the node.
+// states and directions are as for Peers.
+func (s *Service) PeersPage(ctx context.Context,
+	states []string,
+	directions []string,
+	opts *api.PageOpts,
+) (
+	*api.Page[*apiv1.Peer],
+	error,
+) {
+	page, err := getPage[*apiv1.Peer](ctx, s, "/eth/v1/node/peers", peersFilters(states, directions), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain peers page")
+	}
+
+	return page, nil
+}
+
+// PeersIterator returns an iterator over the peers of the node, fetching up to limit peers at a time.
+// states and directions are as for Peers.
+func (s *Service) PeersIterator(states []string, directions []string, limit uint64) *api.PageIterator[*apiv1.Peer] {
+	return api.NewPageIterator(func(ctx context.Context, opts *api.PageOpts) (*api.Page[*apiv1.Peer], error) {
+		return s.PeersPage(ctx, states, directions, opts)
+	}, limit)
+}
+
+// peersFilters returns the query parameters for the given peer states and directions.
+func peersFilters(states []string, directions []string) []string {
+	filters := make([]string, 0, len(states)+len(directions))
+	for _, state := range states {
+		filters = append(filters, fmt.Sprintf("state=%s", state))
+	}
+	for _, direction := range directions {
+		filters = append(filters, fmt.Sprintf("direction=%s", direction))
+	}
+
+	return filters
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeers(t *testing.T) {
+	tests := []struct {
+		name       string
+		states     []string
+		directions []string
+		body       string
+		query      string
+		expected   []*apiv1.Peer
+		err        string
+	}{
+		{
+			name: "All",
+			body: `{"data":[{"peer_id":"a","last_seen_p2p_address":"/ip4/1.2.3.4/tcp/9000","state":"connected","direction":"inbound"},{"peer_id":"b","enr":"enr:-b","last_seen_p2p_address":"/ip4/1.2.3.5/tcp/9000","state":"disconnected","direction":"outbound"}]}`,
+			expected: []*apiv1.Peer{
+				{PeerID: "a", LastSeenP2PAddress: "/ip4/1.2.3.4/tcp/9000", State: "connected", Direction: "inbound"},
+				{PeerID: "b", ENR: "enr:-b", LastSeenP2PAddress: "/ip4/1.2.3.5/tcp/9000", State: "disconnected", Direction: "outbound"},
+			},
+		},
+		{
+			name:       "Filtered",
+			states:     []string{"connected", "connecting"},
+			directions: []string{"inbound"},
+			body:       `{"data":[{"peer_id":"a","last_seen_p2p_address":"/ip4/1.2.3.4/tcp/9000","state":"connected","direction":"inbound"}]}`,
+			query:      "state=connected&state=connecting&direction=inbound",
+			expected: []*apiv1.Peer{
+				{PeerID: "a", LastSeenP2PAddress: "/ip4/1.2.3.4/tcp/9000", State: "connected", Direction: "inbound"},
+			},
+		},
+		{
+			name: "NoData",
+			body: `{}`,
+			err:  "no peers returned",
+		},
+		{
+			name: "Invalid",
+			body: `{"data":[{}]}`,
+			err:  "failed to parse peers: peer ID missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/node/peers", r.URL.Path)
+				require.Equal(t, test.query, r.URL.RawQuery)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec:    codecs.StdJSON,
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      srv.URL,
+				client:       srv.Client(),
+				timeout:      time.Second,
+				limiter:      newLimiter(0, nil, 0),
+				deprecations: make(map[string]*EndpointDeprecation),
+			}
+
+			res, err := s.Peers(context.Background(), test.states, test.directions)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/pkg/errors"
+)
+
+type pendingDepositsJSON struct {
+	Data []*electra.PendingDeposit `json:"data"`
+}
+
+// PendingDeposits provides the pending deposits for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+func (s *Service) PendingDeposits(ctx context.Context, stateID string) ([]*electra.PendingDeposit, error) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	res, err := s.get2(ctx, fmt.Sprintf("/eth/v1/beacon/states/%s/pending_deposits", stateID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request pending deposits")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, errors.New("failed to obtain pending deposits")
+	}
+
+	switch res.contentType {
+	case ContentTypeSSZ:
+		return s.pendingDepositsFromSSZ(res.body)
+	case ContentTypeJSON:
+		return s.pendingDepositsFromJSON(res.body)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+}
+
+func (s *Service) pendingDepositsFromSSZ(data []byte) ([]*electra.PendingDeposit, error) {
+	// PendingDeposit has a fixed size, so the list is a simple concatenation.
+	size := (&electra.PendingDeposit{}).SizeSSZ()
+	if len(data)%size != 0 {
+		return nil, fmt.Errorf("invalid pending deposits data length %d", len(data))
+	}
+
+	res := make([]*electra.PendingDeposit, len(data)/size)
+	for i := range res {
+		res[i] = &electra.PendingDeposit{}
+		if err := res[i].UnmarshalSSZ(data[i*size : (i+1)*size]); err != nil {
+			return nil, errors.Wrap(err, "failed to decode pending deposit")
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) pendingDepositsFromJSON(data []byte) ([]*electra.PendingDeposit, error) {
+	var resp pendingDepositsJSON
+	if err := s.jsonCodec.Unmarshal(data, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse pending deposits")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no pending deposits returned")
+	}
+
+	return resp.Data, nil
+}
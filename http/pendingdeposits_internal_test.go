@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingDepositsDecode(t *testing.T) {
+	deposits := []*electra.PendingDeposit{
+		{
+			Pubkey:                phase0.BLSPubKey{0x01},
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                32000000000,
+			Signature:             phase0.BLSSignature{0x02},
+			Slot:                  1,
+		},
+		{
+			Pubkey:                phase0.BLSPubKey{0x03},
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                1000000000,
+			Signature:             phase0.BLSSignature{0x04},
+			Slot:                  2,
+		},
+	}
+	data := make([]byte, 0)
+	for _, deposit := range deposits {
+		encoded, err := deposit.MarshalSSZ()
+		require.NoError(t, err)
+		data = append(data, encoded...)
+	}
+	jsonData, err := json.Marshal(&pendingDepositsJSON{Data: deposits})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		ssz      bool
+		input    []byte
+		expected []*electra.PendingDeposit
+		err      string
+	}{
+		{
+			name:     "SSZEmpty",
+			ssz:      true,
+			input:    []byte{},
+			expected: []*electra.PendingDeposit{},
+		},
+		{
+			name:  "SSZShort",
+			ssz:   true,
+			input: data[:len(data)-1],
+			err:   "invalid pending deposits data length 383",
+		},
+		{
+			name:     "SSZGood",
+			ssz:      true,
+			input:    data,
+			expected: deposits,
+		},
+		{
+			name:  "JSONDataMissing",
+			input: []byte(`{}`),
+			err:   "no pending deposits returned",
+		},
+		{
+			name:     "JSONGood",
+			input:    jsonData,
+			expected: deposits,
+		},
+	}
+
+	s := &Service{jsonCodec: codecs.StdJSON}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res []*electra.PendingDeposit
+			var err error
+			if test.ssz {
+				res, err = s.pendingDepositsFromSSZ(test.input)
+			} else {
+				res, err = s.pendingDepositsFromJSON(test.input)
+			}
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
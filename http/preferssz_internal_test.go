@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferSSZ(t *testing.T) {
+	tests := []struct {
+		name        string
+		preferSSZ   bool
+		sszStatus   int
+		accepts     []string
+		contentType ContentType
+		err         string
+	}{
+		{
+			name:        "JSON",
+			accepts:     []string{"application/json"},
+			contentType: ContentTypeJSON,
+		},
+		{
+			name:        "SSZ",
+			preferSSZ:   true,
+			accepts:     []string{"application/octet-stream"},
+			contentType: ContentTypeSSZ,
+		},
+		{
+			name:        "NotAcceptable",
+			preferSSZ:   true,
+			sszStatus:   http.StatusNotAcceptable,
+			accepts:     []string{"application/octet-stream", "application/json"},
+			contentType: ContentTypeJSON,
+		},
+		{
+			name:      "ServerError",
+			preferSSZ: true,
+			sszStatus: http.StatusInternalServerError,
+			accepts:   []string{"application/octet-stream"},
+			err:       "GET failed with status 500: {}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accepts := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				accept := r.Header.Get("Accept")
+				accepts = append(accepts, accept)
+				if accept == "application/octet-stream" {
+					if test.sszStatus != 0 {
+						w.WriteHeader(test.sszStatus)
+						_, _ = w.Write([]byte(`{}`))
+						return
+					}
+					w.Header().Set("Content-Type", "application/octet-stream")
+					_, _ = w.Write([]byte{0x01})
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec:    codecs.StdJSON,
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      srv.URL,
+				client:       srv.Client(),
+				timeout:      time.Second,
+				limiter:      newLimiter(0, nil, 0),
+				deprecations: make(map[string]*EndpointDeprecation),
+				preferSSZ:    test.preferSSZ,
+			}
+
+			res, err := s.get2(context.Background(), "/eth/v2/debug/beacon/states/head")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.contentType, res.contentType)
+			}
+			require.Equal(t, test.accepts, accepts)
+
+			// Endpoints that only serve JSON never request SSZ.
+			accepts = accepts[:0]
+			_, err = s.getJSON(context.Background(), "/eth/v1/node/peers")
+			require.NoError(t, err)
+			require.Equal(t, []string{"application/json"}, accepts)
+		})
+	}
+}
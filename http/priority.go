@@ -0,0 +1,99 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"strings"
+)
+
+// Priority is the priority of a request.
+// When the number of concurrent requests is limited, queued requests are
+// served in order of priority.  High priority requests are never queued.
+type Priority int
+
+const (
+	// PriorityUnknown is an unknown priority, and results in the default
+	// priority for the endpoint being used.
+	PriorityUnknown Priority = iota
+	// PriorityLow is for background requests, such as state downloads.
+	PriorityLow
+	// PriorityNormal is for most requests.
+	PriorityNormal
+	// PriorityHigh is for time-critical requests, such as those carrying out validator duties.
+	PriorityHigh
+)
+
+var priorityStrings = [...]string{
+	"unknown",
+	"low",
+	"normal",
+	"high",
+}
+
+// String returns a string representation of the priority.
+func (p Priority) String() string {
+	if int(p) < 0 || int(p) >= len(priorityStrings) {
+		return "unknown"
+	}
+	return priorityStrings[p]
+}
+
+type priorityContextKey struct{}
+
+// WithPriority returns a context that sets the priority for requests made with it,
+// overriding the default priority for the endpoint.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityContextKey{}, priority)
+}
+
+// endpointPriorities are the default priorities for endpoints, keyed by method and path prefix.
+// Endpoints that are not present have normal priority.
+var endpointPriorities = map[string]Priority{
+	// Time-critical validator duties.
+	"GET /eth/v1/validator/attestation_data":            PriorityHigh,
+	"GET /eth/v1/validator/aggregate_attestation":       PriorityHigh,
+	"GET /eth/v1/validator/blinded_blocks/":             PriorityHigh,
+	"GET /eth/v1/validator/sync_committee_contribution": PriorityHigh,
+	"GET /eth/v2/validator/blocks/":                     PriorityHigh,
+	"GET /eth/v3/validator/blocks/":                     PriorityHigh,
+	"POST /eth/v1/beacon/blinded_blocks":                PriorityHigh,
+	"POST /eth/v1/beacon/blocks":                        PriorityHigh,
+	"POST /eth/v2/beacon/blinded_blocks":                PriorityHigh,
+	"POST /eth/v2/beacon/blocks":                        PriorityHigh,
+	"POST /eth/v1/beacon/pool/attestations":             PriorityHigh,
+	"POST /eth/v1/beacon/pool/sync_committees":          PriorityHigh,
+	"POST /eth/v1/validator/aggregate_and_proofs":       PriorityHigh,
+	"POST /eth/v1/validator/contribution_and_proofs":    PriorityHigh,
+	// Background requests.
+	"GET /eth/v1/debug/beacon/states/": PriorityLow,
+	"GET /eth/v2/debug/beacon/states/": PriorityLow,
+	"GET /eth/v1/debug/fork_choice":    PriorityLow,
+}
+
+// requestPriority returns the priority for a request.
+func requestPriority(ctx context.Context, method string, endpoint string) Priority {
+	if priority, isPriority := ctx.Value(priorityContextKey{}).(Priority); isPriority && priority != PriorityUnknown {
+		return priority
+	}
+
+	key := method + " " + endpoint
+	for prefix, priority := range endpointPriorities {
+		if strings.HasPrefix(key, prefix) {
+			return priority
+		}
+	}
+
+	return PriorityNormal
+}
@@ -0,0 +1,298 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// proposalJSON is the JSON response for a proposal.  The metadata is also
+// supplied in headers, which take precedence if present.
+type proposalJSON struct {
+	Version                 spec.DataVersion `json:"version"`
+	ExecutionPayloadBlinded bool             `json:"execution_payload_blinded"`
+	ExecutionPayloadValue   string           `json:"execution_payload_value"`
+	ConsensusBlockValue     string           `json:"consensus_block_value"`
+	Data                    json.RawMessage  `json:"data"`
+}
+
+// Proposal fetches a proposal for signing, which may contain either a full or a blinded
+// beacon block depending on which the beacon node considers the more valuable.
+// builderBoostFactor, if supplied, is the percentage multiplier applied to the builder's
+// payload value when comparing it with the local payload value; 0 requests a local payload
+// and 100 compares the values unaltered.
+func (s *Service) Proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+	builderBoostFactor *uint64,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	// Graffiti should be 32 bytes.
+	var fixedGraffiti [32]byte
+	copy(fixedGraffiti[:], graffiti)
+
+	url := fmt.Sprintf("/eth/v3/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, fixedGraffiti)
+	if builderBoostFactor != nil {
+		url = fmt.Sprintf("%s&builder_boost_factor=%d", url, *builderBoostFactor)
+	}
+
+	res, err := s.get2(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request proposal")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	var proposal *api.VersionedProposal
+	switch res.contentType {
+	case ContentTypeSSZ:
+		proposal, err = s.proposalFromSSZ(res)
+	case ContentTypeJSON:
+		proposal, err = s.proposalFromJSON(res)
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the data returned to us is as expected given our input.
+	proposalSlot, err := proposal.Slot()
+	if err != nil {
+		return nil, err
+	}
+	if proposalSlot != slot {
+		return nil, errors.New("proposal not for requested slot")
+	}
+
+	// Only check the RANDAO reveal and graffiti if we are not connected to DVT middleware,
+	// as the returned values will be decided by the middleware.
+	if !s.connectedToDVTMiddleware {
+		proposalRandaoReveal, proposalGraffiti, err := proposalRandaoRevealAndGraffiti(proposal)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(proposalRandaoReveal[:], randaoReveal[:]) {
+			return nil, fmt.Errorf("proposal has RANDAO reveal %#x; expected %#x", proposalRandaoReveal[:], randaoReveal[:])
+		}
+		if !bytes.Equal(proposalGraffiti[:], fixedGraffiti[:]) {
+			return nil, fmt.Errorf("proposal has graffiti %#x; expected %#x", proposalGraffiti[:], fixedGraffiti[:])
+		}
+	}
+
+	return proposal, nil
+}
+
+func (s *Service) proposalFromSSZ(res *httpResponse) (*api.VersionedProposal, error) {
+	blinded, err := proposalBlindedFromHeaders(res.headers)
+	if err != nil {
+		return nil, err
+	}
+	proposal, block, err := newVersionedProposal(res.consensusVersion, blinded)
+	if err != nil {
+		return nil, err
+	}
+	if err := block.UnmarshalSSZ(res.body); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to decode %s proposal", res.consensusVersion))
+	}
+	if err := setProposalValues(proposal, res.headers, "", ""); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (s *Service) proposalFromJSON(res *httpResponse) (*api.VersionedProposal, error) {
+	var resp proposalJSON
+	if err := s.jsonCodec.Unmarshal(res.body, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposal")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no proposal returned")
+	}
+
+	blinded := resp.ExecutionPayloadBlinded
+	if res.headers.Get("Eth-Execution-Payload-Blinded") != "" {
+		var err error
+		blinded, err = proposalBlindedFromHeaders(res.headers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	proposal, block, err := newVersionedProposal(resp.Version, blinded)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.jsonCodec.Unmarshal(resp.Data, block); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse %s proposal", resp.Version))
+	}
+	if err := setProposalValues(proposal, res.headers, resp.ExecutionPayloadValue, resp.ConsensusBlockValue); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// proposalBlindedFromHeaders returns the blinded flag from the response headers.
+func proposalBlindedFromHeaders(headers http.Header) (bool, error) {
+	value := headers.Get("Eth-Execution-Payload-Blinded")
+	if value == "" {
+		return false, errors.New("no execution payload blinded header supplied in response")
+	}
+	blinded, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid value for execution payload blinded header")
+	}
+
+	return blinded, nil
+}
+
+// setProposalValues sets the values of the proposal from the response headers, falling
+// back to the supplied values from the response body.
+func setProposalValues(proposal *api.VersionedProposal, headers http.Header, executionPayloadValue string, consensusBlockValue string) error {
+	if value := headers.Get("Eth-Execution-Payload-Value"); value != "" {
+		executionPayloadValue = value
+	}
+	if executionPayloadValue != "" {
+		value, success := new(big.Int).SetString(executionPayloadValue, 10)
+		if !success {
+			return fmt.Errorf("invalid value %s for execution payload value", executionPayloadValue)
+		}
+		proposal.ExecutionPayloadValue = value
+	}
+
+	if value := headers.Get("Eth-Consensus-Block-Value"); value != "" {
+		consensusBlockValue = value
+	}
+	if consensusBlockValue != "" {
+		value, success := new(big.Int).SetString(consensusBlockValue, 10)
+		if !success {
+			return fmt.Errorf("invalid value %s for consensus block value", consensusBlockValue)
+		}
+		proposal.ConsensusBlockValue = value
+	}
+
+	return nil
+}
+
+// newVersionedProposal creates an empty proposal for the given version, returning
+// the block within it into which the response should be decoded.
+func newVersionedProposal(version spec.DataVersion, blinded bool) (*api.VersionedProposal, sszUnmarshaler, error) {
+	proposal := &api.VersionedProposal{
+		Version:                 version,
+		ExecutionPayloadBlinded: blinded,
+	}
+
+	if blinded {
+		proposal.BlindedBlock = &api.VersionedBlindedBeaconBlock{Version: version}
+		switch version {
+		case spec.DataVersionBellatrix:
+			proposal.BlindedBlock.Bellatrix = &apiv1bellatrix.BlindedBeaconBlock{}
+			return proposal, proposal.BlindedBlock.Bellatrix, nil
+		case spec.DataVersionCapella:
+			proposal.BlindedBlock.Capella = &apiv1capella.BlindedBeaconBlock{}
+			return proposal, proposal.BlindedBlock.Capella, nil
+		case spec.DataVersionDeneb:
+			proposal.BlindedBlock.Deneb = &apiv1deneb.BlindedBeaconBlock{}
+			return proposal, proposal.BlindedBlock.Deneb, nil
+		case spec.DataVersionElectra:
+			proposal.BlindedBlock.Electra = &apiv1electra.BlindedBeaconBlock{}
+			return proposal, proposal.BlindedBlock.Electra, nil
+		default:
+			return nil, nil, fmt.Errorf("unsupported blinded proposal version %s", version)
+		}
+	}
+
+	proposal.Block = &spec.VersionedBeaconBlock{Version: version}
+	switch version {
+	case spec.DataVersionPhase0:
+		proposal.Block.Phase0 = &phase0.BeaconBlock{}
+		return proposal, proposal.Block.Phase0, nil
+	case spec.DataVersionAltair:
+		proposal.Block.Altair = &altair.BeaconBlock{}
+		return proposal, proposal.Block.Altair, nil
+	case spec.DataVersionBellatrix:
+		proposal.Block.Bellatrix = &bellatrix.BeaconBlock{}
+		return proposal, proposal.Block.Bellatrix, nil
+	case spec.DataVersionCapella:
+		proposal.Block.Capella = &capella.BeaconBlock{}
+		return proposal, proposal.Block.Capella, nil
+	case spec.DataVersionDeneb:
+		proposal.Block.Deneb = &deneb.BeaconBlock{}
+		return proposal, proposal.Block.Deneb, nil
+	case spec.DataVersionElectra:
+		proposal.Block.Electra = &electra.BeaconBlock{}
+		return proposal, proposal.Block.Electra, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported proposal version %s", version)
+	}
+}
+
+// proposalRandaoRevealAndGraffiti returns the RANDAO reveal and graffiti of the proposal.
+func proposalRandaoRevealAndGraffiti(proposal *api.VersionedProposal) (phase0.BLSSignature, [32]byte, error) {
+	if proposal.ExecutionPayloadBlinded {
+		randaoReveal, err := proposal.BlindedBlock.RandaoReveal()
+		if err != nil {
+			return phase0.BLSSignature{}, [32]byte{}, err
+		}
+		graffiti, err := proposal.BlindedBlock.Graffiti()
+		if err != nil {
+			return phase0.BLSSignature{}, [32]byte{}, err
+		}
+
+		return randaoReveal, graffiti, nil
+	}
+
+	block := proposal.Block
+	switch {
+	case block.Phase0 != nil && block.Phase0.Body != nil:
+		return block.Phase0.Body.RANDAOReveal, block.Phase0.Body.Graffiti, nil
+	case block.Altair != nil && block.Altair.Body != nil:
+		return block.Altair.Body.RANDAOReveal, block.Altair.Body.Graffiti, nil
+	case block.Bellatrix != nil && block.Bellatrix.Body != nil:
+		return block.Bellatrix.Body.RANDAOReveal, block.Bellatrix.Body.Graffiti, nil
+	case block.Capella != nil && block.Capella.Body != nil:
+		return block.Capella.Body.RANDAOReveal, block.Capella.Body.Graffiti, nil
+	case block.Deneb != nil && block.Deneb.Body != nil:
+		return block.Deneb.Body.RANDAOReveal, block.Deneb.Body.Graffiti, nil
+	case block.Electra != nil && block.Electra.Body != nil:
+		return block.Electra.Body.RANDAOReveal, block.Electra.Body.Graffiti, nil
+	default:
+		return phase0.BLSSignature{}, [32]byte{}, errors.New("no proposal block body")
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testProposalBlock(slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti [32]byte) *phase0.BeaconBlock {
+	return &phase0.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: 1,
+		Body: &phase0.BeaconBlockBody{
+			RANDAOReveal: randaoReveal,
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			Graffiti:          graffiti,
+			ProposerSlashings: []*phase0.ProposerSlashing{},
+			AttesterSlashings: []*phase0.AttesterSlashing{},
+			Attestations:      []*phase0.Attestation{},
+			Deposits:          []*phase0.Deposit{},
+			VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+		},
+	}
+}
+
+func testProposalBlindedBlock(slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti [32]byte) *apiv1bellatrix.BlindedBeaconBlock {
+	return &apiv1bellatrix.BlindedBeaconBlock{
+		Slot:          slot,
+		ProposerIndex: 1,
+		Body: &apiv1bellatrix.BlindedBeaconBlockBody{
+			RANDAOReveal: randaoReveal,
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			Graffiti:          graffiti,
+			ProposerSlashings: []*phase0.ProposerSlashing{},
+			AttesterSlashings: []*phase0.AttesterSlashing{},
+			Attestations:      []*phase0.Attestation{},
+			Deposits:          []*phase0.Deposit{},
+			VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayloadHeader: &bellatrix.ExecutionPayloadHeader{
+				BaseFeePerGas: [32]byte{0x01},
+				ExtraData:     []byte{},
+			},
+		},
+	}
+}
+
+func testProposalElectraBlindedBlock(slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti [32]byte) *apiv1electra.BlindedBeaconBlock {
+	return &apiv1electra.BlindedBeaconBlock{
+		Slot:          slot,
+		ProposerIndex: 1,
+		Body: &apiv1electra.BlindedBeaconBlockBody{
+			RANDAOReveal: randaoReveal,
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			Graffiti:          graffiti,
+			ProposerSlashings: []*phase0.ProposerSlashing{},
+			AttesterSlashings: []*electra.AttesterSlashing{},
+			Attestations:      []*electra.Attestation{},
+			Deposits:          []*phase0.Deposit{},
+			VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+				BaseFeePerGas: uint256.NewInt(1),
+				ExtraData:     []byte{},
+			},
+			BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+			BlobKzgCommitments:    []deneb.KzgCommitment{},
+			ExecutionRequests: &electra.ExecutionRequests{
+				Deposits:       []*electra.DepositRequest{},
+				Withdrawals:    []*electra.WithdrawalRequest{},
+				Consolidations: []*electra.ConsolidationRequest{},
+			},
+		},
+	}
+}
+
+func TestProposal(t *testing.T) {
+	ctx := context.Background()
+
+	slot := phase0.Slot(12345)
+	randaoReveal := phase0.BLSSignature{0x01, 0x02}
+	graffiti := [32]byte{'t', 'e', 's', 't'}
+	boostFactor := uint64(90)
+
+	block := testProposalBlock(slot, randaoReveal, graffiti)
+	blockSSZ, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	blockJSON, err := json.Marshal(block)
+	require.NoError(t, err)
+	blindedBlockJSON, err := json.Marshal(testProposalBlindedBlock(slot, randaoReveal, graffiti))
+	require.NoError(t, err)
+	electraBlindedBlockJSON, err := json.Marshal(testProposalElectraBlindedBlock(slot, randaoReveal, graffiti))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                  string
+		slot                  phase0.Slot
+		builderBoostFactor    *uint64
+		status                int
+		contentType           string
+		headers               map[string]string
+		body                  []byte
+		expectedQuery         string
+		blinded               bool
+		version               spec.DataVersion
+		executionPayloadValue *big.Int
+		consensusBlockValue   *big.Int
+		notFound              bool
+		err                   string
+	}{
+		{
+			name:     "NotFound",
+			slot:     slot,
+			status:   http.StatusNotFound,
+			notFound: true,
+		},
+		{
+			name:                  "JSONFull",
+			slot:                  slot,
+			status:                http.StatusOK,
+			contentType:           "application/json",
+			body:                  []byte(fmt.Sprintf(`{"version":"phase0","execution_payload_blinded":false,"execution_payload_value":"12345","consensus_block_value":"67890","data":%s}`, string(blockJSON))),
+			version:               spec.DataVersionPhase0,
+			executionPayloadValue: big.NewInt(12345),
+			consensusBlockValue:   big.NewInt(67890),
+		},
+		{
+			name:        "JSONBlindedHeaders",
+			slot:        slot,
+			status:      http.StatusOK,
+			contentType: "application/json",
+			headers: map[string]string{
+				"Eth-Execution-Payload-Blinded": "true",
+				"Eth-Execution-Payload-Value":   "1000000000000000000000",
+				"Eth-Consensus-Block-Value":     "2",
+			},
+			body:                  []byte(fmt.Sprintf(`{"version":"bellatrix","data":%s}`, string(blindedBlockJSON))),
+			blinded:               true,
+			version:               spec.DataVersionBellatrix,
+			executionPayloadValue: new(big.Int).Mul(big.NewInt(1000000000000), big.NewInt(1000000000)),
+			consensusBlockValue:   big.NewInt(2),
+		},
+		{
+			name:        "JSONBlindedElectra",
+			slot:        slot,
+			status:      http.StatusOK,
+			contentType: "application/json",
+			headers: map[string]string{
+				"Eth-Execution-Payload-Blinded": "true",
+			},
+			body:    []byte(fmt.Sprintf(`{"version":"electra","data":%s}`, string(electraBlindedBlockJSON))),
+			blinded: true,
+			version: spec.DataVersionElectra,
+		},
+		{
+			name:               "SSZFull",
+			slot:               slot,
+			builderBoostFactor: &boostFactor,
+			status:             http.StatusOK,
+			contentType:        "application/octet-stream",
+			headers: map[string]string{
+				"Eth-Consensus-Version":         "phase0",
+				"Eth-Execution-Payload-Blinded": "false",
+				"Eth-Execution-Payload-Value":   "3",
+				"Eth-Consensus-Block-Value":     "4",
+			},
+			body:                  blockSSZ,
+			expectedQuery:         "90",
+			version:               spec.DataVersionPhase0,
+			executionPayloadValue: big.NewInt(3),
+			consensusBlockValue:   big.NewInt(4),
+		},
+		{
+			name:        "SSZBlindedHeaderMissing",
+			slot:        slot,
+			status:      http.StatusOK,
+			contentType: "application/octet-stream",
+			headers: map[string]string{
+				"Eth-Consensus-Version": "phase0",
+			},
+			body: blockSSZ,
+			err:  "no execution payload blinded header supplied in response",
+		},
+		{
+			name:        "InvalidValue",
+			slot:        slot,
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        []byte(fmt.Sprintf(`{"version":"phase0","execution_payload_value":"bad","data":%s}`, string(blockJSON))),
+			err:         "invalid value bad for execution payload value",
+		},
+		{
+			name:        "BlindedUnsupportedVersion",
+			slot:        slot,
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        []byte(fmt.Sprintf(`{"version":"phase0","execution_payload_blinded":true,"data":%s}`, string(blockJSON))),
+			err:         "unsupported blinded proposal version phase0",
+		},
+		{
+			name:        "WrongSlot",
+			slot:        slot + 1,
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        []byte(fmt.Sprintf(`{"version":"phase0","data":%s}`, string(blockJSON))),
+			err:         "proposal not for requested slot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testNodeService(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, fmt.Sprintf("/eth/v3/validator/blocks/%d", test.slot), r.URL.Path)
+				require.Equal(t, test.expectedQuery, r.URL.Query().Get("builder_boost_factor"))
+				if test.contentType != "" {
+					w.Header().Set("Content-Type", test.contentType)
+				}
+				for k, v := range test.headers {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(test.status)
+				_, _ = w.Write(test.body)
+			})
+
+			res, err := s.Proposal(ctx, test.slot, randaoReveal, graffiti[:4], test.builderBoostFactor)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if test.notFound {
+				require.Nil(t, res)
+				return
+			}
+			require.Equal(t, test.version, res.Version)
+			require.Equal(t, test.blinded, res.ExecutionPayloadBlinded)
+			require.Equal(t, test.executionPayloadValue, res.ExecutionPayloadValue)
+			require.Equal(t, test.consensusBlockValue, res.ConsensusBlockValue)
+			require.False(t, res.IsEmpty())
+			proposalSlot, err := res.Slot()
+			require.NoError(t, err)
+			require.Equal(t, test.slot, proposalSlot)
+		})
+	}
+}
+
+func TestProposalRandaoMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	slot := phase0.Slot(1)
+	blockJSON, err := json.Marshal(testProposalBlock(slot, phase0.BLSSignature{0x01}, [32]byte{}))
+	require.NoError(t, err)
+
+	s := testNodeService(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"version":"phase0","data":%s}`, string(blockJSON))))
+	})
+
+	_, err = s.Proposal(ctx, slot, phase0.BLSSignature{0x02}, nil, nil)
+	require.ErrorContains(t, err, "proposal has RANDAO reveal")
+
+	// DVT middleware supplies its own RANDAO reveal, so it is not checked.
+	s.connectedToDVTMiddleware = true
+	res, err := s.Proposal(ctx, slot, phase0.BLSSignature{0x02}, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -39,7 +38,7 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch phase0.Epoch, valida
 	}
 
 	var resp proposerDutiesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse proposer duties response")
 	}
 
@@ -0,0 +1,178 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// doGet sends a GET request to the given URL and reads its response, retrying
+// transient failures as per the retry policy of the service.
+// The body of the returned response has already been read, and is returned separately.
+func (s *Service) doGet(ctx context.Context,
+	log zerolog.Logger,
+	endpoint string,
+	url string,
+	accept string,
+) (
+	*http.Response,
+	[]byte,
+	error,
+) {
+	for attempt := 0; ; attempt++ {
+		resp, body, err := s.doGetAttempt(ctx, endpoint, url, accept)
+		if attempt >= s.retries || !retryable(ctx, resp, err) {
+			return resp, body, err
+		}
+
+		delay := s.retryDelay(attempt, resp)
+		if deadline, hasDeadline := ctx.Deadline(); hasDeadline && time.Now().Add(delay).After(deadline) {
+			// No time to retry.
+			return resp, body, err
+		}
+		e := log.Debug().Int("attempt", attempt+1).Dur("delay", delay)
+		if err != nil {
+			e = e.Err(err)
+		} else {
+			e = e.Int("status_code", resp.StatusCode)
+		}
+		e.Msg("Transient failure; retrying GET request")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, errors.Wrap(ctx.Err(), "context done while waiting to retry GET request")
+		case <-timer.C:
+		}
+	}
+}
+
+// doGetAttempt sends a single GET request to the given URL and reads its response.
+func (s *Service) doGetAttempt(ctx context.Context,
+	endpoint string,
+	url string,
+	accept string,
+) (
+	*http.Response,
+	[]byte,
+	error,
+) {
+	opCtx, cancel := context.WithTimeout(ctx, s.endpointTimeout(endpoint))
+	defer cancel()
+	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create GET request")
+	}
+	s.addExtraHeaders(req)
+	injectTraceContext(req)
+	req.Header.Set("Accept", accept)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to call GET endpoint")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read GET response")
+	}
+	if err := checkContentLength(endpoint, resp, body); err != nil {
+		return nil, nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, body, nil
+}
+
+// retryable returns true if a request failed in a way that may succeed if retried.
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		// Caller has given up.
+		return false
+	}
+
+	if err != nil {
+		var integrityErr IntegrityError
+
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF) ||
+			errors.As(err, &integrityErr)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay returns the time to wait before retrying a failed request.
+// A Retry-After header supplied by the server takes precedence over the backoff
+// of the service.
+func (s *Service) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, present := retryAfter(resp.Header.Get("Retry-After")); present {
+			return delay
+		}
+	}
+
+	delay := s.minBackoff
+	for i := 0; i < attempt && delay < s.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.maxBackoff {
+		delay = s.maxBackoff
+	}
+
+	return delay
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a number
+// of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
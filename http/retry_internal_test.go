@@ -0,0 +1,198 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		retries    int
+		failures   int
+		failStatus int
+		retryAfter string
+		attempts   int
+		err        string
+	}{
+		{
+			name:       "Success",
+			retries:    2,
+			failStatus: http.StatusServiceUnavailable,
+			attempts:   1,
+		},
+		{
+			name:       "Disabled",
+			failures:   1,
+			failStatus: http.StatusServiceUnavailable,
+			attempts:   1,
+			err:        "GET failed with status 503: ",
+		},
+		{
+			name:       "Recovered",
+			retries:    2,
+			failures:   2,
+			failStatus: http.StatusBadGateway,
+			attempts:   3,
+		},
+		{
+			name:       "RetryAfter",
+			retries:    1,
+			failures:   1,
+			failStatus: http.StatusTooManyRequests,
+			retryAfter: "0",
+			attempts:   2,
+		},
+		{
+			name:       "Exhausted",
+			retries:    2,
+			failures:   5,
+			failStatus: http.StatusGatewayTimeout,
+			attempts:   3,
+			err:        "GET failed with status 504: ",
+		},
+		{
+			name:       "NotTransient",
+			retries:    2,
+			failures:   1,
+			failStatus: http.StatusInternalServerError,
+			attempts:   1,
+			err:        "GET failed with status 500: ",
+		},
+		{
+			name:     "ConnectionClosed",
+			retries:  1,
+			failures: 1,
+			attempts: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				attempts++
+				if attempts <= test.failures {
+					if test.failStatus == 0 {
+						// Close the connection without a response.
+						conn, _, err := w.(http.Hijacker).Hijack()
+						require.NoError(t, err)
+						require.NoError(t, conn.Close())
+						return
+					}
+					if test.retryAfter != "" {
+						w.Header().Set("Retry-After", test.retryAfter)
+					}
+					w.WriteHeader(test.failStatus)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec:    codecs.StdJSON,
+				log:          zerolog.Nop(),
+				base:         base,
+				address:      srv.URL,
+				client:       srv.Client(),
+				timeout:      time.Second,
+				limiter:      newLimiter(0, nil, 0),
+				deprecations: make(map[string]*EndpointDeprecation),
+				retries:      test.retries,
+				minBackoff:   time.Millisecond,
+				maxBackoff:   10 * time.Millisecond,
+			}
+
+			_, err = s.get(context.Background(), "/eth/v1/node/version")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.attempts, attempts)
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	s := &Service{
+		jsonCodec:  codecs.StdJSON,
+		minBackoff: 100 * time.Millisecond,
+		maxBackoff: time.Second,
+	}
+
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		expected   time.Duration
+	}{
+		{
+			name:     "First",
+			expected: 100 * time.Millisecond,
+		},
+		{
+			name:     "Third",
+			attempt:  2,
+			expected: 400 * time.Millisecond,
+		},
+		{
+			name:     "Capped",
+			attempt:  10,
+			expected: time.Second,
+		},
+		{
+			name:       "RetryAfterSeconds",
+			retryAfter: "3",
+			expected:   3 * time.Second,
+		},
+		{
+			name:       "RetryAfterPastDate",
+			retryAfter: "Mon, 02 Jan 2006 15:04:05 GMT",
+			expected:   0,
+		},
+		{
+			name:       "RetryAfterInvalid",
+			attempt:    1,
+			retryAfter: "soon",
+			expected:   200 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{
+				Header: http.Header{},
+			}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+			require.Equal(t, test.expected, s.retryDelay(test.attempt, resp))
+		})
+	}
+}
@@ -21,14 +21,17 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Service is an Ethereum 2 client service.
@@ -40,6 +43,12 @@ type Service struct {
 	address string
 	client  *http.Client
 	timeout time.Duration
+	// Timeouts for classes of endpoint, longest prefix first.
+	endpointTimeouts []endpointTimeout
+
+	// Endpoints to which requests may and may not be made.
+	enabledEndpoints  []string
+	disabledEndpoints []string
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
@@ -58,9 +67,70 @@ type Service struct {
 	userIndexChunkSize  int
 	userPubKeyChunkSize int
 	extraHeaders        map[string]string
+	specOverrides       map[string]string
+
+	// Number of chunks of a chunked validators request fetched in parallel.
+	chunkConcurrency int
+	// validatorsPostUnsupported is set if the node does not support POST requests for validators.
+	validatorsPostUnsupported atomic.Bool
+	// validatorBalancesPostUnsupported is set if the node does not support POST requests for validator balances.
+	validatorBalancesPostUnsupported atomic.Bool
+
+	// Codec for request and response bodies.
+	jsonCodec codecs.JSONCodec
+
+	// Concurrency limits.
+	limiter *limiter
+
+	// Hooks called on completion of requests.
+	requestHooks []RequestHook
+
+	// Per-endpoint request metrics.
+	endpointMetrics *endpointMetrics
+
+	// Tracer for request spans.
+	tracer trace.Tracer
+
+	// Scheduler for requests around slot boundaries.
+	slotScheduler *slotScheduler
+
+	// Cache of responses for immutable data.
+	cache Cache
+
+	// Events stream connections.
+	eventsConnectionHandler EventsConnectionHandlerFunc
+	eventsMinBackoff        time.Duration
+	eventsMaxBackoff        time.Duration
+	eventsDeduplication     bool
+	eventTopics             map[string]bool
+	eventTopicsMu           sync.RWMutex
+
+	// Verification of the roots of downloaded blocks and states.
+	verifyRoots bool
+
+	// Submission of blocks as SSZ.
+	sszSubmission bool
+
+	// Preference for SSZ responses.
+	preferSSZ bool
+
+	// Retry policy.
+	retries    int
+	minBackoff time.Duration
+	maxBackoff time.Duration
 
 	// Endpoint support.
 	connectedToDVTMiddleware bool
+	extensions               bool
+
+	// Passthrough of data for unknown consensus versions.
+	unknownVersionPassthrough bool
+
+	// Endpoint versions and deprecations.
+	endpointVersions   map[string]string
+	endpointVersionsMu sync.RWMutex
+	deprecations       map[string]*EndpointDeprecation
+	deprecationsMu     sync.RWMutex
 }
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
@@ -76,9 +146,14 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.Wrap(err, "failed to register metrics")
+	}
+
 	client := &http.Client{
-		Timeout: parameters.timeout,
-		Transport: &http.Transport{
+		// Requests are also limited by their per-endpoint timeout.
+		Timeout: maxTimeout(parameters.timeout, parameters.endpointTimeouts),
+		Transport: intercept(&http.Transport{
 			DialContext: (&net.Dialer{
 				Timeout:   parameters.timeout,
 				KeepAlive: 30 * time.Second,
@@ -88,7 +163,7 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 			MaxConnsPerHost:     64,
 			MaxIdleConnsPerHost: 64,
 			IdleConnTimeout:     600 * time.Second,
-		},
+		}, parameters.interceptors),
 	}
 
 	address := parameters.address
@@ -103,15 +178,45 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		return nil, errors.Wrap(err, "invalid URL")
 	}
 
+	endpointMetrics, err := newEndpointMetrics(parameters.registerer, parameters.address)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Service{
-		log:                 log,
-		base:                base,
-		address:             parameters.address,
-		client:              client,
-		timeout:             parameters.timeout,
-		userIndexChunkSize:  parameters.indexChunkSize,
-		userPubKeyChunkSize: parameters.pubKeyChunkSize,
-		extraHeaders:        parameters.extraHeaders,
+		log:                       log,
+		base:                      base,
+		address:                   parameters.address,
+		client:                    client,
+		timeout:                   parameters.timeout,
+		endpointTimeouts:          newEndpointTimeouts(parameters.endpointTimeouts),
+		enabledEndpoints:          parameters.enabledEndpoints,
+		disabledEndpoints:         parameters.disabledEndpoints,
+		userIndexChunkSize:        parameters.indexChunkSize,
+		userPubKeyChunkSize:       parameters.pubKeyChunkSize,
+		chunkConcurrency:          parameters.chunkConcurrency,
+		extraHeaders:              parameters.extraHeaders,
+		specOverrides:             parameters.specOverrides,
+		jsonCodec:                 parameters.jsonCodec,
+		extensions:                parameters.extensions,
+		unknownVersionPassthrough: parameters.unknownVersionPassthrough,
+		endpointVersions:          make(map[string]string),
+		deprecations:              make(map[string]*EndpointDeprecation),
+		limiter:                   newLimiter(parameters.maxConcurrentRequests, parameters.endpointConcurrency, parameters.queueTimeout),
+		requestHooks:              parameters.requestHooks,
+		endpointMetrics:           endpointMetrics,
+		tracer:                    parameters.tracerProvider.Tracer(tracerName),
+		sszSubmission:             parameters.sszSubmission,
+		preferSSZ:                 parameters.preferSSZ,
+		cache:                     parameters.cache,
+		eventsConnectionHandler:   parameters.eventsConnectionHandler,
+		eventsMinBackoff:          parameters.eventsMinBackoff,
+		eventsMaxBackoff:          parameters.eventsMaxBackoff,
+		eventsDeduplication:       parameters.eventsDeduplication,
+		verifyRoots:               parameters.verifyRoots,
+		retries:                   parameters.retries,
+		minBackoff:                parameters.minBackoff,
+		maxBackoff:                parameters.maxBackoff,
 	}
 
 	// Fetch static values to confirm the connection is good.
@@ -119,6 +224,12 @@ func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
 
+	if len(parameters.slotGuards) > 0 {
+		if err := s.setSlotScheduler(ctx, parameters.slotGuards); err != nil {
+			return nil, err
+		}
+	}
+
 	// Periodially refetch static values in case of client update.
 	s.periodicClearStaticValues(ctx)
 
@@ -183,6 +294,9 @@ func (s *Service) periodicClearStaticValues(ctx context.Context) {
 				s.nodeVersionMutex.Lock()
 				s.nodeVersion = ""
 				s.nodeVersionMutex.Unlock()
+				s.endpointVersionsMu.Lock()
+				s.endpointVersions = make(map[string]string)
+				s.endpointVersionsMu.Unlock()
 			case <-ctx.Done():
 				return
 			}
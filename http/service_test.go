@@ -25,6 +25,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// Ensure that the service provides the full client interface.
+var _ client.FullClient = (*v1.Service)(nil)
+
 func TestService(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -54,6 +57,15 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no timeout specified",
 		},
+		{
+			name: "JSONCodecNil",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithJSONCodec(nil),
+			},
+			err: "problem with parameters: no JSON codec specified",
+		},
 		{
 			name: "AddressInvalid",
 			parameters: []v1.Parameter{
@@ -80,6 +92,33 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no public key chunk size specified",
 		},
+		{
+			name: "MaxConcurrentRequestsNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxConcurrentRequests(-1),
+			},
+			err: "problem with parameters: max concurrent requests cannot be negative",
+		},
+		{
+			name: "EndpointConcurrencyZero",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithEndpointConcurrency(map[string]int{"/eth/v2/debug/beacon/states": 0}),
+			},
+			err: "problem with parameters: endpoint concurrency for /eth/v2/debug/beacon/states must be positive",
+		},
+		{
+			name: "QueueTimeoutNegative",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithQueueTimeout(-1),
+			},
+			err: "problem with parameters: queue timeout cannot be negative",
+		},
 		{
 			name: "Good",
 			parameters: []v1.Parameter{
@@ -87,6 +126,16 @@ func TestService(t *testing.T) {
 				v1.WithTimeout(5 * time.Second),
 			},
 		},
+		{
+			name: "GoodConcurrencyLimited",
+			parameters: []v1.Parameter{
+				v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+				v1.WithTimeout(5 * time.Second),
+				v1.WithMaxConcurrentRequests(4),
+				v1.WithEndpointConcurrency(map[string]int{"/eth/v2/debug/beacon/states": 1}),
+				v1.WithQueueTimeout(time.Second),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -113,28 +162,40 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttestationDataProvider)(nil), s)
 	assert.Implements(t, (*client.AttestationPoolProvider)(nil), s)
+	assert.Implements(t, (*client.AttestationPoolPageProvider)(nil), s)
+	assert.Implements(t, (*client.AttestationPoolStreamer)(nil), s)
 	assert.Implements(t, (*client.AttestationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.AttesterDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.BLSToExecutionChangesSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), s)
+	assert.Implements(t, (*client.BlockRewardsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingConsolidationsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingPartialWithdrawalsProvider)(nil), s)
+	assert.Implements(t, (*client.PendingDepositsProvider)(nil), s)
+	assert.Implements(t, (*client.SyncCommitteeRewardsProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockProposalProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockRootProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BeaconBlockRawSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.BeaconStateProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRandaoProvider)(nil), s)
 	assert.Implements(t, (*client.BeaconStateRootProvider)(nil), s)
 	assert.Implements(t, (*client.BlindedBeaconBlockSubmitter)(nil), s)
+	assert.Implements(t, (*client.BlindedBeaconBlockRawSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorRegistrationsSubmitter)(nil), s)
 	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
 	assert.Implements(t, (*client.EventsProvider)(nil), s)
+	assert.Implements(t, (*client.EventTopicsProvider)(nil), s)
 	assert.Implements(t, (*client.FinalityProvider)(nil), s)
 	assert.Implements(t, (*client.ForkProvider)(nil), s)
 	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
 	assert.Implements(t, (*client.GenesisProvider)(nil), s)
 	assert.Implements(t, (*client.NodeSyncingProvider)(nil), s)
+	assert.Implements(t, (*client.OptimisticHeadProvider)(nil), s)
 	assert.Implements(t, (*client.ProposerDutiesProvider)(nil), s)
 	assert.Implements(t, (*client.ProposalPreparationsSubmitter)(nil), s)
+	assert.Implements(t, (*client.ProposalProvider)(nil), s)
 	assert.Implements(t, (*client.SpecProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeContributionsSubmitter)(nil), s)
@@ -143,7 +204,9 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.SyncCommitteesProvider)(nil), s)
 	assert.Implements(t, (*client.SyncCommitteeSubscriptionsSubmitter)(nil), s)
 	assert.Implements(t, (*client.ValidatorBalancesProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorIdentitiesProvider)(nil), s)
 	assert.Implements(t, (*client.ValidatorsProvider)(nil), s)
+	assert.Implements(t, (*client.ValidatorsStreamer)(nil), s)
 	assert.Implements(t, (*client.VoluntaryExitSubmitter)(nil), s)
 
 	// Non-standard extensions.
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -25,6 +24,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -49,9 +49,22 @@ type denebSignedBeaconBlockJSON struct {
 	Data *deneb.SignedBeaconBlock `json:"data"`
 }
 
+type electraSignedBeaconBlockJSON struct {
+	Data *electra.SignedBeaconBlock `json:"data"`
+}
+
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
 // N.B if a signed beacon block for the block ID is not available this will return nil without an error.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
+	if s.verifyRoots {
+		return s.verifiedSignedBeaconBlock(ctx, blockID)
+	}
+
+	return s.signedBeaconBlock(ctx, blockID)
+}
+
+// signedBeaconBlock fetches a signed beacon block without verification.
+func (s *Service) signedBeaconBlock(ctx context.Context, blockID string) (*spec.VersionedSignedBeaconBlock, error) {
 	res, err := s.get2(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request signed beacon block")
@@ -60,6 +73,18 @@ func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.
 		return nil, nil
 	}
 
+	if res.unknownConsensusVersion != "" {
+		data, err := s.unknownVersionData(res)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain signed beacon block of unknown version")
+		}
+
+		return &spec.VersionedSignedBeaconBlock{
+			Version: spec.DataVersionUnknown,
+			Unknown: data,
+		}, nil
+	}
+
 	switch res.contentType {
 	case ContentTypeSSZ:
 		return s.signedBeaconBlockFromSSZ(res)
@@ -101,6 +126,11 @@ func (s *Service) signedBeaconBlockFromSSZ(res *httpResponse) (*spec.VersionedSi
 		if err := block.Deneb.UnmarshalSSZ(res.body); err != nil {
 			return nil, errors.Wrap(err, "failed to decode deneb signed beacon block")
 		}
+	case spec.DataVersionElectra:
+		block.Electra = &electra.SignedBeaconBlock{}
+		if err := block.Electra.UnmarshalSSZ(res.body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra signed beacon block")
+		}
 	default:
 		return nil, fmt.Errorf("unhandled block version %s", res.consensusVersion)
 	}
@@ -117,34 +147,40 @@ func (s *Service) signedBeaconBlockFromJSON(res *httpResponse) (*spec.VersionedS
 	switch block.Version {
 	case spec.DataVersionPhase0:
 		var resp phase0SignedBeaconBlockJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse phase 0 signed beacon block")
 		}
 		block.Phase0 = resp.Data
 	case spec.DataVersionAltair:
 		var resp altairSignedBeaconBlockJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse altair signed beacon block")
 		}
 		block.Altair = resp.Data
 	case spec.DataVersionBellatrix:
 		var resp bellatrixSignedBeaconBlockJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse bellatrix signed beacon block")
 		}
 		block.Bellatrix = resp.Data
 	case spec.DataVersionCapella:
 		var resp capellaSignedBeaconBlockJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse capella signed beacon block")
 		}
 		block.Capella = resp.Data
 	case spec.DataVersionDeneb:
 		var resp denebSignedBeaconBlockJSON
-		if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		if err := s.decodeJSON(reader, &resp); err != nil {
 			return nil, errors.Wrap(err, "failed to parse deneb signed beacon block")
 		}
 		block.Deneb = resp.Data
+	case spec.DataVersionElectra:
+		var resp electraSignedBeaconBlockJSON
+		if err := s.decodeJSON(reader, &resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse electra signed beacon block")
+		}
+		block.Electra = resp.Data
 	default:
 		return nil, fmt.Errorf("unhandled block version %s", res.consensusVersion)
 	}
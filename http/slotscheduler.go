@@ -0,0 +1,117 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// slotScheduler delays requests of configured priorities that would start close to
+// the start of a slot, when latency-sensitive requests are made, spreading them
+// over the time that follows.
+type slotScheduler struct {
+	genesisTime  time.Time
+	slotDuration time.Duration
+	// guards are the periods at the start of each slot in which requests of each priority are not started.
+	guards map[Priority]time.Duration
+	// jitter returns a random duration in [0, max).
+	jitter func(max time.Duration) time.Duration
+}
+
+// newSlotScheduler creates a slot scheduler, returning nil if there are no guards.
+func newSlotScheduler(genesisTime time.Time,
+	slotDuration time.Duration,
+	guards map[Priority]time.Duration,
+) *slotScheduler {
+	if len(guards) == 0 || slotDuration <= 0 {
+		return nil
+	}
+
+	return &slotScheduler{
+		genesisTime:  genesisTime,
+		slotDuration: slotDuration,
+		guards:       guards,
+		jitter: func(max time.Duration) time.Duration {
+			if max <= 0 {
+				return 0
+			}
+			// #nosec G404
+			return time.Duration(rand.Int63n(int64(max)))
+		},
+	}
+}
+
+// setSlotScheduler sets the slot scheduler for the service from the chain's timing.
+func (s *Service) setSlotScheduler(ctx context.Context, guards map[Priority]time.Duration) error {
+	genesisTime, err := s.GenesisTime(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis time for slot scheduler")
+	}
+	slotDuration, err := s.SlotDuration(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain slot duration for slot scheduler")
+	}
+	for priority, guard := range guards {
+		if guard >= slotDuration {
+			return fmt.Errorf("slot guard for %s priority must be less than the slot duration", priority)
+		}
+	}
+	s.slotScheduler = newSlotScheduler(genesisTime, slotDuration, guards)
+
+	return nil
+}
+
+// delay returns the time for which a request of the given priority made at the
+// given time should be delayed.
+func (s *slotScheduler) delay(priority Priority, now time.Time) time.Duration {
+	guard, exists := s.guards[priority]
+	if !exists || now.Before(s.genesisTime) {
+		return 0
+	}
+
+	offset := now.Sub(s.genesisTime) % s.slotDuration
+	if offset >= guard {
+		return 0
+	}
+
+	// Delay until the end of the guard, plus up to half as long again to
+	// avoid starting all of the delayed requests at once.
+	return guard - offset + s.jitter(guard/2)
+}
+
+// wait waits until a request of the given priority can be started.
+func (s *slotScheduler) wait(ctx context.Context, priority Priority) error {
+	if s == nil {
+		return nil
+	}
+
+	delay := s.delay(priority, time.Now())
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "context done whilst waiting for slot guard")
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlotSchedulerDelay(t *testing.T) {
+	genesisTime := time.Unix(1606824023, 0)
+	s := newSlotScheduler(genesisTime, 12*time.Second, map[Priority]time.Duration{
+		PriorityLow:    2 * time.Second,
+		PriorityNormal: 500 * time.Millisecond,
+	})
+	s.jitter = func(max time.Duration) time.Duration { return max }
+
+	tests := []struct {
+		name     string
+		priority Priority
+		offset   time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "BeforeGenesis",
+			priority: PriorityLow,
+			offset:   -time.Second,
+			expected: 0,
+		},
+		{
+			name:     "HighPriority",
+			priority: PriorityHigh,
+			offset:   0,
+			expected: 0,
+		},
+		{
+			name:     "StartOfSlot",
+			priority: PriorityLow,
+			offset:   12 * time.Second,
+			expected: 3 * time.Second,
+		},
+		{
+			name:     "WithinGuard",
+			priority: PriorityLow,
+			offset:   24*time.Second + 1500*time.Millisecond,
+			expected: 1500 * time.Millisecond,
+		},
+		{
+			name:     "AfterGuard",
+			priority: PriorityLow,
+			offset:   24*time.Second + 2*time.Second,
+			expected: 0,
+		},
+		{
+			name:     "NormalWithinGuard",
+			priority: PriorityNormal,
+			offset:   100 * time.Millisecond,
+			expected: 400*time.Millisecond + 250*time.Millisecond,
+		},
+		{
+			name:     "NormalAfterGuard",
+			priority: PriorityNormal,
+			offset:   time.Second,
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, s.delay(test.priority, genesisTime.Add(test.offset)))
+		})
+	}
+
+	require.Nil(t, newSlotScheduler(genesisTime, 12*time.Second, nil))
+	require.NoError(t, (*slotScheduler)(nil).wait(context.Background(), PriorityLow))
+}
+
+func TestSlotSchedulerRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec:    codecs.StdJSON,
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      srv.URL,
+		client:       srv.Client(),
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+		// A slot has just started.
+		slotScheduler: newSlotScheduler(time.Now(), time.Hour, map[Priority]time.Duration{
+			PriorityLow: 100 * time.Millisecond,
+		}),
+	}
+	s.slotScheduler.jitter = func(_ time.Duration) time.Duration { return 0 }
+
+	ctx := context.Background()
+
+	// Requests without a guard are not delayed.
+	started := time.Now()
+	_, err = s.get(WithPriority(ctx, PriorityHigh), "/eth/v1/node/version")
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), 50*time.Millisecond)
+
+	// Requests with a guard are bounded by their context.
+	shortCtx, cancel := context.WithTimeout(WithPriority(ctx, PriorityLow), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.get(shortCtx, "/eth/v1/node/version")
+	require.ErrorContains(t, err, "context done whilst waiting for slot guard")
+
+	// Requests with a guard are delayed until it has passed.
+	_, err = s.getJSON(WithPriority(ctx, PriorityLow), "/eth/v1/node/version")
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(started), 100*time.Millisecond)
+}
+
+func TestSlotGuardParameter(t *testing.T) {
+	params, err := parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithSlotGuard(PriorityLow, time.Second),
+		WithSlotGuard(PriorityNormal, 100*time.Millisecond),
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[Priority]time.Duration{
+		PriorityLow:    time.Second,
+		PriorityNormal: 100 * time.Millisecond,
+	}, params.slotGuards)
+
+	_, err = parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithSlotGuard(PriorityHigh, time.Second),
+	)
+	require.EqualError(t, err, "slot guard cannot be set for high priority requests")
+
+	_, err = parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithSlotGuard(PriorityLow, 0),
+	)
+	require.EqualError(t, err, "slot guard for low priority must be positive")
+}
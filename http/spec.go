@@ -16,11 +16,12 @@ package http
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -55,93 +56,97 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 	}
 
 	var specJSON specJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&specJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &specJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse spec")
 	}
 
 	config := make(map[string]interface{})
 	for k, v := range specJSON.Data {
-		// Handle domains.
-		if strings.HasPrefix(k, "DOMAIN_") {
-			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
-			if err == nil {
-				var domainType phase0.DomainType
-				copy(domainType[:], byteVal)
-				config[k] = domainType
-				continue
-			}
-		}
-
-		// Handle fork versions.
-		if strings.HasSuffix(k, "_FORK_VERSION") {
-			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
-			if err == nil {
-				var version phase0.Version
-				copy(version[:], byteVal)
-				config[k] = version
-				continue
-			}
-		}
-
-		// Handle hex strings.
-		if strings.HasPrefix(v, "0x") {
-			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
-			if err == nil {
-				config[k] = byteVal
-				continue
-			}
-		}
-
-		// Handle times.
-		if strings.HasSuffix(k, "_TIME") {
-			intVal, err := strconv.ParseInt(v, 10, 64)
-			if err == nil && intVal != 0 {
-				config[k] = time.Unix(intVal, 0)
-				continue
-			}
-		}
-
-		// Handle durations.
-		if strings.HasPrefix(k, "SECONDS_PER_") || k == "GENESIS_DELAY" {
-			intVal, err := strconv.ParseUint(v, 10, 64)
-			if err == nil && intVal != 0 {
-				config[k] = time.Duration(intVal) * time.Second
-				continue
-			}
-		}
-
-		// Handle integers.
-		if v == "0" {
-			config[k] = uint64(0)
-			continue
-		}
-		intVal, err := strconv.ParseUint(v, 10, 64)
-		if err == nil && intVal != 0 {
-			config[k] = intVal
-			continue
-		}
-
-		// Assume string.
-		config[k] = v
+		config[k] = parseSpecValue(k, v)
 	}
 
 	// The application mask domain type is not provided by all nodes, so add it here if not present.
 	if _, exists := config["DOMAIN_APPLICATION_MASK"]; !exists {
-		config["DOMAIN_APPLICATION_MASK"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+		config["DOMAIN_APPLICATION_MASK"] = phase0.DomainApplicationMask
 	}
 	// The BLS to execution change domain type is not provided by all nodes, so add it here if not present.
 	if _, exists := config["DOMAIN_BLS_TO_EXECUTION_CHANGE"]; !exists {
-		config["DOMAIN_BLS_TO_EXECUTION_CHANGE"] = phase0.DomainType{0x0a, 0x00, 0x00, 0x00}
+		config["DOMAIN_BLS_TO_EXECUTION_CHANGE"] = capella.DomainBLSToExecutionChange
 	}
 	// The builder application domain type is not officially part of the spec, so add it here if not present.
 	if _, exists := config["DOMAIN_APPLICATION_BUILDER"]; !exists {
-		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+		config["DOMAIN_APPLICATION_BUILDER"] = phase0.DomainApplicationBuilder
 	}
 	// The blob sidecar domain type is not provided by all nodes, so add it here if not present.
 	if _, exists := config["DOMAIN_BLOB_SIDECAR"]; !exists {
-		config["DOMAIN_BLOB_SIDECAR"] = phase0.DomainType{0x0b, 0x00, 0x00, 0x00}
+		config["DOMAIN_BLOB_SIDECAR"] = deneb.DomainBlobSidecar
+	}
+
+	// Apply user-supplied overrides.
+	for k, v := range s.specOverrides {
+		s.log.Trace().Str("key", k).Str("value", v).Msg("Overriding spec value")
+		config[k] = parseSpecValue(k, v)
 	}
 
 	s.spec = config
 	return s.spec, nil
 }
+
+// parseSpecValue parses a value returned by the spec endpoint in to its type.
+func parseSpecValue(k string, v string) interface{} {
+	// Handle domains.
+	if strings.HasPrefix(k, "DOMAIN_") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			var domainType phase0.DomainType
+			copy(domainType[:], byteVal)
+			return domainType
+		}
+	}
+
+	// Handle fork versions.
+	if strings.HasSuffix(k, "_FORK_VERSION") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			var version phase0.Version
+			copy(version[:], byteVal)
+			return version
+		}
+	}
+
+	// Handle hex strings.
+	if strings.HasPrefix(v, "0x") {
+		byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil {
+			return byteVal
+		}
+	}
+
+	// Handle times.
+	if strings.HasSuffix(k, "_TIME") {
+		intVal, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && intVal != 0 {
+			return time.Unix(intVal, 0)
+		}
+	}
+
+	// Handle durations.
+	if strings.HasPrefix(k, "SECONDS_PER_") || k == "GENESIS_DELAY" {
+		intVal, err := strconv.ParseUint(v, 10, 64)
+		if err == nil && intVal != 0 {
+			return time.Duration(intVal) * time.Second
+		}
+	}
+
+	// Handle integers.
+	if v == "0" {
+		return uint64(0)
+	}
+	intVal, err := strconv.ParseUint(v, 10, 64)
+	if err == nil && intVal != 0 {
+		return intVal
+	}
+
+	// Assume string.
+	return v
+}
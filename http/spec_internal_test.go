@@ -0,0 +1,74 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecOverrides(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12","CONFIG_NAME":"devnet","DOMAIN_BEACON_PROPOSER":"0x00000000"}}`))
+	}))
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec:    codecs.StdJSON,
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      srv.URL,
+		client:       srv.Client(),
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+		specOverrides: map[string]string{
+			"SLOTS_PER_EPOCH":                  "8",
+			"SECONDS_PER_SLOT":                 "6",
+			"TARGET_AGGREGATORS_PER_COMMITTEE": "16",
+			"DOMAIN_BLOB_SIDECAR":              "0x0c000000",
+		},
+	}
+
+	ctx := context.Background()
+	spec, err := s.Spec(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "devnet", spec["CONFIG_NAME"])
+	require.Equal(t, phase0.DomainType{0x00, 0x00, 0x00, 0x00}, spec["DOMAIN_BEACON_PROPOSER"])
+	require.Equal(t, phase0.DomainType{0x0c, 0x00, 0x00, 0x00}, spec["DOMAIN_BLOB_SIDECAR"])
+
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), slotsPerEpoch)
+
+	slotDuration, err := s.SlotDuration(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 6*time.Second, slotDuration)
+
+	targetAggregatorsPerCommittee, err := s.TargetAggregatorsPerCommittee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), targetAggregatorsPerCommittee)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+type sszSubmissionContextKey struct{}
+
+// WithSSZSubmissionOverride returns a context that sets if blocks submitted with it
+// are sent to the node as SSZ rather than JSON, overriding the client-level setting
+// supplied by WithSSZSubmission.
+func WithSSZSubmissionOverride(ctx context.Context, sszSubmission bool) context.Context {
+	return context.WithValue(ctx, sszSubmissionContextKey{}, sszSubmission)
+}
+
+// submitSSZ returns true if block submissions made with the context should use SSZ.
+func (s *Service) submitSSZ(ctx context.Context) bool {
+	if sszSubmission, isBool := ctx.Value(sszSubmissionContextKey{}).(bool); isBool {
+		return sszSubmission
+	}
+
+	return s.sszSubmission
+}
+
+// submitSSZBlock submits an SSZ-encoded block to the given endpoints.
+// It returns true if the node rejected the content type, in which case the
+// caller should fall back to submitting the block as JSON.
+func (s *Service) submitSSZBlock(ctx context.Context,
+	endpoints []string,
+	version spec.DataVersion,
+	data []byte,
+) (
+	bool,
+	error,
+) {
+	err := s.submitRawBlock(ctx, endpoints, &api.RawSignedBeaconBlock{
+		Version:  version,
+		Encoding: api.EncodingSSZ,
+		Data:     data,
+	})
+	if err != nil && contentTypeNotSupported(err) {
+		s.log.Debug().Msg("SSZ block submission not supported by node; falling back to JSON")
+
+		return true, nil
+	}
+
+	return false, err
+}
+
+// contentTypeNotSupported returns true if the error shows that the node does not
+// support the content type of the request body.
+func contentTypeNotSupported(err error) bool {
+	var httpErr Error
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	return httpErr.StatusCode == http.StatusUnsupportedMediaType
+}
@@ -0,0 +1,195 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSZSubmission(t *testing.T) {
+	block := &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.SignedBeaconBlock{
+			Message: &phase0.BeaconBlock{
+				Slot: 5,
+				Body: &phase0.BeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+				},
+			},
+		},
+	}
+	blockSSZ, err := block.Phase0.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		sszSubmission bool
+		override      *bool
+		sszStatusCode int
+		contentTypes  []string
+		err           string
+	}{
+		{
+			name:         "JSON",
+			contentTypes: []string{"application/json"},
+		},
+		{
+			name:          "SSZ",
+			sszSubmission: true,
+			contentTypes:  []string{"application/octet-stream"},
+		},
+		{
+			name:          "OverrideJSON",
+			sszSubmission: true,
+			override:      boolPtr(false),
+			contentTypes:  []string{"application/json"},
+		},
+		{
+			name:         "OverrideSSZ",
+			override:     boolPtr(true),
+			contentTypes: []string{"application/octet-stream"},
+		},
+		{
+			name:          "Fallback",
+			sszSubmission: true,
+			sszStatusCode: http.StatusUnsupportedMediaType,
+			contentTypes:  []string{"application/octet-stream", "application/json"},
+		},
+		{
+			name:          "Rejected",
+			sszSubmission: true,
+			sszStatusCode: http.StatusBadRequest,
+			contentTypes:  []string{"application/octet-stream"},
+			err:           "failed to submit beacon block: POST failed with status 400: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contentTypes := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v2/beacon/blocks", r.URL.Path)
+				require.Equal(t, "phase0", r.Header.Get("Eth-Consensus-Version"))
+				contentType := r.Header.Get("Content-Type")
+				contentTypes = append(contentTypes, contentType)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				if contentType == "application/octet-stream" {
+					require.Equal(t, blockSSZ, body)
+					if test.sszStatusCode != 0 {
+						w.WriteHeader(test.sszStatusCode)
+						return
+					}
+				}
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:              zerolog.Nop(),
+				base:             base,
+				address:          srv.URL,
+				client:           srv.Client(),
+				timeout:          time.Second,
+				jsonCodec:        codecs.StdJSON,
+				limiter:          newLimiter(0, nil, 0),
+				endpointVersions: make(map[string]string),
+				deprecations:     make(map[string]*EndpointDeprecation),
+				sszSubmission:    test.sszSubmission,
+			}
+
+			ctx := context.Background()
+			if test.override != nil {
+				ctx = WithSSZSubmissionOverride(ctx, *test.override)
+			}
+			err = s.SubmitBeaconBlock(ctx, block)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.contentTypes, contentTypes)
+		})
+	}
+}
+
+func TestSSZSubmissionMissingData(t *testing.T) {
+	tests := []struct {
+		name          string
+		sszSubmission bool
+		block         *spec.VersionedSignedBeaconBlock
+		blindedBlock  *api.VersionedSignedBlindedBeaconBlock
+		err           string
+	}{
+		{
+			name:          "SSZ",
+			sszSubmission: true,
+			block:         &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionDeneb},
+			err:           "no deneb block supplied",
+		},
+		{
+			name:  "JSON",
+			block: &spec.VersionedSignedBeaconBlock{Version: spec.DataVersionElectra},
+			err:   "no electra block supplied",
+		},
+		{
+			name:          "BlindedSSZ",
+			sszSubmission: true,
+			blindedBlock:  &api.VersionedSignedBlindedBeaconBlock{Version: spec.DataVersionCapella},
+			err:           "no capella blinded block supplied",
+		},
+		{
+			name:         "BlindedJSON",
+			blindedBlock: &api.VersionedSignedBlindedBeaconBlock{Version: spec.DataVersionElectra},
+			err:          "no electra blinded block supplied",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testNodeService(t, func(_ http.ResponseWriter, _ *http.Request) {
+				t.Fatal("unexpected request")
+			})
+			s.sszSubmission = test.sszSubmission
+
+			var err error
+			if test.block != nil {
+				err = s.SubmitBeaconBlock(context.Background(), test.block)
+			} else {
+				err = s.SubmitBlindedBeaconBlock(context.Background(), test.blindedBlock)
+			}
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -24,7 +23,7 @@ import (
 
 // SubmitAggregateAttestations submits aggregate attestations.
 func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*phase0.SignedAggregateAndProof) error {
-	specJSON, err := json.Marshal(aggregateAndProofs)
+	specJSON, err := s.jsonCodec.Marshal(aggregateAndProofs)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -24,7 +23,7 @@ import (
 
 // SubmitAttestations submits attestations.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
-	specJSON, err := json.Marshal(attestations)
+	specJSON, err := s.jsonCodec.Marshal(attestations)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/pkg/errors"
@@ -31,17 +30,47 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSi
 		return errors.New("no block supplied")
 	}
 
+	if s.submitSSZ(ctx) {
+		fallback, err := s.submitBeaconBlockSSZ(ctx, block)
+		if err != nil {
+			return err
+		}
+		if !fallback {
+			return nil
+		}
+	}
+
 	switch block.Version {
 	case spec.DataVersionPhase0:
-		specJSON, err = json.Marshal(block.Phase0)
+		if block.Phase0 == nil {
+			return errors.New("no phase0 block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Phase0)
 	case spec.DataVersionAltair:
-		specJSON, err = json.Marshal(block.Altair)
+		if block.Altair == nil {
+			return errors.New("no altair block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Altair)
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(block.Bellatrix)
+		if block.Bellatrix == nil {
+			return errors.New("no bellatrix block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Bellatrix)
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(block.Capella)
+		if block.Capella == nil {
+			return errors.New("no capella block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Capella)
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(block.Deneb)
+		if block.Deneb == nil {
+			return errors.New("no deneb block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Deneb)
+	case spec.DataVersionElectra:
+		if block.Electra == nil {
+			return errors.New("no electra block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Electra)
 	default:
 		err = errors.New("unknown block version")
 	}
@@ -49,10 +78,68 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSi
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
 
-	_, err = s.post(ctx, "/eth/v1/beacon/blocks", bytes.NewBuffer(specJSON))
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
+	}
+	err = s.negotiateEndpoint(beaconBlocksEndpoints, func(endpoint string) error {
+		_, err := s.post2(ctx, endpoint, bytes.NewBuffer(specJSON), ContentTypeJSON, headers)
+		return err
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to submit beacon block")
 	}
 
 	return nil
 }
+
+// submitBeaconBlockSSZ submits a beacon block as SSZ, returning true if the
+// node does not accept SSZ and the block should be submitted as JSON instead.
+func (s *Service) submitBeaconBlockSSZ(ctx context.Context, block *spec.VersionedSignedBeaconBlock) (bool, error) {
+	var specSSZ []byte
+	var err error
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		if block.Phase0 == nil {
+			return false, errors.New("no phase0 block supplied")
+		}
+		specSSZ, err = block.Phase0.MarshalSSZ()
+	case spec.DataVersionAltair:
+		if block.Altair == nil {
+			return false, errors.New("no altair block supplied")
+		}
+		specSSZ, err = block.Altair.MarshalSSZ()
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil {
+			return false, errors.New("no bellatrix block supplied")
+		}
+		specSSZ, err = block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		if block.Capella == nil {
+			return false, errors.New("no capella block supplied")
+		}
+		specSSZ, err = block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil {
+			return false, errors.New("no deneb block supplied")
+		}
+		specSSZ, err = block.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		if block.Electra == nil {
+			return false, errors.New("no electra block supplied")
+		}
+		specSSZ, err = block.Electra.MarshalSSZ()
+	default:
+		err = errors.New("unknown block version")
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	fallback, err := s.submitSSZBlock(ctx, beaconBlocksEndpoints, block.Version, specSSZ)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to submit beacon block")
+	}
+
+	return fallback, nil
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// SubmitBeaconBlockRaw submits a beacon block that has already been serialized.
+func (s *Service) SubmitBeaconBlockRaw(ctx context.Context, block *api.RawSignedBeaconBlock) error {
+	if err := s.submitRawBlock(ctx, beaconBlocksEndpoints, block); err != nil {
+		return errors.Wrap(err, "failed to submit beacon block")
+	}
+
+	return nil
+}
+
+// SubmitBlindedBeaconBlockRaw submits a blinded beacon block that has already been serialized.
+func (s *Service) SubmitBlindedBeaconBlockRaw(ctx context.Context, block *api.RawSignedBeaconBlock) error {
+	if err := s.submitRawBlock(ctx, blindedBeaconBlocksEndpoints, block); err != nil {
+		return errors.Wrap(err, "failed to submit blinded beacon block")
+	}
+
+	return nil
+}
+
+// submitRawBlock submits a pre-serialized block to the newest of the given endpoints
+// supported by the node.
+func (s *Service) submitRawBlock(ctx context.Context, endpoints []string, block *api.RawSignedBeaconBlock) error {
+	if block == nil {
+		return errors.New("no block supplied")
+	}
+	if len(block.Data) == 0 {
+		return errors.New("no block data supplied")
+	}
+
+	var contentType ContentType
+	switch block.Encoding {
+	case api.EncodingJSON:
+		contentType = ContentTypeJSON
+	case api.EncodingSSZ:
+		contentType = ContentTypeSSZ
+	default:
+		return errors.New("unknown block encoding")
+	}
+
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
+	}
+
+	return s.negotiateEndpoint(endpoints, func(endpoint string) error {
+		_, err := s.post2(ctx, endpoint, bytes.NewReader(block.Data), contentType, headers)
+		return err
+	})
+}
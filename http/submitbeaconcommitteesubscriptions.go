@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -24,12 +23,12 @@ import (
 
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error {
-	var reqBodyReader bytes.Buffer
-	if err := json.NewEncoder(&reqBodyReader).Encode(subscriptions); err != nil {
+	reqBody, err := s.jsonCodec.Marshal(subscriptions)
+	if err != nil {
 		return errors.Wrap(err, "failed to encode beacon committee subscriptions")
 	}
 
-	_, err := s.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", &reqBodyReader)
+	_, err = s.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", bytes.NewBuffer(reqBody))
 	if err != nil {
 		return errors.Wrap(err, "failed to request beacon committee subscriptions")
 	}
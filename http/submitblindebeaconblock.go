@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -32,17 +31,41 @@ func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Versi
 		return errors.New("no blinded block supplied")
 	}
 
+	if s.submitSSZ(ctx) {
+		fallback, err := s.submitBlindedBeaconBlockSSZ(ctx, block)
+		if err != nil {
+			return err
+		}
+		if !fallback {
+			return nil
+		}
+	}
+
 	switch block.Version {
 	case spec.DataVersionPhase0:
 		err = errors.New("blinded phase0 blocks not supported")
 	case spec.DataVersionAltair:
 		err = errors.New("blinded altair blocks not supported")
 	case spec.DataVersionBellatrix:
-		specJSON, err = json.Marshal(block.Bellatrix)
+		if block.Bellatrix == nil {
+			return errors.New("no bellatrix blinded block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Bellatrix)
 	case spec.DataVersionCapella:
-		specJSON, err = json.Marshal(block.Capella)
+		if block.Capella == nil {
+			return errors.New("no capella blinded block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Capella)
 	case spec.DataVersionDeneb:
-		specJSON, err = json.Marshal(block.Deneb)
+		if block.Deneb == nil {
+			return errors.New("no deneb blinded block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Deneb)
+	case spec.DataVersionElectra:
+		if block.Electra == nil {
+			return errors.New("no electra blinded block supplied")
+		}
+		specJSON, err = s.jsonCodec.Marshal(block.Electra)
 	default:
 		err = errors.New("unknown block version")
 	}
@@ -50,10 +73,62 @@ func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Versi
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
 
-	_, err = s.post(ctx, "/eth/v1/beacon/blinded_blocks", bytes.NewBuffer(specJSON))
+	headers := map[string]string{
+		"Eth-Consensus-Version": block.Version.String(),
+	}
+	err = s.negotiateEndpoint(blindedBeaconBlocksEndpoints, func(endpoint string) error {
+		_, err := s.post2(ctx, endpoint, bytes.NewBuffer(specJSON), ContentTypeJSON, headers)
+		return err
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to submit blinded beacon block")
 	}
 
 	return nil
 }
+
+// submitBlindedBeaconBlockSSZ submits a blinded beacon block as SSZ, returning true if the
+// node does not accept SSZ and the block should be submitted as JSON instead.
+func (s *Service) submitBlindedBeaconBlockSSZ(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) (bool, error) {
+	var specSSZ []byte
+	var err error
+
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		err = errors.New("blinded phase0 blocks not supported")
+	case spec.DataVersionAltair:
+		err = errors.New("blinded altair blocks not supported")
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil {
+			return false, errors.New("no bellatrix blinded block supplied")
+		}
+		specSSZ, err = block.Bellatrix.MarshalSSZ()
+	case spec.DataVersionCapella:
+		if block.Capella == nil {
+			return false, errors.New("no capella blinded block supplied")
+		}
+		specSSZ, err = block.Capella.MarshalSSZ()
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil {
+			return false, errors.New("no deneb blinded block supplied")
+		}
+		specSSZ, err = block.Deneb.MarshalSSZ()
+	case spec.DataVersionElectra:
+		if block.Electra == nil {
+			return false, errors.New("no electra blinded block supplied")
+		}
+		specSSZ, err = block.Electra.MarshalSSZ()
+	default:
+		err = errors.New("unknown block version")
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to marshal SSZ")
+	}
+
+	fallback, err := s.submitSSZBlock(ctx, blindedBeaconBlocksEndpoints, block.Version, specSSZ)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to submit blinded beacon block")
+	}
+
+	return fallback, nil
+}
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/pkg/errors"
@@ -24,7 +23,7 @@ import (
 
 // SubmitBLSToExecutionChanges submits BLS to execution address change operations.
 func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
-	specJSON, err := json.Marshal(blsToExecutionChanges)
+	specJSON, err := s.jsonCodec.Marshal(blsToExecutionChanges)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
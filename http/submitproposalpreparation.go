@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -25,12 +24,12 @@ import (
 // SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
 // shows up in the next epoch.
 func (s *Service) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) error {
-	var reqBodyReader bytes.Buffer
-	if err := json.NewEncoder(&reqBodyReader).Encode(preparations); err != nil {
+	reqBody, err := s.jsonCodec.Marshal(preparations)
+	if err != nil {
 		return errors.Wrap(err, "failed to encode proposal preparations")
 	}
 
-	_, err := s.post(ctx, "/eth/v1/validator/prepare_beacon_proposer", &reqBodyReader)
+	_, err = s.post(ctx, "/eth/v1/validator/prepare_beacon_proposer", bytes.NewBuffer(reqBody))
 	if err != nil {
 		return errors.Wrap(err, "failed to send proposal preparations")
 	}
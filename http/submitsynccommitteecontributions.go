@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/pkg/errors"
@@ -24,7 +23,7 @@ import (
 
 // SubmitSyncCommitteeContributions submits sync committee contributions.
 func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
-	specJSON, err := json.Marshal(contributionAndProofs)
+	specJSON, err := s.jsonCodec.Marshal(contributionAndProofs)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/pkg/errors"
@@ -24,7 +23,7 @@ import (
 
 // SubmitSyncCommitteeMessages submits sync committee messages.
 func (s *Service) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) error {
-	specJSON, err := json.Marshal(messages)
+	specJSON, err := s.jsonCodec.Marshal(messages)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
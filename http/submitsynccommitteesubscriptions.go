@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
@@ -24,12 +23,12 @@ import (
 
 // SubmitSyncCommitteeSubscriptions subscribes to sync committees.
 func (s *Service) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*api.SyncCommitteeSubscription) error {
-	var reqBodyReader bytes.Buffer
-	if err := json.NewEncoder(&reqBodyReader).Encode(subscriptions); err != nil {
+	reqBody, err := s.jsonCodec.Marshal(subscriptions)
+	if err != nil {
 		return errors.Wrap(err, "failed to encode sync committee subscriptions")
 	}
 
-	_, err := s.post(ctx, "/eth/v1/validator/sync_committee_subscriptions", &reqBodyReader)
+	_, err = s.post(ctx, "/eth/v1/validator/sync_committee_subscriptions", bytes.NewBuffer(reqBody))
 	if err != nil {
 		return errors.Wrap(err, "failed to request sync committee subscriptions")
 	}
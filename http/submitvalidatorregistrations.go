@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec"
@@ -54,7 +53,7 @@ func (s *Service) SubmitValidatorRegistrations(ctx context.Context, registration
 		}
 	}
 
-	specJSON, err := json.Marshal(unversionedRegistrations)
+	specJSON, err := s.jsonCodec.Marshal(unversionedRegistrations)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
@@ -24,7 +23,7 @@ import (
 
 // SubmitVoluntaryExit submits a voluntary exit.
 func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *phase0.SignedVoluntaryExit) error {
-	specJSON, err := json.Marshal(voluntaryExit)
+	specJSON, err := s.jsonCodec.Marshal(voluntaryExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
 	}
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -39,7 +38,7 @@ func (s *Service) SyncCommittee(ctx context.Context, stateID string) (*api.SyncC
 	}
 
 	var resp syncCommitteeJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse sync committee")
 	}
 
@@ -58,7 +57,7 @@ func (s *Service) SyncCommitteeAtEpoch(ctx context.Context, stateID string, epoc
 	}
 
 	var resp syncCommitteeJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse sync committee")
 	}
 
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/attestantio/go-eth2-client/spec/altair"
@@ -46,7 +45,7 @@ func (s *Service) SyncCommitteeContribution(ctx context.Context,
 	}
 
 	var resp syncCommitteeContributionJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse sync committee contribution")
 	}
 
@@ -16,7 +16,6 @@ package http
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -57,7 +56,7 @@ func (s *Service) SyncCommitteeDuties(ctx context.Context, epoch phase0.Epoch, v
 	}
 
 	var resp syncCommitteeDutiesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&resp); err != nil {
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
 		return nil, errors.Wrap(err, "failed to parse sync committee duties response")
 	}
 
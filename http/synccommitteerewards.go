@@ -0,0 +1,64 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type syncCommitteeRewardsJSON struct {
+	Data []*api.SyncCommitteeReward `json:"data"`
+}
+
+// SyncCommitteeRewards provides the rewards received by sync committee members for their
+// participation in the given block ID.  If no indices are supplied rewards are returned for
+// all members of the sync committee.
+func (s *Service) SyncCommitteeRewards(ctx context.Context, blockID string, indices []phase0.ValidatorIndex) ([]*api.SyncCommitteeReward, error) {
+	if blockID == "" {
+		return nil, errors.New("no block ID specified")
+	}
+
+	ids := make([]string, len(indices))
+	for i := range indices {
+		ids[i] = fmt.Sprintf("%d", indices[i])
+	}
+	reqBody, err := s.jsonCodec.Marshal(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+
+	respBodyReader, err := s.post(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/sync_committee/%s", blockID), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request sync committee rewards")
+	}
+	if respBodyReader == nil {
+		return nil, errors.New("failed to obtain sync committee rewards")
+	}
+
+	var resp syncCommitteeRewardsJSON
+	if err := s.decodeJSON(respBodyReader, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse sync committee rewards")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("no sync committee rewards returned")
+	}
+
+	return resp.Data, nil
+}
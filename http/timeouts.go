@@ -0,0 +1,66 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// endpointTimeout is the timeout for a class of endpoint.
+type endpointTimeout struct {
+	prefix  string
+	timeout time.Duration
+}
+
+// newEndpointTimeouts returns the endpoint timeouts ordered longest prefix first,
+// so that the most specific prefix matches.
+func newEndpointTimeouts(timeouts map[string]time.Duration) []endpointTimeout {
+	res := make([]endpointTimeout, 0, len(timeouts))
+	for prefix, timeout := range timeouts {
+		res = append(res, endpointTimeout{prefix: prefix, timeout: timeout})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if len(res[i].prefix) != len(res[j].prefix) {
+			return len(res[i].prefix) > len(res[j].prefix)
+		}
+		return res[i].prefix < res[j].prefix
+	})
+
+	return res
+}
+
+// maxTimeout returns the longest of the default and endpoint timeouts.
+func maxTimeout(timeout time.Duration, timeouts map[string]time.Duration) time.Duration {
+	res := timeout
+	for _, endpointTimeout := range timeouts {
+		if endpointTimeout > res {
+			res = endpointTimeout
+		}
+	}
+
+	return res
+}
+
+// endpointTimeout returns the timeout for requests to the given endpoint.
+func (s *Service) endpointTimeout(endpoint string) time.Duration {
+	for _, endpointTimeout := range s.endpointTimeouts {
+		if strings.HasPrefix(endpoint, endpointTimeout.prefix) {
+			return endpointTimeout.timeout
+		}
+	}
+
+	return s.timeout
+}
@@ -0,0 +1,120 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointTimeout(t *testing.T) {
+	s := &Service{
+		jsonCodec: codecs.StdJSON,
+		timeout:   time.Second,
+		endpointTimeouts: newEndpointTimeouts(map[string]time.Duration{
+			"/eth/v2/debug/beacon/states": time.Minute,
+			"/eth/v2/debug":               10 * time.Second,
+			"/eth/v1/validator/":          100 * time.Millisecond,
+		}),
+	}
+
+	tests := []struct {
+		endpoint string
+		expected time.Duration
+	}{
+		{
+			endpoint: "/eth/v2/debug/beacon/states/head",
+			expected: time.Minute,
+		},
+		{
+			endpoint: "/eth/v2/debug/fork_choice",
+			expected: 10 * time.Second,
+		},
+		{
+			endpoint: "/eth/v1/validator/attestation_data?slot=1&committee_index=2",
+			expected: 100 * time.Millisecond,
+		},
+		{
+			endpoint: "/eth/v1/node/version",
+			expected: time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			require.Equal(t, test.expected, s.endpointTimeout(test.endpoint))
+		})
+	}
+
+	require.Equal(t, time.Minute, maxTimeout(time.Second, map[string]time.Duration{"/a": time.Minute, "/b": time.Millisecond}))
+	require.Equal(t, time.Second, maxTimeout(time.Second, nil))
+}
+
+func TestEndpointTimeoutRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec: codecs.StdJSON,
+		log:       zerolog.Nop(),
+		base:      base,
+		address:   srv.URL,
+		client:    srv.Client(),
+		timeout:   10 * time.Millisecond,
+		endpointTimeouts: newEndpointTimeouts(map[string]time.Duration{
+			"/eth/v2/debug/beacon/states": time.Second,
+		}),
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+	}
+
+	_, err = s.get(context.Background(), "/eth/v1/node/version")
+	require.ErrorContains(t, err, "context deadline exceeded")
+
+	_, err = s.get(context.Background(), "/eth/v2/debug/beacon/states/head")
+	require.NoError(t, err)
+}
+
+func TestEndpointTimeoutParameter(t *testing.T) {
+	params, err := parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithEndpointTimeout("/eth/v2/debug/beacon/states", time.Minute),
+		WithEndpointTimeout("/eth/v1/validator/", time.Second),
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Duration{
+		"/eth/v2/debug/beacon/states": time.Minute,
+		"/eth/v1/validator/":          time.Second,
+	}, params.endpointTimeouts)
+
+	_, err = parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithEndpointTimeout("/eth/v1/validator/", 0),
+	)
+	require.EqualError(t, err, "timeout for /eth/v1/validator/ must be positive")
+}
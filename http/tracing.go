@@ -0,0 +1,104 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// tracerName is the name of the tracer for the module.
+const tracerName = "github.com/attestantio/go-eth2-client/http"
+
+// noopTracer is used if the service has no tracer.
+var noopTracer = noop.NewTracerProvider().Tracer(tracerName)
+
+// idAttributes are the attributes for the path segments that follow block and state
+// segments in endpoints.
+var idAttributes = map[string]string{
+	"blocks":         "eth.block_id",
+	"blinded_blocks": "eth.block_id",
+	"headers":        "eth.block_id",
+	"blob_sidecars":  "eth.block_id",
+	"states":         "eth.state_id",
+}
+
+// startSpan starts a span for a request to an endpoint.
+func (s *Service) startSpan(ctx context.Context, method string, endpoint string) (context.Context, trace.Span) {
+	route := normaliseEndpoint(endpoint)
+	attrs := []attribute.KeyValue{
+		attribute.String("http.method", method),
+		attribute.String("http.route", route),
+		attribute.String("server.address", s.address),
+	}
+	attrs = append(attrs, endpointIDs(endpoint)...)
+
+	tracer := s.tracer
+	if tracer == nil {
+		tracer = noopTracer
+	}
+
+	return tracer.Start(ctx,
+		fmt.Sprintf("%s %s", method, route),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endSpan ends a span for a request with the details of its response.
+func endSpan(span trace.Span, statusCode int, size int, err error) {
+	if statusCode != 0 {
+		span.SetAttributes(attribute.Int("http.status_code", statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(attribute.Int("http.response_content_length", size))
+	}
+	span.End()
+}
+
+// injectTraceContext adds the trace context of the request's context to its headers,
+// using the globally configured propagator.
+func injectTraceContext(req *http.Request) {
+	otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// endpointIDs returns attributes for the block and state IDs in an endpoint.
+func endpointIDs(endpoint string) []attribute.KeyValue {
+	if pos := strings.IndexByte(endpoint, '?'); pos != -1 {
+		endpoint = endpoint[:pos]
+	}
+
+	attrs := make([]attribute.KeyValue, 0)
+	segments := strings.Split(endpoint, "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if key, exists := idAttributes[segments[i]]; exists && segments[i+1] != "" {
+			attrs = append(attrs, attribute.String(key, segments[i+1]))
+			i++
+		}
+	}
+
+	return attrs
+}
@@ -0,0 +1,153 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestEndpointIDs(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected []attribute.KeyValue
+	}{
+		{
+			endpoint: "/eth/v1/node/version",
+			expected: []attribute.KeyValue{},
+		},
+		{
+			endpoint: "/eth/v2/beacon/blocks/head",
+			expected: []attribute.KeyValue{attribute.String("eth.block_id", "head")},
+		},
+		{
+			endpoint: "/eth/v2/beacon/blocks",
+			expected: []attribute.KeyValue{},
+		},
+		{
+			endpoint: "/eth/v1/beacon/headers/0x01",
+			expected: []attribute.KeyValue{attribute.String("eth.block_id", "0x01")},
+		},
+		{
+			endpoint: "/eth/v1/beacon/states/finalized/validators?id=1",
+			expected: []attribute.KeyValue{attribute.String("eth.state_id", "finalized")},
+		},
+		{
+			endpoint: "/eth/v1/beacon/blob_sidecars/123?indices=1",
+			expected: []attribute.KeyValue{attribute.String("eth.block_id", "123")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			require.Equal(t, test.expected, endpointIDs(test.endpoint))
+		})
+	}
+}
+
+func TestTracing(t *testing.T) {
+	propagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagator)
+
+	traceparents := make([]string, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("Traceparent"))
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	tracerProvider := testclients.NewRecordingTracerProvider()
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		jsonCodec:    codecs.StdJSON,
+		log:          zerolog.Nop(),
+		base:         base,
+		address:      srv.URL,
+		client:       srv.Client(),
+		timeout:      time.Second,
+		limiter:      newLimiter(0, nil, 0),
+		deprecations: make(map[string]*EndpointDeprecation),
+		tracer:       tracerProvider.Tracer(tracerName),
+	}
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+	_, err = s.getJSON(ctx, "/eth/v1/beacon/states/head/finality_checkpoints")
+	require.NoError(t, err)
+	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", nil)
+	require.Error(t, err)
+	parent.End()
+
+	spans := tracerProvider.Spans()
+	require.Len(t, spans, 3)
+
+	get := spans[1]
+	require.Equal(t, "GET /eth/v1/beacon/states/head/finality_checkpoints", get.Name())
+	require.Equal(t, parent.SpanContext(), get.Parent())
+	require.True(t, get.Ended())
+	require.Equal(t, codes.Unset, get.StatusCode())
+	value, exists := get.Attribute("eth.state_id")
+	require.True(t, exists)
+	require.Equal(t, "head", value.AsString())
+	value, exists = get.Attribute("http.status_code")
+	require.True(t, exists)
+	require.Equal(t, int64(http.StatusOK), value.AsInt64())
+	value, exists = get.Attribute("http.response_content_length")
+	require.True(t, exists)
+	require.Equal(t, int64(len(`{"data":{}}`)), value.AsInt64())
+
+	post := spans[2]
+	require.Equal(t, "POST /eth/v1/beacon/pool/attestations", post.Name())
+	require.True(t, post.Ended())
+	require.Equal(t, codes.Error, post.StatusCode())
+	require.Len(t, post.Errors(), 1)
+	value, exists = post.Attribute("http.status_code")
+	require.True(t, exists)
+	require.Equal(t, int64(http.StatusBadRequest), value.AsInt64())
+
+	// The trace context of each request's span is propagated to the server.
+	require.Len(t, traceparents, 2)
+	for i, span := range []*testclients.RecordingSpan{get, post} {
+		require.Contains(t, traceparents[i], span.SpanContext().TraceID().String())
+		require.Contains(t, traceparents[i], span.SpanContext().SpanID().String())
+	}
+}
+
+func TestTracerProviderParameter(t *testing.T) {
+	_, err := parseAndCheckParameters(
+		WithAddress("http://localhost:5052"),
+		WithTracerProvider(nil),
+	)
+	require.EqualError(t, err, "no tracer provider specified")
+}
@@ -0,0 +1,51 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+)
+
+type unknownVersionJSON struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// unknownVersionData returns the raw data of a response with an unknown consensus version.
+func (s *Service) unknownVersionData(res *httpResponse) (*spec.UnknownVersionData, error) {
+	data := &spec.UnknownVersionData{
+		Version: res.unknownConsensusVersion,
+	}
+
+	switch res.contentType {
+	case ContentTypeSSZ:
+		data.SSZ = res.body
+	case ContentTypeJSON:
+		var resp unknownVersionJSON
+		if err := s.jsonCodec.Unmarshal(res.body, &resp); err != nil {
+			return nil, errors.Wrap(err, "failed to parse data")
+		}
+		if len(resp.Data) == 0 {
+			return nil, errors.New("no data")
+		}
+		data.JSON = resp.Data
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+
+	return data, nil
+}
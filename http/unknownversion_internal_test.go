@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownVersionPassthrough(t *testing.T) {
+	tests := []struct {
+		name        string
+		passthrough bool
+		version     string
+		contentType string
+		body        []byte
+		expected    *spec.UnknownVersionData
+		err         string
+	}{
+		{
+			name:        "Disabled",
+			version:     "future",
+			contentType: "application/octet-stream",
+			body:        []byte{0x01, 0x02},
+			err:         "failed to request signed beacon block: failed to parse consensus version: failed to parse consensus version: unrecognised data version \"future\"",
+		},
+		{
+			name:        "SSZ",
+			passthrough: true,
+			version:     "future",
+			contentType: "application/octet-stream",
+			body:        []byte{0x01, 0x02},
+			expected: &spec.UnknownVersionData{
+				Version: "future",
+				SSZ:     []byte{0x01, 0x02},
+			},
+		},
+		{
+			name:        "JSON",
+			passthrough: true,
+			version:     "future",
+			contentType: "application/json",
+			body:        []byte(`{"version":"future","data":{"message":{"slot":"1"}}}`),
+			expected: &spec.UnknownVersionData{
+				Version: "future",
+				JSON:    json.RawMessage(`{"message":{"slot":"1"}}`),
+			},
+		},
+		{
+			name:        "JSONNoData",
+			passthrough: true,
+			version:     "future",
+			contentType: "application/json",
+			body:        []byte(`{"version":"future"}`),
+			err:         "failed to obtain signed beacon block of unknown version: no data",
+		},
+		{
+			name:        "JSONInvalid",
+			passthrough: true,
+			version:     "future",
+			contentType: "application/json",
+			body:        []byte(`{"data":`),
+			err:         "failed to obtain signed beacon block of unknown version: failed to parse data: unexpected end of JSON input",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Eth-Consensus-Version", test.version)
+				w.Header().Set("Content-Type", test.contentType)
+				_, _ = w.Write(test.body)
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				jsonCodec:                 codecs.StdJSON,
+				log:                       zerolog.Nop(),
+				base:                      base,
+				address:                   srv.URL,
+				client:                    srv.Client(),
+				timeout:                   time.Second,
+				limiter:                   newLimiter(0, nil, 0),
+				deprecations:              make(map[string]*EndpointDeprecation),
+				unknownVersionPassthrough: test.passthrough,
+			}
+
+			block, err := s.SignedBeaconBlock(context.Background(), "head")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, spec.DataVersionUnknown, block.Version)
+				require.Equal(t, test.expected, block.Unknown)
+			}
+		})
+	}
+}
@@ -14,9 +14,10 @@
 package http
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
@@ -41,16 +42,62 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return s.chunkedValidatorBalances(ctx, stateID, validatorIndices)
 	}
 
-	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", stateID)
-	if len(validatorIndices) != 0 {
-		ids := make([]string, len(validatorIndices))
-		for i := range validatorIndices {
-			ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_balances", stateID)
+	if len(validatorIndices) == 0 {
+		respBodyReader, err := s.get(ctx, endpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to request validator balances")
+		}
+		if respBodyReader == nil {
+			return nil, errors.New("failed to obtain validator balances")
+		}
+
+		return s.decodeValidatorBalances(respBodyReader)
+	}
+
+	ids := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		ids[i] = fmt.Sprintf("%d", validatorIndices[i])
+	}
+
+	return s.validatorBalancesChunk(ctx, endpoint, ids)
+}
+
+// validatorBalancesChunk obtains the validator balances for a single chunk of IDs.
+// The IDs are sent in the body of a POST request where the node supports it, avoiding
+// limits on the length of URLs, otherwise in the URL of a GET request.
+func (s *Service) validatorBalancesChunk(ctx context.Context, endpoint string, ids []string) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	if !s.validatorBalancesPostUnsupported.Load() {
+		body, err := s.jsonCodec.Marshal(ids)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request")
 		}
-		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
+		respBodyReader, err := s.post(ctx, endpoint, bytes.NewReader(body))
+		if err == nil {
+			return s.decodeValidatorBalances(respBodyReader)
+		}
+		if !endpointNotSupported(err) {
+			return nil, errors.Wrap(err, "failed to request validator balances")
+		}
+
+		// A 404 could be because the state is not known rather than because the node does
+		// not support POST, so only record that it is unsupported if GET succeeds.
+		res, err := s.validatorBalancesChunkGet(ctx, endpoint, ids)
+		if err != nil {
+			return nil, err
+		}
+		s.log.Debug().Msg("POST for validator balances not supported by node; using GET")
+		s.validatorBalancesPostUnsupported.Store(true)
+
+		return res, nil
 	}
 
-	respBodyReader, err := s.get(ctx, url)
+	return s.validatorBalancesChunkGet(ctx, endpoint, ids)
+}
+
+// validatorBalancesChunkGet obtains the validator balances for a single chunk of IDs with a GET request.
+func (s *Service) validatorBalancesChunkGet(ctx context.Context, endpoint string, ids []string) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
+	respBodyReader, err := s.get(ctx, fmt.Sprintf("%s?id=%s", endpoint, strings.Join(ids, ",")))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request validator balances")
 	}
@@ -58,15 +105,20 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return nil, errors.New("failed to obtain validator balances")
 	}
 
+	return s.decodeValidatorBalances(respBodyReader)
+}
+
+// decodeValidatorBalances decodes a validator balances response.
+func (s *Service) decodeValidatorBalances(respBodyReader io.Reader) (map[phase0.ValidatorIndex]phase0.Gwei, error) {
 	var validatorBalancesJSON validatorBalancesJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorBalancesJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &validatorBalancesJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validator balances")
 	}
 	if validatorBalancesJSON.Data == nil {
 		return nil, errors.New("no validator balances returned")
 	}
 
-	res := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	res := make(map[phase0.ValidatorIndex]phase0.Gwei, len(validatorBalancesJSON.Data))
 	for _, validatorBalance := range validatorBalancesJSON.Data {
 		res[validatorBalance.Index] = validatorBalance.Balance
 	}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorBalancesPost(t *testing.T) {
+	tests := []struct {
+		name          string
+		postSupported bool
+		indices       []phase0.ValidatorIndex
+		methods       []string
+	}{
+		{
+			name:          "All",
+			postSupported: true,
+			methods:       []string{"GET"},
+		},
+		{
+			name:          "Post",
+			postSupported: true,
+			indices:       []phase0.ValidatorIndex{1, 2, 3},
+			methods:       []string{"POST", "POST"},
+		},
+		{
+			name:    "GetFallback",
+			indices: []phase0.ValidatorIndex{1, 2, 3},
+			// The first chunk tries POST before falling back; later chunks use GET directly.
+			methods: []string{"POST", "GET", "GET"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			methods := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/beacon/states/head/validator_balances", r.URL.Path)
+				methods = append(methods, r.Method)
+
+				var ids []string
+				switch r.Method {
+				case http.MethodPost:
+					if !test.postSupported {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&ids))
+				default:
+					if r.URL.Query().Has("id") {
+						ids = strings.Split(r.URL.Query().Get("id"), ",")
+					} else {
+						ids = []string{"0", "1", "2", "3"}
+					}
+				}
+
+				balances := make([]string, 0, len(ids))
+				for _, id := range ids {
+					index, err := strconv.ParseUint(id, 10, 64)
+					require.NoError(t, err)
+					balances = append(balances, fmt.Sprintf(`{"index":"%d","balance":"%d"}`, index, 32000000000+index))
+				}
+				_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s]}`, strings.Join(balances, ","))))
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:                zerolog.Nop(),
+				base:               base,
+				address:            srv.URL,
+				client:             srv.Client(),
+				timeout:            time.Second,
+				jsonCodec:          codecs.StdJSON,
+				limiter:            newLimiter(0, nil, 0),
+				endpointVersions:   make(map[string]string),
+				deprecations:       make(map[string]*EndpointDeprecation),
+				userIndexChunkSize: 2,
+			}
+
+			balances, err := s.ValidatorBalances(context.Background(), "head", test.indices)
+			require.NoError(t, err)
+			if len(test.indices) == 0 {
+				require.Len(t, balances, 4)
+			} else {
+				require.Len(t, balances, len(test.indices))
+			}
+			for index, balance := range balances {
+				require.Equal(t, phase0.Gwei(32000000000+uint64(index)), balance)
+			}
+			require.Equal(t, test.methods, methods)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type validatorIdentitiesJSON struct {
+	Data []*api.ValidatorIdentity `json:"data"`
+}
+
+// ValidatorIdentities provides the identities of validators, being their index, public key and activation epoch,
+// for a given state.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+// will be applied.
+func (s *Service) ValidatorIdentities(ctx context.Context,
+	stateID string,
+	validatorIndices []phase0.ValidatorIndex,
+) (
+	[]*api.ValidatorIdentity,
+	error,
+) {
+	if stateID == "" {
+		return nil, errors.New("no state ID specified")
+	}
+
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validator_identities", stateID)
+	if len(validatorIndices) == 0 {
+		return s.validatorIdentities(ctx, endpoint, []string{})
+	}
+
+	res := make([]*api.ValidatorIdentity, 0, len(validatorIndices))
+	indexChunkSize := s.indexChunkSize(ctx)
+	for i := 0; i < len(validatorIndices); i += indexChunkSize {
+		chunkEnd := i + indexChunkSize
+		if len(validatorIndices) < chunkEnd {
+			chunkEnd = len(validatorIndices)
+		}
+		ids := make([]string, 0, chunkEnd-i)
+		for _, index := range validatorIndices[i:chunkEnd] {
+			ids = append(ids, fmt.Sprintf("%d", index))
+		}
+		chunkRes, err := s.validatorIdentities(ctx, endpoint, ids)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain chunk")
+		}
+		res = append(res, chunkRes...)
+	}
+
+	return res, nil
+}
+
+// validatorIdentities obtains the identities for the given IDs.
+func (s *Service) validatorIdentities(ctx context.Context, endpoint string, ids []string) ([]*api.ValidatorIdentity, error) {
+	body, err := s.jsonCodec.Marshal(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	respBodyReader, err := s.post(ctx, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validator identities")
+	}
+
+	var validatorIdentitiesJSON validatorIdentitiesJSON
+	if err := s.decodeJSON(respBodyReader, &validatorIdentitiesJSON); err != nil {
+		return nil, errors.Wrap(err, "failed to parse validator identities")
+	}
+	if validatorIdentitiesJSON.Data == nil {
+		return nil, errors.New("no validator identities returned")
+	}
+
+	return validatorIdentitiesJSON.Data, nil
+}
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -92,32 +91,29 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		url = fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ","))
 	}
 
-	respBodyReader, err := s.get(ctx, url)
+	res, err := s.get2(ctx, url)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
-	if respBodyReader == nil {
+	if res.body == nil {
 		return nil, errors.New("failed to obtain validators")
 	}
 
-	var validatorsJSON validatorsJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorsJSON); err != nil {
-		return nil, errors.Wrap(err, "failed to parse validators")
-	}
-	if validatorsJSON.Data == nil {
-		return nil, errors.New("no validators returned")
+	validators, err := s.decodeValidators(res)
+	if err != nil {
+		return nil, err
 	}
 
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	for _, validator := range validatorsJSON.Data {
-		res[validator.Index] = validator
+	validatorsMap := make(map[phase0.ValidatorIndex]*api.Validator, len(validators))
+	for _, validator := range validators {
+		validatorsMap[validator.Index] = validator
 	}
-	return res, nil
+	return validatorsMap, nil
 }
 
 // validatorsFromState fetches all validators from state.
-// This is more efficient than fetching the validators endpoint, as validators uses JSON only,
-// whereas state can be provided using SSZ.
+// This is more efficient than fetching the validators endpoint, as validators is not
+// provided as SSZ by all nodes, whereas state can be.
 func (s *Service) validatorsFromState(ctx context.Context, stateID string) (map[phase0.ValidatorIndex]*api.Validator, error) {
 	state, err := s.BeaconState(ctx, stateID)
 	if err != nil {
@@ -166,22 +162,10 @@ func (s *Service) validatorsFromState(ctx context.Context, stateID string) (map[
 
 // chunkedValidators obtains the validators a chunk at a time.
 func (s *Service) chunkedValidators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*api.Validator, error) {
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	indexChunkSize := s.indexChunkSize(ctx)
-	for i := 0; i < len(validatorIndices); i += indexChunkSize {
-		chunkStart := i
-		chunkEnd := i + indexChunkSize
-		if len(validatorIndices) < chunkEnd {
-			chunkEnd = len(validatorIndices)
-		}
-		chunk := validatorIndices[chunkStart:chunkEnd]
-		chunkRes, err := s.Validators(ctx, stateID, chunk)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain chunk")
-		}
-		for k, v := range chunkRes {
-			res[k] = v
-		}
+	ids := make([]string, len(validatorIndices))
+	for i := range validatorIndices {
+		ids[i] = fmt.Sprintf("%d", validatorIndices[i])
 	}
-	return res, nil
+
+	return s.chunkedValidatorsByID(ctx, stateID, ids, s.indexChunkSize(ctx))
 }
@@ -15,7 +15,6 @@ package http
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -98,7 +97,7 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 	}
 
 	var validatorsByPubKeyJSON validatorsByPubKeyJSON
-	if err := json.NewDecoder(respBodyReader).Decode(&validatorsByPubKeyJSON); err != nil {
+	if err := s.decodeJSON(respBodyReader, &validatorsByPubKeyJSON); err != nil {
 		return nil, errors.Wrap(err, "failed to parse validators")
 	}
 	if validatorsByPubKeyJSON.Data == nil {
@@ -114,22 +113,10 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 
 // chunkedValidatorsByPubKey obtains the validators a chunk at a time.
 func (s *Service) chunkedValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*api.Validator, error) {
-	res := make(map[phase0.ValidatorIndex]*api.Validator)
-	pubKeyChunkSize := s.pubKeyChunkSize(ctx)
-	for i := 0; i < len(validatorPubKeys); i += pubKeyChunkSize {
-		chunkStart := i
-		chunkEnd := i + pubKeyChunkSize
-		if len(validatorPubKeys) < chunkEnd {
-			chunkEnd = len(validatorPubKeys)
-		}
-		chunk := validatorPubKeys[chunkStart:chunkEnd]
-		chunkRes, err := s.ValidatorsByPubKey(ctx, stateID, chunk)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to obtain chunk")
-		}
-		for k, v := range chunkRes {
-			res[k] = v
-		}
+	ids := make([]string, len(validatorPubKeys))
+	for i := range validatorPubKeys {
+		ids[i] = fmt.Sprintf("%#x", validatorPubKeys[i])
 	}
-	return res, nil
+
+	return s.chunkedValidatorsByID(ctx, stateID, ids, s.pubKeyChunkSize(ctx))
 }
@@ -0,0 +1,193 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// validatorsRequestJSON is the body of a POST request for validators.
+type validatorsRequestJSON struct {
+	IDs []string `json:"ids"`
+}
+
+// chunkedValidatorsByID obtains the validators with the given IDs, which can be indices or
+// public keys, in chunks of at most chunkSize IDs.  Up to the configured chunk concurrency
+// of chunks are fetched in parallel, and the results merged.
+func (s *Service) chunkedValidatorsByID(ctx context.Context,
+	stateID string,
+	ids []string,
+	chunkSize int,
+) (
+	map[phase0.ValidatorIndex]*api.Validator,
+	error,
+) {
+	chunks := make([][]string, 0, (len(ids)+chunkSize-1)/chunkSize)
+	for i := 0; i < len(ids); i += chunkSize {
+		chunkEnd := i + chunkSize
+		if len(ids) < chunkEnd {
+			chunkEnd = len(ids)
+		}
+		chunks = append(chunks, ids[i:chunkEnd])
+	}
+
+	concurrency := s.chunkConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]*api.Validator, len(chunks))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var errMu sync.Mutex
+	var firstErr error
+	for i := range chunks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			validators, err := s.validatorsChunk(ctx, stateID, chunks[i])
+			if err != nil {
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					// No point in fetching further chunks.
+					cancel()
+				}
+				errMu.Unlock()
+
+				return
+			}
+			results[i] = validators
+		}(i)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, errors.Wrap(firstErr, "failed to obtain chunk")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	res := make(map[phase0.ValidatorIndex]*api.Validator, len(ids))
+	for _, validators := range results {
+		for _, validator := range validators {
+			res[validator.Index] = validator
+		}
+	}
+
+	return res, nil
+}
+
+// validatorsChunk obtains the validators for a single chunk of IDs.
+// The IDs are sent in the body of a POST request where the node supports it, avoiding
+// limits on the length of URLs, otherwise in the URL of a GET request.
+func (s *Service) validatorsChunk(ctx context.Context, stateID string, ids []string) ([]*api.Validator, error) {
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
+
+	if !s.validatorsPostUnsupported.Load() {
+		body, err := s.jsonCodec.Marshal(&validatorsRequestJSON{IDs: ids})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request")
+		}
+		res, err := s.postSSZ(ctx, endpoint, body)
+		if err == nil {
+			return s.decodeValidators(res)
+		}
+		if !endpointNotSupported(err) {
+			return nil, errors.Wrap(err, "failed to request validators")
+		}
+
+		// A 404 could be because the state is not known rather than because the node does
+		// not support POST, so only record that it is unsupported if GET succeeds.
+		validators, err := s.validatorsChunkGet(ctx, endpoint, ids)
+		if err != nil {
+			return nil, err
+		}
+		s.log.Debug().Msg("POST for validators not supported by node; using GET")
+		s.validatorsPostUnsupported.Store(true)
+
+		return validators, nil
+	}
+
+	return s.validatorsChunkGet(ctx, endpoint, ids)
+}
+
+// validatorsChunkGet obtains the validators for a single chunk of IDs with a GET request.
+func (s *Service) validatorsChunkGet(ctx context.Context, endpoint string, ids []string) ([]*api.Validator, error) {
+	res, err := s.get2(ctx, fmt.Sprintf("%s?id=%s", endpoint, strings.Join(ids, ",")))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request validators")
+	}
+	if res.body == nil {
+		return nil, errors.New("failed to obtain validators")
+	}
+
+	return s.decodeValidators(res)
+}
+
+// decodeValidators decodes a validators response.
+func (s *Service) decodeValidators(res *httpResponse) ([]*api.Validator, error) {
+	switch res.contentType {
+	case ContentTypeSSZ:
+		return validatorsFromSSZ(res.body)
+	case ContentTypeJSON:
+		var validatorsJSON validatorsJSON
+		if err := s.decodeJSON(bytes.NewReader(res.body), &validatorsJSON); err != nil {
+			return nil, errors.Wrap(err, "failed to parse validators")
+		}
+		if validatorsJSON.Data == nil {
+			return nil, errors.New("no validators returned")
+		}
+
+		return validatorsJSON.Data, nil
+	default:
+		return nil, fmt.Errorf("unhandled content type %v", res.contentType)
+	}
+}
+
+// validatorsFromSSZ decodes an SSZ list of validators.
+func validatorsFromSSZ(data []byte) ([]*api.Validator, error) {
+	size := (&api.Validator{}).SizeSSZ()
+	if len(data)%size != 0 {
+		return nil, fmt.Errorf("SSZ length %d is not a multiple of validator size %d", len(data), size)
+	}
+
+	res := make([]*api.Validator, len(data)/size)
+	for i := range res {
+		res[i] = &api.Validator{}
+		if err := res[i].UnmarshalSSZ(data[i*size : (i+1)*size]); err != nil {
+			return nil, errors.Wrap(err, "failed to parse validator")
+		}
+	}
+
+	return res, nil
+}
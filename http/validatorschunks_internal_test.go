@@ -0,0 +1,217 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkedValidators(t *testing.T) {
+	tests := []struct {
+		name          string
+		postSupported bool
+		preferSSZ     bool
+		sszStatus     int
+		methods       []string
+	}{
+		{
+			name:          "Post",
+			postSupported: true,
+			methods:       []string{"POST", "POST", "POST"},
+		},
+		{
+			name: "GetFallback",
+			// The first chunk tries POST before falling back; later chunks use GET directly.
+			methods: []string{"POST", "GET", "GET", "GET"},
+		},
+		{
+			name:          "PostSSZ",
+			postSupported: true,
+			preferSSZ:     true,
+			methods:       []string{"POST", "POST", "POST"},
+		},
+		{
+			name:      "GetFallbackSSZ",
+			preferSSZ: true,
+			methods:   []string{"POST", "GET", "GET", "GET"},
+		},
+		{
+			name:          "SSZNotAcceptable",
+			postSupported: true,
+			preferSSZ:     true,
+			sszStatus:     http.StatusNotAcceptable,
+			// Each chunk requests SSZ before falling back to JSON.
+			methods: []string{"POST", "POST", "POST", "POST", "POST", "POST"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			methods := make([]string, 0)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/eth/v1/beacon/states/head/validators", r.URL.Path)
+				mu.Lock()
+				methods = append(methods, r.Method)
+				mu.Unlock()
+
+				var ids []string
+				switch r.Method {
+				case http.MethodPost:
+					if !test.postSupported {
+						w.WriteHeader(http.StatusMethodNotAllowed)
+						return
+					}
+					var request validatorsRequestJSON
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+					ids = request.IDs
+				default:
+					ids = strings.Split(r.URL.Query().Get("id"), ",")
+				}
+				require.LessOrEqual(t, len(ids), 2)
+
+				validators := make([]*api.Validator, 0, len(ids))
+				for _, id := range ids {
+					index, err := strconv.ParseUint(id, 10, 64)
+					require.NoError(t, err)
+					validator := &api.Validator{
+						Index:   phase0.ValidatorIndex(index),
+						Balance: 32000000000,
+						Status:  api.ValidatorStateActiveOngoing,
+						Validator: &phase0.Validator{
+							WithdrawalCredentials: make([]byte, 32),
+							EffectiveBalance:      32000000000,
+							ExitEpoch:             0xffffffffffffffff,
+							WithdrawableEpoch:     0xffffffffffffffff,
+						},
+					}
+					validator.Validator.PublicKey[47] = byte(index)
+					validators = append(validators, validator)
+				}
+
+				if r.Header.Get("Accept") == "application/octet-stream" {
+					if test.sszStatus != 0 {
+						w.WriteHeader(test.sszStatus)
+						return
+					}
+					w.Header().Set("Content-Type", "application/octet-stream")
+					for _, validator := range validators {
+						data, err := validator.MarshalSSZ()
+						require.NoError(t, err)
+						_, _ = w.Write(data)
+					}
+					return
+				}
+				data, err := json.Marshal(&validatorsJSON{Data: validators})
+				require.NoError(t, err)
+				_, _ = w.Write(data)
+			}))
+			defer srv.Close()
+
+			base, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+			s := &Service{
+				log:                zerolog.Nop(),
+				base:               base,
+				address:            srv.URL,
+				client:             srv.Client(),
+				timeout:            time.Second,
+				jsonCodec:          codecs.StdJSON,
+				limiter:            newLimiter(0, nil, 0),
+				endpointVersions:   make(map[string]string),
+				deprecations:       make(map[string]*EndpointDeprecation),
+				userIndexChunkSize: 2,
+				// Sequential, so that the order of the requests is known.
+				chunkConcurrency: 1,
+				preferSSZ:        test.preferSSZ,
+			}
+
+			validators, err := s.Validators(context.Background(), "head", []phase0.ValidatorIndex{1, 2, 3, 4, 5})
+			require.NoError(t, err)
+			require.Len(t, validators, 5)
+			for index := phase0.ValidatorIndex(1); index <= 5; index++ {
+				require.Equal(t, index, validators[index].Index)
+				require.Equal(t, byte(index), validators[index].Validator.PublicKey[47])
+				require.Equal(t, api.ValidatorStateActiveOngoing, validators[index].Status)
+			}
+			require.Equal(t, test.methods, methods)
+
+			// Requests that fit in a single chunk are made with GET.
+			validators, err = s.Validators(context.Background(), "head", []phase0.ValidatorIndex{1})
+			require.NoError(t, err)
+			require.Equal(t, byte(1), validators[1].Validator.PublicKey[47])
+		})
+	}
+}
+
+func TestChunkedValidatorsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight := 0
+	maxInFlight := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:                zerolog.Nop(),
+		base:               base,
+		address:            srv.URL,
+		client:             srv.Client(),
+		timeout:            time.Second,
+		jsonCodec:          codecs.StdJSON,
+		limiter:            newLimiter(0, nil, 0),
+		endpointVersions:   make(map[string]string),
+		deprecations:       make(map[string]*EndpointDeprecation),
+		userIndexChunkSize: 1,
+		chunkConcurrency:   3,
+	}
+
+	indices := make([]phase0.ValidatorIndex, 12)
+	for i := range indices {
+		indices[i] = phase0.ValidatorIndex(i)
+	}
+	_, err = s.Validators(context.Background(), "head", indices)
+	require.NoError(t, err)
+	require.Greater(t, maxInFlight, 1)
+	require.LessOrEqual(t, maxInFlight, 3)
+}
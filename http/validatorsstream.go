@@ -0,0 +1,147 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// StreamValidators fetches the validators, with their balance and status, for a given state and passes
+// each validator to the handler as it is decoded, avoiding holding the entire validator set in memory.
+// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+// validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
+// If the handler returns an error the stream is stopped and the error returned.
+// A handler is used rather than returning an iterator as this module supports versions
+// of Go that predate range-over-func iterators, and a handler allows the response body
+// to be decoded as it is received without holding the connection open between calls.
+func (s *Service) StreamValidators(ctx context.Context,
+	stateID string,
+	validatorIndices []phase0.ValidatorIndex,
+	handler func(*api.Validator) error,
+) error {
+	if stateID == "" {
+		return errors.New("no state ID specified")
+	}
+	if handler == nil {
+		return errors.New("no handler supplied")
+	}
+
+	url := fmt.Sprintf("/eth/v1/beacon/states/%s/validators", stateID)
+	if len(validatorIndices) == 0 {
+		return s.streamValidators(ctx, url, handler)
+	}
+
+	// Request the validators a chunk at a time, to keep within URL limits.
+	chunkSize := s.indexChunkSize(ctx)
+	for i := 0; i < len(validatorIndices); i += chunkSize {
+		end := i + chunkSize
+		if end > len(validatorIndices) {
+			end = len(validatorIndices)
+		}
+		ids := make([]string, 0, end-i)
+		for _, index := range validatorIndices[i:end] {
+			ids = append(ids, fmt.Sprintf("%d", index))
+		}
+		if err := s.streamValidators(ctx, fmt.Sprintf("%s?id=%s", url, strings.Join(ids, ",")), handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamValidators fetches a single validators response and streams it to the handler
+// as the body is received.
+func (s *Service) streamValidators(ctx context.Context, url string, handler func(*api.Validator) error) error {
+	var streamErr error
+	res, err := s.getStream(ctx, url, ContentTypeJSON, func(_ *httpResponse, body io.Reader) error {
+		streamErr = s.streamValidatorsFromJSON(body, handler)
+
+		return streamErr
+	})
+	if streamErr != nil {
+		// Errors from decoding and the handler are returned as-is.
+		return streamErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to request validators")
+	}
+	if res.statusCode == http.StatusNotFound {
+		return errors.New("failed to obtain validators")
+	}
+
+	return nil
+}
+
+// streamValidatorsFromJSON decodes the data array of a JSON validators
+// response one validator at a time as it is read.
+func (s *Service) streamValidatorsFromJSON(body io.Reader, handler func(*api.Validator) error) error {
+	decoder := json.NewDecoder(body)
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return errors.Wrap(err, "failed to parse validators")
+	}
+
+	foundData := false
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return errors.Wrap(err, "failed to parse validators")
+		}
+		if key, isString := token.(string); !isString || key != "data" {
+			// Not the data we are after; skip the value.
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return errors.Wrap(err, "failed to parse validators")
+			}
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return errors.Wrap(err, "failed to parse validators")
+		}
+		for decoder.More() {
+			var data json.RawMessage
+			if err := decoder.Decode(&data); err != nil {
+				return errors.Wrap(err, "failed to parse validator")
+			}
+			validator := &api.Validator{}
+			if err := s.jsonCodec.Unmarshal(data, validator); err != nil {
+				return errors.Wrap(err, "failed to parse validator")
+			}
+			if err := handler(validator); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return errors.Wrap(err, "failed to parse validators")
+		}
+		foundData = true
+	}
+
+	if !foundData {
+		return errors.New("no validators returned")
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func testValidatorJSON(index uint64) string {
+	return fmt.Sprintf(`{"index":"%d","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"0x%096x","withdrawal_credentials":"0x%064x","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"0","exit_epoch":"18446744073709551615","withdrawable_epoch":"18446744073709551615"}}`, index, index, 0)
+}
+
+func TestStreamValidatorsFromJSON(t *testing.T) {
+	data := []byte(fmt.Sprintf(`{"execution_optimistic":false,"data":[%s,%s,%s]}`, testValidatorJSON(0), testValidatorJSON(1), testValidatorJSON(2)))
+
+	tests := []struct {
+		name       string
+		input      []byte
+		handlerErr error
+		expected   int
+		err        string
+	}{
+		{
+			name:  "Empty",
+			input: []byte(``),
+			err:   "failed to parse validators: EOF",
+		},
+		{
+			name:  "DataMissing",
+			input: []byte(`{}`),
+			err:   "no validators returned",
+		},
+		{
+			name:  "DataWrongType",
+			input: []byte(`{"data":{}}`),
+			err:   "failed to parse validators: expected [, found {",
+		},
+		{
+			name:  "ValidatorInvalid",
+			input: []byte(`{"data":[{}]}`),
+			err:   "failed to parse validator: index missing",
+		},
+		{
+			name:     "DataEmpty",
+			input:    []byte(`{"data":[]}`),
+			expected: 0,
+		},
+		{
+			name:       "HandlerError",
+			input:      data,
+			handlerErr: errors.New("handler failed"),
+			expected:   1,
+			err:        "handler failed",
+		},
+		{
+			name:     "Good",
+			input:    data,
+			expected: 3,
+		},
+	}
+
+	s := &Service{jsonCodec: codecs.StdJSON}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			received := make([]*api.Validator, 0)
+			err := s.streamValidatorsFromJSON(bytes.NewReader(test.input), func(validator *api.Validator) error {
+				received = append(received, validator)
+				return test.handlerErr
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, received, test.expected)
+			for i := range received {
+				require.Equal(t, phase0.ValidatorIndex(i), received[i].Index)
+			}
+		})
+	}
+}
+
+func TestStreamValidators(t *testing.T) {
+	requests := make([]string, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/beacon/states/head/validators", r.URL.Path)
+		requests = append(requests, r.URL.Query().Get("id"))
+
+		ids := strings.Split(r.URL.Query().Get("id"), ",")
+		validators := make([]string, 0, len(ids))
+		for _, id := range ids {
+			index, err := strconv.ParseUint(id, 10, 64)
+			require.NoError(t, err)
+			validators = append(validators, testValidatorJSON(index))
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s]}`, strings.Join(validators, ","))))
+	}))
+	defer srv.Close()
+
+	base, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	s := &Service{
+		log:                zerolog.Nop(),
+		base:               base,
+		address:            srv.URL,
+		client:             srv.Client(),
+		timeout:            time.Second,
+		jsonCodec:          codecs.StdJSON,
+		limiter:            newLimiter(0, nil, 0),
+		endpointVersions:   make(map[string]string),
+		deprecations:       make(map[string]*EndpointDeprecation),
+		userIndexChunkSize: 2,
+	}
+
+	received := make([]phase0.ValidatorIndex, 0)
+	err = s.StreamValidators(context.Background(), "head", []phase0.ValidatorIndex{1, 2, 3, 4, 5}, func(validator *api.Validator) error {
+		received = append(received, validator.Index)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{1, 2, 3, 4, 5}, received)
+	require.Equal(t, []string{"1,2", "3,4", "5"}, requests)
+
+	require.EqualError(t, s.StreamValidators(context.Background(), "", nil, func(*api.Validator) error { return nil }), "no state ID specified")
+	require.EqualError(t, s.StreamValidators(context.Background(), "head", nil, nil), "no handler supplied")
+}
+
+func TestStreamValidatorsIncremental(t *testing.T) {
+	// The server does not complete the response until the handler has received
+	// the first validator, so the body must be decoded as it is received.
+	firstReceived := make(chan struct{})
+	s := testNodeService(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s`, testValidatorJSON(0))))
+		w.(http.Flusher).Flush()
+		select {
+		case <-firstReceived:
+		case <-time.After(time.Second):
+			t.Error("first validator not received before end of response")
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`,%s]}`, testValidatorJSON(1))))
+	})
+
+	received := make([]phase0.ValidatorIndex, 0)
+	err := s.StreamValidators(context.Background(), "head", nil, func(validator *api.Validator) error {
+		if len(received) == 0 {
+			close(firstReceived)
+		}
+		received = append(received, validator.Index)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{0, 1}, received)
+}
@@ -0,0 +1,185 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Versions of endpoints that are available in multiple versions, newest first.
+var (
+	beaconBlocksEndpoints        = []string{"/eth/v2/beacon/blocks", "/eth/v1/beacon/blocks"}
+	blindedBeaconBlocksEndpoints = []string{"/eth/v2/beacon/blinded_blocks", "/eth/v1/beacon/blinded_blocks"}
+)
+
+// EndpointDeprecation contains information about a deprecated endpoint,
+// as supplied by the node in response headers.
+type EndpointDeprecation struct {
+	// Endpoint is the endpoint, with any identifiers in the path replaced by "{id}".
+	Endpoint string
+	// Deprecation is the value of the Deprecation header.
+	Deprecation string
+	// Sunset is the value of the Sunset header, if present.
+	Sunset string
+}
+
+// EndpointDeprecations returns the endpoints that the node has stated are deprecated
+// in responses to requests made by this service.
+func (s *Service) EndpointDeprecations() []*EndpointDeprecation {
+	s.deprecationsMu.RLock()
+	defer s.deprecationsMu.RUnlock()
+
+	res := make([]*EndpointDeprecation, 0, len(s.deprecations))
+	for _, deprecation := range s.deprecations {
+		res = append(res, &EndpointDeprecation{
+			Endpoint:    deprecation.Endpoint,
+			Deprecation: deprecation.Deprecation,
+			Sunset:      deprecation.Sunset,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Endpoint < res[j].Endpoint
+	})
+
+	return res
+}
+
+// EndpointVersions returns the versions of endpoints that have been negotiated
+// with the node, keyed by the newest version of the endpoint.
+func (s *Service) EndpointVersions() map[string]string {
+	s.endpointVersionsMu.RLock()
+	defer s.endpointVersionsMu.RUnlock()
+
+	res := make(map[string]string, len(s.endpointVersions))
+	for k, v := range s.endpointVersions {
+		res[k] = v
+	}
+
+	return res
+}
+
+// checkDeprecation checks the response headers for deprecation information,
+// logging and recording it the first time it is seen for an endpoint.
+func (s *Service) checkDeprecation(log zerolog.Logger, endpoint string, header http.Header) {
+	deprecation := header.Get("Deprecation")
+	sunset := header.Get("Sunset")
+	if deprecation == "" && sunset == "" {
+		return
+	}
+
+	key := normaliseEndpoint(endpoint)
+
+	s.deprecationsMu.Lock()
+	defer s.deprecationsMu.Unlock()
+	if _, exists := s.deprecations[key]; exists {
+		return
+	}
+	s.deprecations[key] = &EndpointDeprecation{
+		Endpoint:    key,
+		Deprecation: deprecation,
+		Sunset:      sunset,
+	}
+	log.Warn().Str("deprecation", deprecation).Str("sunset", sunset).Msg("Endpoint is deprecated")
+}
+
+// normaliseEndpoint removes the query from an endpoint and replaces identifiers
+// in its path, so that requests for different items are recorded together.
+func normaliseEndpoint(endpoint string) string {
+	if idx := strings.Index(endpoint, "?"); idx != -1 {
+		endpoint = endpoint[:idx]
+	}
+
+	segments := strings.Split(endpoint, "/")
+	for i := range segments {
+		if segments[i] == "" {
+			continue
+		}
+		if strings.HasPrefix(segments[i], "0x") || (segments[i][0] >= '0' && segments[i][0] <= '9') {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// negotiateEndpoint calls the newest version of an endpoint supported by the node.
+// Versions are tried newest first, falling back to the next version if the node
+// states that the endpoint is not present; the version that succeeds is used
+// for future calls.
+func (s *Service) negotiateEndpoint(endpoints []string, call func(endpoint string) error) error {
+	if len(endpoints) == 0 {
+		return errors.New("no endpoints supplied")
+	}
+
+	s.endpointVersionsMu.RLock()
+	negotiated, exists := s.endpointVersions[endpoints[0]]
+	s.endpointVersionsMu.RUnlock()
+
+	start := 0
+	if exists {
+		for i := range endpoints {
+			if endpoints[i] == negotiated {
+				start = i
+				break
+			}
+		}
+	}
+
+	var err error
+	for i := start; i < len(endpoints); i++ {
+		err = call(endpoints[i])
+		if err == nil {
+			if !exists || negotiated != endpoints[i] {
+				s.endpointVersionsMu.Lock()
+				s.endpointVersions[endpoints[0]] = endpoints[i]
+				s.endpointVersionsMu.Unlock()
+			}
+
+			return nil
+		}
+		if !endpointNotSupported(err) {
+			return err
+		}
+		s.log.Debug().Str("endpoint", endpoints[i]).Msg("Endpoint not supported by node; trying previous version")
+	}
+
+	return err
+}
+
+// endpointNotSupported returns true if the error shows that the node does not
+// support the endpoint that was called.
+func endpointNotSupported(err error) bool {
+	var httpErr Error
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	return httpErr.StatusCode == http.StatusNotFound || httpErr.StatusCode == http.StatusMethodNotAllowed
+}
+
+// contentNotAcceptable returns true if the error shows that the node cannot
+// provide the response in the requested content type.
+func contentNotAcceptable(err error) bool {
+	var httpErr Error
+	if !errors.As(err, &httpErr) {
+		return false
+	}
+
+	return httpErr.StatusCode == http.StatusNotAcceptable
+}
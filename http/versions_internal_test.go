@@ -0,0 +1,168 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormaliseEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected string
+	}{
+		{
+			endpoint: "/eth/v1/node/version",
+			expected: "/eth/v1/node/version",
+		},
+		{
+			endpoint: "/eth/v1/beacon/pool/attestations?slot=12",
+			expected: "/eth/v1/beacon/pool/attestations",
+		},
+		{
+			endpoint: "/eth/v2/beacon/blocks/12345",
+			expected: "/eth/v2/beacon/blocks/{id}",
+		},
+		{
+			endpoint: "/eth/v1/beacon/headers/0x0102030405060708091011121314151617181920212223242526272829303132",
+			expected: "/eth/v1/beacon/headers/{id}",
+		},
+		{
+			endpoint: "/eth/v1/beacon/states/head/validators",
+			expected: "/eth/v1/beacon/states/head/validators",
+		},
+		{
+			endpoint: "/eth/v1/validator/duties/proposer/10",
+			expected: "/eth/v1/validator/duties/proposer/{id}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			require.Equal(t, test.expected, normaliseEndpoint(test.endpoint))
+		})
+	}
+}
+
+func TestCheckDeprecation(t *testing.T) {
+	s := &Service{
+		jsonCodec:    codecs.StdJSON,
+		deprecations: make(map[string]*EndpointDeprecation),
+	}
+	log := zerolog.Nop()
+
+	// No headers.
+	s.checkDeprecation(log, "/eth/v1/node/version", http.Header{})
+	require.Empty(t, s.EndpointDeprecations())
+
+	header := http.Header{}
+	header.Set("Deprecation", "true")
+	header.Set("Sunset", "Sat, 01 Jun 2024 00:00:00 GMT")
+	s.checkDeprecation(log, "/eth/v1/beacon/blocks/1", header)
+	s.checkDeprecation(log, "/eth/v1/beacon/blocks/2", header)
+	require.Equal(t, []*EndpointDeprecation{
+		{
+			Endpoint:    "/eth/v1/beacon/blocks/{id}",
+			Deprecation: "true",
+			Sunset:      "Sat, 01 Jun 2024 00:00:00 GMT",
+		},
+	}, s.EndpointDeprecations())
+}
+
+func TestNegotiateEndpoint(t *testing.T) {
+	endpoints := []string{"/eth/v2/test", "/eth/v1/test"}
+	notFound := errors.Wrap(Error{Method: http.MethodPost, StatusCode: http.StatusNotFound, Endpoint: "/eth/v2/test"}, "failed")
+	badRequest := errors.Wrap(Error{Method: http.MethodPost, StatusCode: http.StatusBadRequest, Endpoint: "/eth/v2/test"}, "failed")
+
+	tests := []struct {
+		name     string
+		supports map[string]error
+		called   []string
+		expected string
+		err      string
+	}{
+		{
+			name: "Newest",
+			supports: map[string]error{
+				"/eth/v2/test": nil,
+				"/eth/v1/test": nil,
+			},
+			called:   []string{"/eth/v2/test"},
+			expected: "/eth/v2/test",
+		},
+		{
+			name: "Fallback",
+			supports: map[string]error{
+				"/eth/v2/test": notFound,
+				"/eth/v1/test": nil,
+			},
+			called:   []string{"/eth/v2/test", "/eth/v1/test"},
+			expected: "/eth/v1/test",
+		},
+		{
+			name: "OtherError",
+			supports: map[string]error{
+				"/eth/v2/test": badRequest,
+				"/eth/v1/test": nil,
+			},
+			called: []string{"/eth/v2/test"},
+			err:    badRequest.Error(),
+		},
+		{
+			name: "NoneSupported",
+			supports: map[string]error{
+				"/eth/v2/test": notFound,
+				"/eth/v1/test": notFound,
+			},
+			called: []string{"/eth/v2/test", "/eth/v1/test"},
+			err:    notFound.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				jsonCodec:        codecs.StdJSON,
+				log:              zerolog.Nop(),
+				endpointVersions: make(map[string]string),
+			}
+			called := make([]string, 0)
+			err := s.negotiateEndpoint(endpoints, func(endpoint string) error {
+				called = append(called, endpoint)
+				return test.supports[endpoint]
+			})
+			require.Equal(t, test.called, called)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, s.EndpointVersions()["/eth/v2/test"])
+
+				// Subsequent calls should go straight to the negotiated endpoint.
+				called = make([]string, 0)
+				require.NoError(t, s.negotiateEndpoint(endpoints, func(endpoint string) error {
+					called = append(called, endpoint)
+					return test.supports[endpoint]
+				}))
+				require.Equal(t, []string{test.expected}, called)
+			}
+		})
+	}
+}
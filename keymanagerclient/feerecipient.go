@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type feeRecipientJSON struct {
+	ETHAddress string `json:"ethaddress"`
+}
+
+// FeeRecipient fetches the fee recipient for a validator.
+func (s *Service) FeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) (bellatrix.ExecutionAddress, error) {
+	var data feeRecipientJSON
+	if err := s.get(ctx, validatorEndpoint(pubKey, "feerecipient"), &data); err != nil {
+		return bellatrix.ExecutionAddress{}, errors.Wrap(err, "failed to obtain fee recipient")
+	}
+
+	address, err := hex.DecodeString(strings.TrimPrefix(data.ETHAddress, "0x"))
+	if err != nil {
+		return bellatrix.ExecutionAddress{}, errors.Wrap(err, "invalid value for fee recipient")
+	}
+	if len(address) != bellatrix.ExecutionAddressLength {
+		return bellatrix.ExecutionAddress{}, errors.New("incorrect length for fee recipient")
+	}
+
+	var feeRecipient bellatrix.ExecutionAddress
+	copy(feeRecipient[:], address)
+
+	return feeRecipient, nil
+}
+
+// SetFeeRecipient sets the fee recipient for a validator.
+func (s *Service) SetFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey, feeRecipient bellatrix.ExecutionAddress) error {
+	if _, err := s.do(ctx, http.MethodPost, validatorEndpoint(pubKey, "feerecipient"), &feeRecipientJSON{
+		ETHAddress: fmt.Sprintf("%#x", feeRecipient),
+	}); err != nil {
+		return errors.Wrap(err, "failed to set fee recipient")
+	}
+
+	return nil
+}
+
+// DeleteFeeRecipient deletes the fee recipient for a validator, returning it to the validator client's default.
+func (s *Service) DeleteFeeRecipient(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	if _, err := s.do(ctx, http.MethodDelete, validatorEndpoint(pubKey, "feerecipient"), nil); err != nil {
+		return errors.Wrap(err, "failed to delete fee recipient")
+	}
+
+	return nil
+}
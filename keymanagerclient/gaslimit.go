@@ -0,0 +1,63 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type gasLimitJSON struct {
+	GasLimit string `json:"gas_limit"`
+}
+
+// GasLimit fetches the gas limit for a validator.
+func (s *Service) GasLimit(ctx context.Context, pubKey phase0.BLSPubKey) (uint64, error) {
+	var data gasLimitJSON
+	if err := s.get(ctx, validatorEndpoint(pubKey, "gas_limit"), &data); err != nil {
+		return 0, errors.Wrap(err, "failed to obtain gas limit")
+	}
+
+	gasLimit, err := strconv.ParseUint(data.GasLimit, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid value for gas limit")
+	}
+
+	return gasLimit, nil
+}
+
+// SetGasLimit sets the gas limit for a validator.
+func (s *Service) SetGasLimit(ctx context.Context, pubKey phase0.BLSPubKey, gasLimit uint64) error {
+	if _, err := s.do(ctx, http.MethodPost, validatorEndpoint(pubKey, "gas_limit"), &gasLimitJSON{
+		GasLimit: fmt.Sprintf("%d", gasLimit),
+	}); err != nil {
+		return errors.Wrap(err, "failed to set gas limit")
+	}
+
+	return nil
+}
+
+// DeleteGasLimit deletes the gas limit for a validator, returning it to the validator client's default.
+func (s *Service) DeleteGasLimit(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	if _, err := s.do(ctx, http.MethodDelete, validatorEndpoint(pubKey, "gas_limit"), nil); err != nil {
+		return errors.Wrap(err, "failed to delete gas limit")
+	}
+
+	return nil
+}
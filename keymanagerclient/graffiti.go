@@ -0,0 +1,63 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// maxGraffitiLength is the maximum length of graffiti, in bytes.
+const maxGraffitiLength = 32
+
+type graffitiJSON struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// Graffiti fetches the graffiti for a validator.
+func (s *Service) Graffiti(ctx context.Context, pubKey phase0.BLSPubKey) (string, error) {
+	var data graffitiJSON
+	if err := s.get(ctx, validatorEndpoint(pubKey, "graffiti"), &data); err != nil {
+		return "", errors.Wrap(err, "failed to obtain graffiti")
+	}
+
+	return data.Graffiti, nil
+}
+
+// SetGraffiti sets the graffiti for a validator.
+func (s *Service) SetGraffiti(ctx context.Context, pubKey phase0.BLSPubKey, graffiti string) error {
+	if len(graffiti) > maxGraffitiLength {
+		return errors.New("graffiti too long")
+	}
+
+	if _, err := s.do(ctx, http.MethodPost, validatorEndpoint(pubKey, "graffiti"), &graffitiJSON{
+		Graffiti: graffiti,
+	}); err != nil {
+		return errors.Wrap(err, "failed to set graffiti")
+	}
+
+	return nil
+}
+
+// DeleteGraffiti deletes the graffiti for a validator, returning it to the validator client's default.
+func (s *Service) DeleteGraffiti(ctx context.Context, pubKey phase0.BLSPubKey) error {
+	if _, err := s.do(ctx, http.MethodDelete, validatorEndpoint(pubKey, "graffiti"), nil); err != nil {
+		return errors.Wrap(err, "failed to delete graffiti")
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const contentTypeJSON = "application/json"
+
+// Error represents an http error.
+type Error struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Data       []byte
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s failed with status %d: %s", e.Method, e.StatusCode, e.Data)
+}
+
+// dataJSON is the keymanager API representation of a response.
+type dataJSON struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// do sends a request to the keymanager API and returns the body of the response.
+// If body is not nil it is sent as JSON.
+// Responses with a status code other than 2xx are returned as an Error.
+func (s *Service) do(ctx context.Context,
+	method string,
+	endpoint string,
+	body interface{},
+) (
+	[]byte,
+	error,
+) {
+	log := s.log.With().Str("method", method).Str("endpoint", endpoint).Logger()
+	log.Trace().Msg("Sending request")
+
+	reference, err := url.Parse(strings.TrimPrefix(endpoint, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request")
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.base.ResolveReference(reference).String(), bodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	for k, v := range s.extraHeaders {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.bearerToken))
+	req.Header.Set("Accept", contentTypeJSON)
+	if body != nil {
+		req.Header.Set("Content-Type", contentTypeJSON)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call keymanager API")
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	log.Trace().Int("status_code", resp.StatusCode).Int("size", len(data)).Msg("Received response")
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, Error{
+			Method:     method,
+			Endpoint:   endpoint,
+			StatusCode: resp.StatusCode,
+			Data:       data,
+		}
+	}
+
+	return data, nil
+}
+
+// get sends a GET request and decodes the data of the response in to the object.
+func (s *Service) get(ctx context.Context, endpoint string, obj interface{}) error {
+	data, err := s.do(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp dataJSON
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	if err := json.Unmarshal(resp.Data, obj); err != nil {
+		return errors.Wrap(err, "invalid JSON data")
+	}
+
+	return nil
+}
+
+// validatorEndpoint returns the endpoint for a per-validator setting.
+func validatorEndpoint(pubKey phase0.BLSPubKey, setting string) string {
+	return fmt.Sprintf("/eth/v1/validator/%#x/%s", pubKey, setting)
+}
+
+// parsePubKey parses a hex string in to a public key.
+func parsePubKey(input string) (phase0.BLSPubKey, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return phase0.BLSPubKey{}, errors.Wrap(err, "invalid value for public key")
+	}
+	if len(data) != phase0.PublicKeyLength {
+		return phase0.BLSPubKey{}, errors.New("incorrect length for public key")
+	}
+
+	var pubKey phase0.BLSPubKey
+	copy(pubKey[:], data)
+
+	return pubKey, nil
+}
+
+// pubKeyStrings returns the hex string representations of public keys.
+func pubKeyStrings(pubKeys []phase0.BLSPubKey) []string {
+	res := make([]string, len(pubKeys))
+	for i := range pubKeys {
+		res[i] = fmt.Sprintf("%#x", pubKeys[i])
+	}
+
+	return res
+}
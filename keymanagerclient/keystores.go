@@ -0,0 +1,144 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type keystoreJSON struct {
+	ValidatingPubkey string `json:"validating_pubkey"`
+	DerivationPath   string `json:"derivation_path,omitempty"`
+	ReadOnly         bool   `json:"readonly,omitempty"`
+}
+
+type importKeystoresJSON struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type deleteKeysJSON struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type deleteKeystoresResponseJSON struct {
+	Data               []*Result `json:"data"`
+	SlashingProtection string    `json:"slashing_protection"`
+}
+
+// ListKeystores lists the keystores held by the validator client.
+func (s *Service) ListKeystores(ctx context.Context) ([]*Keystore, error) {
+	var data []*keystoreJSON
+	if err := s.get(ctx, "/eth/v1/keystores", &data); err != nil {
+		return nil, errors.Wrap(err, "failed to list keystores")
+	}
+
+	res := make([]*Keystore, 0, len(data))
+	for _, keystore := range data {
+		pubKey, err := parsePubKey(keystore.ValidatingPubkey)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &Keystore{
+			ValidatingPubkey: pubKey,
+			DerivationPath:   keystore.DerivationPath,
+			ReadOnly:         keystore.ReadOnly,
+		})
+	}
+
+	return res, nil
+}
+
+// ImportKeystores imports EIP-2335 keystores, along with their passwords and optional
+// EIP-3076 slashing protection data, in to the validator client.
+// The results are in the same order as the keystores.
+func (s *Service) ImportKeystores(ctx context.Context,
+	keystores []string,
+	passwords []string,
+	slashingProtection string,
+) (
+	[]*Result,
+	error,
+) {
+	if len(keystores) == 0 {
+		return nil, errors.New("no keystores supplied")
+	}
+	if len(passwords) != len(keystores) {
+		return nil, errors.New("number of passwords does not match number of keystores")
+	}
+
+	data, err := s.do(ctx, http.MethodPost, "/eth/v1/keystores", &importKeystoresJSON{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import keystores")
+	}
+
+	return parseResults(data, len(keystores))
+}
+
+// DeleteKeystores deletes keystores from the validator client.
+// The results are in the same order as the public keys, and are returned along with the
+// EIP-3076 slashing protection data for the keys.
+func (s *Service) DeleteKeystores(ctx context.Context,
+	pubKeys []phase0.BLSPubKey,
+) (
+	[]*Result,
+	string,
+	error,
+) {
+	if len(pubKeys) == 0 {
+		return nil, "", errors.New("no public keys supplied")
+	}
+
+	data, err := s.do(ctx, http.MethodDelete, "/eth/v1/keystores", &deleteKeysJSON{
+		Pubkeys: pubKeyStrings(pubKeys),
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to delete keystores")
+	}
+
+	var resp deleteKeystoresResponseJSON
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, "", errors.Wrap(err, "invalid JSON")
+	}
+	if len(resp.Data) != len(pubKeys) {
+		return nil, "", errors.New("incorrect number of results")
+	}
+
+	return resp.Data, resp.SlashingProtection, nil
+}
+
+// parseResults parses the results of an import or delete operation.
+func parseResults(data []byte, expected int) ([]*Result, error) {
+	var resp struct {
+		Data []*Result `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, errors.Wrap(err, "invalid JSON")
+	}
+	if len(resp.Data) != expected {
+		return nil, errors.New("incorrect number of results")
+	}
+
+	return resp.Data, nil
+}
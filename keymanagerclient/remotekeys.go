@@ -0,0 +1,101 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+type remoteKeyJSON struct {
+	Pubkey   string `json:"pubkey"`
+	URL      string `json:"url,omitempty"`
+	ReadOnly bool   `json:"readonly,omitempty"`
+}
+
+type importRemoteKeysJSON struct {
+	RemoteKeys []*remoteKeyJSON `json:"remote_keys"`
+}
+
+// ListRemoteKeys lists the remote keys used by the validator client.
+func (s *Service) ListRemoteKeys(ctx context.Context) ([]*RemoteKey, error) {
+	var data []*remoteKeyJSON
+	if err := s.get(ctx, "/eth/v1/remotekeys", &data); err != nil {
+		return nil, errors.Wrap(err, "failed to list remote keys")
+	}
+
+	res := make([]*RemoteKey, 0, len(data))
+	for _, remoteKey := range data {
+		pubKey, err := parsePubKey(remoteKey.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &RemoteKey{
+			Pubkey:   pubKey,
+			URL:      remoteKey.URL,
+			ReadOnly: remoteKey.ReadOnly,
+		})
+	}
+
+	return res, nil
+}
+
+// ImportRemoteKeys imports remote keys in to the validator client.
+// The results are in the same order as the keys.
+func (s *Service) ImportRemoteKeys(ctx context.Context, remoteKeys []*RemoteKey) ([]*Result, error) {
+	if len(remoteKeys) == 0 {
+		return nil, errors.New("no remote keys supplied")
+	}
+
+	request := &importRemoteKeysJSON{
+		RemoteKeys: make([]*remoteKeyJSON, 0, len(remoteKeys)),
+	}
+	for _, remoteKey := range remoteKeys {
+		if remoteKey == nil {
+			return nil, errors.New("nil remote key supplied")
+		}
+		request.RemoteKeys = append(request.RemoteKeys, &remoteKeyJSON{
+			Pubkey: fmt.Sprintf("%#x", remoteKey.Pubkey),
+			URL:    remoteKey.URL,
+		})
+	}
+
+	data, err := s.do(ctx, http.MethodPost, "/eth/v1/remotekeys", request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import remote keys")
+	}
+
+	return parseResults(data, len(remoteKeys))
+}
+
+// DeleteRemoteKeys deletes remote keys from the validator client.
+// The results are in the same order as the public keys.
+func (s *Service) DeleteRemoteKeys(ctx context.Context, pubKeys []phase0.BLSPubKey) ([]*Result, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys supplied")
+	}
+
+	data, err := s.do(ctx, http.MethodDelete, "/eth/v1/remotekeys", &deleteKeysJSON{
+		Pubkeys: pubKeyStrings(pubKeys),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete remote keys")
+	}
+
+	return parseResults(data, len(pubKeys))
+}
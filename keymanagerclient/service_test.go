@@ -0,0 +1,245 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/keymanagerclient"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "secret"
+
+var testPubKey = phase0.BLSPubKey{0x01}
+
+// validatorClient is a fake validator client.
+type validatorClient struct {
+	mu       sync.Mutex
+	requests map[string][]byte
+}
+
+func (v *validatorClient) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Authorization") != "Bearer "+testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"unauthorized"}`))
+
+		return
+	}
+
+	body, _ := io.ReadAll(req.Body)
+	v.mu.Lock()
+	v.requests[fmt.Sprintf("%s %s", req.Method, req.URL.Path)] = body
+	v.mu.Unlock()
+
+	pubKey := fmt.Sprintf("%#x", testPubKey)
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/eth/v1/keystores":
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[{"validating_pubkey":%q,"derivation_path":"m/12381/3600/0/0/0","readonly":false}]}`, pubKey)))
+	case req.Method == http.MethodPost && req.URL.Path == "/eth/v1/keystores":
+		_, _ = w.Write([]byte(`{"data":[{"status":"imported"}]}`))
+	case req.Method == http.MethodDelete && req.URL.Path == "/eth/v1/keystores":
+		_, _ = w.Write([]byte(`{"data":[{"status":"deleted"}],"slashing_protection":"{}"}`))
+	case req.Method == http.MethodGet && req.URL.Path == "/eth/v1/remotekeys":
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[{"pubkey":%q,"url":"https://signer:9000","readonly":true}]}`, pubKey)))
+	case req.Method == http.MethodPost && req.URL.Path == "/eth/v1/remotekeys":
+		_, _ = w.Write([]byte(`{"data":[{"status":"duplicate","message":"already present"}]}`))
+	case req.Method == http.MethodDelete && req.URL.Path == "/eth/v1/remotekeys":
+		_, _ = w.Write([]byte(`{"data":[{"status":"not_found"}]}`))
+	case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/feerecipient"):
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"pubkey":%q,"ethaddress":"0x0200000000000000000000000000000000000000"}}`, pubKey)))
+	case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/gas_limit"):
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"pubkey":%q,"gas_limit":"30000000"}}`, pubKey)))
+	case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/graffiti"):
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"pubkey":%q,"graffiti":"hello"}}`, pubKey)))
+	case req.Method == http.MethodPost:
+		w.WriteHeader(http.StatusAccepted)
+	case req.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found"}`))
+	}
+}
+
+func (v *validatorClient) request(key string) map[string]interface{} {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	res := make(map[string]interface{})
+	_ = json.Unmarshal(v.requests[key], &res)
+
+	return res
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []keymanagerclient.Parameter
+		err    string
+	}{
+		{
+			name: "AddressMissing",
+			params: []keymanagerclient.Parameter{
+				keymanagerclient.WithLogLevel(zerolog.Disabled),
+				keymanagerclient.WithBearerToken(testToken),
+			},
+			err: "problem with parameters: no address specified",
+		},
+		{
+			name: "BearerTokenMissing",
+			params: []keymanagerclient.Parameter{
+				keymanagerclient.WithLogLevel(zerolog.Disabled),
+				keymanagerclient.WithAddress("localhost:1"),
+			},
+			err: "problem with parameters: no bearer token specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []keymanagerclient.Parameter{
+				keymanagerclient.WithLogLevel(zerolog.Disabled),
+				keymanagerclient.WithAddress("localhost:1"),
+				keymanagerclient.WithBearerToken(testToken),
+				keymanagerclient.WithTimeout(0),
+			},
+			err: "problem with parameters: no timeout specified",
+		},
+		{
+			name: "Good",
+			params: []keymanagerclient.Parameter{
+				keymanagerclient.WithLogLevel(zerolog.Disabled),
+				keymanagerclient.WithAddress("localhost:1"),
+				keymanagerclient.WithBearerToken(testToken),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := keymanagerclient.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequests(t *testing.T) {
+	ctx := context.Background()
+	fake := &validatorClient{requests: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s, err := keymanagerclient.New(ctx,
+		keymanagerclient.WithLogLevel(zerolog.Disabled),
+		keymanagerclient.WithAddress(server.URL),
+		keymanagerclient.WithBearerToken(testToken),
+	)
+	require.NoError(t, err)
+
+	// Keystores.
+	keystores, err := s.ListKeystores(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*keymanagerclient.Keystore{
+		{ValidatingPubkey: testPubKey, DerivationPath: "m/12381/3600/0/0/0"},
+	}, keystores)
+
+	_, err = s.ImportKeystores(ctx, []string{"{}"}, nil, "")
+	require.EqualError(t, err, "number of passwords does not match number of keystores")
+	results, err := s.ImportKeystores(ctx, []string{"{}"}, []string{"password"}, "")
+	require.NoError(t, err)
+	require.Equal(t, keymanagerclient.StatusImported, results[0].Status)
+	require.Equal(t, []interface{}{"password"}, fake.request("POST /eth/v1/keystores")["passwords"])
+
+	results, slashingProtection, err := s.DeleteKeystores(ctx, []phase0.BLSPubKey{testPubKey})
+	require.NoError(t, err)
+	require.Equal(t, keymanagerclient.StatusDeleted, results[0].Status)
+	require.Equal(t, "{}", slashingProtection)
+	require.Equal(t, []interface{}{fmt.Sprintf("%#x", testPubKey)}, fake.request("DELETE /eth/v1/keystores")["pubkeys"])
+
+	// Remote keys.
+	remoteKeys, err := s.ListRemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*keymanagerclient.RemoteKey{
+		{Pubkey: testPubKey, URL: "https://signer:9000", ReadOnly: true},
+	}, remoteKeys)
+
+	results, err = s.ImportRemoteKeys(ctx, remoteKeys)
+	require.NoError(t, err)
+	require.Equal(t, &keymanagerclient.Result{Status: keymanagerclient.StatusDuplicate, Message: "already present"}, results[0])
+
+	results, err = s.DeleteRemoteKeys(ctx, []phase0.BLSPubKey{testPubKey})
+	require.NoError(t, err)
+	require.Equal(t, keymanagerclient.StatusNotFound, results[0].Status)
+
+	// Fee recipient.
+	feeRecipient, err := s.FeeRecipient(ctx, testPubKey)
+	require.NoError(t, err)
+	require.Equal(t, bellatrix.ExecutionAddress{0x02}, feeRecipient)
+	require.NoError(t, s.SetFeeRecipient(ctx, testPubKey, bellatrix.ExecutionAddress{0x03}))
+	require.Equal(t, "0x0300000000000000000000000000000000000000",
+		fake.request(fmt.Sprintf("POST /eth/v1/validator/%#x/feerecipient", testPubKey))["ethaddress"])
+	require.NoError(t, s.DeleteFeeRecipient(ctx, testPubKey))
+
+	// Gas limit.
+	gasLimit, err := s.GasLimit(ctx, testPubKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000000), gasLimit)
+	require.NoError(t, s.SetGasLimit(ctx, testPubKey, 36000000))
+	require.Equal(t, "36000000", fake.request(fmt.Sprintf("POST /eth/v1/validator/%#x/gas_limit", testPubKey))["gas_limit"])
+	require.NoError(t, s.DeleteGasLimit(ctx, testPubKey))
+
+	// Graffiti.
+	graffiti, err := s.Graffiti(ctx, testPubKey)
+	require.NoError(t, err)
+	require.Equal(t, "hello", graffiti)
+	require.EqualError(t, s.SetGraffiti(ctx, testPubKey, strings.Repeat("x", 33)), "graffiti too long")
+	require.NoError(t, s.SetGraffiti(ctx, testPubKey, "world"))
+	require.NoError(t, s.DeleteGraffiti(ctx, testPubKey))
+}
+
+func TestUnauthorized(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(&validatorClient{requests: make(map[string][]byte)})
+	defer server.Close()
+
+	s, err := keymanagerclient.New(ctx,
+		keymanagerclient.WithLogLevel(zerolog.Disabled),
+		keymanagerclient.WithAddress(server.URL),
+		keymanagerclient.WithBearerToken("wrong"),
+	)
+	require.NoError(t, err)
+
+	_, err = s.ListKeystores(ctx)
+	require.EqualError(t, err, `failed to list keystores: GET failed with status 401: {"message":"unauthorized"}`)
+	var apiErr keymanagerclient.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keymanagerclient
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Status is the status of an individual key in an import or delete operation.
+type Status string
+
+const (
+	// StatusImported is returned when the key was imported.
+	StatusImported Status = "imported"
+	// StatusDuplicate is returned when the key was already present.
+	StatusDuplicate Status = "duplicate"
+	// StatusDeleted is returned when the key was deleted.
+	StatusDeleted Status = "deleted"
+	// StatusNotActive is returned when the key was not present, but slashing protection data was.
+	StatusNotActive Status = "not_active"
+	// StatusNotFound is returned when the key was not present.
+	StatusNotFound Status = "not_found"
+	// StatusError is returned when the operation failed for the key.
+	StatusError Status = "error"
+)
+
+// Result is the result of an import or delete operation for an individual key.
+type Result struct {
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Keystore is a keystore held by the validator client.
+type Keystore struct {
+	ValidatingPubkey phase0.BLSPubKey
+	DerivationPath   string
+	ReadOnly         bool
+}
+
+// RemoteKey is a key held by a remote signer on behalf of the validator client.
+type RemoteKey struct {
+	Pubkey   phase0.BLSPubKey
+	URL      string
+	ReadOnly bool
+}
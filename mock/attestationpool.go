@@ -16,11 +16,44 @@ package mock
 import (
 	"context"
 
+	"github.com/attestantio/go-eth2-client/api"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // AttestationPool fetches the attestation pool for the given slot.
-func (s *Service) AttestationPool(_ context.Context, _ spec.Slot) ([]*spec.Attestation, error) {
+func (s *Service) AttestationPool(ctx context.Context, slot spec.Slot) ([]*spec.Attestation, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.AttestationPoolFunc != nil {
+		return s.AttestationPoolFunc(ctx, slot)
+	}
+
+	return attestationPool(), nil
+}
+
+// AttestationPoolPage fetches a single page of the attestation pool for the given slot.
+func (s *Service) AttestationPoolPage(ctx context.Context,
+	slot spec.Slot,
+	opts *api.PageOpts,
+) (
+	*api.Page[*spec.Attestation],
+	error,
+) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.AttestationPoolPageFunc != nil {
+		return s.AttestationPoolPageFunc(ctx, slot, opts)
+	}
+
+	return &api.Page[*spec.Attestation]{
+		Data: attestationPool(),
+	}, nil
+}
+
+// attestationPool returns the default attestation pool of the mock.
+func attestationPool() []*spec.Attestation {
 	res := make([]*spec.Attestation, 5)
 	for i := 0; i < 5; i++ {
 		res[i] = &spec.Attestation{
@@ -31,5 +64,5 @@ func (s *Service) AttestationPool(_ context.Context, _ spec.Slot) ([]*spec.Attes
 		}
 	}
 
-	return res, nil
+	return res
 }
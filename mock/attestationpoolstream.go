@@ -0,0 +1,50 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// StreamAttestationPool fetches the attestation pool, passing each attestation to the handler.
+func (s *Service) StreamAttestationPool(ctx context.Context,
+	slot *spec.Slot,
+	committeeIndex *spec.CommitteeIndex,
+	handler func(*spec.Attestation) error,
+) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.StreamAttestationPoolFunc != nil {
+		return s.StreamAttestationPoolFunc(ctx, slot, committeeIndex, handler)
+	}
+
+	var poolSlot spec.Slot
+	if slot != nil {
+		poolSlot = *slot
+	}
+	attestations, err := s.AttestationPool(ctx, poolSlot)
+	if err != nil {
+		return err
+	}
+	for _, attestation := range attestations {
+		if err := handler(attestation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttestationRewards provides the ideal and actual attestation rewards for the given epoch
+// and validator indices.  If no indices are supplied rewards are returned for all validators.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.AttestationRewardsFunc != nil {
+		return s.AttestationRewardsFunc(ctx, epoch, indices)
+	}
+
+	res := &api.AttestationRewards{
+		IdealRewards: []*api.IdealAttestationRewards{
+			{
+				EffectiveBalance: 32000000000,
+				Head:             2500,
+				Target:           5000,
+				Source:           3000,
+			},
+		},
+		TotalRewards: make([]*api.ValidatorAttestationRewards, 0, len(indices)),
+	}
+	for _, index := range indices {
+		res.TotalRewards = append(res.TotalRewards, &api.ValidatorAttestationRewards{
+			ValidatorIndex: index,
+			Head:           2500,
+			Target:         5000,
+			Source:         3000,
+		})
+	}
+
+	return res, nil
+}
@@ -20,6 +20,13 @@ import (
 )
 
 // BeaconAttesterDomain provides the beacon attester domain.
-func (s *Service) BeaconAttesterDomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x01, 0x00, 0x00, 0x00}, nil
+func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.BeaconAttesterDomainFunc != nil {
+		return s.BeaconAttesterDomainFunc(ctx)
+	}
+
+	return spec.DomainBeaconAttester, nil
 }
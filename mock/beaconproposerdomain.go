@@ -20,6 +20,13 @@ import (
 )
 
 // BeaconProposerDomain provides the beacon proposer domain.
-func (s *Service) BeaconProposerDomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x00, 0x00, 0x00, 0x00}, nil
+func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.BeaconProposerDomainFunc != nil {
+		return s.BeaconProposerDomainFunc(ctx)
+	}
+
+	return spec.DomainBeaconProposer, nil
 }
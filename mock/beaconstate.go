@@ -21,7 +21,14 @@ import (
 )
 
 // BeaconState fetches a beacon state given a state ID.
-func (s *Service) BeaconState(_ context.Context, _ string) (*spec.VersionedBeaconState, error) {
+func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.VersionedBeaconState, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.BeaconStateFunc != nil {
+		return s.BeaconStateFunc(ctx, stateID)
+	}
+
 	return &spec.VersionedBeaconState{
 		Version: spec.DataVersionPhase0,
 		Phase0: &phase0.BeaconState{
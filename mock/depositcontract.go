@@ -20,6 +20,13 @@ import (
 )
 
 // DepositContract provides details of the Ethereum 1 deposit contract for the chain.
-func (s *Service) DepositContract(_ context.Context) (*api.DepositContract, error) {
+func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.DepositContractFunc != nil {
+		return s.DepositContractFunc(ctx)
+	}
+
 	return &api.DepositContract{}, nil
 }
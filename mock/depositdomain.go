@@ -20,6 +20,13 @@ import (
 )
 
 // DepositDomain provides the deposit domain.
-func (s *Service) DepositDomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x03, 0x00, 0x00, 0x00}, nil
+func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.DepositDomainFunc != nil {
+		return s.DepositDomainFunc(ctx)
+	}
+
+	return spec.DomainDeposit, nil
 }
@@ -23,6 +23,13 @@ import (
 
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	if err := s.inject(ctx); err != nil {
+		return phase0.Domain{}, err
+	}
+	if s.DomainFunc != nil {
+		return s.DomainFunc(ctx, domainType, epoch)
+	}
+
 	// Obtain the fork for the epoch.
 	fork, err := s.forkAtEpoch(ctx, epoch)
 	if err != nil {
@@ -37,6 +44,13 @@ func (s *Service) Domain(ctx context.Context, domainType phase0.DomainType, epoc
 // for a chain's fork schedule to have multiple forks at genesis.  In this situation,
 // GenesisDomain() will return the first, and Domain() will return the last.
 func (s *Service) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error) {
+	if err := s.inject(ctx); err != nil {
+		return phase0.Domain{}, err
+	}
+	if s.GenesisDomainFunc != nil {
+		return s.GenesisDomainFunc(ctx, domainType)
+	}
+
 	// Obtain the fork for genesis .
 	fork, err := s.forkAtGenesis(ctx)
 	if err != nil {
@@ -20,6 +20,13 @@ import (
 )
 
 // Events feeds requested events with the given topics to the supplied handler.
-func (s *Service) Events(_ context.Context, _ []string, _ client.EventHandlerFunc) error {
+func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.EventsFunc != nil {
+		return s.EventsFunc(ctx, topics, handler)
+	}
+
 	return nil
 }
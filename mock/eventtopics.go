@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// EventTopics returns the event topics supported by the node.
+func (s *Service) EventTopics(ctx context.Context) ([]string, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.EventTopicsFunc != nil {
+		return s.EventTopicsFunc(ctx)
+	}
+
+	topics := make([]string, 0, len(api.SupportedEventTopics))
+	for topic := range api.SupportedEventTopics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	return topics, nil
+}
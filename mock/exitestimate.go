@@ -0,0 +1,44 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// EstimateExit estimates the exit and withdrawable epochs for a validator.
+func (s *Service) EstimateExit(ctx context.Context, validatorIndex phase0.ValidatorIndex) (*api.ExitEstimate, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.EstimateExitFunc != nil {
+		return s.EstimateExitFunc(ctx, validatorIndex)
+	}
+
+	epochDuration := 12 * time.Second * 32
+	exitEpoch := phase0.Epoch(time.Since(s.genesisTime)/epochDuration) + 5
+	withdrawableEpoch := exitEpoch + 256
+
+	return &api.ExitEstimate{
+		ValidatorIndex:    validatorIndex,
+		ExitEpoch:         exitEpoch,
+		WithdrawableEpoch: withdrawableEpoch,
+		ExitTime:          s.genesisTime.Add(time.Duration(exitEpoch) * epochDuration),
+		WithdrawableTime:  s.genesisTime.Add(time.Duration(withdrawableEpoch) * epochDuration),
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// LightClientBootstrap fetches the light client bootstrap for the given trusted block root.
+func (s *Service) LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*api.VersionedLightClientBootstrap, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.LightClientBootstrapFunc != nil {
+		return s.LightClientBootstrapFunc(ctx, blockRoot)
+	}
+
+	return nil, nil
+}
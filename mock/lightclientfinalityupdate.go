@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// LightClientFinalityUpdate fetches the latest light client finality update.
+func (s *Service) LightClientFinalityUpdate(ctx context.Context) (*api.VersionedLightClientFinalityUpdate, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.LightClientFinalityUpdateFunc != nil {
+		return s.LightClientFinalityUpdateFunc(ctx)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,32 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+)
+
+// LightClientUpdates fetches light client updates for a range of sync committee periods.
+func (s *Service) LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*api.VersionedLightClientUpdate, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.LightClientUpdatesFunc != nil {
+		return s.LightClientUpdatesFunc(ctx, startPeriod, count)
+	}
+
+	return make([]*api.VersionedLightClientUpdate, 0), nil
+}
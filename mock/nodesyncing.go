@@ -20,7 +20,14 @@ import (
 )
 
 // NodeSyncing provides the state of the node's synchronization with the chain.
-func (s *Service) NodeSyncing(_ context.Context) (*api.SyncState, error) {
+func (s *Service) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.NodeSyncingFunc != nil {
+		return s.NodeSyncingFunc(ctx)
+	}
+
 	return &api.SyncState{
 		HeadSlot:     s.HeadSlot,
 		SyncDistance: s.SyncDistance,
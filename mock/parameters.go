@@ -25,6 +25,8 @@ type parameters struct {
 	name        string
 	timeout     time.Duration
 	genesisTime time.Time
+	latency     time.Duration
+	errorRate   float64
 }
 
 // Parameter is the interface for service parameters.
@@ -66,6 +68,20 @@ func WithGenesisTime(genesisTime time.Time) Parameter {
 	})
 }
 
+// WithLatency sets the delay added to each call to the mock.
+func WithLatency(latency time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.latency = latency
+	})
+}
+
+// WithErrorRate sets the probability, between 0 and 1, that a call to the mock returns an error.
+func WithErrorRate(errorRate float64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.errorRate = errorRate
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -83,6 +99,12 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.name == "" {
 		return nil, errors.New("name not specified")
 	}
+	if parameters.latency < 0 {
+		return nil, errors.New("latency cannot be negative")
+	}
+	if parameters.errorRate < 0 || parameters.errorRate > 1 {
+		return nil, errors.New("error rate must be between 0 and 1")
+	}
 
 	return &parameters, nil
 }
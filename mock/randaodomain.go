@@ -20,6 +20,13 @@ import (
 )
 
 // RANDAODomain provides the RANDAO domain.
-func (s *Service) RANDAODomain(_ context.Context) (spec.DomainType, error) {
-	return spec.DomainType{0x02, 0x00, 0x00, 0x00}, nil
+func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	if err := s.inject(ctx); err != nil {
+		return spec.DomainType{}, err
+	}
+	if s.RANDAODomainFunc != nil {
+		return s.RANDAODomainFunc(ctx)
+	}
+
+	return spec.DomainRANDAO, nil
 }
@@ -15,8 +15,17 @@ package mock
 
 import (
 	"context"
+	"math/rand"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -41,8 +50,107 @@ type Service struct {
 	// Values that can be altered if required.
 	HeadSlot     phase0.Slot
 	SyncDistance phase0.Slot
+
+	// Latency is the delay added to each call.
+	Latency time.Duration
+	// ErrorRate is the probability, between 0 and 1, that a call returns ErrInjected.
+	ErrorRate float64
+
+	// Functions that, if set, are called to provide the result of the matching
+	// method in place of the mock's default behaviour.  Latency and errors are
+	// injected before the function is called.
+	AggregateAndProofDomainFunc            func(context.Context) (phase0.DomainType, error)
+	AggregateAttestationFunc               func(context.Context, phase0.Slot, phase0.Root) (*phase0.Attestation, error)
+	AttestationDataFunc                    func(context.Context, phase0.Slot, phase0.CommitteeIndex) (*phase0.AttestationData, error)
+	AttestationPoolFunc                    func(context.Context, phase0.Slot) ([]*phase0.Attestation, error)
+	AttestationPoolPageFunc                func(context.Context, phase0.Slot, *api.PageOpts) (*api.Page[*phase0.Attestation], error)
+	AttestationRewardsFunc                 func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error)
+	AttesterDutiesFunc                     func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error)
+	BeaconAttesterDomainFunc               func(context.Context) (phase0.DomainType, error)
+	BeaconBlockBlobsFunc                   func(context.Context, string) ([]*deneb.BlobSidecar, error)
+	BeaconBlockHeaderFunc                  func(context.Context, string) (*apiv1.BeaconBlockHeader, error)
+	BeaconBlockProposalFunc                func(context.Context, phase0.Slot, phase0.BLSSignature, []byte) (*spec.VersionedBeaconBlock, error)
+	BeaconBlockRootFunc                    func(context.Context, string) (*phase0.Root, error)
+	BeaconCommitteesAtEpochFunc            func(context.Context, string, phase0.Epoch) ([]*apiv1.BeaconCommittee, error)
+	BeaconCommitteesFunc                   func(context.Context, string) ([]*apiv1.BeaconCommittee, error)
+	BeaconProposerDomainFunc               func(context.Context) (phase0.DomainType, error)
+	BeaconStateFunc                        func(context.Context, string) (*spec.VersionedBeaconState, error)
+	BeaconStateRandaoFunc                  func(context.Context, string) (*phase0.Root, error)
+	BeaconStateRootFunc                    func(context.Context, string) (*phase0.Root, error)
+	BlindedBeaconBlockProposalFunc         func(context.Context, phase0.Slot, phase0.BLSSignature, []byte) (*api.VersionedBlindedBeaconBlock, error)
+	BlobSidecarsFunc                       func(context.Context, string, []deneb.BlobIndex) ([]*deneb.BlobSidecar, error)
+	BlockRewardsFunc                       func(context.Context, string) (*apiv1.BlockRewards, error)
+	DepositContractFunc                    func(context.Context) (*apiv1.DepositContract, error)
+	DepositDomainFunc                      func(context.Context) (phase0.DomainType, error)
+	DomainFunc                             func(context.Context, phase0.DomainType, phase0.Epoch) (phase0.Domain, error)
+	EstimateExitFunc                       func(context.Context, phase0.ValidatorIndex) (*apiv1.ExitEstimate, error)
+	EventTopicsFunc                        func(context.Context) ([]string, error)
+	EventsFunc                             func(context.Context, []string, client.EventHandlerFunc) error
+	FarFutureEpochFunc                     func(context.Context) (phase0.Epoch, error)
+	FinalityFunc                           func(context.Context, string) (*apiv1.Finality, error)
+	ForkChoiceFunc                         func(context.Context) (*apiv1.ForkChoice, error)
+	ForkFunc                               func(context.Context, string) (*phase0.Fork, error)
+	ForkScheduleFunc                       func(context.Context) ([]*phase0.Fork, error)
+	GenesisDomainFunc                      func(context.Context, phase0.DomainType) (phase0.Domain, error)
+	GenesisFunc                            func(context.Context) (*apiv1.Genesis, error)
+	GenesisTimeFunc                        func(context.Context) (time.Time, error)
+	IsOptimisticHeadFunc                   func(context.Context) (bool, error)
+	LightClientBootstrapFunc               func(context.Context, phase0.Root) (*api.VersionedLightClientBootstrap, error)
+	LightClientFinalityUpdateFunc          func(context.Context) (*api.VersionedLightClientFinalityUpdate, error)
+	LightClientOptimisticUpdateFunc        func(context.Context) (*api.VersionedLightClientOptimisticUpdate, error)
+	LightClientUpdatesFunc                 func(context.Context, uint64, uint64) ([]*api.VersionedLightClientUpdate, error)
+	NodeClientFunc                         func(context.Context) (string, error)
+	NodeIdentityFunc                       func(context.Context) (*apiv1.NodeIdentity, error)
+	NodeSyncingFunc                        func(context.Context) (*apiv1.SyncState, error)
+	NodeVersionFunc                        func(context.Context) (string, error)
+	PeerCountFunc                          func(context.Context) (*apiv1.PeerCount, error)
+	PeerFunc                               func(context.Context, string) (*apiv1.Peer, error)
+	PendingConsolidationsFunc              func(context.Context, string) ([]*electra.PendingConsolidation, error)
+	PendingDepositsFunc                    func(context.Context, string) ([]*electra.PendingDeposit, error)
+	PendingPartialWithdrawalsFunc          func(context.Context, string) ([]*electra.PendingPartialWithdrawal, error)
+	PeersFunc                              func(context.Context, []string, []string) ([]*apiv1.Peer, error)
+	PeersPageFunc                          func(context.Context, []string, []string, *api.PageOpts) (*api.Page[*apiv1.Peer], error)
+	ProposalFunc                           func(context.Context, phase0.Slot, phase0.BLSSignature, []byte, *uint64) (*api.VersionedProposal, error)
+	ProposerDutiesFunc                     func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error)
+	RANDAODomainFunc                       func(context.Context) (phase0.DomainType, error)
+	SelectionProofDomainFunc               func(context.Context) (phase0.DomainType, error)
+	SignedBeaconBlockFunc                  func(context.Context, string) (*spec.VersionedSignedBeaconBlock, error)
+	SlotDurationFunc                       func(context.Context) (time.Duration, error)
+	SlotsPerEpochFunc                      func(context.Context) (uint64, error)
+	SpecFunc                               func(context.Context) (map[string]interface{}, error)
+	StreamAttestationPoolFunc              func(context.Context, *phase0.Slot, *phase0.CommitteeIndex, func(*phase0.Attestation) error) error
+	StreamValidatorsFunc                   func(context.Context, string, []phase0.ValidatorIndex, func(*apiv1.Validator) error) error
+	SubmitAggregateAttestationsFunc        func(context.Context, []*phase0.SignedAggregateAndProof) error
+	SubmitAttestationsFunc                 func(context.Context, []*phase0.Attestation) error
+	SubmitBLSToExecutionChangeFunc         func(context.Context, *capella.SignedBLSToExecutionChange) error
+	SubmitBLSToExecutionChangesFunc        func(context.Context, []*capella.SignedBLSToExecutionChange) error
+	SubmitBeaconBlockFunc                  func(context.Context, *spec.VersionedSignedBeaconBlock) error
+	SubmitBeaconBlockRawFunc               func(context.Context, *api.RawSignedBeaconBlock) error
+	SubmitBeaconCommitteeSubscriptionsFunc func(context.Context, []*apiv1.BeaconCommitteeSubscription) error
+	SubmitBlindedBeaconBlockFunc           func(context.Context, *api.VersionedSignedBlindedBeaconBlock) error
+	SubmitBlindedBeaconBlockRawFunc        func(context.Context, *api.RawSignedBeaconBlock) error
+	SubmitProposalPreparationsFunc         func(context.Context, []*apiv1.ProposalPreparation) error
+	SubmitSyncCommitteeContributionsFunc   func(context.Context, []*altair.SignedContributionAndProof) error
+	SubmitSyncCommitteeMessagesFunc        func(context.Context, []*altair.SyncCommitteeMessage) error
+	SubmitSyncCommitteeSubscriptionsFunc   func(context.Context, []*apiv1.SyncCommitteeSubscription) error
+	SubmitValidatorRegistrationsFunc       func(context.Context, []*api.VersionedSignedValidatorRegistration) error
+	SubmitVoluntaryExitFunc                func(context.Context, *phase0.SignedVoluntaryExit) error
+	SyncCommitteeAtEpochFunc               func(context.Context, string, phase0.Epoch) (*apiv1.SyncCommittee, error)
+	SyncCommitteeContributionFunc          func(context.Context, phase0.Slot, uint64, phase0.Root) (*altair.SyncCommitteeContribution, error)
+	SyncCommitteeDutiesFunc                func(context.Context, phase0.Epoch, []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeDuty, error)
+	SyncCommitteeRewardsFunc               func(context.Context, string, []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error)
+	SyncCommitteeFunc                      func(context.Context, string) (*apiv1.SyncCommittee, error)
+	TargetAggregatorsPerCommitteeFunc      func(context.Context) (uint64, error)
+	ValidatorBalancesFunc                  func(context.Context, string, []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error)
+	ValidatorIdentitiesFunc                func(context.Context, string, []phase0.ValidatorIndex) ([]*apiv1.ValidatorIdentity, error)
+	ValidatorsByPubKeyFunc                 func(context.Context, string, []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
+	ValidatorsFunc                         func(context.Context, string, []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
+	VoluntaryExitDomainFunc                func(context.Context) (phase0.DomainType, error)
 }
 
+// ErrInjected is the error returned by calls selected to fail by the error rate.
+var ErrInjected = errors.New("injected error")
+
 // log is a service-wide logger.
 var log zerolog.Logger
 
@@ -74,6 +182,10 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
 
+	// Set injection after fetching static values, so that they cannot cause creation to fail.
+	s.Latency = parameters.latency
+	s.ErrorRate = parameters.errorRate
+
 	// Close the service on context done.
 	go func(s *Service) {
 		<-ctx.Done()
@@ -113,6 +225,23 @@ func (s *Service) Address() string {
 	return s.name
 }
 
+// inject applies the latency and errors configured for the mock to a call.
+func (s *Service) inject(ctx context.Context) error {
+	if s.Latency > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.Latency):
+		}
+	}
+	// #nosec G404
+	if s.ErrorRate > 0 && rand.Float64() < s.ErrorRate {
+		return ErrInjected
+	}
+
+	return nil
+}
+
 // close closes the service, freeing up resources.
 func (s *Service) close() {
 }
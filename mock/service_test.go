@@ -0,0 +1,145 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure that the mock provides the full client interface.
+var _ client.FullClient = (*mock.Service)(nil)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []mock.Parameter
+		err    string
+	}{
+		{
+			name: "Good",
+		},
+		{
+			name:   "NameMissing",
+			params: []mock.Parameter{mock.WithName("")},
+			err:    "problem with parameters: name not specified",
+		},
+		{
+			name:   "LatencyNegative",
+			params: []mock.Parameter{mock.WithLatency(-time.Second)},
+			err:    "problem with parameters: latency cannot be negative",
+		},
+		{
+			name:   "ErrorRateHigh",
+			params: []mock.Parameter{mock.WithErrorRate(1.5)},
+			err:    "problem with parameters: error rate must be between 0 and 1",
+		},
+		{
+			// Injected errors do not affect creation.
+			name:   "ErrorRateFull",
+			params: []mock.Parameter{mock.WithErrorRate(1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := mock.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScriptedResponses(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	// Default behaviour.
+	header, err := service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(0), header.Header.Message.Slot)
+
+	// Scripted behaviour, varying per call.
+	calls := 0
+	service.BeaconBlockHeaderFunc = func(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+		calls++
+		if calls > 1 {
+			return nil, errors.New("no more headers")
+		}
+		require.Equal(t, "head", blockID)
+
+		return &apiv1.BeaconBlockHeader{
+			Header: &phase0.SignedBeaconBlockHeader{
+				Message: &phase0.BeaconBlockHeader{Slot: 5},
+			},
+		}, nil
+	}
+	header, err = service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(5), header.Header.Message.Slot)
+	_, err = service.BeaconBlockHeader(ctx, "head")
+	require.EqualError(t, err, "no more headers")
+
+	// Scripted functions are used by methods that build on them.
+	service.GenesisFunc = func(_ context.Context) (*apiv1.Genesis, error) {
+		return nil, errors.New("no genesis")
+	}
+	_, err = service.GenesisTime(ctx)
+	require.ErrorContains(t, err, "no genesis")
+}
+
+func TestInjection(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithErrorRate(1))
+	require.NoError(t, err)
+
+	_, err = service.NodeVersion(ctx)
+	require.ErrorIs(t, err, mock.ErrInjected)
+	require.ErrorIs(t, service.SubmitAttestations(ctx, nil), mock.ErrInjected)
+
+	// Injected errors take precedence over scripted functions.
+	service.NodeVersionFunc = func(_ context.Context) (string, error) {
+		return "scripted", nil
+	}
+	_, err = service.NodeVersion(ctx)
+	require.ErrorIs(t, err, mock.ErrInjected)
+	service.ErrorRate = 0
+	version, err := service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "scripted", version)
+
+	service.Latency = 50 * time.Millisecond
+	started := time.Now()
+	_, err = service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(started), service.Latency)
+
+	// Latency respects the context.
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = service.NodeVersion(shortCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
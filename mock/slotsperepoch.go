@@ -18,6 +18,13 @@ import (
 )
 
 // SlotsPerEpoch provides the slots per epoch of the chain.
-func (s *Service) SlotsPerEpoch(_ context.Context) (uint64, error) {
+func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	if err := s.inject(ctx); err != nil {
+		return 0, err
+	}
+	if s.SlotsPerEpochFunc != nil {
+		return s.SlotsPerEpochFunc(ctx)
+	}
+
 	return 32, nil
 }
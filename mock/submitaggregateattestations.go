@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitAggregateAttestations submits aggregate attestations.
-func (s *Service) SubmitAggregateAttestations(_ context.Context, _ []*spec.SignedAggregateAndProof) error {
+func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*spec.SignedAggregateAndProof) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitAggregateAttestationsFunc != nil {
+		return s.SubmitAggregateAttestationsFunc(ctx, aggregateAndProofs)
+	}
+
 	return nil
 }
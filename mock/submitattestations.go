@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitAttestations submits attestations.
-func (s *Service) SubmitAttestations(_ context.Context, _ []*spec.Attestation) error {
+func (s *Service) SubmitAttestations(ctx context.Context, attestations []*spec.Attestation) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitAttestationsFunc != nil {
+		return s.SubmitAttestationsFunc(ctx, attestations)
+	}
+
 	return nil
 }
@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitBlindedBeaconBlock submits a blinded beacon block.
-func (s *Service) SubmitBlindedBeaconBlock(_ context.Context, _ *api.VersionedSignedBlindedBeaconBlock) error {
+func (s *Service) SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitBlindedBeaconBlockFunc != nil {
+		return s.SubmitBlindedBeaconBlockFunc(ctx, block)
+	}
+
 	return nil
 }
@@ -20,6 +20,25 @@ import (
 )
 
 // SubmitBLSToExecutionChange submits a BLS to execution address change operation.
-func (s *Service) SubmitBLSToExecutionChange(_ context.Context, _ *capella.SignedBLSToExecutionChange) error {
+func (s *Service) SubmitBLSToExecutionChange(ctx context.Context, change *capella.SignedBLSToExecutionChange) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitBLSToExecutionChangeFunc != nil {
+		return s.SubmitBLSToExecutionChangeFunc(ctx, change)
+	}
+
+	return nil
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Service) SubmitBLSToExecutionChanges(ctx context.Context, changes []*capella.SignedBLSToExecutionChange) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitBLSToExecutionChangesFunc != nil {
+		return s.SubmitBLSToExecutionChangesFunc(ctx, changes)
+	}
+
 	return nil
 }
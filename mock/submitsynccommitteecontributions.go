@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitSyncCommitteeContributions submits sync committee contributions.
-func (s *Service) SubmitSyncCommitteeContributions(_ context.Context, _ []*altair.SignedContributionAndProof) error {
+func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitSyncCommitteeContributionsFunc != nil {
+		return s.SubmitSyncCommitteeContributionsFunc(ctx, contributionAndProofs)
+	}
+
 	return nil
 }
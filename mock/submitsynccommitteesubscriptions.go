@@ -20,6 +20,13 @@ import (
 )
 
 // SubmitSyncCommitteeSubscriptions subscribes to sync committees.
-func (s *Service) SubmitSyncCommitteeSubscriptions(_ context.Context, _ []*api.SyncCommitteeSubscription) error {
+func (s *Service) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*api.SyncCommitteeSubscription) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.SubmitSyncCommitteeSubscriptionsFunc != nil {
+		return s.SubmitSyncCommitteeSubscriptionsFunc(ctx, subscriptions)
+	}
+
 	return nil
 }
@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SyncCommitteeRewards provides the rewards received by sync committee members for their
+// participation in the given block ID.
+func (s *Service) SyncCommitteeRewards(ctx context.Context, blockID string, indices []phase0.ValidatorIndex) ([]*api.SyncCommitteeReward, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.SyncCommitteeRewardsFunc != nil {
+		return s.SyncCommitteeRewardsFunc(ctx, blockID, indices)
+	}
+
+	return []*api.SyncCommitteeReward{
+		{
+			ValidatorIndex: 1,
+			Reward:         20000,
+		},
+	}, nil
+}
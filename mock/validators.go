@@ -24,6 +24,13 @@ import (
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators IDs are supplied no filter
 // will be applied.
-func (s *Service) Validators(_ context.Context, _ string, _ []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*api.Validator, error) {
+func (s *Service) Validators(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*api.Validator, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.ValidatorsFunc != nil {
+		return s.ValidatorsFunc(ctx, stateID, validatorIndices)
+	}
+
 	return map[phase0.ValidatorIndex]*api.Validator{}, nil
 }
@@ -24,6 +24,13 @@ import (
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
 // supplied no filter will be applied.
-func (s *Service) ValidatorsByPubKey(_ context.Context, _ string, _ []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*api.Validator, error) {
+func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, pubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*api.Validator, error) {
+	if err := s.inject(ctx); err != nil {
+		return nil, err
+	}
+	if s.ValidatorsByPubKeyFunc != nil {
+		return s.ValidatorsByPubKeyFunc(ctx, stateID, pubKeys)
+	}
+
 	return map[phase0.ValidatorIndex]*api.Validator{}, nil
 }
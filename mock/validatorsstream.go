@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// StreamValidators fetches the validators, passing each validator to the handler.
+func (s *Service) StreamValidators(ctx context.Context,
+	stateID string,
+	validatorIndices []phase0.ValidatorIndex,
+	handler func(*api.Validator) error,
+) error {
+	if err := s.inject(ctx); err != nil {
+		return err
+	}
+	if s.StreamValidatorsFunc != nil {
+		return s.StreamValidatorsFunc(ctx, stateID, validatorIndices, handler)
+	}
+
+	validators, err := s.Validators(ctx, stateID, validatorIndices)
+	if err != nil {
+		return err
+	}
+	indices := make([]phase0.ValidatorIndex, 0, len(validators))
+	for index := range validators {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	for _, index := range indices {
+		if err := handler(validators[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
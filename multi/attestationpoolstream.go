@@ -0,0 +1,49 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// StreamAttestationPool fetches the attestation pool, optionally filtered by slot and
+// committee index, and passes each attestation to the handler as it is decoded.
+// If the handler returns an error the stream is stopped and the error returned.
+func (s *Service) StreamAttestationPool(ctx context.Context,
+	slot *phase0.Slot,
+	committeeIndex *phase0.CommitteeIndex,
+	handler func(*phase0.Attestation) error,
+) error {
+	// Failing over once the handler has been passed attestations would
+	// result in it receiving duplicates, so track if this has happened.
+	streamed := false
+	streamingHandler := func(attestation *phase0.Attestation) error {
+		streamed = true
+		return handler(attestation)
+	}
+
+	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.AttestationPoolStreamer).StreamAttestationPool(ctx, slot, committeeIndex, streamingHandler)
+		if err != nil {
+			return nil, err
+		}
+		return true, nil
+	}, func(ctx context.Context, client consensusclient.Service, err error) (bool, error) {
+		return !streamed, err
+	})
+	return err
+}
@@ -0,0 +1,41 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttestationRewards provides the ideal and actual attestation rewards for the given epoch
+// and validator indices.  If no indices are supplied rewards are returned for all validators.
+func (s *Service) AttestationRewards(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) (*api.AttestationRewards, error) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		attestationRewards, err := client.(consensusclient.AttestationRewardsProvider).AttestationRewards(ctx, epoch, indices)
+		if err != nil {
+			return nil, err
+		}
+		return attestationRewards, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(*api.AttestationRewards), nil
+}
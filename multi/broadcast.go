@@ -0,0 +1,145 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BroadcastError is returned when a broadcast submission is rejected by all clients.
+type BroadcastError struct {
+	// Errors are the errors returned by each client, keyed by address.
+	Errors map[string]error
+}
+
+// Error implements the error interface.
+func (e *BroadcastError) Error() string {
+	addresses := make([]string, 0, len(e.Errors))
+	for address := range e.Errors {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	errs := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		errs = append(errs, fmt.Sprintf("%s: %v", address, e.Errors[address]))
+	}
+
+	return fmt.Sprintf("submission failed on all clients: %s", strings.Join(errs, "; "))
+}
+
+// doSubmit carries out a submission, either on the active clients in turn until one
+// succeeds or, if submissions are broadcast, on all active clients at once.
+func (s *Service) doSubmit(ctx context.Context, call callFunc, errHandler errHandlerFunc) error {
+	if s.broadcastSubmissions {
+		return s.doBroadcast(ctx, call, errHandler)
+	}
+
+	_, err := s.doCall(ctx, call, errHandler)
+
+	return err
+}
+
+// doBroadcast carries out a call on all active clients in parallel, succeeding
+// if any of them succeed.  It waits for all of the clients to respond.
+// Clients are not deactivated on error, as the submission may have reached
+// them through another client.  Errors from each client are passed through the
+// error handler as for doCall; errors that do not require failover are not
+// counted against the health of the client.
+func (s *Service) doBroadcast(ctx context.Context, call callFunc, errHandler errHandlerFunc) (err error) {
+	ctx, span := s.tracer.Start(ctx, "multi."+callName(), trace.WithAttributes(attribute.Bool("broadcast", true)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	if len(activeClients) == 0 {
+		// There are no active clients; attempt to re-enable the inactive clients.
+		s.recheck(ctx)
+		s.clientsMu.RLock()
+		activeClients = s.activeClients
+		s.clientsMu.RUnlock()
+	}
+
+	if len(activeClients) == 0 {
+		return errors.New("no active clients to which to make call")
+	}
+
+	var mu sync.Mutex
+	errs := make(map[string]error)
+	var wg sync.WaitGroup
+	for _, client := range activeClients {
+		wg.Add(1)
+		go func(client consensusclient.Service) {
+			defer wg.Done()
+			_, err := call(ctx, client)
+			failover := true
+			if err != nil && errHandler != nil {
+				failover, err = errHandler(ctx, client, err)
+			}
+			if !errors.Is(err, http.ErrEndpointDisabled) {
+				if failover {
+					s.recordCall(client, err)
+				} else {
+					// The client handled the submission correctly.
+					s.recordCall(client, nil)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			span.AddEvent("attempt", trace.WithAttributes(
+				attribute.String("server.address", client.Address()),
+				attribute.Bool("success", err == nil),
+			))
+			if err != nil {
+				log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Broadcast submission failed")
+				errs[client.Address()] = err
+			}
+		}(client)
+	}
+	wg.Wait()
+
+	if len(errs) == len(activeClients) {
+		return &BroadcastError{
+			Errors: errs,
+		}
+	}
+	if len(errs) > 0 {
+		log.Debug().Int("succeeded", len(activeClients)-len(errs)).Int("failed", len(errs)).Msg("Broadcast submission partially succeeded")
+	}
+
+	return nil
+}
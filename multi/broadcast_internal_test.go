@@ -0,0 +1,178 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// submittingClient is a client that records the attestations submitted to it.
+type submittingClient struct {
+	address string
+	err     error
+
+	mu          sync.Mutex
+	submissions int
+}
+
+func (*submittingClient) Name() string { return "submitting" }
+
+func (c *submittingClient) Address() string { return c.address }
+
+func (*submittingClient) NodeSyncing(_ context.Context) (*apiv1.SyncState, error) {
+	return &apiv1.SyncState{}, nil
+}
+
+func (c *submittingClient) SubmitAttestations(_ context.Context, _ []*phase0.Attestation) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.submissions++
+
+	return c.err
+}
+
+func TestBroadcastSubmissions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		broadcast   bool
+		errs        []error
+		submissions []int
+		err         string
+	}{
+		{
+			name:        "Failover",
+			errs:        []error{nil, nil, nil},
+			submissions: []int{1, 0, 0},
+		},
+		{
+			name:        "FailoverAfterError",
+			errs:        []error{errors.New("error 1"), nil, nil},
+			submissions: []int{1, 1, 0},
+		},
+		{
+			name:        "Broadcast",
+			broadcast:   true,
+			errs:        []error{nil, nil, nil},
+			submissions: []int{1, 1, 1},
+		},
+		{
+			name:        "BroadcastPartialFailure",
+			broadcast:   true,
+			errs:        []error{errors.New("error 1"), nil, errors.New("error 3")},
+			submissions: []int{1, 1, 1},
+		},
+		{
+			name:        "BroadcastFailure",
+			broadcast:   true,
+			errs:        []error{errors.New("error 1"), errors.New("error 2"), errors.New("error 3")},
+			submissions: []int{1, 1, 1},
+			err:         "submission failed on all clients: client 1: error 1; client 2: error 2; client 3: error 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submitters := make([]*submittingClient, len(test.errs))
+			clients := make([]consensusclient.Service, len(test.errs))
+			for i := range test.errs {
+				submitters[i] = &submittingClient{
+					address: "client " + string(rune('1'+i)),
+					err:     test.errs[i],
+				}
+				clients[i] = submitters[i]
+			}
+			tracerProvider := testclients.NewRecordingTracerProvider()
+			s, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients(clients),
+				WithBroadcastSubmissions(test.broadcast),
+				WithTracerProvider(tracerProvider),
+			)
+			require.NoError(t, err)
+
+			err = s.(consensusclient.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var broadcastErr *BroadcastError
+				require.ErrorAs(t, err, &broadcastErr)
+				require.Len(t, broadcastErr.Errors, len(test.errs))
+			} else {
+				require.NoError(t, err)
+			}
+			for i := range submitters {
+				require.Equal(t, test.submissions[i], submitters[i].submissions)
+			}
+
+			spans := tracerProvider.Spans()
+			require.Len(t, spans, 1)
+			require.Equal(t, "multi.SubmitAttestations", spans[0].Name())
+			if test.broadcast {
+				// Clients are not deactivated by broadcast failures.
+				require.Len(t, s.(*Service).activeClients, len(test.errs))
+			}
+		})
+	}
+}
+
+func TestBroadcastErrHandler(t *testing.T) {
+	ctx := context.Background()
+
+	clients := []consensusclient.Service{
+		&submittingClient{address: "client 1", err: errors.New("known")},
+		&submittingClient{address: "client 2", err: errors.New("error 2")},
+	}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients(clients),
+		WithBroadcastSubmissions(true),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	errHandler := func(_ context.Context, _ consensusclient.Service, err error) (bool, error) {
+		if err.Error() == "known" {
+			return false, errors.New("already known")
+		}
+
+		return true, err
+	}
+	err = multi.doBroadcast(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		return nil, client.(consensusclient.AttestationsSubmitter).SubmitAttestations(ctx, []*phase0.Attestation{})
+	}, errHandler)
+	require.EqualError(t, err, "submission failed on all clients: client 1: already known; client 2: error 2")
+
+	// Only the error that required failover counts against the health of its client.
+	health := multi.Health()
+	require.Len(t, health, 2)
+	for i := range health {
+		switch health[i].Client.Address() {
+		case "client 1":
+			require.Zero(t, health[i].Failures)
+		case "client 2":
+			require.Equal(t, 1, health[i].Failures)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+)
+
+// BudgetStrategy is the strategy used to split the deadline of a call
+// between the clients that are tried in turn.
+type BudgetStrategy int
+
+const (
+	// BudgetStrategyNone does not split the deadline; each attempt can use
+	// all of the time remaining for the call.
+	BudgetStrategyNone BudgetStrategy = iota
+	// BudgetStrategyEven splits the remaining time evenly between the
+	// clients that are yet to be tried.
+	BudgetStrategyEven
+	// BudgetStrategyHalving gives each attempt half of the remaining time,
+	// with the final attempt receiving all of it.
+	BudgetStrategyHalving
+)
+
+var budgetStrategyStrings = [...]string{
+	"none",
+	"even",
+	"halving",
+}
+
+// String returns a string representation of the budget strategy.
+func (b BudgetStrategy) String() string {
+	if int(b) < 0 || int(b) >= len(budgetStrategyStrings) {
+		return "unknown"
+	}
+	return budgetStrategyStrings[b]
+}
+
+// attemptBudget returns the time available to an attempt given the time
+// remaining for the call and the number of clients yet to be tried,
+// including the client for this attempt.
+func attemptBudget(strategy BudgetStrategy, remaining time.Duration, clients int) time.Duration {
+	if clients <= 1 {
+		return remaining
+	}
+
+	switch strategy {
+	case BudgetStrategyEven:
+		return remaining / time.Duration(clients)
+	case BudgetStrategyHalving:
+		return remaining / 2
+	default:
+		return remaining
+	}
+}
+
+// attemptContext returns a context for a single attempt of a call, with
+// its deadline set according to the budget strategy of the service.
+// If the context has no deadline it is returned unaltered.
+func (s *Service) attemptContext(ctx context.Context, clients int) (context.Context, context.CancelFunc) {
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline || s.budgetStrategy == BudgetStrategyNone {
+		return ctx, func() {}
+	}
+
+	budget := attemptBudget(s.budgetStrategy, time.Until(deadline), clients)
+
+	return context.WithTimeout(ctx, budget)
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttemptBudget(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  BudgetStrategy
+		remaining time.Duration
+		clients   int
+		expected  time.Duration
+	}{
+		{
+			name:      "NoneMultiple",
+			strategy:  BudgetStrategyNone,
+			remaining: 3 * time.Second,
+			clients:   3,
+			expected:  3 * time.Second,
+		},
+		{
+			name:      "EvenSingle",
+			strategy:  BudgetStrategyEven,
+			remaining: 3 * time.Second,
+			clients:   1,
+			expected:  3 * time.Second,
+		},
+		{
+			name:      "EvenMultiple",
+			strategy:  BudgetStrategyEven,
+			remaining: 3 * time.Second,
+			clients:   3,
+			expected:  time.Second,
+		},
+		{
+			name:      "HalvingSingle",
+			strategy:  BudgetStrategyHalving,
+			remaining: 3 * time.Second,
+			clients:   1,
+			expected:  3 * time.Second,
+		},
+		{
+			name:      "HalvingMultiple",
+			strategy:  BudgetStrategyHalving,
+			remaining: 3 * time.Second,
+			clients:   3,
+			expected:  1500 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, attemptBudget(test.strategy, test.remaining, test.clients))
+		})
+	}
+}
+
+// TestBudgetFailover ensures that a client that does not respond does not
+// consume the entire deadline of a call.
+func TestBudgetFailover(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithBudgetStrategy(BudgetStrategyEven),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	callCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	res, err := multi.doCall(callCtx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		if client == client1 {
+			// Never responds.
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return client.Address(), nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+
+	// The slow client is not deactivated, but its failure is recorded.
+	multi.clientsMu.RLock()
+	require.Contains(t, multi.activeClients, client1)
+	multi.clientsMu.RUnlock()
+	multi.healthMu.RLock()
+	require.Equal(t, 1, multi.health[client1].Failures)
+	multi.healthMu.RUnlock()
+}
+
+// TestBudgetDeadlinePassed ensures that no further clients are tried once
+// the deadline of a call has passed.
+func TestBudgetDeadlinePassed(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{
+			client1,
+			client2,
+		}),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	callCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	calls := 0
+	_, err = multi.doCall(callCtx, func(ctx context.Context, _ consensusclient.Service) (interface{}, error) {
+		calls++
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, calls)
+}
@@ -19,8 +19,12 @@ import (
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // monitor monitors active and inactive connections, and moves them between
@@ -35,7 +39,7 @@ func (s *Service) monitor(ctx context.Context) {
 		case <-ctx.Done():
 			log.Trace().Msg("Context done; monitor stopping")
 			return
-		case <-time.After(30 * time.Second):
+		case <-time.After(s.healthCheckInterval):
 			s.recheck(ctx)
 		}
 	}
@@ -52,7 +56,9 @@ func (s *Service) recheck(ctx context.Context) {
 
 	// Ping each client to update its state.
 	for _, client := range clients {
-		if ping(ctx, client) {
+		health := ping(ctx, client)
+		s.recordCheck(health)
+		if health.Active {
 			s.activateClient(ctx, client)
 		} else {
 			s.deactivateClient(ctx, client)
@@ -114,24 +120,34 @@ func (s *Service) activateClient(ctx context.Context, client consensusclient.Ser
 	setProvidersMetric(ctx, "inactive", len(s.inactiveClients))
 }
 
-// ping pings a client, returning true if it is ready to serve requests and
-// false otherwise.
-func ping(ctx context.Context, client consensusclient.Service) bool {
+// ping pings a client, returning its health.  The client is active if it is
+// ready to serve requests.
+func ping(ctx context.Context, client consensusclient.Service) *ClientHealth {
 	log := zerolog.Ctx(ctx)
 
+	health := &ClientHealth{
+		Client:      client,
+		LastChecked: time.Now(),
+	}
+
 	provider, isProvider := client.(consensusclient.NodeSyncingProvider)
 	if !isProvider {
 		log.Debug().Str("provider", client.Address()).Msg("Client does not provide sync state")
-		return false
+		return health
 	}
 
 	syncState, err := provider.NodeSyncing(ctx)
+	health.Latency = time.Since(health.LastChecked)
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to obtain sync state from node")
-		return false
+		return health
 	}
 
-	return (!syncState.IsSyncing) || (syncState.HeadSlot == 0 && syncState.SyncDistance == 0)
+	health.Active = (!syncState.IsSyncing) || (syncState.HeadSlot == 0 && syncState.SyncDistance == 0)
+	health.SyncDistance = syncState.SyncDistance
+	health.IsOptimistic = syncState.IsOptimistic
+
+	return health
 }
 
 // callFunc is the definition for a call function.  It provides a generic return interface
@@ -144,7 +160,16 @@ type callFunc func(ctx context.Context, client consensusclient.Service) (interfa
 type errHandlerFunc func(ctx context.Context, client consensusclient.Service, err error) (bool, error)
 
 // doCall carries out a call on the active clients in turn until one succeeds.
-func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
+func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandlerFunc) (_ interface{}, err error) {
+	ctx, span := s.tracer.Start(ctx, "multi."+callName())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	log := s.log.With().Logger()
 	ctx = log.WithContext(ctx)
 
@@ -165,10 +190,38 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 		return nil, errors.New("no active clients to which to make call")
 	}
 
-	var err error
+	activeClients = s.orderClients(activeClients)
+
 	var res interface{}
-	for _, client := range activeClients {
-		res, err = call(ctx, client)
+	for i, client := range activeClients {
+		if ctx.Err() != nil {
+			// Call deadline has passed; no point trying further clients.
+			if err == nil {
+				err = ctx.Err()
+			}
+			break
+		}
+		attemptCtx, cancel := s.attemptContext(ctx, len(activeClients)-i)
+		res, err = call(attemptCtx, client)
+		// The attempt may have run out of its share of the call's time without the call
+		// itself having run out of time.
+		budgetExhausted := err != nil && attemptCtx.Err() != nil && ctx.Err() == nil
+		cancel()
+		if errors.Is(err, http.ErrEndpointDisabled) {
+			// The endpoint is disabled for this client, which is not a failure of the client; try the next.
+			log.Trace().Str("client", client.Name()).Str("address", client.Address()).Msg("Endpoint disabled for client")
+			continue
+		}
+		s.recordCall(client, err)
+		span.AddEvent("attempt", trace.WithAttributes(
+			attribute.String("server.address", client.Address()),
+			attribute.Bool("success", err == nil),
+		))
+		if budgetExhausted {
+			// A slow response is not a failure of the client, so try the next without deactivating it.
+			log.Debug().Str("client", client.Name()).Str("address", client.Address()).Err(err).Msg("Attempt budget exhausted; trying next client")
+			continue
+		}
 		if err != nil {
 			failover := true
 			if errHandler != nil {
@@ -190,6 +243,7 @@ func (s *Service) doCall(ctx context.Context, call callFunc, errHandler errHandl
 			err = errors.New("empty response")
 			continue
 		}
+		span.SetAttributes(attribute.String("server.address", client.Address()))
 		return res, nil
 	}
 	return nil, err
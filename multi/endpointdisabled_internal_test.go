@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	disabled := &rootClient{
+		address: "client 1",
+		err:     errors.Wrap(http.ErrEndpointDisabled, "/eth/v1/beacon/blocks/head/root"),
+	}
+	enabled := &rootClient{
+		address: "client 2",
+		root:    &phase0.Root{0x02},
+	}
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{disabled, enabled}),
+	)
+	require.NoError(t, err)
+
+	// The call is routed to the client with the endpoint enabled.
+	res, err := s.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, &phase0.Root{0x02}, res)
+	require.Equal(t, 1, disabled.calls)
+
+	// The client with the endpoint disabled remains active and is not marked as failing.
+	require.Equal(t, "client 1", s.Address())
+	for _, health := range s.(*Service).Health() {
+		require.Zero(t, health.Failures)
+	}
+
+	// If no client has the endpoint enabled the call fails.
+	enabled.err = errors.Wrap(http.ErrEndpointDisabled, "/eth/v1/beacon/blocks/head/root")
+	_, err = s.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.ErrorIs(t, err, http.ErrEndpointDisabled)
+}
@@ -21,6 +21,8 @@ import (
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
@@ -50,6 +52,10 @@ func (s *Service) Events(ctx context.Context,
 			handler: handler,
 		}
 		if err := client.(consensusclient.EventsProvider).Events(ctx, topics, ah.handleEvent); err != nil {
+			if errors.Is(err, http.ErrEndpointDisabled) {
+				log.Trace().Str("address", ah.address).Msg("Events disabled for client")
+				continue
+			}
 			inactiveClients = append(inactiveClients, client)
 			continue
 		}
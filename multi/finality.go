@@ -22,7 +22,7 @@ import (
 
 // Finality provides the finality given a state ID.
 func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
-	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	res, err := s.doRead(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		finality, err := client.(consensusclient.FinalityProvider).Finality(ctx, stateID)
 		if err != nil {
 			return nil, err
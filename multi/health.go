@@ -0,0 +1,189 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"sort"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// latencyWeight is the weight given to the latest measurement in the moving average of latency.
+const latencyWeight = 0.25
+
+// ClientHealth is the health of a client, as measured by health checks and calls.
+type ClientHealth struct {
+	// Client is the client.
+	Client consensusclient.Service
+	// Active is true if the client is ready to serve requests.
+	Active bool
+	// SyncDistance is the sync distance reported by the client at its last health check.
+	SyncDistance phase0.Slot
+	// IsOptimistic is true if the client reported an optimistic head at its last health check.
+	IsOptimistic bool
+	// Latency is a moving average of the time taken by the client to respond to health checks.
+	Latency time.Duration
+	// Failures is the number of consecutive calls to the client that have failed.
+	Failures int
+	// LastChecked is the time of the last health check.
+	LastChecked time.Time
+}
+
+// SelectionStrategy selects the order in which active clients are tried for a call.
+type SelectionStrategy interface {
+	// Order returns the clients to try, best first, given the health of the active
+	// clients in the order in which they became active.
+	Order(health []*ClientHealth) []consensusclient.Service
+}
+
+// ActiveOrderStrategy tries clients in the order in which they became active, so
+// a client is used until it fails.  This is the default strategy.
+type ActiveOrderStrategy struct{}
+
+// Order returns the clients to try, best first.
+func (*ActiveOrderStrategy) Order(health []*ClientHealth) []consensusclient.Service {
+	clients := make([]consensusclient.Service, len(health))
+	for i := range health {
+		clients[i] = health[i].Client
+	}
+
+	return clients
+}
+
+// HealthScoreStrategy tries the healthiest clients first: those with the fewest
+// consecutive failures, then the lowest sync distance, then a non-optimistic head,
+// then the lowest latency.  Latencies are grouped in to buckets the width of the
+// tolerance and latencies in the same bucket are considered equal, to avoid
+// switching between similar clients.
+type HealthScoreStrategy struct {
+	// LatencyTolerance is the width of the buckets in to which latencies are grouped.
+	LatencyTolerance time.Duration
+}
+
+// Order returns the clients to try, best first.
+func (s *HealthScoreStrategy) Order(health []*ClientHealth) []consensusclient.Service {
+	sorted := make([]*ClientHealth, len(health))
+	copy(sorted, health)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		if sorted[i].Failures != sorted[j].Failures {
+			return sorted[i].Failures < sorted[j].Failures
+		}
+		if sorted[i].SyncDistance != sorted[j].SyncDistance {
+			return sorted[i].SyncDistance < sorted[j].SyncDistance
+		}
+		if sorted[i].IsOptimistic != sorted[j].IsOptimistic {
+			return !sorted[i].IsOptimistic
+		}
+
+		// Comparing buckets rather than latencies within the tolerance of each other keeps
+		// the ordering transitive, as required by the sort.
+		return s.latencyBucket(sorted[i].Latency) < s.latencyBucket(sorted[j].Latency)
+	})
+
+	return (&ActiveOrderStrategy{}).Order(sorted)
+}
+
+// latencyBucket returns the bucket of the given latency.
+func (s *HealthScoreStrategy) latencyBucket(latency time.Duration) time.Duration {
+	if s.LatencyTolerance <= 0 {
+		return latency
+	}
+
+	return latency / s.LatencyTolerance
+}
+
+// Health returns the health of the clients, active clients first.
+func (s *Service) Health() []*ClientHealth {
+	s.clientsMu.RLock()
+	clients := make([]consensusclient.Service, 0, len(s.activeClients)+len(s.inactiveClients))
+	clients = append(clients, s.activeClients...)
+	clients = append(clients, s.inactiveClients...)
+	s.clientsMu.RUnlock()
+
+	return s.healthOf(clients)
+}
+
+// healthOf returns copies of the health of the given clients.
+func (s *Service) healthOf(clients []consensusclient.Service) []*ClientHealth {
+	s.healthMu.RLock()
+	defer s.healthMu.RUnlock()
+
+	res := make([]*ClientHealth, 0, len(clients))
+	for _, client := range clients {
+		health := &ClientHealth{
+			Client: client,
+		}
+		if existing, exists := s.health[client]; exists {
+			*health = *existing
+		}
+		res = append(res, health)
+	}
+
+	return res
+}
+
+// orderClients returns the active clients in the order in which they should be tried.
+func (s *Service) orderClients(activeClients []consensusclient.Service) []consensusclient.Service {
+	if len(activeClients) < 2 {
+		return activeClients
+	}
+
+	return s.selectionStrategy.Order(s.healthOf(activeClients))
+}
+
+// recordCheck records the result of a health check of a client.
+func (s *Service) recordCheck(health *ClientHealth) {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+
+	existing, exists := s.health[health.Client]
+	if !exists {
+		s.health[health.Client] = health
+		return
+	}
+	existing.Active = health.Active
+	existing.SyncDistance = health.SyncDistance
+	existing.IsOptimistic = health.IsOptimistic
+	existing.LastChecked = health.LastChecked
+	if health.Active {
+		existing.Failures = 0
+	}
+	if existing.Latency == 0 {
+		existing.Latency = health.Latency
+	} else {
+		existing.Latency = time.Duration(latencyWeight*float64(health.Latency) + (1-latencyWeight)*float64(existing.Latency))
+	}
+}
+
+// recordCall records the result of a call to a client.
+func (s *Service) recordCall(client consensusclient.Service, err error) {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+
+	health, exists := s.health[client]
+	if !exists {
+		health = &ClientHealth{
+			Client: client,
+			Active: true,
+		}
+		s.health[client] = health
+	}
+	if err == nil {
+		health.Failures = 0
+	} else {
+		health.Failures++
+	}
+}
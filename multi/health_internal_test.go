@@ -0,0 +1,184 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthScoreStrategy(t *testing.T) {
+	ctx := context.Background()
+
+	clients := make([]consensusclient.Service, 4)
+	for i := range clients {
+		client, err := mock.New(ctx, mock.WithName(string(rune('a'+i))))
+		require.NoError(t, err)
+		clients[i] = client
+	}
+
+	tests := []struct {
+		name      string
+		health    []*ClientHealth
+		tolerance time.Duration
+		expected  []consensusclient.Service
+	}{
+		{
+			name:     "Empty",
+			health:   []*ClientHealth{},
+			expected: []consensusclient.Service{},
+		},
+		{
+			name: "Failures",
+			health: []*ClientHealth{
+				{Client: clients[0], Failures: 2},
+				{Client: clients[1], Failures: 1, SyncDistance: 5},
+				{Client: clients[2], Failures: 0, Latency: time.Second},
+			},
+			expected: []consensusclient.Service{clients[2], clients[1], clients[0]},
+		},
+		{
+			name: "SyncDistance",
+			health: []*ClientHealth{
+				{Client: clients[0], SyncDistance: 2, Latency: time.Millisecond},
+				{Client: clients[1], SyncDistance: 0, Latency: time.Second},
+			},
+			expected: []consensusclient.Service{clients[1], clients[0]},
+		},
+		{
+			name: "Optimistic",
+			health: []*ClientHealth{
+				{Client: clients[0], IsOptimistic: true, Latency: time.Millisecond},
+				{Client: clients[1], Latency: time.Second},
+			},
+			expected: []consensusclient.Service{clients[1], clients[0]},
+		},
+		{
+			name: "Latency",
+			health: []*ClientHealth{
+				{Client: clients[0], Latency: 30 * time.Millisecond},
+				{Client: clients[1], Latency: 10 * time.Millisecond},
+				{Client: clients[2], Latency: 20 * time.Millisecond},
+			},
+			expected: []consensusclient.Service{clients[1], clients[2], clients[0]},
+		},
+		{
+			name: "LatencyTolerance",
+			health: []*ClientHealth{
+				{Client: clients[0], Latency: 29 * time.Millisecond},
+				{Client: clients[1], Latency: 25 * time.Millisecond},
+				{Client: clients[2], Latency: 10 * time.Millisecond},
+			},
+			tolerance: 10 * time.Millisecond,
+			expected:  []consensusclient.Service{clients[2], clients[0], clients[1]},
+		},
+		{
+			// Each latency is within the tolerance of the next, but the first and
+			// last are not, so pairwise comparison would not be transitive.
+			name: "LatencyToleranceChain",
+			health: []*ClientHealth{
+				{Client: clients[0], Latency: 26 * time.Millisecond},
+				{Client: clients[1], Latency: 18 * time.Millisecond},
+				{Client: clients[2], Latency: 10 * time.Millisecond},
+			},
+			tolerance: 10 * time.Millisecond,
+			expected:  []consensusclient.Service{clients[1], clients[2], clients[0]},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			strategy := &HealthScoreStrategy{LatencyTolerance: test.tolerance}
+			require.Equal(t, test.expected, strategy.Order(test.health))
+		})
+	}
+
+	health := []*ClientHealth{
+		{Client: clients[0], Failures: 3},
+		{Client: clients[1]},
+	}
+	require.Equal(t, []consensusclient.Service{clients[0], clients[1]}, (&ActiveOrderStrategy{}).Order(health))
+}
+
+func TestHealthRouting(t *testing.T) {
+	ctx := context.Background()
+
+	client1, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	client2, err := mock.New(ctx, mock.WithName("mock 2"))
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client1, client2}),
+		WithSelectionStrategy(&HealthScoreStrategy{}),
+	)
+	require.NoError(t, err)
+	multi := s.(*Service)
+
+	health := multi.Health()
+	require.Len(t, health, 2)
+	for _, clientHealth := range health {
+		require.True(t, clientHealth.Active)
+		require.False(t, clientHealth.LastChecked.IsZero())
+	}
+
+	// Prefer the client with lower latency.
+	multi.recordCheck(&ClientHealth{Client: client1, Active: true, Latency: time.Second})
+	multi.recordCheck(&ClientHealth{Client: client2, Active: true, Latency: time.Millisecond})
+	require.Equal(t, "mock 2", multi.Address())
+
+	// Failed calls move a client down the order, and successful checks restore it.
+	multi.recordCall(client2, errors.New("mock error"))
+	require.Equal(t, "mock 1", multi.Address())
+	multi.recordCheck(&ClientHealth{Client: client2, Active: true, Latency: time.Millisecond})
+	require.Equal(t, "mock 2", multi.Address())
+
+	// Latency is a moving average.
+	multi.recordCheck(&ClientHealth{Client: client2, Active: true, Latency: 5 * time.Millisecond})
+	for _, clientHealth := range multi.Health() {
+		if clientHealth.Client == client2 {
+			require.Greater(t, clientHealth.Latency, time.Millisecond)
+			require.Less(t, clientHealth.Latency, 5*time.Millisecond)
+		}
+	}
+
+	// Calls are routed to the best client.
+	res, err := multi.doCall(ctx, func(_ context.Context, client consensusclient.Service) (interface{}, error) {
+		return client.Address(), nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "mock 2", res)
+}
+
+func TestHealthParameters(t *testing.T) {
+	_, err := parseAndCheckParameters(
+		WithAddresses([]string{"localhost:5052"}),
+		WithSelectionStrategy(nil),
+	)
+	require.EqualError(t, err, "no selection strategy specified")
+
+	_, err = parseAndCheckParameters(
+		WithAddresses([]string{"localhost:5052"}),
+		WithHealthCheckInterval(0),
+	)
+	require.EqualError(t, err, "health check interval must be positive")
+}
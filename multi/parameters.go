@@ -20,15 +20,26 @@ import (
 	"github.com/attestantio/go-eth2-client/metrics"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 type parameters struct {
-	logLevel     zerolog.Level
-	monitor      metrics.Service
-	clients      []consensusclient.Service
-	addresses    []string
-	timeout      time.Duration
-	extraHeaders map[string]string
+	logLevel       zerolog.Level
+	monitor        metrics.Service
+	clients        []consensusclient.Service
+	addresses      []string
+	timeout        time.Duration
+	extraHeaders   map[string]string
+	budgetStrategy BudgetStrategy
+	tracerProvider trace.TracerProvider
+	// Broadcast of submissions.
+	broadcastSubmissions bool
+	// Quorum for critical reads.
+	quorum int
+	// Selection of clients.
+	selectionStrategy   SelectionStrategy
+	healthCheckInterval time.Duration
 }
 
 // Parameter is the interface for service parameters.
@@ -84,12 +95,67 @@ func WithExtraHeaders(headers map[string]string) Parameter {
 	})
 }
 
+// WithBudgetStrategy sets the strategy used to split the deadline of a call
+// between clients when failing over.
+func WithBudgetStrategy(strategy BudgetStrategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.budgetStrategy = strategy
+	})
+}
+
+// WithBroadcastSubmissions sets if submissions, such as attestations and blocks, are
+// sent to all active clients in parallel rather than to each in turn until one succeeds.
+// A broadcast submission succeeds if any client accepts it, otherwise the errors from
+// all clients are returned in a BroadcastError.
+func WithBroadcastSubmissions(broadcastSubmissions bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.broadcastSubmissions = broadcastSubmissions
+	})
+}
+
+// WithQuorum sets the number of clients that must return matching responses to critical
+// reads, such as finality and block roots, before the response is returned.  If the quorum
+// is not reached a QuorumError is returned.  If not set, or set to 0, critical reads are
+// made on each client in turn as with other calls.
+func WithQuorum(quorum int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.quorum = quorum
+	})
+}
+
+// WithSelectionStrategy sets the strategy used to select the order in which active
+// clients are tried for each call.
+func WithSelectionStrategy(strategy SelectionStrategy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.selectionStrategy = strategy
+	})
+}
+
+// WithHealthCheckInterval sets the interval at which the health of clients is checked.
+func WithHealthCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthCheckInterval = interval
+	})
+}
+
+// WithTracerProvider sets the provider of the tracer used to create a span for each
+// call, and is passed to the clients created from addresses.  If not set no spans are created.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tracerProvider = tracerProvider
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel:     zerolog.GlobalLevel(),
-		timeout:      2 * time.Second,
-		extraHeaders: make(map[string]string),
+		logLevel:            zerolog.GlobalLevel(),
+		timeout:             2 * time.Second,
+		extraHeaders:        make(map[string]string),
+		budgetStrategy:      BudgetStrategyNone,
+		tracerProvider:      noop.NewTracerProvider(),
+		selectionStrategy:   &ActiveOrderStrategy{},
+		healthCheckInterval: 30 * time.Second,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -100,9 +166,27 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.timeout == 0 {
 		return nil, errors.New("no timeout specified")
 	}
+	if parameters.selectionStrategy == nil {
+		return nil, errors.New("no selection strategy specified")
+	}
+	if parameters.healthCheckInterval <= 0 {
+		return nil, errors.New("health check interval must be positive")
+	}
+	if parameters.tracerProvider == nil {
+		return nil, errors.New("no tracer provider specified")
+	}
+	if parameters.budgetStrategy < BudgetStrategyNone || parameters.budgetStrategy > BudgetStrategyHalving {
+		return nil, errors.New("invalid budget strategy specified")
+	}
 	if len(parameters.clients)+len(parameters.addresses) == 0 {
 		return nil, errors.New("no Ethereum 2 clients specified")
 	}
+	if parameters.quorum < 0 {
+		return nil, errors.New("quorum cannot be negative")
+	}
+	if parameters.quorum > len(parameters.clients)+len(parameters.addresses) {
+		return nil, errors.New("quorum cannot be more than the number of clients")
+	}
 
 	return &parameters, nil
 }
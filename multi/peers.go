@@ -0,0 +1,36 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// Peers fetches the peers of the node.
+func (s *Service) Peers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	res, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		peers, err := client.(consensusclient.PeersProvider).Peers(ctx, states, directions)
+		if err != nil {
+			return nil, err
+		}
+		return peers, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.([]*apiv1.Peer), nil
+}
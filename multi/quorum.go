@@ -0,0 +1,167 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QuorumError is returned when a quorum read does not obtain matching responses
+// from the required number of clients.
+type QuorumError struct {
+	// Quorum is the number of clients required to agree.
+	Quorum int
+	// Agreed is the largest number of clients that returned the same response.
+	Agreed int
+	// Responses are the responses returned by each client, keyed by address.
+	Responses map[string]interface{}
+	// Errors are the errors returned by each client, keyed by address.
+	Errors map[string]error
+}
+
+// Error implements the error interface.
+func (e *QuorumError) Error() string {
+	if len(e.Responses) > e.Agreed {
+		return fmt.Sprintf("quorum of %d not reached: clients disagree, with at most %d of %d responses matching (%d errors)",
+			e.Quorum, e.Agreed, len(e.Responses), len(e.Errors))
+	}
+
+	return fmt.Sprintf("quorum of %d not reached: %d matching responses (%d errors)", e.Quorum, e.Agreed, len(e.Errors))
+}
+
+// Disagreement returns true if clients returned differing responses, as opposed
+// to too few clients returning a response.
+func (e *QuorumError) Disagreement() bool {
+	return len(e.Responses) > e.Agreed
+}
+
+// doRead carries out a critical read, either on the active clients in turn until one
+// succeeds or, if a quorum is set, on all active clients at once.
+func (s *Service) doRead(ctx context.Context, call callFunc, errHandler errHandlerFunc) (interface{}, error) {
+	if s.quorum > 0 {
+		return s.doQuorum(ctx, call)
+	}
+
+	return s.doCall(ctx, call, errHandler)
+}
+
+type quorumResult struct {
+	client consensusclient.Service
+	res    interface{}
+	err    error
+}
+
+// doQuorum carries out a call on all active clients in parallel, returning the response
+// once the quorum of clients have returned matching responses.  Responses are compared
+// by their JSON representation, and empty responses are treated as errors.  Outstanding
+// calls are cancelled once the quorum is reached.
+func (s *Service) doQuorum(ctx context.Context, call callFunc) (_ interface{}, err error) {
+	ctx, span := s.tracer.Start(ctx, "multi."+callName(), trace.WithAttributes(attribute.Int("quorum", s.quorum)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	log := s.log.With().Logger()
+	ctx = log.WithContext(ctx)
+
+	s.clientsMu.RLock()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+
+	if len(activeClients) < s.quorum {
+		// There are insufficient active clients; attempt to re-enable the inactive clients.
+		s.recheck(ctx)
+		s.clientsMu.RLock()
+		activeClients = s.activeClients
+		s.clientsMu.RUnlock()
+	}
+
+	if len(activeClients) < s.quorum {
+		return nil, fmt.Errorf("%d active clients insufficient for quorum of %d", len(activeClients), s.quorum)
+	}
+
+	callCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan *quorumResult, len(activeClients))
+	for _, client := range activeClients {
+		go func(client consensusclient.Service) {
+			res, err := call(callCtx, client)
+			results <- &quorumResult{
+				client: client,
+				res:    res,
+				err:    err,
+			}
+		}(client)
+	}
+
+	quorumErr := &QuorumError{
+		Quorum:    s.quorum,
+		Responses: make(map[string]interface{}),
+		Errors:    make(map[string]error),
+	}
+	matches := make(map[string]int)
+	for range activeClients {
+		result := <-results
+		address := result.client.Address()
+		if result.err != nil {
+			if callCtx.Err() == nil && !errors.Is(result.err, http.ErrEndpointDisabled) {
+				s.recordCall(result.client, result.err)
+			}
+			log.Debug().Str("client", result.client.Name()).Str("address", address).Err(result.err).Msg("Quorum read failed")
+			quorumErr.Errors[address] = result.err
+			continue
+		}
+		s.recordCall(result.client, nil)
+		span.AddEvent("attempt", trace.WithAttributes(attribute.String("server.address", address)))
+
+		data, err := json.Marshal(result.res)
+		if err != nil {
+			quorumErr.Errors[address] = errors.Wrap(err, "failed to marshal response")
+			continue
+		}
+		if string(data) == "null" {
+			// An absent response is not a vote for absence, so does not count towards the quorum.
+			quorumErr.Errors[address] = errors.New("empty response")
+			continue
+		}
+		quorumErr.Responses[address] = result.res
+		key := string(data)
+		matches[key]++
+		if matches[key] > quorumErr.Agreed {
+			quorumErr.Agreed = matches[key]
+		}
+		if matches[key] >= s.quorum {
+			return result.res, nil
+		}
+	}
+
+	if quorumErr.Disagreement() {
+		log.Warn().Int("quorum", s.quorum).Int("agreed", quorumErr.Agreed).Int("responses", len(quorumErr.Responses)).Msg("Clients disagree on response")
+	}
+
+	return nil, quorumErr
+}
@@ -0,0 +1,181 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// rootClient is a client that returns a fixed block root.
+type rootClient struct {
+	address string
+	root    *phase0.Root
+	err     error
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (*rootClient) Name() string { return "root" }
+
+func (c *rootClient) Address() string { return c.address }
+
+func (*rootClient) NodeSyncing(_ context.Context) (*apiv1.SyncState, error) {
+	return &apiv1.SyncState{}, nil
+}
+
+func (c *rootClient) BeaconBlockRoot(_ context.Context, _ string) (*phase0.Root, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+
+	return c.root, c.err
+}
+
+func TestQuorum(t *testing.T) {
+	ctx := context.Background()
+
+	root1 := &phase0.Root{0x01}
+	root2 := &phase0.Root{0x02}
+
+	tests := []struct {
+		name         string
+		quorum       int
+		roots        []*phase0.Root
+		errs         []error
+		calls        int
+		res          *phase0.Root
+		err          string
+		disagreement bool
+	}{
+		{
+			name:  "Disabled",
+			roots: []*phase0.Root{root1, root2, root2},
+			errs:  []error{nil, nil, nil},
+			calls: 1,
+			res:   root1,
+		},
+		{
+			name:   "Agreed",
+			quorum: 2,
+			roots:  []*phase0.Root{root1, root1, root1},
+			errs:   []error{nil, nil, nil},
+			res:    root1,
+		},
+		{
+			name:   "Majority",
+			quorum: 2,
+			roots:  []*phase0.Root{root2, root1, root2},
+			errs:   []error{nil, nil, nil},
+			res:    root2,
+		},
+		{
+			name:   "AgreedWithError",
+			quorum: 2,
+			roots:  []*phase0.Root{root1, nil, root1},
+			errs:   []error{nil, errors.New("error 2"), nil},
+			res:    root1,
+		},
+		{
+			name:         "Disagreement",
+			quorum:       2,
+			roots:        []*phase0.Root{root1, root2, nil},
+			errs:         []error{nil, nil, errors.New("error 3")},
+			calls:        3,
+			err:          "quorum of 2 not reached: clients disagree, with at most 1 of 2 responses matching (1 errors)",
+			disagreement: true,
+		},
+		{
+			name:   "InsufficientResponses",
+			quorum: 2,
+			roots:  []*phase0.Root{root1, nil, nil},
+			errs:   []error{nil, errors.New("error 2"), errors.New("error 3")},
+			calls:  3,
+			err:    "quorum of 2 not reached: 1 matching responses (2 errors)",
+		},
+		{
+			name:   "EmptyResponses",
+			quorum: 2,
+			roots:  []*phase0.Root{nil, root1, nil},
+			errs:   []error{nil, nil, nil},
+			calls:  3,
+			err:    "quorum of 2 not reached: 1 matching responses (2 errors)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rootClients := make([]*rootClient, len(test.roots))
+			clients := make([]consensusclient.Service, len(test.roots))
+			for i := range test.roots {
+				rootClients[i] = &rootClient{
+					address: "client " + string(rune('1'+i)),
+					root:    test.roots[i],
+					err:     test.errs[i],
+				}
+				clients[i] = rootClients[i]
+			}
+			s, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithClients(clients),
+				WithQuorum(test.quorum),
+			)
+			require.NoError(t, err)
+
+			res, err := s.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				var quorumErr *QuorumError
+				require.ErrorAs(t, err, &quorumErr)
+				require.Equal(t, test.disagreement, quorumErr.Disagreement())
+				require.Len(t, quorumErr.Responses, len(test.roots)-len(quorumErr.Errors))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+
+			if test.calls != 0 {
+				calls := 0
+				for i := range rootClients {
+					calls += rootClients[i].calls
+				}
+				require.Equal(t, test.calls, calls)
+			}
+		})
+	}
+}
+
+func TestQuorumParameters(t *testing.T) {
+	ctx := context.Background()
+
+	clients := []consensusclient.Service{
+		&rootClient{address: "client 1"},
+		&rootClient{address: "client 2"},
+	}
+
+	_, err := New(ctx, WithLogLevel(zerolog.Disabled), WithClients(clients), WithQuorum(-1))
+	require.EqualError(t, err, "problem with parameters: quorum cannot be negative")
+
+	_, err = New(ctx, WithLogLevel(zerolog.Disabled), WithClients(clients), WithQuorum(3))
+	require.EqualError(t, err, "problem with parameters: quorum cannot be more than the number of clients")
+}
@@ -16,17 +16,29 @@ package multi
 import (
 	"context"
 	"sync"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/http"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Service handles multiple Ethereum 2 clients.
 type Service struct {
-	log zerolog.Logger
+	log            zerolog.Logger
+	budgetStrategy BudgetStrategy
+	tracer         trace.Tracer
+
+	broadcastSubmissions bool
+	quorum               int
+
+	selectionStrategy   SelectionStrategy
+	healthCheckInterval time.Duration
+	healthMu            sync.RWMutex
+	health              map[consensusclient.Service]*ClientHealth
 
 	clientsMu       sync.RWMutex
 	activeClients   []consensusclient.Service
@@ -59,8 +71,11 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	// Check the state of each client and put it in an active or inactive list, accordingly.
 	activeClients := make([]consensusclient.Service, 0, len(parameters.clients))
 	inactiveClients := make([]consensusclient.Service, 0, len(parameters.clients))
+	health := make(map[consensusclient.Service]*ClientHealth, len(parameters.clients)+len(parameters.addresses))
 	for _, client := range parameters.clients {
-		if ping(ctx, client) {
+		clientHealth := ping(ctx, client)
+		health[client] = clientHealth
+		if clientHealth.Active {
 			activeClients = append(activeClients, client)
 		} else {
 			inactiveClients = append(inactiveClients, client)
@@ -72,12 +87,15 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 			http.WithTimeout(parameters.timeout),
 			http.WithAddress(address),
 			http.WithExtraHeaders(parameters.extraHeaders),
+			http.WithTracerProvider(parameters.tracerProvider),
 		)
 		if err != nil {
 			log.Error().Str("provider", address).Msg("Provider not present; dropping from rotation")
 			continue
 		}
-		if ping(ctx, client) {
+		clientHealth := ping(ctx, client)
+		health[client] = clientHealth
+		if clientHealth.Active {
 			activeClients = append(activeClients, client)
 			setProviderActiveMetric(ctx, client.Address(), "active")
 		} else {
@@ -93,9 +111,16 @@ func New(ctx context.Context, params ...Parameter) (consensusclient.Service, err
 	setProvidersMetric(ctx, "inactive", len(inactiveClients))
 
 	s := &Service{
-		log:             log,
-		activeClients:   activeClients,
-		inactiveClients: inactiveClients,
+		log:                  log,
+		budgetStrategy:       parameters.budgetStrategy,
+		tracer:               parameters.tracerProvider.Tracer(tracerName),
+		selectionStrategy:    parameters.selectionStrategy,
+		broadcastSubmissions: parameters.broadcastSubmissions,
+		quorum:               parameters.quorum,
+		healthCheckInterval:  parameters.healthCheckInterval,
+		health:               health,
+		activeClients:        activeClients,
+		inactiveClients:      inactiveClients,
 	}
 
 	// Kick off monitor.
@@ -112,9 +137,10 @@ func (s *Service) Name() string {
 // Address returns the address of the client.
 func (s *Service) Address() string {
 	s.clientsMu.RLock()
-	defer s.clientsMu.RUnlock()
-	if len(s.activeClients) > 0 {
-		return s.activeClients[0].Address()
+	activeClients := s.activeClients
+	s.clientsMu.RUnlock()
+	if len(activeClients) > 0 {
+		return s.orderClients(activeClients)[0].Address()
 	}
 	return "none"
 }
@@ -24,7 +24,7 @@ import (
 func (s *Service) SubmitSyncCommitteeContributions(ctx context.Context,
 	contributionAndProofs []*altair.SignedContributionAndProof,
 ) error {
-	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+	err := s.doSubmit(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
 		err := client.(consensusclient.SyncCommitteeContributionsSubmitter).SubmitSyncCommitteeContributions(ctx, contributionAndProofs)
 		if err != nil {
 			return nil, err
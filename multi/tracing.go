@@ -0,0 +1,54 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// tracerName is the name of the tracer for the module.
+const tracerName = "github.com/attestantio/go-eth2-client/multi"
+
+// callName returns the name of the exported service method that led to the call
+// of this function, for example "BeaconBlockHeader", or "call" if it cannot be obtained.
+func callName() string {
+	pcs := make([]uintptr, 8)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name := methodName(frame.Function); name != "call" && unicode.IsUpper([]rune(name)[0]) {
+			return name
+		}
+		if !more {
+			return "call"
+		}
+	}
+}
+
+// methodName returns the name of the service method from a fully qualified function name.
+func methodName(name string) string {
+	pos := strings.Index(name, "(*Service).")
+	if pos == -1 {
+		return "call"
+	}
+	name = name[pos+len("(*Service)."):]
+	if pos := strings.IndexByte(name, '.'); pos != -1 {
+		// Closure within the method.
+		name = name[:pos]
+	}
+
+	return name
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func TestMethodName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "github.com/attestantio/go-eth2-client/multi.(*Service).BeaconBlockHeader",
+			expected: "BeaconBlockHeader",
+		},
+		{
+			name:     "github.com/attestantio/go-eth2-client/multi.(*Service).Events.func1",
+			expected: "Events",
+		},
+		{
+			name:     "github.com/attestantio/go-eth2-client/multi.ping",
+			expected: "call",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			require.Equal(t, test.expected, methodName(test.name))
+		})
+	}
+}
+
+func TestTracing(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx, mock.WithName("mock 1"))
+	require.NoError(t, err)
+	tracerProvider := testclients.NewRecordingTracerProvider()
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client}),
+		WithTracerProvider(tracerProvider),
+	)
+	require.NoError(t, err)
+
+	_, err = s.(consensusclient.BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, "1")
+	require.NoError(t, err)
+
+	spans := tracerProvider.Spans()
+	require.Len(t, spans, 1)
+	require.Equal(t, "multi.BeaconBlockHeader", spans[0].Name())
+	require.True(t, spans[0].Ended())
+	require.Equal(t, codes.Unset, spans[0].StatusCode())
+	require.Equal(t, []string{"attempt"}, spans[0].Events())
+	address, exists := spans[0].Attribute("server.address")
+	require.True(t, exists)
+	require.Equal(t, client.Address(), address.AsString())
+
+	// Failed calls are marked as errors.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.(consensusclient.BeaconBlockHeadersProvider).BeaconBlockHeader(cancelledCtx, "1")
+	require.Error(t, err)
+	spans = tracerProvider.Spans()
+	require.Len(t, spans, 2)
+	require.Equal(t, codes.Error, spans[1].StatusCode())
+	require.True(t, spans[1].Ended())
+
+	_, err = New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithClients([]consensusclient.Service{client}),
+		WithTracerProvider(nil),
+	)
+	require.EqualError(t, err, "problem with parameters: no tracer provider specified")
+}
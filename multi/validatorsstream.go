@@ -0,0 +1,50 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// StreamValidators fetches the validators, with their balance and status, for a given state and passes
+// each validator to the handler as it is decoded.
+// If the handler returns an error the stream is stopped and the error returned.
+func (s *Service) StreamValidators(ctx context.Context,
+	stateID string,
+	validatorIndices []phase0.ValidatorIndex,
+	handler func(*api.Validator) error,
+) error {
+	// Failing over once the handler has been passed validators would
+	// result in it receiving duplicates, so track if this has happened.
+	streamed := false
+	streamingHandler := func(validator *api.Validator) error {
+		streamed = true
+		return handler(validator)
+	}
+
+	_, err := s.doCall(ctx, func(ctx context.Context, client consensusclient.Service) (interface{}, error) {
+		err := client.(consensusclient.ValidatorsStreamer).StreamValidators(ctx, stateID, validatorIndices, streamingHandler)
+		if err != nil {
+			return nil, err
+		}
+		return true, nil
+	}, func(ctx context.Context, client consensusclient.Service, err error) (bool, error) {
+		return !streamed, err
+	})
+	return err
+}
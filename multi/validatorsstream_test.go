@@ -0,0 +1,73 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamValidators(t *testing.T) {
+	ctx := context.Background()
+
+	validatorsFunc := func(_ context.Context, _ string, indices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]*api.Validator, error) {
+		res := make(map[phase0.ValidatorIndex]*api.Validator, len(indices))
+		for _, index := range indices {
+			res[index] = &api.Validator{Index: index}
+		}
+		return res, nil
+	}
+
+	clients := make([]consensusclient.Service, 0, 3)
+	for i := 1; i <= 3; i++ {
+		client, err := mock.New(ctx, mock.WithName(fmt.Sprintf("mock %d", i)))
+		require.NoError(t, err)
+		client.ValidatorsFunc = validatorsFunc
+		if i == 3 {
+			clients = append(clients, client)
+			continue
+		}
+		erroringClient, err := testclients.NewErroring(ctx, 0.1, client)
+		require.NoError(t, err)
+		clients = append(clients, erroringClient)
+	}
+
+	multiClient, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithClients(clients),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 128; i++ {
+		received := make([]phase0.ValidatorIndex, 0)
+		err := multiClient.(consensusclient.ValidatorsStreamer).StreamValidators(ctx, "head", []phase0.ValidatorIndex{3, 1, 2}, func(validator *api.Validator) error {
+			received = append(received, validator.Index)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []phase0.ValidatorIndex{1, 2, 3}, received)
+	}
+	// At this point we expect mock 3 to be in active (unless probability hates us).
+	require.Equal(t, "mock 3", multiClient.Address())
+}
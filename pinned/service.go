@@ -0,0 +1,162 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pinned carries out sequences of related reads pinned to a single block and
+// its state, so that the results are consistent with each other even if the head of
+// the chain moves while the reads are in progress.
+package pinned
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// ErrMoved is returned when the block moved during every attempt to read it.
+var ErrMoved = errors.New("block moved during reads")
+
+// Pin is the block and state to which a sequence of reads is pinned.
+type Pin struct {
+	// Header is the header of the pinned block.
+	Header *apiv1.BeaconBlockHeader
+	// Slot is the slot of the pinned block.
+	Slot phase0.Slot
+	// BlockRoot is the root of the pinned block.
+	BlockRoot phase0.Root
+	// StateRoot is the root of the post-state of the pinned block.
+	StateRoot phase0.Root
+}
+
+// BlockID returns the block ID with which to request the pinned block.
+func (p *Pin) BlockID() string {
+	return fmt.Sprintf("%#x", p.BlockRoot)
+}
+
+// StateID returns the state ID with which to request the pinned state.
+func (p *Pin) StateID() string {
+	return fmt.Sprintf("%#x", p.StateRoot)
+}
+
+// ReadFunc carries out a sequence of reads pinned to the given block and state,
+// using the block and state IDs of the pin in place of IDs such as "head".
+// It can be called more than once, so should not retain results from earlier calls.
+type ReadFunc func(ctx context.Context, pin *Pin) error
+
+// Service carries out pinned reads.
+type Service struct {
+	log                        zerolog.Logger
+	beaconBlockHeadersProvider consensusclient.BeaconBlockHeadersProvider
+	maxAttempts                int
+}
+
+// New creates a new pinned read service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "pinned").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:                        log,
+		beaconBlockHeadersProvider: parameters.client.(consensusclient.BeaconBlockHeadersProvider),
+		maxAttempts:                parameters.maxAttempts,
+	}, nil
+}
+
+// Read resolves the block ID to a block, and calls the read function pinned to that
+// block and its state.  Once the reads are complete the block ID is resolved again;
+// if it now refers to a different block, for example because the head moved, the
+// reads are retried against the new block.  The pin for the successful reads is returned.
+//
+// If the read function returns an error and the block did not move the error is
+// returned, otherwise the reads are retried, as the error may be due to the move.
+func (s *Service) Read(ctx context.Context, blockID string, fn ReadFunc) (*Pin, error) {
+	if fn == nil {
+		return nil, errors.New("no read function supplied")
+	}
+
+	pin, err := s.resolve(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		readErr := fn(ctx, pin)
+		if isRoot(blockID) {
+			// A block root always refers to the same block.
+			if readErr != nil {
+				return nil, readErr
+			}
+
+			return pin, nil
+		}
+
+		current, err := s.resolve(ctx, blockID)
+		if err != nil {
+			return nil, err
+		}
+		if current.BlockRoot == pin.BlockRoot {
+			if readErr != nil {
+				return nil, readErr
+			}
+
+			return pin, nil
+		}
+
+		s.log.Debug().
+			Str("block_id", blockID).
+			Int("attempt", attempt).
+			Stringer("old_root", pin.BlockRoot).
+			Stringer("new_root", current.BlockRoot).
+			Msg("Block moved during reads")
+		if attempt >= s.maxAttempts {
+			return nil, errors.Wrapf(ErrMoved, "%s changed on each of %d attempts", blockID, attempt)
+		}
+		pin = current
+	}
+}
+
+// resolve obtains the pin for the block ID.
+func (s *Service) resolve(ctx context.Context, blockID string) (*Pin, error) {
+	header, err := s.beaconBlockHeadersProvider.BeaconBlockHeader(ctx, blockID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain header for block %s", blockID)
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return nil, errors.Errorf("no header returned for block %s", blockID)
+	}
+
+	return &Pin{
+		Header:    header,
+		Slot:      header.Header.Message.Slot,
+		BlockRoot: header.Root,
+		StateRoot: header.Header.Message.StateRoot,
+	}, nil
+}
+
+// isRoot returns true if the block ID is a block root.
+func isRoot(blockID string) bool {
+	return strings.HasPrefix(blockID, "0x")
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pinned_test
+
+import (
+	"context"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/pinned"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// headerClient returns headers for successive slots, advancing the slot on each
+// call until it reaches the final slot.
+type headerClient struct {
+	slot      phase0.Slot
+	finalSlot phase0.Slot
+	err       error
+}
+
+func (*headerClient) Name() string { return "header" }
+
+func (*headerClient) Address() string { return "header" }
+
+func (c *headerClient) BeaconBlockHeader(_ context.Context, blockID string) (*apiv1.BeaconBlockHeader, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	slot := c.slot
+	if blockID == "head" && c.slot < c.finalSlot {
+		c.slot++
+	}
+
+	return &apiv1.BeaconBlockHeader{
+		Root: phase0.Root{byte(slot)},
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:      slot,
+				StateRoot: phase0.Root{0xff, byte(slot)},
+			},
+		},
+	}, nil
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []pinned.Parameter
+		err    string
+	}{
+		{
+			name:   "ClientMissing",
+			params: []pinned.Parameter{pinned.WithLogLevel(zerolog.Disabled)},
+			err:    "problem with parameters: no client specified",
+		},
+		{
+			name: "ClientNotProvider",
+			params: []pinned.Parameter{
+				pinned.WithLogLevel(zerolog.Disabled),
+				pinned.WithClient(&struct{ consensusclient.Service }{}),
+			},
+			err: "problem with parameters: client does not provide beacon block headers",
+		},
+		{
+			name: "MaxAttemptsZero",
+			params: []pinned.Parameter{
+				pinned.WithLogLevel(zerolog.Disabled),
+				pinned.WithClient(&headerClient{}),
+				pinned.WithMaxAttempts(0),
+			},
+			err: "problem with parameters: max attempts must be at least 1",
+		},
+		{
+			name: "Good",
+			params: []pinned.Parameter{
+				pinned.WithLogLevel(zerolog.Disabled),
+				pinned.WithClient(&headerClient{}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := pinned.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		blockID   string
+		slot      phase0.Slot
+		finalSlot phase0.Slot
+		readErr   error
+		clientErr error
+		calls     int
+		pinSlot   phase0.Slot
+		err       string
+	}{
+		{
+			name:      "Stable",
+			blockID:   "head",
+			slot:      10,
+			finalSlot: 10,
+			calls:     1,
+			pinSlot:   10,
+		},
+		{
+			name:      "MovedOnce",
+			blockID:   "head",
+			slot:      10,
+			finalSlot: 11,
+			calls:     2,
+			pinSlot:   11,
+		},
+		{
+			name:      "MovedEveryTime",
+			blockID:   "head",
+			slot:      10,
+			finalSlot: 20,
+			calls:     3,
+			err:       "head changed on each of 3 attempts: block moved during reads",
+		},
+		{
+			name:    "Root",
+			blockID: "0x0a",
+			slot:    10,
+			calls:   1,
+			pinSlot: 10,
+		},
+		{
+			name:      "ReadError",
+			blockID:   "head",
+			slot:      10,
+			finalSlot: 10,
+			readErr:   errors.New("read failed"),
+			calls:     1,
+			err:       "read failed",
+		},
+		{
+			name:      "ReadErrorMoved",
+			blockID:   "head",
+			slot:      10,
+			finalSlot: 11,
+			readErr:   errors.New("read failed"),
+			calls:     2,
+			err:       "read failed",
+		},
+		{
+			name:      "ClientError",
+			blockID:   "head",
+			clientErr: errors.New("client failed"),
+			err:       "failed to obtain header for block head: client failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &headerClient{
+				slot:      test.slot,
+				finalSlot: test.finalSlot,
+				err:       test.clientErr,
+			}
+			s, err := pinned.New(ctx,
+				pinned.WithLogLevel(zerolog.Disabled),
+				pinned.WithClient(client),
+			)
+			require.NoError(t, err)
+
+			calls := 0
+			pin, err := s.Read(ctx, test.blockID, func(_ context.Context, pin *pinned.Pin) error {
+				calls++
+				require.Equal(t, phase0.Root{byte(pin.Slot)}, pin.BlockRoot)
+				require.Equal(t, phase0.Root{0xff, byte(pin.Slot)}, pin.StateRoot)
+
+				return test.readErr
+			})
+			require.Equal(t, test.calls, calls)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.pinSlot, pin.Slot)
+				require.Equal(t, pin.Header.Root, pin.BlockRoot)
+			}
+		})
+	}
+}
+
+func TestPinIDs(t *testing.T) {
+	pin := &pinned.Pin{
+		BlockRoot: phase0.Root{0x01},
+		StateRoot: phase0.Root{0x02},
+	}
+	require.Equal(t, "0x0100000000000000000000000000000000000000000000000000000000000000", pin.BlockID())
+	require.Equal(t, "0x0200000000000000000000000000000000000000000000000000000000000000", pin.StateID())
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publish
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	publicationsMetric        *prometheus.CounterVec
+	publicationDurationMetric *prometheus.HistogramVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if publicationsMetric != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	publicationsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "consensusclient",
+		Subsystem: "publish",
+		Name:      "blocks_total",
+		Help:      "Number of block publications, by the path used",
+	}, []string{"path", "result"})
+	if err := prometheus.Register(publicationsMetric); err != nil {
+		return errors.Wrap(err, "failed to register blocks_total")
+	}
+	publicationDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "consensusclient",
+		Subsystem: "publish",
+		Name:      "duration_seconds",
+		Help:      "Duration of block publications, by the path used",
+		Buckets:   []float64{0.1, 0.25, 0.5, 0.75, 1, 1.5, 2, 3, 4, 6, 8, 12},
+	}, []string{"path"})
+	if err := prometheus.Register(publicationDurationMetric); err != nil {
+		return errors.Wrap(err, "failed to register duration_seconds")
+	}
+
+	return nil
+}
+
+func monitorPublication(path Path, duration time.Duration, err error) {
+	if publicationsMetric != nil {
+		result := "succeeded"
+		if err != nil {
+			result = "failed"
+		}
+		publicationsMetric.WithLabelValues(string(path), result).Inc()
+	}
+	if publicationDurationMetric != nil && err == nil {
+		publicationDurationMetric.WithLabelValues(string(path)).Observe(duration.Seconds())
+	}
+}
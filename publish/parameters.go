@@ -0,0 +1,119 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publish
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel       zerolog.Level
+	monitor        metrics.Service
+	client         consensusclient.Service
+	blindedTimeout time.Duration
+	slotDeadline   time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the service.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithClient sets the client through which blocks are published.
+// The client must submit blinded and unblinded beacon blocks, and provide the
+// genesis time and slot duration.  If it also provides beacon block headers they
+// are used to check if a blinded block was published despite its submission failing.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithBlindedTimeout sets the maximum time to wait for the submission of a
+// blinded block before falling back to the local block.
+func WithBlindedTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blindedTimeout = timeout
+	})
+}
+
+// WithSlotDeadline sets the time from the start of the slot by which a block must be
+// published.  If not set the deadline is the end of the slot.
+func WithSlotDeadline(deadline time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotDeadline = deadline
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:       zerolog.GlobalLevel(),
+		blindedTimeout: 2 * time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.BlindedBeaconBlockSubmitter); !isProvider {
+		return nil, errors.New("client does not submit blinded beacon blocks")
+	}
+	if _, isProvider := parameters.client.(consensusclient.BeaconBlockSubmitter); !isProvider {
+		return nil, errors.New("client does not submit beacon blocks")
+	}
+	if _, isProvider := parameters.client.(consensusclient.GenesisTimeProvider); !isProvider {
+		return nil, errors.New("client does not provide genesis time")
+	}
+	if _, isProvider := parameters.client.(consensusclient.SlotDurationProvider); !isProvider {
+		return nil, errors.New("client does not provide slot duration")
+	}
+	if parameters.blindedTimeout <= 0 {
+		return nil, errors.New("blinded timeout must be positive")
+	}
+	if parameters.slotDeadline < 0 {
+		return nil, errors.New("slot deadline cannot be negative")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,275 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publish publishes proposed blocks, submitting the blinded block from a
+// builder and falling back to the locally built block if that submission fails.
+package publish
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Path is the path through which a block was published.
+type Path string
+
+const (
+	// PathBlinded is publication of the blinded block, unblinded by the builder.
+	PathBlinded Path = "blinded"
+	// PathLocal is publication of the locally built block.
+	PathLocal Path = "local"
+)
+
+// Outcome is the outcome of a successful publication.
+type Outcome struct {
+	// Slot is the slot of the published block.
+	Slot phase0.Slot
+	// Path is the path through which the block was published.
+	Path Path
+	// Duration is the time taken to publish the block, including any failed attempts.
+	Duration time.Duration
+	// BlindedErr is the error returned by the submission of the blinded block, if
+	// the block was published through the local path after that submission failed.
+	BlindedErr error
+}
+
+// Service publishes blocks.
+type Service struct {
+	log              zerolog.Logger
+	blindedSubmitter consensusclient.BlindedBeaconBlockSubmitter
+	submitter        consensusclient.BeaconBlockSubmitter
+	headersProvider  consensusclient.BeaconBlockHeadersProvider
+	genesisTime      time.Time
+	slotDuration     time.Duration
+	blindedTimeout   time.Duration
+	slotDeadline     time.Duration
+}
+
+// New creates a new publish service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "publish").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.Wrap(err, "failed to register metrics")
+	}
+
+	genesisTime, err := parameters.client.(consensusclient.GenesisTimeProvider).GenesisTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := parameters.client.(consensusclient.SlotDurationProvider).SlotDuration(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	slotDeadline := parameters.slotDeadline
+	if slotDeadline == 0 {
+		slotDeadline = slotDuration
+	}
+
+	s := &Service{
+		log:              log,
+		blindedSubmitter: parameters.client.(consensusclient.BlindedBeaconBlockSubmitter),
+		submitter:        parameters.client.(consensusclient.BeaconBlockSubmitter),
+		genesisTime:      genesisTime,
+		slotDuration:     slotDuration,
+		blindedTimeout:   parameters.blindedTimeout,
+		slotDeadline:     slotDeadline,
+	}
+	if headersProvider, isProvider := parameters.client.(consensusclient.BeaconBlockHeadersProvider); isProvider {
+		s.headersProvider = headersProvider
+	}
+
+	return s, nil
+}
+
+// Publish publishes a block for a slot before the slot deadline.  If a blinded block
+// is supplied it is submitted first; if that submission fails or times out, and a
+// local block is supplied, the local block is submitted instead.
+//
+// Note that signing both a blinded and a local block for the same slot creates two
+// proposals for the slot, which is a slashable offence if both become public, and the
+// builder holds the signed blinded block even if its submission appeared to fail.  To
+// reduce this risk the local block is not submitted if the beacon node already has the
+// blinded block, but callers should only supply a local block alongside a blinded block
+// if they accept the remaining risk.
+func (s *Service) Publish(ctx context.Context,
+	blinded *api.VersionedSignedBlindedBeaconBlock,
+	local *spec.VersionedSignedBeaconBlock,
+) (
+	*Outcome,
+	error,
+) {
+	started := time.Now()
+	slot, err := publicationSlot(blinded, local)
+	if err != nil {
+		return nil, err
+	}
+	log := s.log.With().Uint64("slot", uint64(slot)).Logger()
+
+	deadline := s.genesisTime.Add(time.Duration(slot)*s.slotDuration + s.slotDeadline)
+	if !started.Before(deadline) {
+		return nil, fmt.Errorf("deadline for slot %d has passed", slot)
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	outcome := &Outcome{
+		Slot: slot,
+	}
+
+	if blinded != nil {
+		outcome.BlindedErr = s.submitBlinded(ctx, blinded)
+		if outcome.BlindedErr == nil {
+			return s.published(log, outcome, PathBlinded, started), nil
+		}
+		log.Warn().Err(outcome.BlindedErr).Msg("Failed to publish blinded block")
+
+		if local == nil {
+			monitorPublication(PathBlinded, time.Since(started), outcome.BlindedErr)
+			return nil, errors.Wrap(outcome.BlindedErr, "failed to publish blinded block and no local block available")
+		}
+		if s.blindedBlockKnown(ctx, log, slot, blinded) {
+			outcome.BlindedErr = nil
+			return s.published(log, outcome, PathBlinded, started), nil
+		}
+		log.Info().Msg("Falling back to local block")
+	}
+
+	if err := s.submitter.SubmitBeaconBlock(ctx, local); err != nil {
+		monitorPublication(PathLocal, time.Since(started), err)
+		if outcome.BlindedErr != nil {
+			return nil, errors.Wrapf(err, "failed to publish local block after blinded block failed (%v)", outcome.BlindedErr)
+		}
+
+		return nil, errors.Wrap(err, "failed to publish local block")
+	}
+
+	return s.published(log, outcome, PathLocal, started), nil
+}
+
+// submitBlinded submits the blinded block, waiting no longer than the blinded timeout.
+func (s *Service) submitBlinded(ctx context.Context, blinded *api.VersionedSignedBlindedBeaconBlock) error {
+	ctx, cancel := context.WithTimeout(ctx, s.blindedTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.blindedSubmitter.SubmitBlindedBeaconBlock(ctx, blinded)
+	}()
+
+	// Do not rely on the submitter honouring the context.
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "blinded block submission timed out")
+	}
+}
+
+// blindedBlockKnown returns true if the beacon node has the blinded block, which can
+// happen if the builder published it despite its submission failing.
+func (s *Service) blindedBlockKnown(ctx context.Context,
+	log zerolog.Logger,
+	slot phase0.Slot,
+	blinded *api.VersionedSignedBlindedBeaconBlock,
+) bool {
+	if s.headersProvider == nil {
+		return false
+	}
+
+	root, err := blinded.Root()
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain root of blinded block")
+		return false
+	}
+	header, err := s.headersProvider.BeaconBlockHeader(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain header for slot")
+		return false
+	}
+	if header == nil || header.Root != root {
+		return false
+	}
+	log.Info().Msg("Beacon node has blinded block despite failed submission")
+
+	return true
+}
+
+// published records a successful publication.
+func (s *Service) published(log zerolog.Logger, outcome *Outcome, path Path, started time.Time) *Outcome {
+	outcome.Path = path
+	outcome.Duration = time.Since(started)
+	monitorPublication(path, outcome.Duration, nil)
+	log.Trace().Str("path", string(path)).Dur("duration", outcome.Duration).Msg("Published block")
+
+	return outcome
+}
+
+// publicationSlot returns the slot of the blocks to publish.
+func publicationSlot(blinded *api.VersionedSignedBlindedBeaconBlock,
+	local *spec.VersionedSignedBeaconBlock,
+) (
+	phase0.Slot,
+	error,
+) {
+	switch {
+	case blinded == nil && local == nil:
+		return 0, errors.New("no block supplied")
+	case blinded == nil:
+		slot, err := local.Slot()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to obtain slot of local block")
+		}
+
+		return slot, nil
+	case local == nil:
+		slot, err := blinded.Slot()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to obtain slot of blinded block")
+		}
+
+		return slot, nil
+	default:
+		blindedSlot, err := blinded.Slot()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to obtain slot of blinded block")
+		}
+		localSlot, err := local.Slot()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to obtain slot of local block")
+		}
+		if blindedSlot != localSlot {
+			return 0, fmt.Errorf("blinded block slot %d does not match local block slot %d", blindedSlot, localSlot)
+		}
+
+		return blindedSlot, nil
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publish_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	"github.com/attestantio/go-eth2-client/publish"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+const slotDuration = 12 * time.Second
+
+// publishClient is a client that records the blocks submitted to it.
+type publishClient struct {
+	genesisTime  time.Time
+	blindedErr   error
+	blindedDelay time.Duration
+	localErr     error
+	header       *apiv1.BeaconBlockHeader
+
+	mu      sync.Mutex
+	blinded int
+	local   int
+}
+
+func newPublishClient() *publishClient {
+	return &publishClient{
+		// Slot 100 started 100ms ago.
+		genesisTime: time.Now().Add(-100*slotDuration - 100*time.Millisecond),
+	}
+}
+
+func (*publishClient) Name() string { return "publish" }
+
+func (*publishClient) Address() string { return "" }
+
+func (c *publishClient) GenesisTime(_ context.Context) (time.Time, error) {
+	return c.genesisTime, nil
+}
+
+func (*publishClient) SlotDuration(_ context.Context) (time.Duration, error) {
+	return slotDuration, nil
+}
+
+func (c *publishClient) SubmitBlindedBeaconBlock(_ context.Context, _ *api.VersionedSignedBlindedBeaconBlock) error {
+	time.Sleep(c.blindedDelay)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.blinded++
+
+	return c.blindedErr
+}
+
+func (c *publishClient) SubmitBeaconBlock(_ context.Context, _ *spec.VersionedSignedBeaconBlock) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.local++
+
+	return c.localErr
+}
+
+func (c *publishClient) BeaconBlockHeader(_ context.Context, _ string) (*apiv1.BeaconBlockHeader, error) {
+	return c.header, nil
+}
+
+func blindedBlock(slot phase0.Slot) *api.VersionedSignedBlindedBeaconBlock {
+	return &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &apiv1capella.SignedBlindedBeaconBlock{
+			Message: &apiv1capella.BlindedBeaconBlock{
+				Slot: slot,
+				Body: &apiv1capella.BlindedBeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{
+						BlockHash: make([]byte, 32),
+					},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: make(bitfield.Bitvector512, syncCommitteeSize/8),
+					},
+					ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{},
+				},
+			},
+		},
+	}
+}
+
+func localBlock(slot phase0.Slot) *spec.VersionedSignedBeaconBlock {
+	return &spec.VersionedSignedBeaconBlock{
+		Version: spec.DataVersionCapella,
+		Capella: &capella.SignedBeaconBlock{
+			Message: &capella.BeaconBlock{
+				Slot: slot,
+			},
+		},
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []publish.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []publish.Parameter{
+				publish.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "BlindedTimeoutZero",
+			params: []publish.Parameter{
+				publish.WithLogLevel(zerolog.Disabled),
+				publish.WithClient(newPublishClient()),
+				publish.WithBlindedTimeout(0),
+			},
+			err: "problem with parameters: blinded timeout must be positive",
+		},
+		{
+			name: "SlotDeadlineNegative",
+			params: []publish.Parameter{
+				publish.WithLogLevel(zerolog.Disabled),
+				publish.WithClient(newPublishClient()),
+				publish.WithSlotDeadline(-1),
+			},
+			err: "problem with parameters: slot deadline cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []publish.Parameter{
+				publish.WithLogLevel(zerolog.Disabled),
+				publish.WithClient(newPublishClient()),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := publish.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+
+	blindedRoot, err := blindedBlock(100).Root()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		blinded      *api.VersionedSignedBlindedBeaconBlock
+		local        *spec.VersionedSignedBeaconBlock
+		blindedErr   error
+		blindedDelay time.Duration
+		localErr     error
+		header       *apiv1.BeaconBlockHeader
+		slotDeadline time.Duration
+		path         publish.Path
+		blindedFail  bool
+		blindedCalls int
+		localCalls   int
+		err          string
+	}{
+		{
+			name: "NoBlocks",
+			err:  "no block supplied",
+		},
+		{
+			name:    "SlotMismatch",
+			blinded: blindedBlock(100),
+			local:   localBlock(101),
+			err:     "blinded block slot 100 does not match local block slot 101",
+		},
+		{
+			name:         "DeadlinePassed",
+			blinded:      blindedBlock(100),
+			slotDeadline: 50 * time.Millisecond,
+			err:          "deadline for slot 100 has passed",
+		},
+		{
+			name:         "Blinded",
+			blinded:      blindedBlock(100),
+			local:        localBlock(100),
+			path:         publish.PathBlinded,
+			blindedCalls: 1,
+		},
+		{
+			name:       "LocalOnly",
+			local:      localBlock(100),
+			path:       publish.PathLocal,
+			localCalls: 1,
+		},
+		{
+			name:         "BlindedFailed",
+			blinded:      blindedBlock(100),
+			local:        localBlock(100),
+			blindedErr:   errors.New("relay error"),
+			path:         publish.PathLocal,
+			blindedFail:  true,
+			blindedCalls: 1,
+			localCalls:   1,
+		},
+		{
+			name:         "BlindedTimedOut",
+			blinded:      blindedBlock(100),
+			local:        localBlock(100),
+			blindedDelay: 200 * time.Millisecond,
+			path:         publish.PathLocal,
+			blindedFail:  true,
+			localCalls:   1,
+		},
+		{
+			name:         "BlindedFailedNoLocal",
+			blinded:      blindedBlock(100),
+			blindedErr:   errors.New("relay error"),
+			blindedCalls: 1,
+			err:          "failed to publish blinded block and no local block available: relay error",
+		},
+		{
+			name:         "BlindedFailedButKnown",
+			blinded:      blindedBlock(100),
+			local:        localBlock(100),
+			blindedErr:   errors.New("relay error"),
+			header:       &apiv1.BeaconBlockHeader{Root: blindedRoot},
+			path:         publish.PathBlinded,
+			blindedCalls: 1,
+		},
+		{
+			name:         "BlindedFailedOtherBlockKnown",
+			blinded:      blindedBlock(100),
+			local:        localBlock(100),
+			blindedErr:   errors.New("relay error"),
+			header:       &apiv1.BeaconBlockHeader{Root: phase0.Root{0x01}},
+			path:         publish.PathLocal,
+			blindedFail:  true,
+			blindedCalls: 1,
+			localCalls:   1,
+		},
+		{
+			name:         "BothFailed",
+			blinded:      blindedBlock(100),
+			local:        localBlock(100),
+			blindedErr:   errors.New("relay error"),
+			localErr:     errors.New("node error"),
+			blindedCalls: 1,
+			localCalls:   1,
+			err:          "failed to publish local block after blinded block failed (relay error): node error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newPublishClient()
+			client.blindedErr = test.blindedErr
+			client.blindedDelay = test.blindedDelay
+			client.localErr = test.localErr
+			client.header = test.header
+			s, err := publish.New(ctx,
+				publish.WithLogLevel(zerolog.Disabled),
+				publish.WithClient(client),
+				publish.WithBlindedTimeout(50*time.Millisecond),
+				publish.WithSlotDeadline(test.slotDeadline),
+			)
+			require.NoError(t, err)
+
+			outcome, err := s.Publish(ctx, test.blinded, test.local)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(100), outcome.Slot)
+				require.Equal(t, test.path, outcome.Path)
+				require.Equal(t, test.blindedFail, outcome.BlindedErr != nil)
+			}
+			client.mu.Lock()
+			require.Equal(t, test.blindedCalls, client.blinded)
+			require.Equal(t, test.localCalls, client.local)
+			client.mu.Unlock()
+		})
+	}
+}
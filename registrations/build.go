@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// Signer signs with BLS validator keys.
+// This package does not hold keys, so signing must be supplied through this interface.
+type Signer interface {
+	// Sign returns the signature of the root by the key with the given public key.
+	Sign(ctx context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error)
+}
+
+// SignerFunc is an adapter to allow the use of an ordinary function as a Signer.
+type SignerFunc func(ctx context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error)
+
+// Sign calls f(ctx, pubKey, root).
+func (f SignerFunc) Sign(ctx context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error) {
+	return f(ctx, pubKey, root)
+}
+
+// Request is a request for a validator registration.
+type Request struct {
+	Pubkey       phase0.BLSPubKey
+	FeeRecipient bellatrix.ExecutionAddress
+	GasLimit     uint64
+	// Timestamp is the time of the registration; if zero the current time is used.
+	Timestamp time.Time
+}
+
+// NewSignedValidatorRegistration creates a validator registration from the request and
+// signs it in the builder domain of the chain with the given genesis fork version.
+func NewSignedValidatorRegistration(ctx context.Context,
+	signer Signer,
+	genesisForkVersion phase0.Version,
+	request *Request,
+) (
+	*apiv1.SignedValidatorRegistration,
+	error,
+) {
+	if signer == nil {
+		return nil, errors.New("no signer supplied")
+	}
+	domain, err := signing.ApplicationBuilderDomain(genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSignedValidatorRegistration(ctx, signer, domain, request)
+}
+
+// Build creates and signs validator registrations for the requests, ready for submission.
+// The registrations are returned in the order of the requests.  All requests share the
+// same timestamp if they do not supply their own.
+func Build(ctx context.Context,
+	signer Signer,
+	genesisForkVersion phase0.Version,
+	requests []*Request,
+) (
+	[]*api.VersionedSignedValidatorRegistration,
+	error,
+) {
+	if signer == nil {
+		return nil, errors.New("no signer supplied")
+	}
+	domain, err := signing.ApplicationBuilderDomain(genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := make([]*api.VersionedSignedValidatorRegistration, 0, len(requests))
+	for i, request := range requests {
+		if request == nil {
+			return nil, errors.Errorf("request %d missing", i)
+		}
+		if request.Timestamp.IsZero() {
+			timestamped := *request
+			timestamped.Timestamp = now
+			request = &timestamped
+		}
+		registration, err := newSignedValidatorRegistration(ctx, signer, domain, request)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create registration for %#x", request.Pubkey)
+		}
+		res = append(res, &api.VersionedSignedValidatorRegistration{
+			Version: spec.BuilderVersionV1,
+			V1:      registration,
+		})
+	}
+
+	return res, nil
+}
+
+func newSignedValidatorRegistration(ctx context.Context,
+	signer Signer,
+	domain phase0.Domain,
+	request *Request,
+) (
+	*apiv1.SignedValidatorRegistration,
+	error,
+) {
+	if request == nil {
+		return nil, errors.New("no request supplied")
+	}
+	if request.GasLimit == 0 {
+		return nil, errors.New("no gas limit supplied")
+	}
+
+	timestamp := request.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	registration := &apiv1.ValidatorRegistration{
+		FeeRecipient: request.FeeRecipient,
+		GasLimit:     request.GasLimit,
+		// Registrations are timestamped to the second.
+		Timestamp: time.Unix(timestamp.Unix(), 0),
+		Pubkey:    request.Pubkey,
+	}
+	root, err := signing.ComputeSigningRoot(registration, domain)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(ctx, request.Pubkey, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign registration")
+	}
+
+	return &apiv1.SignedValidatorRegistration{
+		Message:   registration,
+		Signature: signature,
+	}, nil
+}
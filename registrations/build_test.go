@@ -0,0 +1,156 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/registrations"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// rootSigner signs by copying the root in to the signature.
+var rootSigner = registrations.SignerFunc(func(_ context.Context, _ phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error) {
+	var signature phase0.BLSSignature
+	copy(signature[:], root[:])
+
+	return signature, nil
+})
+
+func TestNewSignedValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+	request := &registrations.Request{
+		Pubkey:       phase0.BLSPubKey{0x01},
+		FeeRecipient: bellatrix.ExecutionAddress{0x02},
+		GasLimit:     30000000,
+		Timestamp:    time.Unix(1600000000, 500),
+	}
+
+	tests := []struct {
+		name    string
+		signer  registrations.Signer
+		request *registrations.Request
+		err     string
+	}{
+		{
+			name:    "SignerMissing",
+			request: request,
+			err:     "no signer supplied",
+		},
+		{
+			name:   "RequestMissing",
+			signer: rootSigner,
+			err:    "no request supplied",
+		},
+		{
+			name:   "GasLimitMissing",
+			signer: rootSigner,
+			request: &registrations.Request{
+				Pubkey: phase0.BLSPubKey{0x01},
+			},
+			err: "no gas limit supplied",
+		},
+		{
+			name: "SignerError",
+			signer: registrations.SignerFunc(func(_ context.Context, _ phase0.BLSPubKey, _ phase0.Root) (phase0.BLSSignature, error) {
+				return phase0.BLSSignature{}, errors.New("locked")
+			}),
+			request: request,
+			err:     "failed to sign registration: locked",
+		},
+		{
+			name:    "Good",
+			signer:  rootSigner,
+			request: request,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registration, err := registrations.NewSignedValidatorRegistration(ctx, test.signer, phase0.Version{}, test.request)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, time.Unix(1600000000, 0), registration.Message.Timestamp)
+			require.Equal(t, bellatrix.ExecutionAddress{0x02}, registration.Message.FeeRecipient)
+
+			// Confirm that the signature is over the registration in the builder domain.
+			domain, err := signing.ApplicationBuilderDomain(phase0.Version{})
+			require.NoError(t, err)
+			root, err := signing.ComputeSigningRoot(registration.Message, domain)
+			require.NoError(t, err)
+			require.Equal(t, root[:], registration.Signature[:32])
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	ctx := context.Background()
+
+	res, err := registrations.Build(ctx, rootSigner, phase0.Version{}, []*registrations.Request{
+		{Pubkey: phase0.BLSPubKey{0x01}, GasLimit: 30000000},
+		{Pubkey: phase0.BLSPubKey{0x02}, GasLimit: 36000000},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	pubKey, err := res[1].PubKey()
+	require.NoError(t, err)
+	require.Equal(t, phase0.BLSPubKey{0x02}, pubKey)
+	// Registrations without their own timestamp share a timestamp.
+	timestamp1, err := res[0].Timestamp()
+	require.NoError(t, err)
+	timestamp2, err := res[1].Timestamp()
+	require.NoError(t, err)
+	require.Equal(t, timestamp1, timestamp2)
+
+	_, err = registrations.Build(ctx, rootSigner, phase0.Version{}, []*registrations.Request{
+		{Pubkey: phase0.BLSPubKey{0x01}},
+	})
+	require.EqualError(t, err, "failed to create registration for 0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000: no gas limit supplied")
+}
+
+func TestSubmit(t *testing.T) {
+	ctx := context.Background()
+	regs := []*api.VersionedSignedValidatorRegistration{
+		registration(1, 30000000),
+		registration(2, 30000000),
+		registration(3, 30000000),
+	}
+
+	_, err := registrations.Submit(ctx, nil, regs, 2)
+	require.EqualError(t, err, "no submitter supplied")
+	_, err = registrations.Submit(ctx, &recordingSubmitter{}, regs, 0)
+	require.EqualError(t, err, "chunk size must be at least 1")
+
+	submitter := &recordingSubmitter{}
+	submitted, err := registrations.Submit(ctx, submitter, regs, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3, submitted)
+	require.Len(t, submitter.calls, 2)
+	require.Len(t, submitter.calls[0], 2)
+	require.Len(t, submitter.calls[1], 1)
+
+	submitted, err = registrations.Submit(ctx, &recordingSubmitter{fail: true}, regs, 2)
+	require.EqualError(t, err, "failed to submit registrations 0 to 1: failed")
+	require.Equal(t, 0, submitted)
+}
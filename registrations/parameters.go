@@ -0,0 +1,118 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations
+
+import (
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel        zerolog.Level
+	submitters      []consensusclient.ValidatorRegistrationsSubmitter
+	refreshInterval time.Duration
+	checkInterval   time.Duration
+	chunkSize       int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithSubmitters sets the targets to which registrations are sent.
+// Each submitter is tracked separately, keyed on its address.
+func WithSubmitters(submitters []consensusclient.ValidatorRegistrationsSubmitter) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submitters = submitters
+	})
+}
+
+// WithRefreshInterval sets the maximum time after which a registration that
+// has already been sent to a target will be sent again.
+func WithRefreshInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.refreshInterval = interval
+	})
+}
+
+// WithCheckInterval sets the interval at which targets are checked for
+// registrations that require sending.
+func WithCheckInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.checkInterval = interval
+	})
+}
+
+// WithChunkSize sets the maximum number of registrations sent to a target in a single request.
+func WithChunkSize(chunkSize int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chunkSize = chunkSize
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		// Builder specification requests registrations once per epoch.
+		refreshInterval: 384 * time.Second,
+		checkInterval:   12 * time.Second,
+		chunkSize:       1000,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.submitters) == 0 {
+		return nil, errors.New("no submitters specified")
+	}
+	for _, submitter := range parameters.submitters {
+		if submitter == nil {
+			return nil, errors.New("nil submitter specified")
+		}
+		if _, isService := submitter.(consensusclient.Service); !isService {
+			return nil, errors.New("submitter does not provide an address")
+		}
+	}
+	if parameters.refreshInterval <= 0 {
+		return nil, errors.New("no refresh interval specified")
+	}
+	if parameters.checkInterval <= 0 {
+		return nil, errors.New("no check interval specified")
+	}
+	if parameters.chunkSize < 1 {
+		return nil, errors.New("no chunk size specified")
+	}
+
+	return &parameters, nil
+}
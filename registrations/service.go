@@ -0,0 +1,247 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service tracks the validator registrations sent to a number of targets
+// (relays, beacon nodes, middleware) and ensures that each target receives
+// the desired registrations, refreshing them periodically.
+type Service struct {
+	log             zerolog.Logger
+	refreshInterval time.Duration
+	chunkSize       int
+	targets         []*target
+
+	mu sync.RWMutex
+	// desired contains the registrations that should be present on all targets.
+	desired map[phase0.BLSPubKey]*registration
+	// sent contains, for each target address, the registrations that have been sent.
+	sent map[string]map[phase0.BLSPubKey]*sentRegistration
+	// lastErrors contains the last error returned by each target.
+	lastErrors map[string]error
+	lastSync   map[string]time.Time
+}
+
+type target struct {
+	address   string
+	submitter consensusclient.ValidatorRegistrationsSubmitter
+}
+
+type registration struct {
+	root         phase0.Root
+	registration *api.VersionedSignedValidatorRegistration
+}
+
+type sentRegistration struct {
+	root   phase0.Root
+	sentAt time.Time
+}
+
+// New creates a new registrations service.
+// The service checks its targets periodically until the context is cancelled.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "registrations").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	targets := make([]*target, 0, len(parameters.submitters))
+	sent := make(map[string]map[phase0.BLSPubKey]*sentRegistration, len(parameters.submitters))
+	for _, submitter := range parameters.submitters {
+		address := submitter.(consensusclient.Service).Address()
+		if _, exists := sent[address]; exists {
+			return nil, errors.Errorf("duplicate submitter %s", address)
+		}
+		targets = append(targets, &target{
+			address:   address,
+			submitter: submitter,
+		})
+		sent[address] = make(map[phase0.BLSPubKey]*sentRegistration)
+	}
+
+	s := &Service{
+		log:             log,
+		refreshInterval: parameters.refreshInterval,
+		chunkSize:       parameters.chunkSize,
+		targets:         targets,
+		desired:         make(map[phase0.BLSPubKey]*registration),
+		sent:            sent,
+		lastErrors:      make(map[string]error),
+		lastSync:        make(map[string]time.Time),
+	}
+
+	go s.run(ctx, parameters.checkInterval)
+
+	return s, nil
+}
+
+// run periodically synchronises registrations with the targets.
+func (s *Service) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.Trace().Msg("Context done; stopping")
+			return
+		case <-ticker.C:
+			s.Sync(ctx)
+		}
+	}
+}
+
+// SetRegistrations sets the desired registrations, replacing any that were previously set.
+// Registrations for validators that are no longer desired are no longer refreshed.
+func (s *Service) SetRegistrations(registrations []*api.VersionedSignedValidatorRegistration) error {
+	desired := make(map[phase0.BLSPubKey]*registration, len(registrations))
+	for i, reg := range registrations {
+		if reg == nil {
+			return errors.Errorf("registration %d missing", i)
+		}
+		pubKey, err := reg.PubKey()
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain public key for registration %d", i)
+		}
+		root, err := reg.Root()
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain root for registration %d", i)
+		}
+		desired[pubKey] = &registration{
+			root:         root,
+			registration: reg,
+		}
+	}
+
+	s.mu.Lock()
+	s.desired = desired
+	for _, sent := range s.sent {
+		for pubKey := range sent {
+			if _, exists := desired[pubKey]; !exists {
+				delete(sent, pubKey)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Diff returns the registrations that need to be sent to the target with the given address
+// at the given time.  A registration needs to be sent if it has never been sent to the
+// target, if it has changed since it was last sent, or if it was last sent longer ago
+// than the refresh interval.
+func (s *Service) Diff(address string, now time.Time) ([]*api.VersionedSignedValidatorRegistration, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.diff(address, now)
+}
+
+// diff is the internal version of Diff; it must be called with the lock held.
+func (s *Service) diff(address string, now time.Time) ([]*api.VersionedSignedValidatorRegistration, error) {
+	sent, exists := s.sent[address]
+	if !exists {
+		return nil, errors.Errorf("unknown target %s", address)
+	}
+
+	res := make([]*api.VersionedSignedValidatorRegistration, 0)
+	for pubKey, desired := range s.desired {
+		sentRegistration, exists := sent[pubKey]
+		switch {
+		case !exists:
+			res = append(res, desired.registration)
+		case sentRegistration.root != desired.root:
+			res = append(res, desired.registration)
+		case now.Sub(sentRegistration.sentAt) >= s.refreshInterval:
+			res = append(res, desired.registration)
+		}
+	}
+
+	return res, nil
+}
+
+// Sync sends all outstanding registrations to all targets.
+func (s *Service) Sync(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, t := range s.targets {
+		wg.Add(1)
+		go func(ctx context.Context, t *target) {
+			defer wg.Done()
+			if err := s.syncTarget(ctx, t); err != nil {
+				s.log.Warn().Str("target", t.address).Err(err).Msg("Failed to send registrations")
+			}
+		}(ctx, t)
+	}
+	wg.Wait()
+}
+
+// syncTarget sends all outstanding registrations to a single target.
+func (s *Service) syncTarget(ctx context.Context, target *target) error {
+	now := time.Now()
+	s.mu.RLock()
+	registrations, err := s.diff(target.address, now)
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if len(registrations) == 0 {
+		s.log.Trace().Str("target", target.address).Msg("No registrations to send")
+		return nil
+	}
+
+	s.log.Trace().Str("target", target.address).Int("registrations", len(registrations)).Msg("Sending registrations")
+	submitted, err := Submit(ctx, target.submitter, registrations, s.chunkSize)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastErrors[target.address] = err
+	if err == nil {
+		s.lastSync[target.address] = now
+	}
+	// Record the registrations that were submitted, even if a later chunk failed.
+	sent := s.sent[target.address]
+	for _, reg := range registrations[:submitted] {
+		// Errors cannot occur here, as the registrations were checked when set.
+		pubKey, _ := reg.PubKey()
+		root, _ := reg.Root()
+		if desired, exists := s.desired[pubKey]; !exists || desired.root != root {
+			// Desired registrations changed whilst we were sending; do not record.
+			continue
+		}
+		sent[pubKey] = &sentRegistration{
+			root:   root,
+			sentAt: now,
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,190 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/registrations"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSubmitter struct {
+	address string
+	fail    bool
+	mu      sync.Mutex
+	calls   [][]*api.VersionedSignedValidatorRegistration
+}
+
+func (r *recordingSubmitter) Name() string    { return "recording" }
+func (r *recordingSubmitter) Address() string { return r.address }
+
+func (r *recordingSubmitter) SubmitValidatorRegistrations(_ context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail {
+		return errors.New("failed")
+	}
+	r.calls = append(r.calls, registrations)
+	return nil
+}
+
+func registration(pubKey byte, gasLimit uint64) *api.VersionedSignedValidatorRegistration {
+	return &api.VersionedSignedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1: &apiv1.SignedValidatorRegistration{
+			Message: &apiv1.ValidatorRegistration{
+				GasLimit:  gasLimit,
+				Timestamp: time.Unix(1600000000, 0),
+				Pubkey:    phase0.BLSPubKey{pubKey},
+			},
+		},
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []registrations.Parameter
+		err    string
+	}{
+		{
+			name: "SubmittersMissing",
+			params: []registrations.Parameter{
+				registrations.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no submitters specified",
+		},
+		{
+			name: "RefreshIntervalZero",
+			params: []registrations.Parameter{
+				registrations.WithLogLevel(zerolog.Disabled),
+				registrations.WithSubmitters([]consensusclient.ValidatorRegistrationsSubmitter{&recordingSubmitter{address: "1"}}),
+				registrations.WithRefreshInterval(0),
+			},
+			err: "problem with parameters: no refresh interval specified",
+		},
+		{
+			name: "DuplicateSubmitter",
+			params: []registrations.Parameter{
+				registrations.WithLogLevel(zerolog.Disabled),
+				registrations.WithSubmitters([]consensusclient.ValidatorRegistrationsSubmitter{
+					&recordingSubmitter{address: "1"},
+					&recordingSubmitter{address: "1"},
+				}),
+			},
+			err: "duplicate submitter 1",
+		},
+		{
+			name: "Good",
+			params: []registrations.Parameter{
+				registrations.WithLogLevel(zerolog.Disabled),
+				registrations.WithSubmitters([]consensusclient.ValidatorRegistrationsSubmitter{&recordingSubmitter{address: "1"}}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := registrations.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	relay1 := &recordingSubmitter{address: "relay1"}
+	relay2 := &recordingSubmitter{address: "relay2", fail: true}
+	s, err := registrations.New(ctx,
+		registrations.WithLogLevel(zerolog.Disabled),
+		registrations.WithSubmitters([]consensusclient.ValidatorRegistrationsSubmitter{relay1, relay2}),
+		registrations.WithCheckInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetRegistrations([]*api.VersionedSignedValidatorRegistration{
+		registration(1, 30000000),
+		registration(2, 30000000),
+	}))
+
+	diff, err := s.Diff("relay1", time.Now())
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+	_, err = s.Diff("unknown", time.Now())
+	require.EqualError(t, err, "unknown target unknown")
+
+	s.Sync(ctx)
+	require.Len(t, relay1.calls, 1)
+	require.Len(t, relay1.calls[0], 2)
+
+	// Nothing further to send to relay 1 until the refresh interval.
+	diff, err = s.Diff("relay1", time.Now())
+	require.NoError(t, err)
+	require.Len(t, diff, 0)
+	diff, err = s.Diff("relay1", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+
+	// Relay 2 failed so should still have everything outstanding.
+	diff, err = s.Diff("relay2", time.Now())
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+
+	// Change a registration; only that should be sent.
+	require.NoError(t, s.SetRegistrations([]*api.VersionedSignedValidatorRegistration{
+		registration(1, 30000000),
+		registration(2, 36000000),
+	}))
+	s.Sync(ctx)
+	require.Len(t, relay1.calls, 2)
+	require.Len(t, relay1.calls[1], 1)
+	require.Equal(t, uint64(36000000), relay1.calls[1][0].V1.Message.GasLimit)
+
+	state := s.State()
+	require.Len(t, state, 2)
+	require.Equal(t, "relay1", state[0].Address)
+	require.Equal(t, 0, state[0].Pending)
+	require.Len(t, state[0].Registrations, 2)
+	require.True(t, state[0].Registrations[0].Current)
+	require.NoError(t, state[0].LastError)
+	require.Equal(t, "relay2", state[1].Address)
+	require.Equal(t, 2, state[1].Pending)
+	require.EqualError(t, state[1].LastError, "failed to submit registrations 0 to 1: failed")
+
+	// Remove a registration; it should no longer be tracked.
+	require.NoError(t, s.SetRegistrations([]*api.VersionedSignedValidatorRegistration{
+		registration(1, 30000000),
+	}))
+	state = s.State()
+	require.Len(t, state[0].Registrations, 1)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations
+
+import (
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// TargetState is the state of registrations for a single target.
+type TargetState struct {
+	// Address is the address of the target.
+	Address string
+	// LastSync is the time of the last successful submission to the target.
+	LastSync time.Time
+	// LastError is the error returned by the last submission to the target, if any.
+	LastError error
+	// Pending is the number of registrations that need to be sent to the target.
+	Pending int
+	// Registrations are the registrations that have been sent to the target.
+	Registrations []*RegistrationState
+}
+
+// RegistrationState is the state of a single registration on a target.
+type RegistrationState struct {
+	// PubKey is the public key of the validator.
+	PubKey phase0.BLSPubKey
+	// Root is the root of the registration message that was sent.
+	Root phase0.Root
+	// SentAt is the time at which the registration was sent.
+	SentAt time.Time
+	// Current is true if the registration sent is the desired registration.
+	Current bool
+}
+
+// State returns the current state of registrations for all targets,
+// for observability purposes.
+func (s *Service) State() []*TargetState {
+	now := time.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]*TargetState, 0, len(s.targets))
+	for _, target := range s.targets {
+		pending, _ := s.diff(target.address, now)
+		targetState := &TargetState{
+			Address:       target.address,
+			LastSync:      s.lastSync[target.address],
+			LastError:     s.lastErrors[target.address],
+			Pending:       len(pending),
+			Registrations: make([]*RegistrationState, 0, len(s.sent[target.address])),
+		}
+		for pubKey, sent := range s.sent[target.address] {
+			desired, exists := s.desired[pubKey]
+			targetState.Registrations = append(targetState.Registrations, &RegistrationState{
+				PubKey:  pubKey,
+				Root:    sent.root,
+				SentAt:  sent.sentAt,
+				Current: exists && desired.root == sent.root,
+			})
+		}
+		sort.Slice(targetState.Registrations, func(i, j int) bool {
+			return string(targetState.Registrations[i].PubKey[:]) < string(targetState.Registrations[j].PubKey[:])
+		})
+		res = append(res, targetState)
+	}
+
+	return res
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrations
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+)
+
+// Submit submits registrations in chunks of at most chunkSize registrations, in order.
+// It returns the number of registrations that were submitted; if a chunk is rejected
+// submission stops, and the registrations from that chunk onwards are not submitted.
+func Submit(ctx context.Context,
+	submitter consensusclient.ValidatorRegistrationsSubmitter,
+	registrations []*api.VersionedSignedValidatorRegistration,
+	chunkSize int,
+) (
+	int,
+	error,
+) {
+	if submitter == nil {
+		return 0, errors.New("no submitter supplied")
+	}
+	if chunkSize < 1 {
+		return 0, errors.New("chunk size must be at least 1")
+	}
+
+	for start := 0; start < len(registrations); start += chunkSize {
+		end := start + chunkSize
+		if end > len(registrations) {
+			end = len(registrations)
+		}
+		if err := submitter.SubmitValidatorRegistrations(ctx, registrations[start:end]); err != nil {
+			return start, errors.Wrapf(err, "failed to submit registrations %d to %d", start, end-1)
+		}
+	}
+
+	return len(registrations), nil
+}
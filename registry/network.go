@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Network describes an Ethereum network.
+type Network struct {
+	// Name is the name of the network.
+	Name string
+	// GenesisForkVersion is the genesis fork version of the network.
+	GenesisForkVersion phase0.Version
+	// GenesisValidatorsRoot is the genesis validators root of the network.
+	// If zero, it is not checked when clients are added.
+	GenesisValidatorsRoot phase0.Root
+}
+
+// Well-known networks.
+var (
+	// Mainnet is the Ethereum mainnet.
+	Mainnet = Network{
+		Name:               "mainnet",
+		GenesisForkVersion: spec.MainnetForkVersions.Phase0,
+		GenesisValidatorsRoot: phase0.Root{
+			0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+			0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+		},
+	}
+	// Goerli is the Goerli testnet.
+	Goerli = Network{
+		Name:               "goerli",
+		GenesisForkVersion: phase0.Version{0x00, 0x00, 0x10, 0x20},
+		GenesisValidatorsRoot: phase0.Root{
+			0x04, 0x3d, 0xb0, 0xd9, 0xa8, 0x38, 0x13, 0x55, 0x1e, 0xe2, 0xf3, 0x34, 0x50, 0xd2, 0x37, 0x97,
+			0x75, 0x7d, 0x43, 0x09, 0x11, 0xa9, 0x32, 0x05, 0x30, 0xad, 0x8a, 0x0e, 0xab, 0xc4, 0x3e, 0xfb,
+		},
+	}
+	// Sepolia is the Sepolia testnet.
+	Sepolia = Network{
+		Name:               "sepolia",
+		GenesisForkVersion: spec.SepoliaForkVersions.Phase0,
+		GenesisValidatorsRoot: phase0.Root{
+			0xd8, 0xea, 0x17, 0x1f, 0x3c, 0x94, 0xae, 0xa2, 0x1e, 0xbc, 0x42, 0xa1, 0xed, 0x61, 0x05, 0x2a,
+			0xcf, 0x3f, 0x92, 0x09, 0xc0, 0x0e, 0x4e, 0xfb, 0xaa, 0xdd, 0xac, 0x09, 0xed, 0x9b, 0x80, 0x78,
+		},
+	}
+	// Holesky is the Holesky testnet.
+	Holesky = Network{
+		Name:               "holesky",
+		GenesisForkVersion: spec.HoleskyForkVersions.Phase0,
+		GenesisValidatorsRoot: phase0.Root{
+			0x91, 0x43, 0xaa, 0x7c, 0x61, 0x5a, 0x7f, 0x71, 0x15, 0xe2, 0xb6, 0xaa, 0xc3, 0x19, 0xc0, 0x35,
+			0x29, 0xdf, 0x82, 0x42, 0xae, 0x70, 0x5f, 0xba, 0x9d, 0xf3, 0x9b, 0x79, 0xc5, 0x9f, 0xa8, 0xb1,
+		},
+	}
+)
+
+// String returns a string version of the structure.
+func (n Network) String() string {
+	return fmt.Sprintf("%s (%#x)", n.Name, n.GenesisForkVersion)
+}
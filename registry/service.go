@@ -0,0 +1,205 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/multi"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service manages clients for a number of networks, keyed by network.
+// Network names are unique within the service.
+// Clients created by the service share its logging and metrics configuration.
+type Service struct {
+	log          zerolog.Logger
+	logLevel     zerolog.Level
+	monitor      metrics.Service
+	timeout      time.Duration
+	extraHeaders map[string]string
+
+	mu      sync.RWMutex
+	entries map[Network]*entry
+}
+
+type entry struct {
+	client consensusclient.Service
+	cancel context.CancelFunc
+}
+
+// New creates a new registry service.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "registry").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	return &Service{
+		log:          log,
+		logLevel:     parameters.logLevel,
+		monitor:      parameters.monitor,
+		timeout:      parameters.timeout,
+		extraHeaders: parameters.extraHeaders,
+		entries:      make(map[Network]*entry),
+	}, nil
+}
+
+// AddAddresses creates a client for the network that connects to the given addresses.
+// The client remains active until it is removed or the context is cancelled.
+func (s *Service) AddAddresses(ctx context.Context, network Network, addresses []string) (consensusclient.Service, error) {
+	return s.add(ctx, network, multi.WithAddresses(addresses))
+}
+
+// AddClients creates a client for the network that uses the given clients.
+// The client remains active until it is removed or the context is cancelled.
+func (s *Service) AddClients(ctx context.Context, network Network, clients []consensusclient.Service) (consensusclient.Service, error) {
+	return s.add(ctx, network, multi.WithClients(clients))
+}
+
+func (s *Service) add(ctx context.Context, network Network, param multi.Parameter) (consensusclient.Service, error) {
+	if network.Name == "" {
+		return nil, errors.New("network has no name")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for existing := range s.entries {
+		if existing.Name == network.Name {
+			return nil, errors.Errorf("network %s already registered", network.Name)
+		}
+	}
+
+	clientCtx, cancel := context.WithCancel(ctx)
+	client, err := multi.New(clientCtx,
+		multi.WithLogLevel(s.logLevel),
+		multi.WithMonitor(s.monitor),
+		multi.WithTimeout(s.timeout),
+		multi.WithExtraHeaders(s.extraHeaders),
+		param,
+	)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrapf(err, "failed to create client for network %s", network.Name)
+	}
+
+	if err := checkNetwork(ctx, client, network); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	s.entries[network] = &entry{
+		client: client,
+		cancel: cancel,
+	}
+	s.log.Trace().Stringer("network", network).Msg("Added network")
+
+	return client, nil
+}
+
+// checkNetwork checks that the client is connected to the given network.
+func checkNetwork(ctx context.Context, client consensusclient.Service, network Network) error {
+	provider, isProvider := client.(consensusclient.GenesisProvider)
+	if !isProvider {
+		return errors.New("client does not provide genesis")
+	}
+	genesis, err := provider.Genesis(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to obtain genesis for network %s", network.Name)
+	}
+	if genesis.GenesisForkVersion != network.GenesisForkVersion {
+		return errors.Errorf("client for network %s has genesis fork version %#x, expected %#x", network.Name, genesis.GenesisForkVersion, network.GenesisForkVersion)
+	}
+	if network.GenesisValidatorsRoot != (phase0.Root{}) && genesis.GenesisValidatorsRoot != network.GenesisValidatorsRoot {
+		return errors.Errorf("client for network %s has genesis validators root %#x, expected %#x", network.Name, genesis.GenesisValidatorsRoot, network.GenesisValidatorsRoot)
+	}
+
+	return nil
+}
+
+// Remove removes the client for the network, stopping its monitoring of providers.
+// It returns false if there was no client for the network.
+func (s *Service) Remove(network Network) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[network]
+	if !exists {
+		return false
+	}
+	entry.cancel()
+	delete(s.entries, network)
+	s.log.Trace().Stringer("network", network).Msg("Removed network")
+
+	return true
+}
+
+// Client returns the client for the network, or nil if there is no client for the network.
+func (s *Service) Client(network Network) consensusclient.Service {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, exists := s.entries[network]
+	if !exists {
+		return nil
+	}
+
+	return entry.client
+}
+
+// ClientByName returns the client for the network with the given name, or nil if there
+// is no such client.
+func (s *Service) ClientByName(name string) consensusclient.Service {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for network, entry := range s.entries {
+		if network.Name == name {
+			return entry.client
+		}
+	}
+
+	return nil
+}
+
+// Networks returns the networks for which the service has clients, ordered by name.
+func (s *Service) Networks() []Network {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	networks := make([]Network, 0, len(s.entries))
+	for network := range s.entries {
+		networks = append(networks, network)
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+
+	return networks
+}
@@ -0,0 +1,145 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/registry"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNetwork is the network of the mock client.
+var mockNetwork = registry.Network{
+	Name:               "mock",
+	GenesisForkVersion: phase0.Version{0x01, 0x02, 0x03, 0x04},
+	GenesisValidatorsRoot: phase0.Root{
+		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+		0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+	},
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := registry.New(ctx, registry.WithTimeout(0))
+	require.EqualError(t, err, "problem with parameters: no timeout specified")
+
+	_, err = registry.New(ctx, registry.WithLogLevel(zerolog.Disabled), registry.WithTimeout(time.Second))
+	require.NoError(t, err)
+}
+
+func TestAddClients(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		network registry.Network
+		err     string
+	}{
+		{
+			name: "NoName",
+			network: registry.Network{
+				GenesisForkVersion: mockNetwork.GenesisForkVersion,
+			},
+			err: "network has no name",
+		},
+		{
+			name:    "WrongForkVersion",
+			network: registry.Mainnet,
+			err:     "client for network mainnet has genesis fork version 0x01020304, expected 0x00000000",
+		},
+		{
+			name: "WrongGenesisValidatorsRoot",
+			network: registry.Network{
+				Name:                  "mock",
+				GenesisForkVersion:    mockNetwork.GenesisForkVersion,
+				GenesisValidatorsRoot: phase0.Root{0x01},
+			},
+			err: "client for network mock has genesis validators root 0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f, expected 0x0100000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "NoGenesisValidatorsRoot",
+			network: registry.Network{
+				Name:               "mock",
+				GenesisForkVersion: mockNetwork.GenesisForkVersion,
+			},
+		},
+		{
+			name:    "Good",
+			network: mockNetwork,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := registry.New(ctx, registry.WithLogLevel(zerolog.Disabled))
+			require.NoError(t, err)
+
+			res, err := s.AddClients(ctx, test.network, []client.Service{mockClient})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Nil(t, s.Client(test.network))
+				require.Empty(t, s.Networks())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, res, s.Client(test.network))
+				require.Equal(t, res, s.ClientByName(test.network.Name))
+				require.Equal(t, []registry.Network{test.network}, s.Networks())
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	s, err := registry.New(ctx, registry.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	otherNetwork := mockNetwork
+	otherNetwork.Name = "other"
+
+	client1, err := s.AddClients(ctx, mockNetwork, []client.Service{mockClient})
+	require.NoError(t, err)
+	client2, err := s.AddClients(ctx, otherNetwork, []client.Service{mockClient})
+	require.NoError(t, err)
+
+	_, err = s.AddClients(ctx, mockNetwork, []client.Service{mockClient})
+	require.EqualError(t, err, "network mock already registered")
+
+	require.Equal(t, []registry.Network{mockNetwork, otherNetwork}, s.Networks())
+	require.Equal(t, client1, s.Client(mockNetwork))
+	require.Equal(t, client2, s.ClientByName("other"))
+	require.Nil(t, s.Client(registry.Mainnet))
+	require.Nil(t, s.ClientByName("mainnet"))
+
+	require.True(t, s.Remove(mockNetwork))
+	require.False(t, s.Remove(mockNetwork))
+	require.Nil(t, s.Client(mockNetwork))
+	require.Equal(t, []registry.Network{otherNetwork}, s.Networks())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -120,6 +121,14 @@ type BeaconBlockBlobsProvider interface {
 	BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb.BlobSidecar, error)
 }
 
+// BlobSidecarsProvider is the interface for providing blob sidecars.
+type BlobSidecarsProvider interface {
+	// BlobSidecars fetches the blob sidecars given a block ID.
+	// indices is a list of blob indices to restrict the returned values.  If no indices are supplied
+	// all blob sidecars for the block are returned.
+	BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error)
+}
+
 // BeaconCommitteesProvider is the interface for providing beacon committees.
 type BeaconCommitteesProvider interface {
 	// BeaconCommittees fetches all beacon committees for the epoch at the given state.
@@ -169,6 +178,24 @@ type AttestationPoolProvider interface {
 	AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phase0.Attestation, error)
 }
 
+// AttestationPoolPageProvider is the interface for providing attestation pools a page at a time.
+type AttestationPoolPageProvider interface {
+	// AttestationPoolPage fetches a single page of the attestation pool for the given slot.
+	AttestationPoolPage(ctx context.Context, slot phase0.Slot, opts *api.PageOpts) (*api.Page[*phase0.Attestation], error)
+}
+
+// AttestationPoolStreamer is the interface for streaming attestation pools.
+type AttestationPoolStreamer interface {
+	// StreamAttestationPool fetches the attestation pool, optionally filtered by slot and
+	// committee index, and passes each attestation to the handler as it is decoded.
+	// If the handler returns an error the stream is stopped and the error returned.
+	StreamAttestationPool(ctx context.Context,
+		slot *phase0.Slot,
+		committeeIndex *phase0.CommitteeIndex,
+		handler func(*phase0.Attestation) error,
+	) error
+}
+
 // AttestationsSubmitter is the interface for submitting attestations.
 type AttestationsSubmitter interface {
 	// SubmitAttestations submits attestations.
@@ -219,6 +246,27 @@ type BLSToExecutionChangesSubmitter interface {
 	SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error
 }
 
+// BlockRewardsProvider is the interface for providing block rewards.
+type BlockRewardsProvider interface {
+	// BlockRewards provides the rewards received by the proposer of a given block ID.
+	BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error)
+}
+
+// AttestationRewardsProvider is the interface for providing attestation rewards.
+type AttestationRewardsProvider interface {
+	// AttestationRewards provides the ideal and actual attestation rewards for the given epoch
+	// and validator indices.  If no indices are supplied rewards are returned for all validators.
+	AttestationRewards(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error)
+}
+
+// SyncCommitteeRewardsProvider is the interface for providing sync committee rewards.
+type SyncCommitteeRewardsProvider interface {
+	// SyncCommitteeRewards provides the rewards received by sync committee members for their
+	// participation in the given block ID.  If no indices are supplied rewards are returned for
+	// all members of the sync committee.
+	SyncCommitteeRewards(ctx context.Context, blockID string, indices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error)
+}
+
 // BeaconBlockHeadersProvider is the interface for providing beacon block headers.
 type BeaconBlockHeadersProvider interface {
 	// BeaconBlockHeader provides the block header of a given block ID.
@@ -243,6 +291,12 @@ type BeaconBlockSubmitter interface {
 	SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error
 }
 
+// BeaconBlockRawSubmitter is the interface for submitting pre-serialized beacon blocks.
+type BeaconBlockRawSubmitter interface {
+	// SubmitBeaconBlockRaw submits a beacon block that has already been serialized.
+	SubmitBeaconBlockRaw(ctx context.Context, block *api.RawSignedBeaconBlock) error
+}
+
 // BeaconCommitteeSubscriptionsSubmitter is the interface for submitting beacon committee subnet subscription requests.
 type BeaconCommitteeSubscriptionsSubmitter interface {
 	// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
@@ -261,6 +315,27 @@ type BeaconStateRandaoProvider interface {
 	BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error)
 }
 
+// PendingDepositsProvider is the interface for providing pending deposits.
+type PendingDepositsProvider interface {
+	// PendingDeposits provides the pending deposits for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	PendingDeposits(ctx context.Context, stateID string) ([]*electra.PendingDeposit, error)
+}
+
+// PendingPartialWithdrawalsProvider is the interface for providing pending partial withdrawals.
+type PendingPartialWithdrawalsProvider interface {
+	// PendingPartialWithdrawals provides the pending partial withdrawals for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	PendingPartialWithdrawals(ctx context.Context, stateID string) ([]*electra.PendingPartialWithdrawal, error)
+}
+
+// PendingConsolidationsProvider is the interface for providing pending consolidations.
+type PendingConsolidationsProvider interface {
+	// PendingConsolidations provides the pending consolidations for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	PendingConsolidations(ctx context.Context, stateID string) ([]*electra.PendingConsolidation, error)
+}
+
 // BeaconStateRootProvider is the interface for providing beacon state roots.
 type BeaconStateRootProvider interface {
 	// BeaconStateRoot fetches a beacon state root given a state ID.
@@ -273,24 +348,71 @@ type BlindedBeaconBlockProposalProvider interface {
 	BlindedBeaconBlockProposal(ctx context.Context, slot phase0.Slot, randaoReveal phase0.BLSSignature, graffiti []byte) (*api.VersionedBlindedBeaconBlock, error)
 }
 
+// ProposalProvider is the interface for providing proposals, which may contain either
+// full or blinded beacon blocks.
+type ProposalProvider interface {
+	// Proposal fetches a proposal for signing.  builderBoostFactor, if supplied, is the
+	// percentage multiplier applied to the builder's payload value when the beacon node
+	// compares it with the local payload value.
+	Proposal(ctx context.Context,
+		slot phase0.Slot,
+		randaoReveal phase0.BLSSignature,
+		graffiti []byte,
+		builderBoostFactor *uint64,
+	) (
+		*api.VersionedProposal,
+		error,
+	)
+}
+
 // BlindedBeaconBlockSubmitter is the interface for submitting blinded beacon blocks.
 type BlindedBeaconBlockSubmitter interface {
 	// SubmitBlindedBeaconBlock submits a beacon block.
 	SubmitBlindedBeaconBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) error
 }
 
+// BlindedBeaconBlockRawSubmitter is the interface for submitting pre-serialized blinded beacon blocks.
+type BlindedBeaconBlockRawSubmitter interface {
+	// SubmitBlindedBeaconBlockRaw submits a blinded beacon block that has already been serialized.
+	SubmitBlindedBeaconBlockRaw(ctx context.Context, block *api.RawSignedBeaconBlock) error
+}
+
 // ValidatorRegistrationsSubmitter is the interface for submitting validator registrations.
 type ValidatorRegistrationsSubmitter interface {
 	// SubmitValidatorRegistrations submits a validator registration.
 	SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error
 }
 
+// BuilderStatusProvider is the interface for providing the status of a builder.
+type BuilderStatusProvider interface {
+	// Status returns an error if the builder is not available.
+	Status(ctx context.Context) error
+}
+
+// BuilderBidProvider is the interface for providing builder bids.
+type BuilderBidProvider interface {
+	// BuilderBid obtains a builder bid for an execution payload, returning nil if there is no bid.
+	BuilderBid(ctx context.Context, slot phase0.Slot, parentHash phase0.Hash32, pubKey phase0.BLSPubKey) (*api.VersionedSignedBuilderBid, error)
+}
+
+// BuilderBlindedBlockSubmitter is the interface for submitting blinded blocks to a builder.
+type BuilderBlindedBlockSubmitter interface {
+	// SubmitBlindedBlock submits a signed blinded block, returning the data that unblinds it.
+	SubmitBlindedBlock(ctx context.Context, block *api.VersionedSignedBlindedBeaconBlock) (*api.VersionedSubmitBlindedBlockResponse, error)
+}
+
 // EventsProvider is the interface for providing events.
 type EventsProvider interface {
 	// Events feeds requested events with the given topics to the supplied handler.
 	Events(ctx context.Context, topics []string, handler EventHandlerFunc) error
 }
 
+// EventTopicsProvider is the interface for providing the event topics supported by a node.
+type EventTopicsProvider interface {
+	// EventTopics returns the event topics supported by the node.
+	EventTopics(ctx context.Context) ([]string, error)
+}
+
 // FinalityProvider is the interface for providing finality information.
 type FinalityProvider interface {
 	// Finality provides the finality given a state ID.
@@ -321,12 +443,75 @@ type GenesisProvider interface {
 	Genesis(ctx context.Context) (*apiv1.Genesis, error)
 }
 
+// LightClientBootstrapProvider is the interface for providing light client bootstraps.
+type LightClientBootstrapProvider interface {
+	// LightClientBootstrap fetches the light client bootstrap for the given trusted block root.
+	LightClientBootstrap(ctx context.Context, blockRoot phase0.Root) (*api.VersionedLightClientBootstrap, error)
+}
+
+// LightClientFinalityUpdateProvider is the interface for providing light client finality updates.
+type LightClientFinalityUpdateProvider interface {
+	// LightClientFinalityUpdate fetches the latest light client finality update.
+	LightClientFinalityUpdate(ctx context.Context) (*api.VersionedLightClientFinalityUpdate, error)
+}
+
+// LightClientOptimisticUpdateProvider is the interface for providing light client optimistic updates.
+type LightClientOptimisticUpdateProvider interface {
+	// LightClientOptimisticUpdate fetches the latest light client optimistic update.
+	LightClientOptimisticUpdate(ctx context.Context) (*api.VersionedLightClientOptimisticUpdate, error)
+}
+
+// LightClientUpdatesProvider is the interface for providing light client updates.
+type LightClientUpdatesProvider interface {
+	// LightClientUpdates fetches light client updates for a range of sync committee periods.
+	LightClientUpdates(ctx context.Context, startPeriod uint64, count uint64) ([]*api.VersionedLightClientUpdate, error)
+}
+
 // NodeSyncingProvider is the interface for providing synchronization state.
 type NodeSyncingProvider interface {
 	// NodeSyncing provides the state of the node's synchronization with the chain.
 	NodeSyncing(ctx context.Context) (*apiv1.SyncState, error)
 }
 
+// PeersProvider is the interface for providing the peers of a node.
+type PeersProvider interface {
+	// Peers fetches the peers of the node.
+	// states and directions restrict the returned peers to those with the given connection
+	// states and directions respectively.  If either is empty no filter is applied for it.
+	Peers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error)
+}
+
+// PeersPageProvider is the interface for providing the peers of a node a page at a time.
+type PeersPageProvider interface {
+	// PeersPage fetches a single page of the peers of the node.
+	// states and directions are as for PeersProvider.
+	PeersPage(ctx context.Context, states []string, directions []string, opts *api.PageOpts) (*api.Page[*apiv1.Peer], error)
+}
+
+// PeerProvider is the interface for providing individual peers of a node.
+type PeerProvider interface {
+	// Peer fetches the peer of the node with the given peer ID.
+	Peer(ctx context.Context, peerID string) (*apiv1.Peer, error)
+}
+
+// PeerCountProvider is the interface for providing the peer count of a node.
+type PeerCountProvider interface {
+	// PeerCount fetches the number of peers of the node in each connection state.
+	PeerCount(ctx context.Context) (*apiv1.PeerCount, error)
+}
+
+// NodeIdentityProvider is the interface for providing the identity of a node.
+type NodeIdentityProvider interface {
+	// NodeIdentity fetches the network identity of the node.
+	NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error)
+}
+
+// OptimisticHeadProvider is the interface for providing the execution status of the head of the chain.
+type OptimisticHeadProvider interface {
+	// IsOptimisticHead returns true if the node's head block has not been fully verified by an execution client.
+	IsOptimisticHead(ctx context.Context) (bool, error)
+}
+
 // ProposalPreparationsSubmitter is the interface for submitting proposal preparations.
 type ProposalPreparationsSubmitter interface {
 	// SubmitProposalPreparations provides the beacon node with information required if a proposal for the given validators
@@ -362,6 +547,16 @@ type ValidatorBalancesProvider interface {
 	ValidatorBalances(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) (map[phase0.ValidatorIndex]phase0.Gwei, error)
 }
 
+// ValidatorIdentitiesProvider is the interface for providing validator identities.
+type ValidatorIdentitiesProvider interface {
+	// ValidatorIdentities provides the identities of validators, being their index, public key and activation epoch,
+	// for a given state.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
+	// will be applied.
+	ValidatorIdentities(ctx context.Context, stateID string, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ValidatorIdentity, error)
+}
+
 // ValidatorsProvider is the interface for providing validator information.
 type ValidatorsProvider interface {
 	// Validators provides the validators, with their balance and status, for a given state.
@@ -377,6 +572,23 @@ type ValidatorsProvider interface {
 	ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []phase0.BLSPubKey) (map[phase0.ValidatorIndex]*apiv1.Validator, error)
 }
 
+// ValidatorsStreamer is the interface for streaming validator information.
+type ValidatorsStreamer interface {
+	// StreamValidators fetches the validators, with their balance and status, for a given state and passes
+	// each validator to the handler as it is decoded, avoiding holding the entire validator set in memory.
+	// stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
+	// validatorIndices is a list of validator indices to restrict the returned values.  If no validators IDs are supplied no filter
+	// will be applied.
+	// If the handler returns an error the stream is stopped and the error returned.
+	// A handler is used rather than an iterator as this module supports versions of Go that
+	// predate range-over-func iterators.
+	StreamValidators(ctx context.Context,
+		stateID string,
+		validatorIndices []phase0.ValidatorIndex,
+		handler func(*apiv1.Validator) error,
+	) error
+}
+
 // VoluntaryExitSubmitter is the interface for submitting voluntary exits.
 type VoluntaryExitSubmitter interface {
 	// SubmitVoluntaryExit submits a voluntary exit.
@@ -399,6 +611,13 @@ type DomainProvider interface {
 	GenesisDomain(ctx context.Context, domainType phase0.DomainType) (phase0.Domain, error)
 }
 
+// ExitEstimateProvider is the interface for estimating validator exit and withdrawal times.
+type ExitEstimateProvider interface {
+	// EstimateExit estimates the exit and withdrawable epochs, and their associated times,
+	// for a validator if it were to request a voluntary exit now.
+	EstimateExit(ctx context.Context, validatorIndex phase0.ValidatorIndex) (*apiv1.ExitEstimate, error)
+}
+
 // GenesisTimeProvider is the interface for providing the genesis time of a chain.
 type GenesisTimeProvider interface {
 	// GenesisTime provides the genesis time of the chain.
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: ba9b356aff4a24dda390869ac57d7a5f468e6503c345bf05c56070c157ecc869
+
+//go:build !minimal
+
 package altair
 
 import (
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 953ae01d4bf26a992e19acb5e535e3fb3d95948158dcfcb53c0089f1dd2072d1
+
+//go:build !minimal
+
 package altair
 
 import (
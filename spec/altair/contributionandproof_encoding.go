@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 9c968bb86bf1d41bd4f61d50c7b53c9cd70c7c12b4edd341fba927fa5288d410
+
+//go:build !minimal
+
 package altair
 
 import (
@@ -0,0 +1,26 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// Domain types introduced in altair.
+var (
+	// DomainSyncCommittee is the domain type for sync committee messages.
+	DomainSyncCommittee = phase0.DomainType{0x07, 0x00, 0x00, 0x00}
+	// DomainSyncCommitteeSelectionProof is the domain type for sync committee aggregator selection proofs.
+	DomainSyncCommitteeSelectionProof = phase0.DomainType{0x08, 0x00, 0x00, 0x00}
+	// DomainContributionAndProof is the domain type for sync committee contribution and proofs.
+	DomainContributionAndProof = phase0.DomainType{0x09, 0x00, 0x00, 0x00}
+)
@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+)
+
+func FuzzBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &altair.BeaconBlock{})
+}
+
+func FuzzBeaconBlockBody(f *testing.F) {
+	codectest.Fuzz(f, &altair.BeaconBlockBody{})
+}
+
+func FuzzBeaconState(f *testing.F) {
+	codectest.Fuzz(f, &altair.BeaconState{})
+}
+
+func FuzzContributionAndProof(f *testing.F) {
+	codectest.Fuzz(f, &altair.ContributionAndProof{})
+}
+
+func FuzzSignedBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &altair.SignedBeaconBlock{})
+}
+
+func FuzzSignedContributionAndProof(f *testing.F) {
+	codectest.Fuzz(f, &altair.SignedContributionAndProof{})
+}
+
+func FuzzSyncAggregate(f *testing.F) {
+	codectest.Fuzz(f, &altair.SyncAggregate{})
+}
+
+func FuzzSyncAggregatorSelectionData(f *testing.F) {
+	codectest.Fuzz(f, &altair.SyncAggregatorSelectionData{})
+}
+
+func FuzzSyncCommittee(f *testing.F) {
+	codectest.Fuzz(f, &altair.SyncCommittee{})
+}
+
+func FuzzSyncCommitteeContribution(f *testing.F) {
+	codectest.Fuzz(f, &altair.SyncCommitteeContribution{})
+}
+
+func FuzzSyncCommitteeMessage(f *testing.F) {
+	codectest.Fuzz(f, &altair.SyncCommitteeMessage{})
+}
@@ -14,6 +14,11 @@
 package altair
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
+// Types whose encoding depends on the preset also have minimal preset encodings, selected with the minimal build tag.
 //go:generate rm -f beaconblock_encoding.go beaconblockbody_encoding.go beaconstate_encoding.go contributionandproof_encoding.go signedbeaconblock_encoding.go signedcontributionandproof_encoding.go syncaggregate_encoding.go syncaggregatorselectiondata_encoding.go synccommitteemessage_encoding.go
-//go:generate sszgen ../phase0 --path . --objs BeaconBlock,BeaconBlockBody,BeaconState,ContributionAndProof,SignedBeaconBlock,SignedContributionAndProof,SyncAggregate,SyncAggregatorSelectionData,SyncCommittee
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --include ../phase0 --path . --objs BeaconBlock,SignedBeaconBlock,SyncAggregatorSelectionData
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --build-tag=!minimal --include ../phase0 --path . --objs BeaconBlockBody,BeaconState,ContributionAndProof,SignedContributionAndProof,SyncAggregate,SyncCommittee,SyncCommitteeContribution
+//go:generate rm -f beaconblockbody_encoding_minimal.go beaconstate_encoding_minimal.go contributionandproof_encoding_minimal.go signedcontributionandproof_encoding_minimal.go syncaggregate_encoding_minimal.go synccommittee_encoding_minimal.go synccommitteecontribution_encoding_minimal.go
+//go:generate go run ../../cmd/presetsszgen --preset=minimal --build-tag=minimal --suffix=encoding_minimal --path . --include ../phase0 --objs BeaconBlockBody,BeaconState,ContributionAndProof,SignedContributionAndProof,SyncAggregate,SyncCommittee,SyncCommitteeContribution
 //go:generate goimports -w beaconblock_encoding.go beaconblockbody_encoding.go beaconstate_encoding.go contributionandproof_encoding.go signedbeaconblock_encoding.go signedcontributionandproof_encoding.go syncaggregate_encoding.go syncaggregatorselectiondata_encoding.go synccommitteemessage_encoding.go
@@ -0,0 +1,43 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !minimal
+
+package altair_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+)
+
+// TestGolden tests the types against the golden files in testdata/golden.
+// The golden files are encoded with the mainnet preset, so this test is not built
+// with the minimal preset.
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		s    codectest.Container
+	}{
+		{
+			name: "SyncAggregate",
+			s:    &altair.SyncAggregate{},
+		},
+	}
+
+	for _, test := range tests {
+		codectest.Golden(t, filepath.Join("testdata", "golden", test.name), test.s)
+	}
+}
@@ -24,6 +24,35 @@ import (
 // ParticipationFlags are validator participation flags in an epoch.
 type ParticipationFlags uint8
 
+// Incentivization weights, in units of WeightDenominator.
+const (
+	// TimelySourceWeight is the reward weight for a timely source vote.
+	TimelySourceWeight = 14
+	// TimelyTargetWeight is the reward weight for a timely target vote.
+	TimelyTargetWeight = 26
+	// TimelyHeadWeight is the reward weight for a timely head vote.
+	TimelyHeadWeight = 14
+	// SyncRewardWeight is the reward weight for sync committee participation.
+	SyncRewardWeight = 2
+	// ProposerWeight is the reward weight for block proposals.
+	ProposerWeight = 8
+	// WeightDenominator is the denominator for all reward weights.
+	WeightDenominator = 64
+)
+
+// ParticipationFlagWeights are the reward weights for each participation flag, indexed by flag.
+var ParticipationFlagWeights = []uint64{TimelySourceWeight, TimelyTargetWeight, TimelyHeadWeight}
+
+// HasFlag returns true if the given flag is set.
+func (p ParticipationFlags) HasFlag(flag ParticipationFlag) bool {
+	return p&(1<<flag) != 0
+}
+
+// AddFlag returns the participation flags with the given flag set.
+func (p ParticipationFlags) AddFlag(flag ParticipationFlag) ParticipationFlags {
+	return p | (1 << flag)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *ParticipationFlags) UnmarshalJSON(input []byte) error {
 	if len(input) == 0 {
@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package altair_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParticipationFlags(t *testing.T) {
+	tests := []struct {
+		name   string
+		flags  altair.ParticipationFlags
+		source bool
+		target bool
+		head   bool
+	}{
+		{
+			name: "None",
+		},
+		{
+			name:   "Source",
+			flags:  0x01,
+			source: true,
+		},
+		{
+			name:   "TargetHead",
+			flags:  0x06,
+			target: true,
+			head:   true,
+		},
+		{
+			name:   "All",
+			flags:  0x07,
+			source: true,
+			target: true,
+			head:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.source, test.flags.HasFlag(altair.TimelySourceFlagIndex))
+			require.Equal(t, test.target, test.flags.HasFlag(altair.TimelyTargetFlagIndex))
+			require.Equal(t, test.head, test.flags.HasFlag(altair.TimelyHeadFlagIndex))
+		})
+	}
+}
+
+func TestParticipationFlagsAddFlag(t *testing.T) {
+	var flags altair.ParticipationFlags
+	flags = flags.AddFlag(altair.TimelyTargetFlagIndex)
+	require.Equal(t, altair.ParticipationFlags(0x02), flags)
+	flags = flags.AddFlag(altair.TimelyTargetFlagIndex)
+	require.Equal(t, altair.ParticipationFlags(0x02), flags)
+	flags = flags.AddFlag(altair.TimelyHeadFlagIndex)
+	require.Equal(t, altair.ParticipationFlags(0x06), flags)
+}
+
+func TestParticipationFlagWeights(t *testing.T) {
+	total := uint64(altair.SyncRewardWeight + altair.ProposerWeight)
+	for _, weight := range altair.ParticipationFlagWeights {
+		total += weight
+	}
+	require.Equal(t, uint64(altair.WeightDenominator), total)
+}
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: d0baec90cef323eb3a15918e27a03e2658dcf0a761d5ff0a671eb5233999c29d
+
+//go:build !minimal
+
 package altair
 
 import (
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: f17b226221728909ff45d6a1c791be2b940474119aa3f6d1c04316235e2eef18
+
+//go:build !minimal
+
 package altair
 
 import (
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 223c284bddd68dfd58b388be4adf0ef3f1f81c4df169977c1aa2e6d548af2f01
+
+//go:build !minimal
+
 package altair
 
 import (
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 29e1a28a877a613dfb7b84c72471b1e26d6f7f159bb6d1de6a7a3a2ef499aa7a
+
+//go:build !minimal
+
 package altair
 
 import (
@@ -0,0 +1,45 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Generalized indices of execution payload fields, relative to the beacon block root.
+// Leaves for integer fields hold the little-endian value; leaves for variable-length
+// fields hold the hash tree root of the field.
+const (
+	GeneralizedIndexExecutionPayload              = uint64(201)
+	GeneralizedIndexExecutionPayloadParentHash    = uint64(3216)
+	GeneralizedIndexExecutionPayloadFeeRecipient  = uint64(3217)
+	GeneralizedIndexExecutionPayloadStateRoot     = uint64(3218)
+	GeneralizedIndexExecutionPayloadReceiptsRoot  = uint64(3219)
+	GeneralizedIndexExecutionPayloadLogsBloom     = uint64(3220)
+	GeneralizedIndexExecutionPayloadPrevRandao    = uint64(3221)
+	GeneralizedIndexExecutionPayloadBlockNumber   = uint64(3222)
+	GeneralizedIndexExecutionPayloadGasLimit      = uint64(3223)
+	GeneralizedIndexExecutionPayloadGasUsed       = uint64(3224)
+	GeneralizedIndexExecutionPayloadTimestamp     = uint64(3225)
+	GeneralizedIndexExecutionPayloadExtraData     = uint64(3226)
+	GeneralizedIndexExecutionPayloadBaseFeePerGas = uint64(3227)
+	GeneralizedIndexExecutionPayloadBlockHash     = uint64(3228)
+	GeneralizedIndexExecutionPayloadTransactions  = uint64(3229)
+)
+
+// Prove generates a proof for the given generalized index of the beacon block.
+func (b *BeaconBlock) Prove(generalizedIndex uint64) (*ssz.Proof, error) {
+	return phase0.ProveGeneralizedIndex(b, generalizedIndex)
+}
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: d18c5969acfbbbf478660a133f7c233288cd3f5f3a9e25b744295118e6d1c0aa
+
+//go:build !minimal
+
 package bellatrix
 
 import (
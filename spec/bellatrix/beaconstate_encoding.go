@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 3fdd5c5ff5c2e0e3e59c8a72be771c4d964b8c6d4716aac215372db3430a4eed
+
+//go:build !minimal
+
 package bellatrix
 
 import (
@@ -0,0 +1,79 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/internal/sszfield"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// StateField is a top-level field of the beacon state.
+type StateField int
+
+// Fields of the beacon state, in the order in which they are encoded.
+const (
+	StateFieldGenesisTime StateField = iota
+	StateFieldGenesisValidatorsRoot
+	StateFieldSlot
+	StateFieldFork
+	StateFieldLatestBlockHeader
+	StateFieldBlockRoots
+	StateFieldStateRoots
+	StateFieldHistoricalRoots
+	StateFieldETH1Data
+	StateFieldETH1DataVotes
+	StateFieldETH1DepositIndex
+	StateFieldValidators
+	StateFieldBalances
+	StateFieldRANDAOMixes
+	StateFieldSlashings
+	StateFieldPreviousEpochParticipation
+	StateFieldCurrentEpochParticipation
+	StateFieldJustificationBits
+	StateFieldPreviousJustifiedCheckpoint
+	StateFieldCurrentJustifiedCheckpoint
+	StateFieldFinalizedCheckpoint
+	StateFieldInactivityScores
+	StateFieldCurrentSyncCommittee
+	StateFieldNextSyncCommittee
+	StateFieldLatestExecutionPayloadHeader
+)
+
+// ExtractStateField returns the SSZ encoding of a single field of an SSZ-encoded beacon state,
+// without decoding the rest of the state.  The returned slice references buf.
+func ExtractStateField(buf []byte, field StateField) ([]byte, error) {
+	return sszfield.Extract(buf, stateFixedSize, stateFields, int(field))
+}
+
+// ExtractBalances returns the validator balances from an SSZ-encoded beacon state,
+// without decoding the rest of the state.
+func ExtractBalances(buf []byte) ([]phase0.Gwei, error) {
+	data, err := ExtractStateField(buf, StateFieldBalances)
+	if err != nil {
+		return nil, err
+	}
+	if len(data)%8 != 0 {
+		return nil, errors.New("invalid length for balances")
+	}
+
+	balances := make([]phase0.Gwei, len(data)/8)
+	for i := range balances {
+		balances[i] = phase0.Gwei(binary.LittleEndian.Uint64(data[i*8 : (i+1)*8]))
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestExtractStateField(t *testing.T) {
+	state := testBeaconState(10)
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	slot, err := bellatrix.ExtractStateField(data, bellatrix.StateFieldSlot)
+	require.NoError(t, err)
+	require.Equal(t, uint64(state.Slot), binary.LittleEndian.Uint64(slot))
+
+	validators, err := bellatrix.ExtractStateField(data, bellatrix.StateFieldValidators)
+	require.NoError(t, err)
+	require.Len(t, validators, 10*121)
+	expected, err := state.Validators[0].MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, validators[:121])
+
+	header, err := bellatrix.ExtractStateField(data, bellatrix.StateFieldLatestExecutionPayloadHeader)
+	require.NoError(t, err)
+	expected, err = state.LatestExecutionPayloadHeader.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, header)
+
+	balances, err := bellatrix.ExtractBalances(data)
+	require.NoError(t, err)
+	require.Equal(t, state.Balances, balances)
+
+	_, err = bellatrix.ExtractStateField(data, bellatrix.StateField(-1))
+	require.EqualError(t, err, "unknown field -1")
+	_, err = bellatrix.ExtractBalances(data[:1000])
+	require.ErrorContains(t, err, "data too short")
+}
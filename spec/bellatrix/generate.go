@@ -14,6 +14,11 @@
 package bellatrix
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
+// Types whose encoding depends on the preset also have minimal preset encodings, selected with the minimal build tag.
 //go:generate rm -f beaconblock_encoding.go beaconblockbody_encoding.go beaconstate_encoding.go executionpayload_encoding.go executionpayloadheader_encoding.go signedbeaconblock_encoding.go
-//go:generate sszgen --path . --objs BeaconBlock,BeaconBlockBody,BeaconState,ExecutionPayload,ExecutionPaylodHeader,SignedBeaconBlock
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --path . --objs BeaconBlock,ExecutionPayload,ExecutionPaylodHeader,SignedBeaconBlock
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --build-tag=!minimal --path . --objs BeaconBlockBody,BeaconState
+//go:generate rm -f beaconblockbody_encoding_minimal.go beaconstate_encoding_minimal.go
+//go:generate go run ../../cmd/presetsszgen --preset=minimal --build-tag=minimal --suffix=encoding_minimal --path . --objs BeaconBlockBody,BeaconState
 //go:generate goimports -w beaconblock_encoding.go beaconblockbody_encoding.go beaconstate_encoding.go executionpayload_encoding.go executionpayloadheader_encoding.go signedbeaconblock_encoding.go
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 70e995ecda118d2cea952b1a831e6cbc52b782de576b7b8f2b03b944e5710204
+
+//go:build !minimal
+
 package capella
 
 import (
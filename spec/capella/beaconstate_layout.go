@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !minimal
+
+package capella
+
+import "github.com/attestantio/go-eth2-client/spec/internal/sszfield"
+
+// stateFixedSize is the size of the fixed part of the encoded beacon state in the mainnet preset.
+const stateFixedSize = 2736653
+
+// stateFields are the locations of the fields in the fixed part of the encoded beacon state.
+var stateFields = []sszfield.Field{
+	StateFieldGenesisTime:                  {Start: 0, End: 8},
+	StateFieldGenesisValidatorsRoot:        {Start: 8, End: 40},
+	StateFieldSlot:                         {Start: 40, End: 48},
+	StateFieldFork:                         {Start: 48, End: 64},
+	StateFieldLatestBlockHeader:            {Start: 64, End: 176},
+	StateFieldBlockRoots:                   {Start: 176, End: 262320},
+	StateFieldStateRoots:                   {Start: 262320, End: 524464},
+	StateFieldHistoricalRoots:              {Start: 524464, End: 524468, Variable: true},
+	StateFieldETH1Data:                     {Start: 524468, End: 524540},
+	StateFieldETH1DataVotes:                {Start: 524540, End: 524544, Variable: true},
+	StateFieldETH1DepositIndex:             {Start: 524544, End: 524552},
+	StateFieldValidators:                   {Start: 524552, End: 524556, Variable: true},
+	StateFieldBalances:                     {Start: 524556, End: 524560, Variable: true},
+	StateFieldRANDAOMixes:                  {Start: 524560, End: 2621712},
+	StateFieldSlashings:                    {Start: 2621712, End: 2687248},
+	StateFieldPreviousEpochParticipation:   {Start: 2687248, End: 2687252, Variable: true},
+	StateFieldCurrentEpochParticipation:    {Start: 2687252, End: 2687256, Variable: true},
+	StateFieldJustificationBits:            {Start: 2687256, End: 2687257},
+	StateFieldPreviousJustifiedCheckpoint:  {Start: 2687257, End: 2687297},
+	StateFieldCurrentJustifiedCheckpoint:   {Start: 2687297, End: 2687337},
+	StateFieldFinalizedCheckpoint:          {Start: 2687337, End: 2687377},
+	StateFieldInactivityScores:             {Start: 2687377, End: 2687381, Variable: true},
+	StateFieldCurrentSyncCommittee:         {Start: 2687381, End: 2712005},
+	StateFieldNextSyncCommittee:            {Start: 2712005, End: 2736629},
+	StateFieldLatestExecutionPayloadHeader: {Start: 2736629, End: 2736633, Variable: true},
+	StateFieldNextWithdrawalIndex:          {Start: 2736633, End: 2736641},
+	StateFieldNextWithdrawalValidatorIndex: {Start: 2736641, End: 2736649},
+	StateFieldHistoricalSummaries:          {Start: 2736649, End: 2736653, Variable: true},
+}
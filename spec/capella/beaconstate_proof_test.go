@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestProve(t *testing.T) {
+	state := testBeaconState(10)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		generalizedIndex uint64
+	}{
+		{
+			name:             "Slot",
+			generalizedIndex: phase0.GeneralizedIndexSlot,
+		},
+		{
+			name:             "ValidatorPubKey",
+			generalizedIndex: phase0.ValidatorPubKeyGeneralizedIndex(3),
+		},
+		{
+			name:             "ValidatorWithdrawalCredentials",
+			generalizedIndex: phase0.ValidatorWithdrawalCredentialsGeneralizedIndex(9),
+		},
+		{
+			name:             "Balance",
+			generalizedIndex: phase0.BalanceGeneralizedIndex(6),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proof, err := state.Prove(test.generalizedIndex)
+			require.NoError(t, err)
+			require.Equal(t, int(test.generalizedIndex), proof.Index)
+			valid, err := ssz.VerifyProof(root[:], proof)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
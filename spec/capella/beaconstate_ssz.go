@@ -1,6 +1,9 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 1d3f1d08772acfc74e6f425ba169c073ce1426b49fe20892e24b06981e78812e
 // Version: 0.1.3-dev
+
+//go:build !minimal
+
 package capella
 
 import (
@@ -0,0 +1,96 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella
+
+import (
+	"io"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/internal/sszstream"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// UnmarshalSSZFrom decodes the state from an SSZ-encoded stream.
+// The large per-validator lists are decoded as they are read, so unlike UnmarshalSSZ
+// the encoded state does not need to be held in memory alongside the decoded state.
+func (b *BeaconState) UnmarshalSSZFrom(r io.Reader) error {
+	var validators []*phase0.Validator
+	var balances []phase0.Gwei
+	var previousEpochParticipation []altair.ParticipationFlags
+	var currentEpochParticipation []altair.ParticipationFlags
+	var inactivityScores []uint64
+
+	fields := []*sszstream.Field{
+		{Offset: stateFields[StateFieldHistoricalRoots].Start},
+		{Offset: stateFields[StateFieldETH1DataVotes].Start},
+		{
+			Offset: stateFields[StateFieldValidators].Start,
+			Decode: func(r io.Reader, length uint64) error {
+				var err error
+				validators, err = sszstream.DecodeContainers[phase0.Validator](r, length, 121, 1099511627776)
+
+				return err
+			},
+		},
+		{
+			Offset: stateFields[StateFieldBalances].Start,
+			Decode: func(r io.Reader, length uint64) error {
+				var err error
+				balances, err = sszstream.DecodeUint64s[phase0.Gwei](r, length, 1099511627776)
+
+				return err
+			},
+		},
+		{
+			Offset: stateFields[StateFieldPreviousEpochParticipation].Start,
+			Decode: func(r io.Reader, length uint64) error {
+				var err error
+				previousEpochParticipation, err = sszstream.DecodeUint8s[altair.ParticipationFlags](r, length, 1099511627776)
+
+				return err
+			},
+		},
+		{
+			Offset: stateFields[StateFieldCurrentEpochParticipation].Start,
+			Decode: func(r io.Reader, length uint64) error {
+				var err error
+				currentEpochParticipation, err = sszstream.DecodeUint8s[altair.ParticipationFlags](r, length, 1099511627776)
+
+				return err
+			},
+		},
+		{
+			Offset: stateFields[StateFieldInactivityScores].Start,
+			Decode: func(r io.Reader, length uint64) error {
+				var err error
+				inactivityScores, err = sszstream.DecodeUint64s[uint64](r, length, 1099511627776)
+
+				return err
+			},
+		},
+		{Offset: stateFields[StateFieldLatestExecutionPayloadHeader].Start},
+		{Offset: stateFields[StateFieldHistoricalSummaries].Start},
+	}
+
+	if err := sszstream.Unmarshal(r, stateFixedSize, fields, b.UnmarshalSSZ); err != nil {
+		return err
+	}
+	b.Validators = validators
+	b.Balances = balances
+	b.PreviousEpochParticipation = previousEpochParticipation
+	b.CurrentEpochParticipation = currentEpochParticipation
+	b.InactivityScores = inactivityScores
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func testBeaconState(validators int) *capella.BeaconState {
+	state := &capella.BeaconState{
+		GenesisTime:                  1606824023,
+		Slot:                         123456,
+		BlockRoots:                   make([]phase0.Root, slotsPerHistoricalRoot),
+		StateRoots:                   make([]phase0.Root, slotsPerHistoricalRoot),
+		HistoricalRoots:              []phase0.Root{{0x01}, {0x02}},
+		ETH1DataVotes:                []*phase0.ETH1Data{{BlockHash: make([]byte, 32)}},
+		ETH1Data:                     &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		RANDAOMixes:                  make([]phase0.Root, epochsPerHistoricalVector),
+		Slashings:                    make([]phase0.Gwei, epochsPerSlashingsVector),
+		JustificationBits:            []byte{0x03},
+		CurrentSyncCommittee:         &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, syncCommitteeSize)},
+		NextSyncCommittee:            &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, syncCommitteeSize)},
+		LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{ExtraData: []byte{0x01}},
+		HistoricalSummaries:          []*capella.HistoricalSummary{{BlockSummaryRoot: phase0.Root{0x04}}},
+	}
+	state.RANDAOMixes[1] = phase0.Root{0x03}
+	for i := 0; i < validators; i++ {
+		state.Validators = append(state.Validators, &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             0xffffffffffffffff,
+		})
+		state.Balances = append(state.Balances, phase0.Gwei(32000000000+i))
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 0x07)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, altair.ParticipationFlags(i%8))
+		state.InactivityScores = append(state.InactivityScores, uint64(i%3))
+	}
+
+	return state
+}
+
+func TestBeaconStateUnmarshalSSZFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		validators int
+	}{
+		{
+			name:       "Empty",
+			validators: 0,
+		},
+		{
+			name:       "Single",
+			validators: 1,
+		},
+		{
+			// Enough validators that lists span multiple read buffers.
+			name:       "Large",
+			validators: 100000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := testBeaconState(test.validators).MarshalSSZ()
+			require.NoError(t, err)
+
+			expected := &capella.BeaconState{}
+			require.NoError(t, expected.UnmarshalSSZ(data))
+
+			state := &capella.BeaconState{}
+			require.NoError(t, state.UnmarshalSSZFrom(bytes.NewReader(data)))
+			require.Equal(t, expected, state)
+		})
+	}
+}
+
+func TestBeaconStateUnmarshalSSZFromTruncated(t *testing.T) {
+	data, err := testBeaconState(10).MarshalSSZ()
+	require.NoError(t, err)
+
+	state := &capella.BeaconState{}
+	err = state.UnmarshalSSZFrom(bytes.NewReader(data[:1000]))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// Truncated within the validators.
+	state = &capella.BeaconState{}
+	err = state.UnmarshalSSZFrom(bytes.NewReader(data[:len(data)-1000]))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
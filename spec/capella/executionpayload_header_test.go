@@ -0,0 +1,133 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	require "github.com/stretchr/testify/require"
+)
+
+func testExecutionPayload(transactions int, withdrawals int) *capella.ExecutionPayload {
+	payload := &capella.ExecutionPayload{
+		BlockNumber:  12345,
+		GasLimit:     30000000,
+		GasUsed:      21000,
+		Timestamp:    1681338455,
+		ExtraData:    []byte("extra"),
+		Transactions: make([]bellatrix.Transaction, transactions),
+		Withdrawals:  make([]*capella.Withdrawal, withdrawals),
+	}
+	payload.ParentHash[0] = 0x01
+	payload.BlockHash[0] = 0x02
+	payload.BaseFeePerGas[0] = 0x07
+	for i := range payload.Transactions {
+		payload.Transactions[i] = make([]byte, 100+i*50)
+		payload.Transactions[i][0] = byte(i)
+	}
+	for i := range payload.Withdrawals {
+		payload.Withdrawals[i] = &capella.Withdrawal{
+			Index:  capella.WithdrawalIndex(i),
+			Amount: 1000,
+		}
+	}
+
+	return payload
+}
+
+func TestHeaderFromPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload *capella.ExecutionPayload
+		err     string
+	}{
+		{
+			name: "Nil",
+			err:  "no payload",
+		},
+		{
+			name:    "Empty",
+			payload: testExecutionPayload(0, 0),
+		},
+		{
+			name:    "Full",
+			payload: testExecutionPayload(20, maxWithdrawalsPerPayload),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header, err := capella.HeaderFromPayload(test.payload)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			headerRoot, err := header.HashTreeRoot()
+			require.NoError(t, err)
+			payloadRoot, err := test.payload.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, payloadRoot, headerRoot)
+
+			matches, err := header.MatchesPayload(test.payload)
+			require.NoError(t, err)
+			require.True(t, matches)
+		})
+	}
+}
+
+func TestMatchesPayload(t *testing.T) {
+	header, err := capella.HeaderFromPayload(testExecutionPayload(5, 4))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		payload *capella.ExecutionPayload
+		matches bool
+		err     string
+	}{
+		{
+			name: "Nil",
+			err:  "no payload",
+		},
+		{
+			name:    "Matching",
+			payload: testExecutionPayload(5, 4),
+			matches: true,
+		},
+		{
+			name:    "ExtraTransaction",
+			payload: testExecutionPayload(6, 4),
+		},
+		{
+			name:    "MissingWithdrawal",
+			payload: testExecutionPayload(5, 3),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matches, err := header.MatchesPayload(test.payload)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.matches, matches)
+		})
+	}
+}
@@ -1,5 +1,8 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 2b49f559ef4b9bb9317dfb0f1c358a675fdd2f42e744dbbe65b6511c0c2cfe3a
+
+//go:build !minimal
+
 package capella
 
 import (
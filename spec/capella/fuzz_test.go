@@ -0,0 +1,61 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+func FuzzBLSToExecutionChange(f *testing.F) {
+	codectest.Fuzz(f, &capella.BLSToExecutionChange{})
+}
+
+func FuzzBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &capella.BeaconBlock{})
+}
+
+func FuzzBeaconBlockBody(f *testing.F) {
+	codectest.Fuzz(f, &capella.BeaconBlockBody{})
+}
+
+func FuzzBeaconState(f *testing.F) {
+	codectest.Fuzz(f, &capella.BeaconState{})
+}
+
+func FuzzExecutionPayload(f *testing.F) {
+	codectest.Fuzz(f, &capella.ExecutionPayload{})
+}
+
+func FuzzExecutionPayloadHeader(f *testing.F) {
+	codectest.Fuzz(f, &capella.ExecutionPayloadHeader{})
+}
+
+func FuzzHistoricalSummary(f *testing.F) {
+	codectest.Fuzz(f, &capella.HistoricalSummary{})
+}
+
+func FuzzSignedBLSToExecutionChange(f *testing.F) {
+	codectest.Fuzz(f, &capella.SignedBLSToExecutionChange{})
+}
+
+func FuzzSignedBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &capella.SignedBeaconBlock{})
+}
+
+func FuzzWithdrawal(f *testing.F) {
+	codectest.Fuzz(f, &capella.Withdrawal{})
+}
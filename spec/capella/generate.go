@@ -14,6 +14,11 @@
 package capella
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
+// Types whose encoding depends on the preset also have minimal preset encodings, selected with the minimal build tag.
 //go:generate rm -f blstoexecutionchange_encoding.go signedblstoexecutionchange_encoding.go withdrawal.go
-//go:generate sszgen --path . --objs BLSToExecutionChange SignedBLSToExecutionChange Withdrawal
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --path . --objs BLSToExecutionChange SignedBLSToExecutionChange Withdrawal
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --build-tag=!minimal --suffix=ssz --path . --include ../phase0,../altair,../bellatrix --objs BeaconBlockBody,BeaconState,ExecutionPayload
+//go:generate rm -f beaconblockbody_ssz_minimal.go beaconstate_ssz_minimal.go executionpayload_ssz_minimal.go
+//go:generate go run ../../cmd/presetsszgen --preset=minimal --build-tag=minimal --suffix=ssz_minimal --path . --include ../phase0,../altair,../bellatrix --objs BeaconBlockBody,BeaconState,ExecutionPayload
 //go:generate goimports -w blstoexecutionchange_encoding.go signedblstoexecutionchange_encoding.go withdrawal_encoding.go
@@ -0,0 +1,39 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capella_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+)
+
+// TestGolden tests the types against the golden files in testdata/golden.
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		s    codectest.Container
+	}{
+		{
+			name: "Withdrawal",
+			s:    &capella.Withdrawal{},
+		},
+	}
+
+	for _, test := range tests {
+		codectest.Golden(t, filepath.Join("testdata", "golden", test.name), test.s)
+	}
+}
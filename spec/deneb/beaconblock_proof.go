@@ -0,0 +1,48 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Generalized indices of execution payload fields, relative to the beacon block root.
+// Leaves for integer fields hold the little-endian value; leaves for variable-length
+// fields hold the hash tree root of the field.
+const (
+	GeneralizedIndexExecutionPayload              = uint64(201)
+	GeneralizedIndexExecutionPayloadParentHash    = uint64(6432)
+	GeneralizedIndexExecutionPayloadFeeRecipient  = uint64(6433)
+	GeneralizedIndexExecutionPayloadStateRoot     = uint64(6434)
+	GeneralizedIndexExecutionPayloadReceiptsRoot  = uint64(6435)
+	GeneralizedIndexExecutionPayloadLogsBloom     = uint64(6436)
+	GeneralizedIndexExecutionPayloadPrevRandao    = uint64(6437)
+	GeneralizedIndexExecutionPayloadBlockNumber   = uint64(6438)
+	GeneralizedIndexExecutionPayloadGasLimit      = uint64(6439)
+	GeneralizedIndexExecutionPayloadGasUsed       = uint64(6440)
+	GeneralizedIndexExecutionPayloadTimestamp     = uint64(6441)
+	GeneralizedIndexExecutionPayloadExtraData     = uint64(6442)
+	GeneralizedIndexExecutionPayloadBaseFeePerGas = uint64(6443)
+	GeneralizedIndexExecutionPayloadBlockHash     = uint64(6444)
+	GeneralizedIndexExecutionPayloadTransactions  = uint64(6445)
+	GeneralizedIndexExecutionPayloadWithdrawals   = uint64(6446)
+	GeneralizedIndexExecutionPayloadBlobGasUsed   = uint64(6447)
+	GeneralizedIndexExecutionPayloadExcessBlobGas = uint64(6448)
+)
+
+// Prove generates a proof for the given generalized index of the beacon block.
+func (b *BeaconBlock) Prove(generalizedIndex uint64) (*ssz.Proof, error) {
+	return phase0.ProveGeneralizedIndex(b, generalizedIndex)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockProve(t *testing.T) {
+	payload := testExecutionPayload(3, 2)
+	block := &deneb.BeaconBlock{
+		Slot:          100,
+		ProposerIndex: 5,
+		Body: &deneb.BeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: make(bitfield.Bitvector512, syncCommitteeSize/8),
+			},
+			ExecutionPayload: payload,
+		},
+	}
+	root, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	blockNumber := make([]byte, 32)
+	binary.LittleEndian.PutUint64(blockNumber, payload.BlockNumber)
+	feeRecipient := make([]byte, 32)
+	copy(feeRecipient, payload.FeeRecipient[:])
+
+	tests := []struct {
+		name             string
+		generalizedIndex uint64
+		leaf             []byte
+	}{
+		{
+			name:             "ExecutionPayload",
+			generalizedIndex: deneb.GeneralizedIndexExecutionPayload,
+			leaf:             payloadRoot[:],
+		},
+		{
+			name:             "BlockNumber",
+			generalizedIndex: deneb.GeneralizedIndexExecutionPayloadBlockNumber,
+			leaf:             blockNumber,
+		},
+		{
+			name:             "BlockHash",
+			generalizedIndex: deneb.GeneralizedIndexExecutionPayloadBlockHash,
+			leaf:             payload.BlockHash[:],
+		},
+		{
+			name:             "FeeRecipient",
+			generalizedIndex: deneb.GeneralizedIndexExecutionPayloadFeeRecipient,
+			leaf:             feeRecipient,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proof, err := block.Prove(test.generalizedIndex)
+			require.NoError(t, err)
+			require.Equal(t, int(test.generalizedIndex), proof.Index)
+			require.Equal(t, test.leaf, proof.Leaf)
+			valid, err := ssz.VerifyProof(root[:], proof)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
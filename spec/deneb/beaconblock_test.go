@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/attestantio/go-eth2-client/codectest"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/assert"
@@ -168,8 +169,8 @@ func TestBeaconBlockYAML(t *testing.T) {
 				require.NoError(t, err)
 				rt, err := yaml.Marshal(&res)
 				require.NoError(t, err)
-				assert.Equal(t, testYAMLFormat([]byte(res.String())), testYAMLFormat(rt))
-				assert.Equal(t, testYAMLFormat(test.input), testYAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat([]byte(res.String())), codectest.YAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat(test.input), codectest.YAMLFormat(rt))
 			}
 		})
 	}
@@ -122,7 +122,13 @@ func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "sync_aggregate")
 	}
 
-	if err := json.Unmarshal(raw["execution_payload"], &b.ExecutionPayload); err != nil {
+	if codecs.FastJSON() && !bytes.Equal(raw["execution_payload"], []byte("null")) {
+		// Decode directly, avoiding encoding/json scanning the large payload.
+		b.ExecutionPayload = &ExecutionPayload{}
+		if err := b.ExecutionPayload.UnmarshalJSON(raw["execution_payload"]); err != nil {
+			return errors.Wrap(err, "execution_payload")
+		}
+	} else if err := json.Unmarshal(raw["execution_payload"], &b.ExecutionPayload); err != nil {
 		return errors.Wrap(err, "execution_payload")
 	}
 
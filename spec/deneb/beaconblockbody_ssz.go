@@ -1,6 +1,9 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 2dedb1f5d2650e4c46b876321a078714a2b403dfbfb16d9979abc24b4e9a0bda
 // Version: 0.1.3
+
+//go:build !minimal
+
 package deneb
 
 import (
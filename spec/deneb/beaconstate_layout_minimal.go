@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build minimal
+
+package deneb
+
+import "github.com/attestantio/go-eth2-client/spec/internal/sszfield"
+
+// stateFixedSize is the size of the fixed part of the encoded beacon state in the minimal preset.
+const stateFixedSize = 10253
+
+// stateFields are the locations of the fields in the fixed part of the encoded beacon state.
+var stateFields = []sszfield.Field{
+	StateFieldGenesisTime:                  {Start: 0, End: 8},
+	StateFieldGenesisValidatorsRoot:        {Start: 8, End: 40},
+	StateFieldSlot:                         {Start: 40, End: 48},
+	StateFieldFork:                         {Start: 48, End: 64},
+	StateFieldLatestBlockHeader:            {Start: 64, End: 176},
+	StateFieldBlockRoots:                   {Start: 176, End: 2224},
+	StateFieldStateRoots:                   {Start: 2224, End: 4272},
+	StateFieldHistoricalRoots:              {Start: 4272, End: 4276, Variable: true},
+	StateFieldETH1Data:                     {Start: 4276, End: 4348},
+	StateFieldETH1DataVotes:                {Start: 4348, End: 4352, Variable: true},
+	StateFieldETH1DepositIndex:             {Start: 4352, End: 4360},
+	StateFieldValidators:                   {Start: 4360, End: 4364, Variable: true},
+	StateFieldBalances:                     {Start: 4364, End: 4368, Variable: true},
+	StateFieldRANDAOMixes:                  {Start: 4368, End: 6416},
+	StateFieldSlashings:                    {Start: 6416, End: 6928},
+	StateFieldPreviousEpochParticipation:   {Start: 6928, End: 6932, Variable: true},
+	StateFieldCurrentEpochParticipation:    {Start: 6932, End: 6936, Variable: true},
+	StateFieldJustificationBits:            {Start: 6936, End: 6937},
+	StateFieldPreviousJustifiedCheckpoint:  {Start: 6937, End: 6977},
+	StateFieldCurrentJustifiedCheckpoint:   {Start: 6977, End: 7017},
+	StateFieldFinalizedCheckpoint:          {Start: 7017, End: 7057},
+	StateFieldInactivityScores:             {Start: 7057, End: 7061, Variable: true},
+	StateFieldCurrentSyncCommittee:         {Start: 7061, End: 8645},
+	StateFieldNextSyncCommittee:            {Start: 8645, End: 10229},
+	StateFieldLatestExecutionPayloadHeader: {Start: 10229, End: 10233, Variable: true},
+	StateFieldNextWithdrawalIndex:          {Start: 10233, End: 10241},
+	StateFieldNextWithdrawalValidatorIndex: {Start: 10241, End: 10249},
+	StateFieldHistoricalSummaries:          {Start: 10249, End: 10253, Variable: true},
+}
@@ -1,6 +1,9 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 7c7db5f5d8cf77db9db876c546000abe22a3dacb37a582ebb8922caf3949cc23
 // Version: 0.1.3
+
+//go:build !minimal
+
 package deneb
 
 import (
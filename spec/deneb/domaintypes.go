@@ -0,0 +1,20 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import "github.com/attestantio/go-eth2-client/spec/phase0"
+
+// DomainBlobSidecar is the domain type for signed blob sidecars.
+// It was removed from the final deneb specification but is still reported by some nodes.
+var DomainBlobSidecar = phase0.DomainType{0x0b, 0x00, 0x00, 0x00}
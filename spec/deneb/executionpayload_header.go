@@ -0,0 +1,86 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/internal/sszfield"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// Generalized indices of the list fields of the execution payload.
+const (
+	transactionsGeneralizedIndex = 45
+	withdrawalsGeneralizedIndex  = 46
+)
+
+// HeaderFromPayload returns the execution payload header that commits to the given payload.
+func HeaderFromPayload(payload *ExecutionPayload) (*ExecutionPayloadHeader, error) {
+	if payload == nil {
+		return nil, errors.New("no payload")
+	}
+	if payload.BaseFeePerGas == nil {
+		return nil, errors.New("no base fee per gas")
+	}
+
+	transactionsRoot, err := sszfield.Root(payload, transactionsGeneralizedIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain transactions root")
+	}
+	withdrawalsRoot, err := sszfield.Root(payload, withdrawalsGeneralizedIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain withdrawals root")
+	}
+
+	extraData := make([]byte, len(payload.ExtraData))
+	copy(extraData, payload.ExtraData)
+
+	return &ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        extraData,
+		BaseFeePerGas:    new(uint256.Int).Set(payload.BaseFeePerGas),
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+		BlobGasUsed:      payload.BlobGasUsed,
+		ExcessBlobGas:    payload.ExcessBlobGas,
+	}, nil
+}
+
+// MatchesPayload returns true if the header commits to the given payload.
+func (e *ExecutionPayloadHeader) MatchesPayload(payload *ExecutionPayload) (bool, error) {
+	if payload == nil {
+		return false, errors.New("no payload")
+	}
+
+	headerRoot, err := e.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain header root")
+	}
+	payloadRoot, err := payload.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain payload root")
+	}
+
+	return headerRoot == payloadRoot, nil
+}
@@ -18,7 +18,6 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"strconv"
 
 	"github.com/attestantio/go-eth2-client/codecs"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
@@ -51,6 +50,17 @@ type executionPayloadJSON struct {
 
 // MarshalJSON implements json.Marshaler.
 func (e *ExecutionPayload) MarshalJSON() ([]byte, error) {
+	return e.marshalJSON(false)
+}
+
+// MarshalJSONHex marshals the payload to JSON with its numeric fields as
+// 0x-prefixed hex quantities, as used by the execution engine API.
+// The result can be unmarshalled with UnmarshalJSON.
+func (e *ExecutionPayload) MarshalJSONHex() ([]byte, error) {
+	return e.marshalJSON(true)
+}
+
+func (e *ExecutionPayload) marshalJSON(hexQuantities bool) ([]byte, error) {
 	transactions := make([]string, len(e.Transactions))
 	for i := range e.Transactions {
 		transactions[i] = fmt.Sprintf("%#x", e.Transactions[i])
@@ -61,6 +71,13 @@ func (e *ExecutionPayload) MarshalJSON() ([]byte, error) {
 		extraData = fmt.Sprintf("%#x", e.ExtraData)
 	}
 
+	quantityFormat := "%d"
+	baseFeePerGas := e.BaseFeePerGas.Dec()
+	if hexQuantities {
+		quantityFormat = "%#x"
+		baseFeePerGas = e.BaseFeePerGas.Hex()
+	}
+
 	return json.Marshal(&executionPayloadJSON{
 		ParentHash:    e.ParentHash,
 		FeeRecipient:  e.FeeRecipient,
@@ -68,17 +85,17 @@ func (e *ExecutionPayload) MarshalJSON() ([]byte, error) {
 		ReceiptsRoot:  e.ReceiptsRoot,
 		LogsBloom:     fmt.Sprintf("%#x", e.LogsBloom),
 		PrevRandao:    fmt.Sprintf("%#x", e.PrevRandao),
-		BlockNumber:   fmt.Sprintf("%d", e.BlockNumber),
-		GasLimit:      fmt.Sprintf("%d", e.GasLimit),
-		GasUsed:       fmt.Sprintf("%d", e.GasUsed),
-		Timestamp:     fmt.Sprintf("%d", e.Timestamp),
+		BlockNumber:   fmt.Sprintf(quantityFormat, e.BlockNumber),
+		GasLimit:      fmt.Sprintf(quantityFormat, e.GasLimit),
+		GasUsed:       fmt.Sprintf(quantityFormat, e.GasUsed),
+		Timestamp:     fmt.Sprintf(quantityFormat, e.Timestamp),
 		ExtraData:     extraData,
-		BaseFeePerGas: e.BaseFeePerGas.Dec(),
+		BaseFeePerGas: baseFeePerGas,
 		BlockHash:     e.BlockHash,
 		Transactions:  transactions,
 		Withdrawals:   e.Withdrawals,
-		BlobGasUsed:   fmt.Sprintf("%d", e.BlobGasUsed),
-		ExcessBlobGas: fmt.Sprintf("%d", e.ExcessBlobGas),
+		BlobGasUsed:   fmt.Sprintf(quantityFormat, e.BlobGasUsed),
+		ExcessBlobGas: fmt.Sprintf(quantityFormat, e.ExcessBlobGas),
 	})
 }
 
@@ -143,25 +160,25 @@ func (e *ExecutionPayload) UnmarshalJSON(input []byte) error {
 		return errors.New("prev_randao: incorrect length")
 	}
 
-	tmpUint, err := strconv.ParseUint(string(bytes.Trim(raw["block_number"], `"`)), 10, 64)
+	tmpUint, err := codecs.DecodeUint64(raw["block_number"])
 	if err != nil {
 		return errors.Wrap(err, "block_number")
 	}
 	e.BlockNumber = tmpUint
 
-	tmpUint, err = strconv.ParseUint(string(bytes.Trim(raw["gas_limit"], `"`)), 10, 64)
+	tmpUint, err = codecs.DecodeUint64(raw["gas_limit"])
 	if err != nil {
 		return errors.Wrap(err, "gas_limit")
 	}
 	e.GasLimit = tmpUint
 
-	tmpUint, err = strconv.ParseUint(string(bytes.Trim(raw["gas_used"], `"`)), 10, 64)
+	tmpUint, err = codecs.DecodeUint64(raw["gas_used"])
 	if err != nil {
 		return errors.Wrap(err, "gas_used")
 	}
 	e.GasUsed = tmpUint
 
-	tmpUint, err = strconv.ParseUint(string(bytes.Trim(raw["timestamp"], `"`)), 10, 64)
+	tmpUint, err = codecs.DecodeUint64(raw["timestamp"])
 	if err != nil {
 		return errors.Wrap(err, "timestamp")
 	}
@@ -187,7 +204,6 @@ func (e *ExecutionPayload) UnmarshalJSON(input []byte) error {
 	}
 
 	tmpBytes = bytes.Trim(raw["base_fee_per_gas"], `"`)
-	tmpBytes = bytes.TrimPrefix(tmpBytes, []byte{'0', 'x'})
 	if bytes.HasPrefix(tmpBytes, []byte{'0', 'x'}) {
 		e.BaseFeePerGas, err = uint256.FromHex(string(tmpBytes))
 	} else {
@@ -201,8 +217,8 @@ func (e *ExecutionPayload) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "block_hash")
 	}
 
-	transactions := make([]json.RawMessage, 0)
-	if err := json.Unmarshal(raw["transactions"], &transactions); err != nil {
+	transactions, err := codecs.RawJSONArray(raw["transactions"])
+	if err != nil {
 		return errors.Wrap(err, "transactions")
 	}
 	e.Transactions = make([]bellatrix.Transaction, len(transactions))
@@ -213,7 +229,13 @@ func (e *ExecutionPayload) UnmarshalJSON(input []byte) error {
 			return fmt.Errorf("transaction %d: missing", i)
 		}
 		e.Transactions[i] = make([]byte, (len(transactions[i])-4)/2)
-		if err := json.Unmarshal(transactions[i], &e.Transactions[i]); err != nil {
+		if codecs.FastJSON() {
+			// The scanner has already checked the structure of the transaction.
+			err = e.Transactions[i].UnmarshalJSON(transactions[i])
+		} else {
+			err = json.Unmarshal(transactions[i], &e.Transactions[i])
+		}
+		if err != nil {
 			return errors.Wrapf(err, "transaction %d", i)
 		}
 	}
@@ -222,13 +244,13 @@ func (e *ExecutionPayload) UnmarshalJSON(input []byte) error {
 		return errors.Wrap(err, "withdrawals")
 	}
 
-	tmpUint, err = strconv.ParseUint(string(bytes.Trim(raw["blob_gas_used"], `"`)), 10, 64)
+	tmpUint, err = codecs.DecodeUint64(raw["blob_gas_used"])
 	if err != nil {
 		return errors.Wrap(err, "blob_gas_used")
 	}
 	e.BlobGasUsed = tmpUint
 
-	tmpUint, err = strconv.ParseUint(string(bytes.Trim(raw["excess_blob_gas"], `"`)), 10, 64)
+	tmpUint, err = codecs.DecodeUint64(raw["excess_blob_gas"])
 	if err != nil {
 		return errors.Wrap(err, "excess_blob_gas")
 	}
@@ -1,6 +1,9 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 11e48d50a0b734205176a879cdd916ec1792ce5fcdb41d3a75cef7d0ee4c0548
 // Version: 0.1.3
+
+//go:build !minimal
+
 package deneb
 
 import (
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/attestantio/go-eth2-client/codectest"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/assert"
@@ -406,9 +407,29 @@ func TestExecutionPayloadYAML(t *testing.T) {
 				require.NoError(t, err)
 				rt, err := yaml.Marshal(&res)
 				require.NoError(t, err)
-				assert.Equal(t, testYAMLFormat([]byte(res.String())), testYAMLFormat(rt))
-				assert.Equal(t, testYAMLFormat(test.input), testYAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat([]byte(res.String())), codectest.YAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat(test.input), codectest.YAMLFormat(rt))
 			}
 		})
 	}
 }
+
+func TestExecutionPayloadJSONHex(t *testing.T) {
+	payload := testExecutionPayload(2, 2)
+
+	data, err := payload.MarshalJSONHex()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"block_number":"0x3039"`)
+	require.Contains(t, string(data), `"base_fee_per_gas":"0x7"`)
+	require.Contains(t, string(data), `"excess_blob_gas":"0x40000"`)
+
+	var res deneb.ExecutionPayload
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, payload, &res)
+
+	// Ensure that the standard encoding is unchanged.
+	data, err = json.Marshal(&res)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"block_number":"12345"`)
+	require.Contains(t, string(data), `"base_fee_per_gas":"7"`)
+}
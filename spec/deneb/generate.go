@@ -14,6 +14,11 @@
 package deneb
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
+// Types whose encoding depends on the preset also have minimal preset encodings, selected with the minimal build tag.
 //go:generate rm -f beaconblockbody_ssz.go beaconblock_ssz.go beaconstate_ssz.go blobidentifier_ssz.go blobsidecar_ssz.go executionpayload_ssz.go executionpayloadheader_ssz.go signedbeaconblock_ssz.go signedblobsidecar_ssz.go
-//go:generate sszgen --suffix=ssz --path . --include ../phase0,../altair,../bellatrix,../capella --objs BeaconBlockBody,BeaconBlock,BeaconState,BlobIdentifier,BlobSidecar,ExecutionPayload,ExecutionPayloadHeader,SignedBeaconBlock,SignedBlobSidecar
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --suffix=ssz --path . --include ../phase0,../altair,../bellatrix,../capella --objs BeaconBlock,BlobIdentifier,BlobSidecar,ExecutionPayloadHeader,SignedBeaconBlock,SignedBlobSidecar
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --build-tag=!minimal --suffix=ssz --path . --include ../phase0,../altair,../bellatrix,../capella --objs BeaconBlockBody,BeaconState,ExecutionPayload
+//go:generate rm -f beaconblockbody_ssz_minimal.go beaconstate_ssz_minimal.go executionpayload_ssz_minimal.go
+//go:generate go run ../../cmd/presetsszgen --preset=minimal --build-tag=minimal --suffix=ssz_minimal --path . --include ../phase0,../altair,../bellatrix,../capella --objs BeaconBlockBody,BeaconState,ExecutionPayload
 //go:generate goimports -w beaconblockbody_ssz.go beaconblock_ssz.go beaconstate_ssz.go blobidentifier_ssz.go blobsidecar_ssz.go executionpayload_ssz.go executionpayloadheader_ssz.go signedbeaconblock_ssz.go signedblobsidecar_ssz.go
@@ -0,0 +1,25 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build minimal
+
+package deneb_test
+
+// Values of the minimal preset used to size test data.
+const (
+	slotsPerHistoricalRoot    = 64
+	epochsPerHistoricalVector = 64
+	epochsPerSlashingsVector  = 64
+	syncCommitteeSize         = 32
+	maxWithdrawalsPerPayload  = 4
+)
@@ -0,0 +1,111 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deneb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+// testFullBlockJSON returns the JSON of a signed block with a full execution payload.
+func testFullBlockJSON(tb testing.TB) []byte {
+	tb.Helper()
+
+	payload := testExecutionPayload(0, 16)
+	payload.Transactions = make([]bellatrix.Transaction, 1500)
+	for i := range payload.Transactions {
+		payload.Transactions[i] = make([]byte, 600)
+		payload.Transactions[i][0] = byte(i)
+	}
+	block := &deneb.SignedBeaconBlock{
+		Message: &deneb.BeaconBlock{
+			Slot:          100,
+			ProposerIndex: 5,
+			Body: &deneb.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				ProposerSlashings: []*phase0.ProposerSlashing{},
+				AttesterSlashings: []*phase0.AttesterSlashing{},
+				Attestations:      []*phase0.Attestation{},
+				Deposits:          []*phase0.Deposit{},
+				VoluntaryExits:    []*phase0.SignedVoluntaryExit{},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+				ExecutionPayload:      payload,
+				BLSToExecutionChanges: []*capella.SignedBLSToExecutionChange{},
+				BlobKzgCommitments:    []deneb.KzgCommitment{},
+			},
+		},
+	}
+	data, err := json.Marshal(block)
+	require.NoError(tb, err)
+
+	return data
+}
+
+func TestSignedBeaconBlockJSONFast(t *testing.T) {
+	data := testFullBlockJSON(t)
+
+	defer codecs.SetFastJSON(codecs.FastJSON())
+
+	codecs.SetFastJSON(false)
+	var expected deneb.SignedBeaconBlock
+	require.NoError(t, json.Unmarshal(data, &expected))
+
+	codecs.SetFastJSON(true)
+	var res deneb.SignedBeaconBlock
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, expected, res)
+
+	rt, err := json.Marshal(&res)
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(rt))
+}
+
+func benchmarkSignedBeaconBlockUnmarshalJSON(b *testing.B, fast bool) {
+	b.Helper()
+
+	data := testFullBlockJSON(b)
+	defer codecs.SetFastJSON(codecs.FastJSON())
+	codecs.SetFastJSON(fast)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var block deneb.SignedBeaconBlock
+		if err := json.Unmarshal(data, &block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignedBeaconBlockUnmarshalJSON(b *testing.B) {
+	benchmarkSignedBeaconBlockUnmarshalJSON(b, false)
+}
+
+func BenchmarkSignedBeaconBlockUnmarshalJSONFast(b *testing.B) {
+	benchmarkSignedBeaconBlockUnmarshalJSON(b, true)
+}
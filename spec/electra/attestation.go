@@ -0,0 +1,52 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// Attestation is the Ethereum 2 attestation structure.
+// From electra an attestation can aggregate votes from multiple committees in the same slot;
+// the committees are listed in CommitteeBits and the index in the attestation data is always 0.
+type Attestation struct {
+	AggregationBits bitfield.Bitlist `ssz-max:"131072"`
+	Data            *phase0.AttestationData
+	Signature       phase0.BLSSignature  `ssz-size:"96"`
+	CommitteeBits   bitfield.Bitvector64 `ssz-size:"8"`
+}
+
+// CommitteeIndices returns the indices of the committees that contribute to the attestation.
+func (a *Attestation) CommitteeIndices() []phase0.CommitteeIndex {
+	indices := a.CommitteeBits.BitIndices()
+	res := make([]phase0.CommitteeIndex, len(indices))
+	for i := range indices {
+		res[i] = phase0.CommitteeIndex(indices[i])
+	}
+
+	return res
+}
+
+// String returns a string version of the structure.
+func (a *Attestation) String() string {
+	data, err := yaml.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// attestationJSON is the spec representation of the struct.
+type attestationJSON struct {
+	AggregationBits string                  `json:"aggregation_bits"`
+	Data            *phase0.AttestationData `json:"data"`
+	Signature       string                  `json:"signature"`
+	CommitteeBits   string                  `json:"committee_bits"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *Attestation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationJSON{
+		AggregationBits: fmt.Sprintf("%#x", []byte(a.AggregationBits)),
+		Data:            a.Data,
+		Signature:       a.Signature.String(),
+		CommitteeBits:   fmt.Sprintf("%#x", []byte(a.CommitteeBits)),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Attestation) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&attestationJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	aggregationBits := bytes.TrimPrefix(bytes.Trim(raw["aggregation_bits"], `"`), []byte{'0', 'x'})
+	if len(aggregationBits) == 0 {
+		return errors.New("aggregation_bits: missing")
+	}
+	if a.AggregationBits, err = hex.DecodeString(string(aggregationBits)); err != nil {
+		return errors.Wrap(err, "aggregation_bits")
+	}
+
+	a.Data = &phase0.AttestationData{}
+	if err := a.Data.UnmarshalJSON(raw["data"]); err != nil {
+		return errors.Wrap(err, "data")
+	}
+
+	if err := a.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	committeeBits := bytes.TrimPrefix(bytes.Trim(raw["committee_bits"], `"`), []byte{'0', 'x'})
+	if a.CommitteeBits, err = hex.DecodeString(string(committeeBits)); err != nil {
+		return errors.Wrap(err, "committee_bits")
+	}
+	if len(a.CommitteeBits) != 8 {
+		return errors.New("committee_bits: incorrect length")
+	}
+
+	return nil
+}
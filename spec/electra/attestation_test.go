@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestAttestationJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type map[string]json.RawMessage",
+		},
+		{
+			name:  "AggregationBitsMissing",
+			input: []byte(`{"data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","committee_bits":"0x0500000000000000"}`),
+			err:   "aggregation_bits: missing",
+		},
+		{
+			name:  "AggregationBitsEmpty",
+			input: []byte(`{"aggregation_bits":"","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","committee_bits":"0x0500000000000000"}`),
+			err:   "aggregation_bits: missing",
+		},
+		{
+			name:  "AggregationBitsInvalid",
+			input: []byte(`{"aggregation_bits":"0xzz","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","committee_bits":"0x0500000000000000"}`),
+			err:   "aggregation_bits: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "DataMissing",
+			input: []byte(`{"aggregation_bits":"0x0f01","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","committee_bits":"0x0500000000000000"}`),
+			err:   "data: missing",
+		},
+		{
+			name:  "SignatureMissing",
+			input: []byte(`{"aggregation_bits":"0x0f01","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"committee_bits":"0x0500000000000000"}`),
+			err:   "signature: missing",
+		},
+		{
+			name:  "SignatureIncorrectLength",
+			input: []byte(`{"aggregation_bits":"0x0f01","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a","committee_bits":"0x0500000000000000"}`),
+			err:   "signature: incorrect length",
+		},
+		{
+			name:  "CommitteeBitsMissing",
+			input: []byte(`{"aggregation_bits":"0x0f01","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59"}`),
+			err:   "committee_bits: missing",
+		},
+		{
+			name:  "CommitteeBitsIncorrectLength",
+			input: []byte(`{"aggregation_bits":"0x0f01","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","committee_bits":"0x0500"}`),
+			err:   "committee_bits: incorrect length",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"aggregation_bits":"0x0f01","data":{"slot":"100","index":"0","beacon_block_root":"0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18","source":{"epoch":"2","root":"0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849"},"target":{"epoch":"3","root":"0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e"}},"signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","committee_bits":"0x0500000000000000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.Attestation
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestAttestationYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{aggregation_bits: '0x0f01', data: {slot: 100, index: 0, beacon_block_root: '0xd0508cb8ac4296ebff9aafb902978f0ef167b6c03aa332bef035b521aabe6a18', source: {epoch: 2, root: '0x27e641bc7b14206d64267832137b662f7d6d1c18fafc835b1e55820b79fc7849'}, target: {epoch: 3, root: '0x5422096ef69b175aa4f16f7e8d652b3e9f4b8ccf942e295739661c2b4583ae3e'}}, signature: '0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59', committee_bits: '0x0500000000000000'}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.Attestation
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, codectest.YAMLFormat([]byte(res.String())), codectest.YAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat(test.input), codectest.YAMLFormat(rt))
+			}
+		})
+	}
+}
+
+func TestAttestationCommitteeIndices(t *testing.T) {
+	attestation := &electra.Attestation{
+		CommitteeBits: []byte{0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80},
+	}
+	require.Equal(t, []phase0.CommitteeIndex{0, 2, 63}, attestation.CommitteeIndices())
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/pkg/errors"
+)
+
+// attesterSlashingJSON is the spec representation of the struct.
+type attesterSlashingJSON struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttesterSlashing) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attesterSlashingJSON{
+		Attestation1: a.Attestation1,
+		Attestation2: a.Attestation2,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttesterSlashing) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&attesterSlashingJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	a.Attestation1 = &IndexedAttestation{}
+	if err := a.Attestation1.UnmarshalJSON(raw["attestation_1"]); err != nil {
+		return errors.Wrap(err, "attestation_1")
+	}
+
+	a.Attestation2 = &IndexedAttestation{}
+	if err := a.Attestation2.UnmarshalJSON(raw["attestation_2"]); err != nil {
+		return errors.Wrap(err, "attestation_2")
+	}
+
+	return nil
+}
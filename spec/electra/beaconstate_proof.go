@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Generalized indices of beacon state fields.
+// The electra beacon state has more than 32 fields, so its tree is one level
+// deeper than that of earlier forks and these indices differ from those in phase0.
+const (
+	GeneralizedIndexGenesisTime                   = uint64(64)
+	GeneralizedIndexGenesisValidatorsRoot         = uint64(65)
+	GeneralizedIndexSlot                          = uint64(66)
+	GeneralizedIndexFork                          = uint64(67)
+	GeneralizedIndexLatestBlockHeader             = uint64(68)
+	GeneralizedIndexBlockRoots                    = uint64(69)
+	GeneralizedIndexStateRoots                    = uint64(70)
+	GeneralizedIndexHistoricalRoots               = uint64(71)
+	GeneralizedIndexETH1Data                      = uint64(72)
+	GeneralizedIndexETH1DataVotes                 = uint64(73)
+	GeneralizedIndexETH1DepositIndex              = uint64(74)
+	GeneralizedIndexValidators                    = uint64(75)
+	GeneralizedIndexBalances                      = uint64(76)
+	GeneralizedIndexRANDAOMixes                   = uint64(77)
+	GeneralizedIndexSlashings                     = uint64(78)
+	GeneralizedIndexPreviousEpochParticipation    = uint64(79)
+	GeneralizedIndexCurrentEpochParticipation     = uint64(80)
+	GeneralizedIndexJustificationBits             = uint64(81)
+	GeneralizedIndexPreviousJustifiedCheckpoint   = uint64(82)
+	GeneralizedIndexCurrentJustifiedCheckpoint    = uint64(83)
+	GeneralizedIndexFinalizedCheckpoint           = uint64(84)
+	GeneralizedIndexInactivityScores              = uint64(85)
+	GeneralizedIndexCurrentSyncCommittee          = uint64(86)
+	GeneralizedIndexNextSyncCommittee             = uint64(87)
+	GeneralizedIndexLatestExecutionPayloadHeader  = uint64(88)
+	GeneralizedIndexNextWithdrawalIndex           = uint64(89)
+	GeneralizedIndexNextWithdrawalValidatorIndex  = uint64(90)
+	GeneralizedIndexHistoricalSummaries           = uint64(91)
+	GeneralizedIndexDepositRequestsStartIndex     = uint64(92)
+	GeneralizedIndexDepositBalanceToConsume       = uint64(93)
+	GeneralizedIndexExitBalanceToConsume          = uint64(94)
+	GeneralizedIndexEarliestExitEpoch             = uint64(95)
+	GeneralizedIndexConsolidationBalanceToConsume = uint64(96)
+	GeneralizedIndexEarliestConsolidationEpoch    = uint64(97)
+	GeneralizedIndexPendingDeposits               = uint64(98)
+	GeneralizedIndexPendingPartialWithdrawals     = uint64(99)
+	GeneralizedIndexPendingConsolidations         = uint64(100)
+)
+
+const (
+	// registryLimitDepth is the depth of the validators list, with a limit of 2^40.
+	registryLimitDepth = 40
+	// balancesLimitDepth is the depth of the balances list, with 4 balances per chunk.
+	balancesLimitDepth = 38
+	// validatorDepth is the depth of a validator, with 8 fields.
+	validatorDepth = 3
+)
+
+// ValidatorGeneralizedIndex returns the generalized index of the given validator in the beacon state.
+func ValidatorGeneralizedIndex(index phase0.ValidatorIndex) uint64 {
+	// Multiplying by 2 moves past the length mixin to the list data.
+	return (GeneralizedIndexValidators*2)<<registryLimitDepth + uint64(index)
+}
+
+// ValidatorPubKeyGeneralizedIndex returns the generalized index of the public key of the given validator in the beacon state.
+func ValidatorPubKeyGeneralizedIndex(index phase0.ValidatorIndex) uint64 {
+	return ValidatorGeneralizedIndex(index)<<validatorDepth + 0
+}
+
+// ValidatorWithdrawalCredentialsGeneralizedIndex returns the generalized index of the withdrawal credentials
+// of the given validator in the beacon state.
+func ValidatorWithdrawalCredentialsGeneralizedIndex(index phase0.ValidatorIndex) uint64 {
+	return ValidatorGeneralizedIndex(index)<<validatorDepth + 1
+}
+
+// ValidatorEffectiveBalanceGeneralizedIndex returns the generalized index of the effective balance
+// of the given validator in the beacon state.
+func ValidatorEffectiveBalanceGeneralizedIndex(index phase0.ValidatorIndex) uint64 {
+	return ValidatorGeneralizedIndex(index)<<validatorDepth + 2
+}
+
+// BalanceGeneralizedIndex returns the generalized index of the chunk holding the balance of the given
+// validator in the beacon state.
+// Each chunk holds 4 balances; the balance is at byte offset 8*(index%4) of the leaf.
+func BalanceGeneralizedIndex(index phase0.ValidatorIndex) uint64 {
+	return (GeneralizedIndexBalances*2)<<balancesLimitDepth + uint64(index)/4
+}
+
+// Prove generates a proof for the given generalized index of the beacon state.
+// Generalized indices must be those of the electra beacon state, as provided by this package.
+func (b *BeaconState) Prove(generalizedIndex uint64) (*ssz.Proof, error) {
+	return phase0.ProveGeneralizedIndex(b, generalizedIndex)
+}
@@ -0,0 +1,160 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	require "github.com/stretchr/testify/require"
+)
+
+func testBeaconState(validators int) *electra.BeaconState {
+	state := &electra.BeaconState{
+		GenesisTime:       1606824023,
+		Slot:              123456,
+		BlockRoots:        make([]phase0.Root, slotsPerHistoricalRoot),
+		StateRoots:        make([]phase0.Root, slotsPerHistoricalRoot),
+		HistoricalRoots:   []phase0.Root{{0x01}, {0x02}},
+		ETH1DataVotes:     []*phase0.ETH1Data{{BlockHash: make([]byte, 32)}},
+		ETH1Data:          &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		RANDAOMixes:       make([]phase0.Root, epochsPerHistoricalVector),
+		Slashings:         make([]phase0.Gwei, epochsPerSlashingsVector),
+		JustificationBits: []byte{0x03},
+		CurrentSyncCommittee: &altair.SyncCommittee{
+			Pubkeys: make([]phase0.BLSPubKey, syncCommitteeSize),
+		},
+		NextSyncCommittee: &altair.SyncCommittee{
+			Pubkeys: make([]phase0.BLSPubKey, syncCommitteeSize),
+		},
+		LatestExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+			ExtraData:     []byte{0x01},
+			BaseFeePerGas: uint256.NewInt(7),
+		},
+		HistoricalSummaries:       []*capella.HistoricalSummary{{BlockSummaryRoot: phase0.Root{0x04}}},
+		DepositRequestsStartIndex: 5,
+		EarliestExitEpoch:         6,
+		PendingDeposits: []*electra.PendingDeposit{
+			{
+				Pubkey:                phase0.BLSPubKey{0x05},
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                1000000000,
+				Slot:                  123,
+			},
+		},
+		PendingPartialWithdrawals: []*electra.PendingPartialWithdrawal{{ValidatorIndex: 2, Amount: 1000}},
+		PendingConsolidations:     []*electra.PendingConsolidation{{SourceIndex: 1, TargetIndex: 4}},
+	}
+	state.RANDAOMixes[1] = phase0.Root{0x03}
+	for i := 0; i < validators; i++ {
+		state.Validators = append(state.Validators, &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             0xffffffffffffffff,
+		})
+		state.Balances = append(state.Balances, phase0.Gwei(32000000000+i))
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 0x07)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, altair.ParticipationFlags(i%8))
+		state.InactivityScores = append(state.InactivityScores, uint64(i%3))
+	}
+
+	return state
+}
+
+func TestProve(t *testing.T) {
+	state := testBeaconState(10)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		generalizedIndex uint64
+	}{
+		{
+			name:             "Slot",
+			generalizedIndex: electra.GeneralizedIndexSlot,
+		},
+		{
+			name:             "ValidatorPubKey",
+			generalizedIndex: electra.ValidatorPubKeyGeneralizedIndex(3),
+		},
+		{
+			name:             "ValidatorWithdrawalCredentials",
+			generalizedIndex: electra.ValidatorWithdrawalCredentialsGeneralizedIndex(9),
+		},
+		{
+			name:             "ValidatorEffectiveBalance",
+			generalizedIndex: electra.ValidatorEffectiveBalanceGeneralizedIndex(0),
+		},
+		{
+			name:             "Balance",
+			generalizedIndex: electra.BalanceGeneralizedIndex(6),
+		},
+		{
+			name:             "PendingDeposits",
+			generalizedIndex: electra.GeneralizedIndexPendingDeposits,
+		},
+		{
+			name:             "PendingConsolidations",
+			generalizedIndex: electra.GeneralizedIndexPendingConsolidations,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proof, err := state.Prove(test.generalizedIndex)
+			require.NoError(t, err)
+			require.Equal(t, int(test.generalizedIndex), proof.Index)
+			valid, err := ssz.VerifyProof(root[:], proof)
+			require.NoError(t, err)
+			require.True(t, valid)
+
+			// The versioned state must produce the same proof.
+			versioned := &spec.VersionedBeaconState{
+				Version: spec.DataVersionElectra,
+				Electra: state,
+			}
+			versionedProof, err := versioned.Prove(test.generalizedIndex)
+			require.NoError(t, err)
+			require.Equal(t, proof, versionedProof)
+		})
+	}
+}
+
+func TestProveSlotLeaf(t *testing.T) {
+	state := testBeaconState(1)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := state.Prove(electra.GeneralizedIndexSlot)
+	require.NoError(t, err)
+	// The slot is stored little-endian in the leaf.
+	require.Equal(t, []byte{0x40, 0xe2, 0x01}, proof.Leaf[:3])
+	valid, err := ssz.VerifyProof(root[:], proof)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// The pre-electra index refers to a different node of the electra tree.
+	proof, err = state.Prove(phase0.GeneralizedIndexSlot)
+	require.NoError(t, err)
+	require.NotEqual(t, []byte{0x40, 0xe2, 0x01}, proof.Leaf[:3])
+}
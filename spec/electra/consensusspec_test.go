@@ -0,0 +1,232 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// TestConsensusSpec tests the types against the Ethereum consensus spec tests.
+func TestConsensusSpec(t *testing.T) {
+	if os.Getenv("CONSENSUS_SPEC_TESTS_DIR") == "" {
+		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+
+	tests := []struct {
+		name string
+		s    codectest.Container
+	}{
+		{
+			name: "AggregateAndProof",
+			s:    &phase0.AggregateAndProof{},
+		},
+		{
+			name: "Attestation",
+			s:    &electra.Attestation{},
+		},
+		{
+			name: "AttestationData",
+			s:    &phase0.AttestationData{},
+		},
+		{
+			name: "AttesterSlashing",
+			s:    &electra.AttesterSlashing{},
+		},
+		{
+			name: "BeaconBlock",
+			s:    &electra.BeaconBlock{},
+		},
+		{
+			name: "BeaconBlockBody",
+			s:    &electra.BeaconBlockBody{},
+		},
+		{
+			name: "BeaconBlockHeader",
+			s:    &phase0.BeaconBlockHeader{},
+		},
+		{
+			name: "BeaconState",
+			s:    &electra.BeaconState{},
+		},
+		{
+			name: "BlobIdentifier",
+			s:    &deneb.BlobIdentifier{},
+		},
+		{
+			name: "BlobSidecar",
+			s:    &deneb.BlobSidecar{},
+		},
+		{
+			name: "BLSToExecutionChange",
+			s:    &capella.BLSToExecutionChange{},
+		},
+		{
+			name: "Checkpoint",
+			s:    &phase0.Checkpoint{},
+		},
+		{
+			name: "ConsolidationRequest",
+			s:    &electra.ConsolidationRequest{},
+		},
+		{
+			name: "ContributionAndProof",
+			s:    &altair.ContributionAndProof{},
+		},
+		{
+			name: "Deposit",
+			s:    &phase0.Deposit{},
+		},
+		{
+			name: "DepositData",
+			s:    &phase0.DepositData{},
+		},
+		{
+			name: "DepositMessage",
+			s:    &phase0.DepositMessage{},
+		},
+		{
+			name: "DepositRequest",
+			s:    &electra.DepositRequest{},
+		},
+		{
+			name: "Eth1Data",
+			s:    &phase0.ETH1Data{},
+		},
+		{
+			name: "ExecutionPayload",
+			s:    &deneb.ExecutionPayload{},
+		},
+		{
+			name: "ExecutionPayloadHeader",
+			s:    &deneb.ExecutionPayloadHeader{},
+		},
+		{
+			name: "ExecutionRequests",
+			s:    &electra.ExecutionRequests{},
+		},
+		{
+			name: "Fork",
+			s:    &phase0.Fork{},
+		},
+		{
+			name: "ForkData",
+			s:    &phase0.ForkData{},
+		},
+		// TODO
+		// {
+		// 	name: "HistoricalBatch",
+		// 	s:    &phase0.HistoricalBatch{},
+		// },
+		{
+			name: "HistoricalSummary",
+			s:    &capella.HistoricalSummary{},
+		},
+		{
+			name: "IndexedAttestation",
+			s:    &electra.IndexedAttestation{},
+		},
+		// TODO lightclient*, sync*, others?
+		{
+			name: "PendingAttestation",
+			s:    &phase0.PendingAttestation{},
+		},
+		{
+			name: "PendingConsolidation",
+			s:    &electra.PendingConsolidation{},
+		},
+		{
+			name: "PendingDeposit",
+			s:    &electra.PendingDeposit{},
+		},
+		{
+			name: "PendingPartialWithdrawal",
+			s:    &electra.PendingPartialWithdrawal{},
+		},
+		// TODO Powblock
+		{
+			name: "ProposerSlashing",
+			s:    &phase0.ProposerSlashing{},
+		},
+		{
+			name: "SignedAggregateAndProof",
+			s:    &phase0.SignedAggregateAndProof{},
+		},
+		{
+			name: "SignedBeaconBlock",
+			s:    &electra.SignedBeaconBlock{},
+		},
+		{
+			name: "SignedBeaconBlockHeader",
+			s:    &phase0.SignedBeaconBlockHeader{},
+		},
+		{
+			name: "SignedBLSToExecutionChange",
+			s:    &capella.SignedBLSToExecutionChange{},
+		},
+		{
+			name: "SignedContributionAndProof",
+			s:    &altair.SignedContributionAndProof{},
+		},
+		{
+			name: "SignedVoluntaryExit",
+			s:    &phase0.SignedVoluntaryExit{},
+		},
+		{
+			name: "SyncAggregate",
+			s:    &altair.SyncAggregate{},
+		},
+		{
+			name: "SyncCommittee",
+			s:    &altair.SyncCommittee{},
+		},
+		{
+			name: "SyncCommitteeContribution",
+			s:    &altair.SyncCommitteeContribution{},
+		},
+		{
+			name: "SyncCommitteeMessage",
+			s:    &altair.SyncCommitteeMessage{},
+		},
+		{
+			name: "Validator",
+			s:    &phase0.Validator{},
+		},
+		{
+			name: "VoluntaryExit",
+			s:    &phase0.VoluntaryExit{},
+		},
+		{
+			name: "Withdrawal",
+			s:    &capella.Withdrawal{},
+		},
+		{
+			name: "WithdrawalRequest",
+			s:    &electra.WithdrawalRequest{},
+		},
+	}
+
+	baseDir := filepath.Join(os.Getenv("CONSENSUS_SPEC_TESTS_DIR"), "tests", "mainnet", "electra", "ssz_static")
+	for _, test := range tests {
+		codectest.ConsensusSpec(t, filepath.Join(baseDir, test.name, "ssz_random"), test.s)
+	}
+}
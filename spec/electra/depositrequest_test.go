@@ -0,0 +1,151 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestDepositRequestJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type map[string]json.RawMessage",
+		},
+		{
+			name:  "PubkeyMissing",
+			input: []byte(`{"withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "pubkey: missing",
+		},
+		{
+			name:  "PubkeyWrongType",
+			input: []byte(`{"pubkey":true,"withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "pubkey: invalid prefix",
+		},
+		{
+			name:  "PubkeyIncorrectLength",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e7","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "pubkey: incorrect length",
+		},
+		{
+			name:  "WithdrawalCredentialsMissing",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "withdrawal_credentials: missing",
+		},
+		{
+			name:  "WithdrawalCredentialsWrongType",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":true,"amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "withdrawal_credentials: invalid prefix",
+		},
+		{
+			name:  "WithdrawalCredentialsIncorrectLength",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431e","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "withdrawal_credentials: incorrect length",
+		},
+		{
+			name:  "AmountMissing",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "amount: missing",
+		},
+		{
+			name:  "AmountInvalid",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"-1","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+			err:   "amount: invalid value -1: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "SignatureMissing",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","index":"12345"}`),
+			err:   "signature: missing",
+		},
+		{
+			name:  "SignatureIncorrectLength",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a","index":"12345"}`),
+			err:   "signature: incorrect length",
+		},
+		{
+			name:  "IndexMissing",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59"}`),
+			err:   "index: missing",
+		},
+		{
+			name:  "IndexInvalid",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"-1"}`),
+			err:   "index: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.DepositRequest
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestDepositRequestYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{pubkey: '0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745', withdrawal_credentials: '0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6', amount: 32000000000, signature: '0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59', index: 12345}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.DepositRequest
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, codectest.YAMLFormat([]byte(res.String())), codectest.YAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat(test.input), codectest.YAMLFormat(rt))
+			}
+		})
+	}
+}
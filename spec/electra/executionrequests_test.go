@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestExecutionRequestsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "EmptyInput",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type map[string]json.RawMessage",
+		},
+		{
+			name:  "DepositsMissing",
+			input: []byte(`{"withdrawals":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","validator_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"1000000000"}],"consolidations":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","source_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","target_pubkey":"0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08"}]}`),
+			err:   "deposits: missing",
+		},
+		{
+			name:  "DepositsWrongType",
+			input: []byte(`{"deposits":true,"withdrawals":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","validator_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"1000000000"}],"consolidations":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","source_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","target_pubkey":"0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08"}]}`),
+			err:   "deposits: json: cannot unmarshal bool into Go value of type []*electra.DepositRequest",
+		},
+		{
+			name:  "WithdrawalsMissing",
+			input: []byte(`{"deposits":[{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}],"consolidations":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","source_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","target_pubkey":"0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08"}]}`),
+			err:   "withdrawals: missing",
+		},
+		{
+			name:  "WithdrawalInvalid",
+			input: []byte(`{"deposits":[{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}],"withdrawals":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","validator_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"-1"}],"consolidations":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","source_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","target_pubkey":"0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08"}]}`),
+			err:   "withdrawals: amount: invalid value -1: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ConsolidationsMissing",
+			input: []byte(`{"deposits":[{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}],"withdrawals":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","validator_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"1000000000"}]}`),
+			err:   "consolidations: missing",
+		},
+		{
+			name:  "ConsolidationInvalid",
+			input: []byte(`{"deposits":[{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}],"withdrawals":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","validator_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"1000000000"}],"consolidations":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d9","source_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","target_pubkey":"0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08"}]}`),
+			err:   "consolidations: source_address: incorrect length",
+		},
+		{
+			name:  "Empty",
+			input: []byte(`{"deposits":[],"withdrawals":[],"consolidations":[]}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"deposits":[{"pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","withdrawal_credentials":"0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6","amount":"32000000000","signature":"0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59","index":"12345"}],"withdrawals":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","validator_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","amount":"1000000000"}],"consolidations":[{"source_address":"0x0De4BF8eD54bbD167495522b71b48a7e37b0d933","source_pubkey":"0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745","target_pubkey":"0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.ExecutionRequests
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestExecutionRequestsYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{deposits: [{pubkey: '0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745', withdrawal_credentials: '0x752231844af6514d4e176f12e98aaf1506288a3dd99546287cdc87f3bb431eb6', amount: 32000000000, signature: '0xf3f15a23e43f1388ece45c2f00ba41bfd2920b2279d403707655f6153c114205645761ef0cb669e4c9879bb2dbb64c5fdd8de10211f307fd0d0366b6b96ceee5c7ef45afd6494bc8bb44b5274ce2e46d91eba5ad8b7136a693829bea4bbd5a59', index: 12345}], withdrawals: [{source_address: '0x0De4BF8eD54bbD167495522b71b48a7e37b0d933', validator_pubkey: '0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745', amount: 1000000000}], consolidations: [{source_address: '0x0De4BF8eD54bbD167495522b71b48a7e37b0d933', source_pubkey: '0xdd1d188490ed268e1911b492e51d30691f6520c7ae6816bb210cacd8594e1d1c6e73bff1d94b0a7500145be82722e745', target_pubkey: '0x06200fcf688278f2f290d60fcb1af47fe8025d1baa2843b160734a68f28cdf96e1372fc792daa6d79b15ad38014dbf08'}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res electra.ExecutionRequests
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, codectest.YAMLFormat([]byte(res.String())), codectest.YAMLFormat(rt))
+				assert.Equal(t, codectest.YAMLFormat(test.input), codectest.YAMLFormat(rt))
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+)
+
+func FuzzAttestation(f *testing.F) {
+	codectest.Fuzz(f, &electra.Attestation{})
+}
+
+func FuzzAttesterSlashing(f *testing.F) {
+	codectest.Fuzz(f, &electra.AttesterSlashing{})
+}
+
+func FuzzBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &electra.BeaconBlock{})
+}
+
+func FuzzBeaconBlockBody(f *testing.F) {
+	codectest.Fuzz(f, &electra.BeaconBlockBody{})
+}
+
+func FuzzBeaconState(f *testing.F) {
+	codectest.Fuzz(f, &electra.BeaconState{})
+}
+
+func FuzzConsolidationRequest(f *testing.F) {
+	codectest.Fuzz(f, &electra.ConsolidationRequest{})
+}
+
+func FuzzDepositRequest(f *testing.F) {
+	codectest.Fuzz(f, &electra.DepositRequest{})
+}
+
+func FuzzExecutionRequests(f *testing.F) {
+	codectest.Fuzz(f, &electra.ExecutionRequests{})
+}
+
+func FuzzIndexedAttestation(f *testing.F) {
+	codectest.Fuzz(f, &electra.IndexedAttestation{})
+}
+
+func FuzzPendingConsolidation(f *testing.F) {
+	codectest.Fuzz(f, &electra.PendingConsolidation{})
+}
+
+func FuzzPendingDeposit(f *testing.F) {
+	codectest.Fuzz(f, &electra.PendingDeposit{})
+}
+
+func FuzzPendingPartialWithdrawal(f *testing.F) {
+	codectest.Fuzz(f, &electra.PendingPartialWithdrawal{})
+}
+
+func FuzzSignedBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &electra.SignedBeaconBlock{})
+}
+
+func FuzzWithdrawalRequest(f *testing.F) {
+	codectest.Fuzz(f, &electra.WithdrawalRequest{})
+}
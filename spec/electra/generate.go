@@ -0,0 +1,24 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+// Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
+// Types whose encoding depends on the preset also have minimal preset encodings, selected with the minimal build tag.
+//go:generate rm -f attestation_ssz.go attesterslashing_ssz.go beaconblock_ssz.go beaconblockbody_ssz.go beaconstate_ssz.go consolidationrequest_ssz.go depositrequest_ssz.go executionrequests_ssz.go indexedattestation_ssz.go pendingconsolidation_ssz.go pendingdeposit_ssz.go pendingpartialwithdrawal_ssz.go signedbeaconblock_ssz.go withdrawalrequest_ssz.go
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --suffix=ssz --path . --include ../phase0,../altair,../bellatrix,../capella,../deneb --objs AttesterSlashing,BeaconBlock,ConsolidationRequest,DepositRequest,PendingConsolidation,PendingDeposit,PendingPartialWithdrawal,SignedBeaconBlock,WithdrawalRequest
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --build-tag=!minimal --suffix=ssz --path . --include ../phase0,../altair,../bellatrix,../capella,../deneb --objs Attestation,BeaconBlockBody,BeaconState,ExecutionRequests,IndexedAttestation
+//go:generate rm -f attestation_ssz_minimal.go beaconblockbody_ssz_minimal.go beaconstate_ssz_minimal.go executionrequests_ssz_minimal.go indexedattestation_ssz_minimal.go
+//go:generate go run ../../cmd/presetsszgen --preset=minimal --build-tag=minimal --suffix=ssz_minimal --path . --include ../phase0,../altair,../bellatrix,../capella,../deneb --objs Attestation,BeaconBlockBody,BeaconState,ExecutionRequests,IndexedAttestation
+//go:generate goimports -w attestation_ssz.go attesterslashing_ssz.go beaconblock_ssz.go beaconblockbody_ssz.go beaconstate_ssz.go consolidationrequest_ssz.go depositrequest_ssz.go executionrequests_ssz.go indexedattestation_ssz.go pendingconsolidation_ssz.go pendingdeposit_ssz.go pendingpartialwithdrawal_ssz.go signedbeaconblock_ssz.go withdrawalrequest_ssz.go
@@ -0,0 +1,72 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/pkg/errors"
+)
+
+// pendingDepositJSON is the spec representation of the struct.
+type pendingDepositJSON struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+	Slot                  string `json:"slot"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PendingDeposit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&pendingDepositJSON{
+		Pubkey:                p.Pubkey.String(),
+		WithdrawalCredentials: fmt.Sprintf("%#x", p.WithdrawalCredentials),
+		Amount:                fmt.Sprintf("%d", p.Amount),
+		Signature:             p.Signature.String(),
+		Slot:                  fmt.Sprintf("%d", p.Slot),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PendingDeposit) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&pendingDepositJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Pubkey.UnmarshalJSON(raw["pubkey"]); err != nil {
+		return errors.Wrap(err, "pubkey")
+	}
+
+	if p.WithdrawalCredentials, err = unmarshalWithdrawalCredentials(raw["withdrawal_credentials"]); err != nil {
+		return errors.Wrap(err, "withdrawal_credentials")
+	}
+
+	if err := p.Amount.UnmarshalJSON(raw["amount"]); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	if err := p.Signature.UnmarshalJSON(raw["signature"]); err != nil {
+		return errors.Wrap(err, "signature")
+	}
+
+	if err := p.Slot.UnmarshalJSON(raw["slot"]); err != nil {
+		return errors.Wrap(err, "slot")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electra
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/codecs"
+	"github.com/pkg/errors"
+)
+
+// withdrawalRequestJSON is the spec representation of the struct.
+type withdrawalRequestJSON struct {
+	SourceAddress   string `json:"source_address"`
+	ValidatorPubkey string `json:"validator_pubkey"`
+	Amount          string `json:"amount"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (w *WithdrawalRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&withdrawalRequestJSON{
+		SourceAddress:   w.SourceAddress.String(),
+		ValidatorPubkey: w.ValidatorPubkey.String(),
+		Amount:          fmt.Sprintf("%d", w.Amount),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (w *WithdrawalRequest) UnmarshalJSON(input []byte) error {
+	raw, err := codecs.RawJSON(&withdrawalRequestJSON{}, input)
+	if err != nil {
+		return err
+	}
+
+	if err := w.SourceAddress.UnmarshalJSON(raw["source_address"]); err != nil {
+		return errors.Wrap(err, "source_address")
+	}
+
+	if err := w.ValidatorPubkey.UnmarshalJSON(raw["validator_pubkey"]); err != nil {
+		return errors.Wrap(err, "validator_pubkey")
+	}
+
+	if err := w.Amount.UnmarshalJSON(raw["amount"]); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	return nil
+}
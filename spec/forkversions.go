@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ForkVersions are the fork versions of a network, by fork.
+type ForkVersions struct {
+	Phase0    phase0.Version
+	Altair    phase0.Version
+	Bellatrix phase0.Version
+	Capella   phase0.Version
+	Deneb     phase0.Version
+	Electra   phase0.Version
+}
+
+// Fork versions for well-known public networks.
+var (
+	// MainnetForkVersions are the fork versions for mainnet.
+	MainnetForkVersions = &ForkVersions{
+		Phase0:    phase0.Version{0x00, 0x00, 0x00, 0x00},
+		Altair:    phase0.Version{0x01, 0x00, 0x00, 0x00},
+		Bellatrix: phase0.Version{0x02, 0x00, 0x00, 0x00},
+		Capella:   phase0.Version{0x03, 0x00, 0x00, 0x00},
+		Deneb:     phase0.Version{0x04, 0x00, 0x00, 0x00},
+		Electra:   phase0.Version{0x05, 0x00, 0x00, 0x00},
+	}
+	// SepoliaForkVersions are the fork versions for the Sepolia testnet.
+	SepoliaForkVersions = &ForkVersions{
+		Phase0:    phase0.Version{0x90, 0x00, 0x00, 0x69},
+		Altair:    phase0.Version{0x90, 0x00, 0x00, 0x70},
+		Bellatrix: phase0.Version{0x90, 0x00, 0x00, 0x71},
+		Capella:   phase0.Version{0x90, 0x00, 0x00, 0x72},
+		Deneb:     phase0.Version{0x90, 0x00, 0x00, 0x73},
+		Electra:   phase0.Version{0x90, 0x00, 0x00, 0x74},
+	}
+	// HoleskyForkVersions are the fork versions for the Holesky testnet.
+	HoleskyForkVersions = &ForkVersions{
+		Phase0:    phase0.Version{0x01, 0x01, 0x70, 0x00},
+		Altair:    phase0.Version{0x02, 0x01, 0x70, 0x00},
+		Bellatrix: phase0.Version{0x03, 0x01, 0x70, 0x00},
+		Capella:   phase0.Version{0x04, 0x01, 0x70, 0x00},
+		Deneb:     phase0.Version{0x05, 0x01, 0x70, 0x00},
+		Electra:   phase0.Version{0x06, 0x01, 0x70, 0x00},
+	}
+	// HoodiForkVersions are the fork versions for the Hoodi testnet.
+	HoodiForkVersions = &ForkVersions{
+		Phase0:    phase0.Version{0x10, 0x00, 0x09, 0x10},
+		Altair:    phase0.Version{0x20, 0x00, 0x09, 0x10},
+		Bellatrix: phase0.Version{0x30, 0x00, 0x09, 0x10},
+		Capella:   phase0.Version{0x40, 0x00, 0x09, 0x10},
+		Deneb:     phase0.Version{0x50, 0x00, 0x09, 0x10},
+		Electra:   phase0.Version{0x60, 0x00, 0x09, 0x10},
+	}
+)
+
+// ForkVersion returns the fork version for the given data version.
+func (f *ForkVersions) ForkVersion(version DataVersion) (phase0.Version, error) {
+	switch version {
+	case DataVersionPhase0:
+		return f.Phase0, nil
+	case DataVersionAltair:
+		return f.Altair, nil
+	case DataVersionBellatrix:
+		return f.Bellatrix, nil
+	case DataVersionCapella:
+		return f.Capella, nil
+	case DataVersionDeneb:
+		return f.Deneb, nil
+	case DataVersionElectra:
+		return f.Electra, nil
+	default:
+		return phase0.Version{}, fmt.Errorf("unsupported data version %v", version)
+	}
+}
+
+// DataVersion returns the data version for the given fork version.
+func (f *ForkVersions) DataVersion(forkVersion phase0.Version) (DataVersion, error) {
+	switch forkVersion {
+	case f.Phase0:
+		return DataVersionPhase0, nil
+	case f.Altair:
+		return DataVersionAltair, nil
+	case f.Bellatrix:
+		return DataVersionBellatrix, nil
+	case f.Capella:
+		return DataVersionCapella, nil
+	case f.Deneb:
+		return DataVersionDeneb, nil
+	case f.Electra:
+		return DataVersionElectra, nil
+	default:
+		return DataVersionUnknown, fmt.Errorf("unknown fork version %#x", forkVersion)
+	}
+}
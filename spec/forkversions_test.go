@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkVersions(t *testing.T) {
+	tests := []struct {
+		name        string
+		versions    *spec.ForkVersions
+		dataVersion spec.DataVersion
+		forkVersion phase0.Version
+		err         string
+	}{
+		{
+			name:        "MainnetPhase0",
+			versions:    spec.MainnetForkVersions,
+			dataVersion: spec.DataVersionPhase0,
+			forkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "MainnetDeneb",
+			versions:    spec.MainnetForkVersions,
+			dataVersion: spec.DataVersionDeneb,
+			forkVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+		},
+		{
+			name:        "SepoliaCapella",
+			versions:    spec.SepoliaForkVersions,
+			dataVersion: spec.DataVersionCapella,
+			forkVersion: phase0.Version{0x90, 0x00, 0x00, 0x72},
+		},
+		{
+			name:        "HoleskyElectra",
+			versions:    spec.HoleskyForkVersions,
+			dataVersion: spec.DataVersionElectra,
+			forkVersion: phase0.Version{0x06, 0x01, 0x70, 0x00},
+		},
+		{
+			name:        "HoodiAltair",
+			versions:    spec.HoodiForkVersions,
+			dataVersion: spec.DataVersionAltair,
+			forkVersion: phase0.Version{0x20, 0x00, 0x09, 0x10},
+		},
+		{
+			name:        "Unknown",
+			versions:    spec.MainnetForkVersions,
+			dataVersion: spec.DataVersionUnknown,
+			err:         "unsupported data version unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			forkVersion, err := test.versions.ForkVersion(test.dataVersion)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.forkVersion, forkVersion)
+
+			dataVersion, err := test.versions.DataVersion(forkVersion)
+			require.NoError(t, err)
+			require.Equal(t, test.dataVersion, dataVersion)
+		})
+	}
+}
+
+func TestForkVersionsUnknownForkVersion(t *testing.T) {
+	_, err := spec.MainnetForkVersions.DataVersion(phase0.Version{0x90, 0x00, 0x00, 0x69})
+	require.EqualError(t, err, "unknown fork version 0x90000069")
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"runtime"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// HasherPool is a pool of SSZ hashers.
+// The generated HashTreeRoot functions use the fastssz default pool, which is backed by a
+// sync.Pool and so is emptied by garbage collection.  Hashing a large object such as a beacon
+// state both grows a hasher's buffers and triggers garbage collection, so the buffers are
+// usually reallocated on each call.  Hashers in this pool are retained across garbage
+// collections, so repeated hashing of large objects reuses their buffers.
+type HasherPool struct {
+	hashers chan *ssz.Hasher
+}
+
+// DefaultHasherPool is a hasher pool that retains a hasher for each processor.
+var DefaultHasherPool = NewHasherPool(runtime.GOMAXPROCS(0))
+
+// NewHasherPool creates a hasher pool that retains up to size hashers.
+func NewHasherPool(size int) *HasherPool {
+	if size < 1 {
+		size = 1
+	}
+
+	return &HasherPool{
+		hashers: make(chan *ssz.Hasher, size),
+	}
+}
+
+// Get obtains a hasher from the pool, creating one if the pool is empty.
+func (p *HasherPool) Get() *ssz.Hasher {
+	select {
+	case hasher := <-p.hashers:
+		return hasher
+	default:
+		return ssz.NewHasher()
+	}
+}
+
+// Put returns a hasher to the pool, discarding it if the pool is full.
+func (p *HasherPool) Put(hasher *ssz.Hasher) {
+	hasher.Reset()
+	select {
+	case p.hashers <- hasher:
+	default:
+	}
+}
+
+// HashTreeRoot calculates the hash tree root of an object with a hasher from the pool.
+func (p *HasherPool) HashTreeRoot(obj ssz.HashRoot) ([32]byte, error) {
+	hasher := p.Get()
+	defer p.Put(hasher)
+
+	if err := obj.HashTreeRootWith(hasher); err != nil {
+		return [32]byte{}, err
+	}
+
+	return hasher.HashRoot()
+}
+
+// HashTreeRootWithPool calculates the hash tree root of an object with a hasher from the default hasher pool.
+func HashTreeRootWithPool(obj ssz.HashRoot) ([32]byte, error) {
+	return DefaultHasherPool.HashTreeRoot(obj)
+}
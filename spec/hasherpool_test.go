@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func testBeaconState(validators int) *phase0.BeaconState {
+	state := &phase0.BeaconState{
+		Fork:                        &phase0.Fork{},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, slotsPerHistoricalRoot),
+		StateRoots:                  make([]phase0.Root, slotsPerHistoricalRoot),
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		Validators:                  make([]*phase0.Validator, validators),
+		Balances:                    make([]phase0.Gwei, validators),
+		RANDAOMixes:                 make([]phase0.Root, epochsPerHistoricalVector),
+		Slashings:                   make([]phase0.Gwei, epochsPerSlashingsVector),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+	for i := range state.Validators {
+		state.Validators[i] = &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{byte(i), byte(i >> 8), byte(i >> 16)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             0xffffffffffffffff,
+			WithdrawableEpoch:     0xffffffffffffffff,
+		}
+		state.Balances[i] = 32000000000
+	}
+
+	return state
+}
+
+func TestHasherPool(t *testing.T) {
+	state := testBeaconState(100)
+	expected, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	pool := spec.NewHasherPool(1)
+	for i := 0; i < 3; i++ {
+		root, err := pool.HashTreeRoot(state)
+		require.NoError(t, err)
+		require.Equal(t, expected, root)
+	}
+
+	root, err := spec.HashTreeRootWithPool(state)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// Hashers returned to a full pool are discarded.
+	pool.Put(pool.Get())
+	pool.Put(pool.Get())
+}
+
+// The benchmarks run garbage collection between iterations, as happens between
+// successive hashes of a state in a long-running process.  This empties the
+// default fastssz pool, but not the hasher pool.
+
+func BenchmarkBeaconStateHashTreeRoot(b *testing.B) {
+	state := testBeaconState(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		runtime.GC()
+		runtime.GC()
+		b.StartTimer()
+		if _, err := state.HashTreeRoot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateHashTreeRootWithPool(b *testing.B) {
+	state := testBeaconState(100000)
+	pool := spec.NewHasherPool(1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		runtime.GC()
+		runtime.GC()
+		b.StartTimer()
+		if _, err := pool.HashTreeRoot(state); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
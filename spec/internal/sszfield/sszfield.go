@@ -0,0 +1,78 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sszfield provides access to individual fields of SSZ-encoded containers.
+package sszfield
+
+import (
+	"encoding/binary"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// Field is the location of a field in the fixed part of a container.
+// For variable-size fields the location is that of the field's offset.
+type Field struct {
+	Start    int
+	End      int
+	Variable bool
+}
+
+// Extract returns the encoding of the field at the given index of a container.
+// The returned slice references buf.
+func Extract(buf []byte, fixedSize int, fields []Field, index int) ([]byte, error) {
+	if index < 0 || index >= len(fields) {
+		return nil, errors.Errorf("unknown field %d", index)
+	}
+	if len(buf) < fixedSize {
+		return nil, errors.Errorf("data too short: %d < %d", len(buf), fixedSize)
+	}
+
+	field := fields[index]
+	if !field.Variable {
+		return buf[field.Start:field.End], nil
+	}
+
+	start := int(binary.LittleEndian.Uint32(buf[field.Start:field.End]))
+	end := len(buf)
+	for _, next := range fields[index+1:] {
+		if next.Variable {
+			end = int(binary.LittleEndian.Uint32(buf[next.Start:next.End]))
+			break
+		}
+	}
+	if start < fixedSize || start > end || end > len(buf) {
+		return nil, errors.Errorf("invalid offset for field %d", index)
+	}
+
+	return buf[start:end], nil
+}
+
+// Root returns the hash tree root of the node at the given generalized index
+// of an object's merkle tree.
+func Root(obj ssz.HashRoot, generalizedIndex int) ([32]byte, error) {
+	tree, err := obj.GetTree()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "failed to build tree")
+	}
+	node, err := tree.Get(generalizedIndex)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "failed to obtain node")
+	}
+
+	var root [32]byte
+	copy(root[:], node.Hash())
+
+	return root, nil
+}
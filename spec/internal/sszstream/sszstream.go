@@ -0,0 +1,221 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sszstream provides helpers to decode large SSZ containers from a stream,
+// without first holding the entire encoded container in memory.
+package sszstream
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// bufferSize is the size of the read buffer used when streaming.
+const bufferSize = 64 * 1024
+
+// maxPreallocate is the maximum number of list items allocated in advance,
+// to avoid a corrupt offset causing a large allocation.
+const maxPreallocate = 1 << 20
+
+// Field is a variable-length field of a container.
+type Field struct {
+	// Offset is the position of the field's offset in the fixed part of the container.
+	Offset int
+	// Decode decodes the field from the stream, reading exactly length bytes.
+	// If nil, the field is instead passed to the container's own decoder.
+	Decode func(r io.Reader, length uint64) error
+}
+
+// Unmarshal decodes a container with the given fixed size and variable-length fields from a stream.
+//
+// Fields with a decoder are decoded directly from the stream.  The fixed part of the container and
+// any remaining fields are then passed to unmarshal, with the offsets of the streamed fields altered
+// so that they appear empty.  This allows the generated decoder to be used for all but the largest
+// fields.  unmarshal will overwrite streamed fields with empty values, so decoders should store
+// their results elsewhere and they should be set in the container after this returns.
+//
+// fields must be in the order in which they appear in the container, and the final field must not
+// have a decoder, as its length is not known until the stream is exhausted.
+func Unmarshal(r io.Reader, fixedSize int, fields []*Field, unmarshal func([]byte) error) error {
+	if len(fields) == 0 || fields[len(fields)-1].Decode != nil {
+		return errors.New("final field cannot be streamed")
+	}
+
+	buf := make([]byte, fixedSize, fixedSize+bufferSize)
+	if err := readFull(r, buf); err != nil {
+		return errors.Wrap(err, "failed to read fixed fields")
+	}
+
+	offsets := make([]uint64, len(fields))
+	for i, field := range fields {
+		offsets[i] = uint64(binary.LittleEndian.Uint32(buf[field.Offset : field.Offset+4]))
+		if i == 0 && offsets[i] != uint64(fixedSize) {
+			return errors.New("invalid first offset")
+		}
+		if i > 0 && offsets[i] < offsets[i-1] {
+			return errors.Errorf("offset for field %d before previous field", i)
+		}
+	}
+
+	br := bufio.NewReaderSize(r, bufferSize)
+	for i, field := range fields {
+		// The new offset reflects only those fields retained in the buffer.
+		binary.LittleEndian.PutUint32(buf[field.Offset:field.Offset+4], uint32(len(buf)))
+		if i == len(fields)-1 {
+			remainder, err := io.ReadAll(br)
+			if err != nil {
+				return errors.Wrap(err, "failed to read final field")
+			}
+			buf = append(buf, remainder...)
+
+			break
+		}
+
+		length := offsets[i+1] - offsets[i]
+		if field.Decode == nil {
+			start := len(buf)
+			buf = append(buf, make([]byte, length)...)
+			if err := readFull(br, buf[start:]); err != nil {
+				return errors.Wrapf(err, "failed to read field %d", i)
+			}
+
+			continue
+		}
+		if err := field.Decode(br, length); err != nil {
+			return errors.Wrapf(err, "failed to decode field %d", i)
+		}
+	}
+
+	return unmarshal(buf)
+}
+
+// readFull fills the buffer from the reader.
+// Running out of data is always unexpected, as the length of the data is known in advance.
+func readFull(r io.Reader, buf []byte) error {
+	_, err := io.ReadFull(r, buf)
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// itemCount returns the number of items of the given size in a list of the given length.
+func itemCount(length uint64, itemSize uint64, limit uint64) (uint64, error) {
+	if length%itemSize != 0 {
+		return 0, errors.Errorf("length %d not a multiple of item size %d", length, itemSize)
+	}
+	count := length / itemSize
+	if count > limit {
+		return 0, errors.Errorf("list of %d items exceeds limit of %d", count, limit)
+	}
+
+	return count, nil
+}
+
+// preallocate returns the number of items to allocate in advance for a list.
+func preallocate(count uint64) uint64 {
+	if count > maxPreallocate {
+		return maxPreallocate
+	}
+
+	return count
+}
+
+// DecodeUint64s decodes a list of 64-bit values.
+func DecodeUint64s[T ~uint64](r io.Reader, length uint64, limit uint64) ([]T, error) {
+	count, err := itemCount(length, 8, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]T, 0, preallocate(count))
+	chunk := make([]byte, bufferSize)
+	for remaining := length; remaining > 0; {
+		size := uint64(len(chunk))
+		if remaining < size {
+			size = remaining
+		}
+		if err := readFull(r, chunk[:size]); err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < size; i += 8 {
+			res = append(res, T(binary.LittleEndian.Uint64(chunk[i:i+8])))
+		}
+		remaining -= size
+	}
+
+	return res, nil
+}
+
+// DecodeUint8s decodes a list of 8-bit values.
+func DecodeUint8s[T ~uint8](r io.Reader, length uint64, limit uint64) ([]T, error) {
+	count, err := itemCount(length, 1, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]T, 0, preallocate(count))
+	chunk := make([]byte, bufferSize)
+	for remaining := length; remaining > 0; {
+		size := uint64(len(chunk))
+		if remaining < size {
+			size = remaining
+		}
+		if err := readFull(r, chunk[:size]); err != nil {
+			return nil, err
+		}
+		for _, val := range chunk[:size] {
+			res = append(res, T(val))
+		}
+		remaining -= size
+	}
+
+	return res, nil
+}
+
+// DecodeContainers decodes a list of fixed-size containers.
+func DecodeContainers[T any, PT interface {
+	*T
+	UnmarshalSSZ([]byte) error
+}](r io.Reader,
+	length uint64,
+	itemSize uint64,
+	limit uint64,
+) (
+	[]*T,
+	error,
+) {
+	count, err := itemCount(length, itemSize, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*T, 0, preallocate(count))
+	item := make([]byte, itemSize)
+	for i := uint64(0); i < count; i++ {
+		if err := readFull(r, item); err != nil {
+			return nil, err
+		}
+		container := new(T)
+		if err := PT(container).UnmarshalSSZ(item); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode item %d", i)
+		}
+		res = append(res, container)
+	}
+
+	return res, nil
+}
@@ -1,6 +1,9 @@
 // Code generated by fastssz. DO NOT EDIT.
 // Hash: 09f9d13610aa245fa594574f924367376c4581eede1015ebc05b9a4ae17f9919
 // Version: 0.1.3
+
+//go:build !minimal
+
 package phase0
 
 import (
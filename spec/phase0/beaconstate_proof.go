@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+import (
+	"math"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// Generalized indices of beacon state fields.
+// The positions of these fields are unchanged in later forks, and beacon states
+// up to and including deneb have at most 32 fields so share the same tree depth,
+// hence the indices and the helpers below apply to all of these states.
+// Electra beacon states have more than 32 fields, and so have their own indices.
+const (
+	GeneralizedIndexGenesisTime           = uint64(32)
+	GeneralizedIndexGenesisValidatorsRoot = uint64(33)
+	GeneralizedIndexSlot                  = uint64(34)
+	GeneralizedIndexFork                  = uint64(35)
+	GeneralizedIndexLatestBlockHeader     = uint64(36)
+	GeneralizedIndexBlockRoots            = uint64(37)
+	GeneralizedIndexStateRoots            = uint64(38)
+	GeneralizedIndexHistoricalRoots       = uint64(39)
+	GeneralizedIndexETH1Data              = uint64(40)
+	GeneralizedIndexETH1DataVotes         = uint64(41)
+	GeneralizedIndexETH1DepositIndex      = uint64(42)
+	GeneralizedIndexValidators            = uint64(43)
+	GeneralizedIndexBalances              = uint64(44)
+	GeneralizedIndexRANDAOMixes           = uint64(45)
+	GeneralizedIndexSlashings             = uint64(46)
+)
+
+const (
+	// registryLimitDepth is the depth of the validators list, with a limit of 2^40.
+	registryLimitDepth = 40
+	// balancesLimitDepth is the depth of the balances list, with 4 balances per chunk.
+	balancesLimitDepth = 38
+	// validatorDepth is the depth of a validator, with 8 fields.
+	validatorDepth = 3
+)
+
+// ValidatorGeneralizedIndex returns the generalized index of the given validator in the beacon state.
+func ValidatorGeneralizedIndex(index ValidatorIndex) uint64 {
+	// Multiplying by 2 moves past the length mixin to the list data.
+	return (GeneralizedIndexValidators*2)<<registryLimitDepth + uint64(index)
+}
+
+// ValidatorPubKeyGeneralizedIndex returns the generalized index of the public key of the given validator in the beacon state.
+func ValidatorPubKeyGeneralizedIndex(index ValidatorIndex) uint64 {
+	return ValidatorGeneralizedIndex(index)<<validatorDepth + 0
+}
+
+// ValidatorWithdrawalCredentialsGeneralizedIndex returns the generalized index of the withdrawal credentials
+// of the given validator in the beacon state.
+func ValidatorWithdrawalCredentialsGeneralizedIndex(index ValidatorIndex) uint64 {
+	return ValidatorGeneralizedIndex(index)<<validatorDepth + 1
+}
+
+// ValidatorEffectiveBalanceGeneralizedIndex returns the generalized index of the effective balance
+// of the given validator in the beacon state.
+func ValidatorEffectiveBalanceGeneralizedIndex(index ValidatorIndex) uint64 {
+	return ValidatorGeneralizedIndex(index)<<validatorDepth + 2
+}
+
+// BalanceGeneralizedIndex returns the generalized index of the chunk holding the balance of the given
+// validator in the beacon state.
+// Each chunk holds 4 balances; the balance is at byte offset 8*(index%4) of the leaf.
+func BalanceGeneralizedIndex(index ValidatorIndex) uint64 {
+	return (GeneralizedIndexBalances*2)<<balancesLimitDepth + uint64(index)/4
+}
+
+// ProveGeneralizedIndex generates a proof for the given generalized index of an SSZ object.
+func ProveGeneralizedIndex(obj ssz.HashRoot, generalizedIndex uint64) (*ssz.Proof, error) {
+	if generalizedIndex == 0 || generalizedIndex > math.MaxInt64 {
+		return nil, errors.New("invalid generalized index")
+	}
+
+	tree, err := ssz.ProofTree(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build tree")
+	}
+
+	proof, err := tree.Prove(int(generalizedIndex))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate proof")
+	}
+	if proof.Leaf == nil {
+		// The index refers to a branch rather than a leaf, for example a container,
+		// so the leaf of the proof is the root of the branch.
+		node, err := tree.Get(int(generalizedIndex))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain node")
+		}
+		proof.Leaf = node.Hash()
+	}
+
+	return proof, nil
+}
+
+// Prove generates a proof for the given generalized index of the beacon state.
+func (b *BeaconState) Prove(generalizedIndex uint64) (*ssz.Proof, error) {
+	return ProveGeneralizedIndex(b, generalizedIndex)
+}
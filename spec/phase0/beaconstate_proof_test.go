@@ -0,0 +1,66 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestGeneralizedIndices(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    uint64
+		expected uint64
+	}{
+		{
+			name:     "Validator0PubKey",
+			index:    phase0.ValidatorPubKeyGeneralizedIndex(0),
+			expected: 756463999909888,
+		},
+		{
+			name:     "Validator0WithdrawalCredentials",
+			index:    phase0.ValidatorWithdrawalCredentialsGeneralizedIndex(0),
+			expected: 756463999909889,
+		},
+		{
+			name:     "Validator5EffectiveBalance",
+			index:    phase0.ValidatorEffectiveBalanceGeneralizedIndex(5),
+			expected: 756463999909930,
+		},
+		{
+			name:     "Balance7",
+			index:    phase0.BalanceGeneralizedIndex(7),
+			expected: 24189255811073,
+		},
+		{
+			name:     "Balance1000",
+			index:    phase0.BalanceGeneralizedIndex(1000),
+			expected: 24189255811322,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.index)
+		})
+	}
+}
+
+func TestProveInvalid(t *testing.T) {
+	_, err := (&phase0.BeaconState{}).Prove(0)
+	require.EqualError(t, err, "invalid generalized index")
+}
@@ -0,0 +1,36 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0
+
+// Domain types introduced in phase 0.
+var (
+	// DomainBeaconProposer is the domain type for beacon block proposals.
+	DomainBeaconProposer = DomainType{0x00, 0x00, 0x00, 0x00}
+	// DomainBeaconAttester is the domain type for attestations.
+	DomainBeaconAttester = DomainType{0x01, 0x00, 0x00, 0x00}
+	// DomainRANDAO is the domain type for RANDAO reveals.
+	DomainRANDAO = DomainType{0x02, 0x00, 0x00, 0x00}
+	// DomainDeposit is the domain type for deposits.
+	DomainDeposit = DomainType{0x03, 0x00, 0x00, 0x00}
+	// DomainVoluntaryExit is the domain type for voluntary exits.
+	DomainVoluntaryExit = DomainType{0x04, 0x00, 0x00, 0x00}
+	// DomainSelectionProof is the domain type for aggregator selection proofs.
+	DomainSelectionProof = DomainType{0x05, 0x00, 0x00, 0x00}
+	// DomainAggregateAndProof is the domain type for aggregate and proofs.
+	DomainAggregateAndProof = DomainType{0x06, 0x00, 0x00, 0x00}
+	// DomainApplicationMask is the domain type mask for application-specific domains.
+	DomainApplicationMask = DomainType{0x00, 0x00, 0x00, 0x01}
+	// DomainApplicationBuilder is the domain type for builder API signatures.
+	DomainApplicationBuilder = DomainType{0x00, 0x00, 0x00, 0x01}
+)
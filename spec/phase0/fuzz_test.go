@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/codectest"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+func FuzzAggregateAndProof(f *testing.F) {
+	codectest.Fuzz(f, &phase0.AggregateAndProof{})
+}
+
+func FuzzAttestation(f *testing.F) {
+	codectest.Fuzz(f, &phase0.Attestation{})
+}
+
+func FuzzAttestationData(f *testing.F) {
+	codectest.Fuzz(f, &phase0.AttestationData{})
+}
+
+func FuzzAttesterSlashing(f *testing.F) {
+	codectest.Fuzz(f, &phase0.AttesterSlashing{})
+}
+
+func FuzzBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &phase0.BeaconBlock{})
+}
+
+func FuzzBeaconBlockBody(f *testing.F) {
+	codectest.Fuzz(f, &phase0.BeaconBlockBody{})
+}
+
+func FuzzBeaconBlockHeader(f *testing.F) {
+	codectest.Fuzz(f, &phase0.BeaconBlockHeader{})
+}
+
+func FuzzBeaconState(f *testing.F) {
+	codectest.Fuzz(f, &phase0.BeaconState{})
+}
+
+func FuzzCheckpoint(f *testing.F) {
+	codectest.Fuzz(f, &phase0.Checkpoint{})
+}
+
+func FuzzDeposit(f *testing.F) {
+	codectest.Fuzz(f, &phase0.Deposit{})
+}
+
+func FuzzDepositData(f *testing.F) {
+	codectest.Fuzz(f, &phase0.DepositData{})
+}
+
+func FuzzDepositMessage(f *testing.F) {
+	codectest.Fuzz(f, &phase0.DepositMessage{})
+}
+
+func FuzzETH1Data(f *testing.F) {
+	codectest.Fuzz(f, &phase0.ETH1Data{})
+}
+
+func FuzzFork(f *testing.F) {
+	codectest.Fuzz(f, &phase0.Fork{})
+}
+
+func FuzzForkData(f *testing.F) {
+	codectest.Fuzz(f, &phase0.ForkData{})
+}
+
+func FuzzIndexedAttestation(f *testing.F) {
+	codectest.Fuzz(f, &phase0.IndexedAttestation{})
+}
+
+func FuzzPendingAttestation(f *testing.F) {
+	codectest.Fuzz(f, &phase0.PendingAttestation{})
+}
+
+func FuzzProposerSlashing(f *testing.F) {
+	codectest.Fuzz(f, &phase0.ProposerSlashing{})
+}
+
+func FuzzSignedAggregateAndProof(f *testing.F) {
+	codectest.Fuzz(f, &phase0.SignedAggregateAndProof{})
+}
+
+func FuzzSignedBeaconBlock(f *testing.F) {
+	codectest.Fuzz(f, &phase0.SignedBeaconBlock{})
+}
+
+func FuzzSignedBeaconBlockHeader(f *testing.F) {
+	codectest.Fuzz(f, &phase0.SignedBeaconBlockHeader{})
+}
+
+func FuzzSignedVoluntaryExit(f *testing.F) {
+	codectest.Fuzz(f, &phase0.SignedVoluntaryExit{})
+}
+
+func FuzzSigningData(f *testing.F) {
+	codectest.Fuzz(f, &phase0.SigningData{})
+}
+
+func FuzzValidator(f *testing.F) {
+	codectest.Fuzz(f, &phase0.Validator{})
+}
+
+func FuzzVoluntaryExit(f *testing.F) {
+	codectest.Fuzz(f, &phase0.VoluntaryExit{})
+}
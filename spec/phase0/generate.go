@@ -14,5 +14,10 @@
 package phase0
 
 // Need to `go install github.com/ferranbt/fastssz/sszgen@latest` for this to work.
+// Set SSZ_PRESET to a preset name, file or directory to generate for a preset other than mainnet.
+// Types whose encoding depends on the preset also have minimal preset encodings, selected with the minimal build tag.
 //go:generate rm -f aggregateandproof_encoding.go attestationdata_encoding.go attestation_encoding.go attesterslashing_encoding.go beaconblockbody_encoding.go beaconblock_encoding.go beaconblockheader_encoding.go beaconstate_encoding.go checkpoint_encoding.go depositdata_encoding.go deposit_encoding.go depositmessage_encoding.go eth1data_encoding.go forkdata_encoding.go fork_encoding.go indexedattestation_encoding.go pendingattestation_encoding.go proposerslashing_encoding.go signedaggregateandproof_encoding.go signedbeaconblock_encoding.go signedbeaconblockheader_encoding.go signedvoluntaryexit_encoding.go signingdata_encoding.go validator_encoding.go voluntaryexit_encoding.go
-//go:generate sszgen --path . --objs AggregateAndProof,AttestationData,Attestation,AttesterSlashing,BeaconBlockBody,BeaconBlock,BeaconBlockHeader,BeaconState,Checkpoint,Deposit,DepositData,DepositMessage,ETH1Data,Fork,ForkData,IndexedAttestation,PendingAttestation,ProposerSlashing,SignedAggregateAndProof,SignedBeaconBlock,SignedBeaconBlockHeader,SignedVoluntaryExit,SigningData,Validator,VoluntaryExit
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --path . --objs AggregateAndProof,AttestationData,Attestation,AttesterSlashing,BeaconBlockBody,BeaconBlock,BeaconBlockHeader,Checkpoint,Deposit,DepositData,DepositMessage,ETH1Data,Fork,ForkData,IndexedAttestation,PendingAttestation,ProposerSlashing,SignedAggregateAndProof,SignedBeaconBlock,SignedBeaconBlockHeader,SignedVoluntaryExit,SigningData,Validator,VoluntaryExit
+//go:generate go run ../../cmd/presetsszgen --preset=$SSZ_PRESET --build-tag=!minimal --path . --objs BeaconState
+//go:generate rm -f beaconstate_encoding_minimal.go
+//go:generate go run ../../cmd/presetsszgen --preset=minimal --build-tag=minimal --suffix=encoding_minimal --path . --objs BeaconState
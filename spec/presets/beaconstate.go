@@ -0,0 +1,65 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DecodeBeaconState decodes the SSZ encoding of a beacon state of the given
+// version with the sizes of preset-dependent fields taken from the preset.
+func (p *Preset) DecodeBeaconState(version spec.DataVersion, data []byte) (*spec.VersionedBeaconState, error) {
+	res := &spec.VersionedBeaconState{
+		Version: version,
+	}
+
+	var state interface{}
+	switch version {
+	case spec.DataVersionPhase0:
+		res.Phase0 = &phase0.BeaconState{}
+		state = res.Phase0
+	case spec.DataVersionAltair:
+		res.Altair = &altair.BeaconState{}
+		state = res.Altair
+	case spec.DataVersionBellatrix:
+		res.Bellatrix = &bellatrix.BeaconState{}
+		state = res.Bellatrix
+	case spec.DataVersionCapella:
+		res.Capella = &capella.BeaconState{}
+		state = res.Capella
+	case spec.DataVersionDeneb:
+		res.Deneb = &deneb.BeaconState{}
+		state = res.Deneb
+	case spec.DataVersionElectra:
+		res.Electra = &electra.BeaconState{}
+		state = res.Electra
+	default:
+		return nil, fmt.Errorf("unsupported version %v", version)
+	}
+
+	if err := p.UnmarshalSSZ(state, data); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %v beacon state", version)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBeaconState(t *testing.T) {
+	p := presets.New("custom", map[string]uint64{
+		"SLOTS_PER_HISTORICAL_ROOT":    4,
+		"EPOCHS_PER_HISTORICAL_VECTOR": 2,
+		"EPOCHS_PER_SLASHINGS_VECTOR":  2,
+	})
+	state := &phase0.BeaconState{
+		GenesisTime:                 1,
+		Slot:                        2,
+		Fork:                        &phase0.Fork{},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, 4),
+		StateRoots:                  make([]phase0.Root, 4),
+		HistoricalRoots:             []phase0.Root{},
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes:               []*phase0.ETH1Data{},
+		Validators:                  []*phase0.Validator{},
+		Balances:                    []phase0.Gwei{},
+		RANDAOMixes:                 make([]phase0.Root, 2),
+		Slashings:                   []phase0.Gwei{3, 4},
+		PreviousEpochAttestations:   []*phase0.PendingAttestation{},
+		CurrentEpochAttestations:    []*phase0.PendingAttestation{},
+		JustificationBits:           []byte{0x01},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+	}
+	data, err := p.MarshalSSZ(state)
+	require.NoError(t, err)
+
+	// The generated code cannot decode the state, as it uses a different preset.
+	require.Error(t, (&phase0.BeaconState{}).UnmarshalSSZ(data))
+
+	res, err := p.DecodeBeaconState(spec.DataVersionPhase0, data)
+	require.NoError(t, err)
+	require.Equal(t, spec.DataVersionPhase0, res.Version)
+	require.Equal(t, state, res.Phase0)
+
+	_, err = p.DecodeBeaconState(spec.DataVersionAltair, data)
+	require.ErrorContains(t, err, "failed to decode altair beacon state")
+	_, err = p.DecodeBeaconState(spec.DataVersionUnknown, data)
+	require.EqualError(t, err, "unsupported version unknown")
+}
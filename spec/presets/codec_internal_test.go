@@ -0,0 +1,220 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+type sszObject interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// generatedObjects returns empty objects with generated SSZ code.
+func generatedObjects() map[string]func() sszObject {
+	return map[string]func() sszObject{
+		"phase0.BeaconState":          func() sszObject { return &phase0.BeaconState{} },
+		"phase0.SignedBeaconBlock":    func() sszObject { return &phase0.SignedBeaconBlock{} },
+		"altair.BeaconState":          func() sszObject { return &altair.BeaconState{} },
+		"altair.SignedBeaconBlock":    func() sszObject { return &altair.SignedBeaconBlock{} },
+		"altair.ContributionAndProof": func() sszObject { return &altair.ContributionAndProof{} },
+		"bellatrix.BeaconState":       func() sszObject { return &bellatrix.BeaconState{} },
+		"bellatrix.SignedBeaconBlock": func() sszObject { return &bellatrix.SignedBeaconBlock{} },
+		"capella.BeaconState":         func() sszObject { return &capella.BeaconState{} },
+		"capella.SignedBeaconBlock":   func() sszObject { return &capella.SignedBeaconBlock{} },
+		"deneb.BeaconState":           func() sszObject { return &deneb.BeaconState{} },
+		"deneb.SignedBeaconBlock":     func() sszObject { return &deneb.SignedBeaconBlock{} },
+		"electra.BeaconState":         func() sszObject { return &electra.BeaconState{} },
+		"electra.SignedBeaconBlock":   func() sszObject { return &electra.SignedBeaconBlock{} },
+		"electra.Attestation":         func() sszObject { return &electra.Attestation{} },
+	}
+}
+
+// fill fills a value with random data that is valid for its SSZ type.
+func fill(rng *rand.Rand, typ *sszType, val reflect.Value) {
+	switch typ.kind {
+	case kindUint:
+		val.SetUint(rng.Uint64() >> (64 - 8*typ.size))
+	case kindBool:
+		val.SetBool(rng.Intn(2) == 1)
+	case kindUint256:
+		val.Set(reflect.ValueOf(new(uint256.Int).SetBytes32(randomBytes(rng, 32))))
+	case kindBytes:
+		decodeBytes(randomBytes(rng, typ.size), val)
+	case kindByteList:
+		decodeBytes(randomBytes(rng, rng.Intn(smallLength(typ.length, 64)+1)), val)
+	case kindBitlist:
+		bits := bitfield.NewBitlist(uint64(rng.Intn(smallLength(typ.length, 64) + 1)))
+		for i := uint64(0); i < bits.Len(); i++ {
+			bits.SetBitAt(i, rng.Intn(2) == 1)
+		}
+		decodeBytes(bits, val)
+	case kindVector, kindList:
+		count := int(typ.length)
+		if typ.kind == kindList {
+			count = rng.Intn(smallLength(typ.length, 3) + 1)
+		}
+		res := val
+		if val.Kind() == reflect.Slice {
+			res = reflect.MakeSlice(val.Type(), count, count)
+		}
+		for i := 0; i < count; i++ {
+			fill(rng, typ.elem, res.Index(i))
+		}
+		val.Set(res)
+	case kindContainer:
+		container := reflect.New(val.Type().Elem())
+		for _, field := range typ.fields {
+			fill(rng, field.typ, container.Elem().Field(field.index))
+		}
+		val.Set(container)
+	}
+}
+
+func smallLength(length uint64, limit int) int {
+	if length < uint64(limit) {
+		return int(length)
+	}
+
+	return limit
+}
+
+func randomBytes(rng *rand.Rand, length int) []byte {
+	res := make([]byte, length)
+	_, _ = rng.Read(res)
+
+	return res
+}
+
+// randomObject returns a pointer to a struct filled with random data for the preset.
+func randomObject(t *testing.T, p *Preset, rng *rand.Rand, obj interface{}) {
+	t.Helper()
+
+	typ, err := p.typeOf(reflect.TypeOf(obj))
+	require.NoError(t, err)
+	val := reflect.New(reflect.TypeOf(obj))
+	fill(rng, typ, val.Elem())
+	reflect.ValueOf(obj).Elem().Set(val.Elem().Elem())
+}
+
+func TestCompiledPreset(t *testing.T) {
+	p := compiledPreset()
+	rng := rand.New(rand.NewSource(1))
+
+	for name, generator := range generatedObjects() {
+		t.Run(name, func(t *testing.T) {
+			obj := generator()
+			randomObject(t, p, rng, obj)
+
+			expected, err := obj.MarshalSSZ()
+			require.NoError(t, err)
+			data, err := p.MarshalSSZ(obj)
+			require.NoError(t, err)
+			require.Equal(t, expected, data)
+			size, err := p.SizeSSZ(obj)
+			require.NoError(t, err)
+			require.Equal(t, obj.SizeSSZ(), size)
+
+			expectedRoot, err := obj.HashTreeRoot()
+			require.NoError(t, err)
+			root, err := p.HashTreeRoot(obj)
+			require.NoError(t, err)
+			require.Equal(t, expectedRoot, root)
+
+			decoded := generator()
+			require.NoError(t, p.UnmarshalSSZ(decoded, data))
+			require.Equal(t, obj, decoded)
+		})
+	}
+}
+
+func TestOtherPresets(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+
+	for _, p := range []*Preset{Mainnet(), Minimal(), Gnosis(), New("custom", map[string]uint64{"SLOTS_PER_HISTORICAL_ROOT": 16})} {
+		t.Run(p.Name(), func(t *testing.T) {
+			state := &deneb.BeaconState{}
+			randomObject(t, p, rng, state)
+			require.Len(t, state.BlockRoots, int(value(t, p, "SLOTS_PER_HISTORICAL_ROOT")))
+
+			data, err := p.MarshalSSZ(state)
+			require.NoError(t, err)
+			root, err := p.HashTreeRoot(state)
+			require.NoError(t, err)
+
+			decoded := &deneb.BeaconState{}
+			require.NoError(t, p.UnmarshalSSZ(decoded, data))
+			require.Equal(t, state, decoded)
+			decodedRoot, err := p.HashTreeRoot(decoded)
+			require.NoError(t, err)
+			require.Equal(t, root, decodedRoot)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	p := Mainnet()
+	rng := rand.New(rand.NewSource(3))
+	block := &phase0.BeaconBlock{}
+	randomObject(t, p, rng, block)
+	data, err := p.MarshalSSZ(block)
+	require.NoError(t, err)
+
+	_, err = p.MarshalSSZ(phase0.BeaconBlock{})
+	require.EqualError(t, err, "unsupported type phase0.BeaconBlock; must be a pointer to a struct")
+	_, err = p.MarshalSSZ((*phase0.BeaconBlock)(nil))
+	require.EqualError(t, err, "nil object")
+
+	state := &phase0.BeaconState{}
+	randomObject(t, p, rng, state)
+	state.BlockRoots = state.BlockRoots[1:]
+	_, err = p.MarshalSSZ(state)
+	require.EqualError(t, err, "BlockRoots: incorrect length 8191, expected 8192")
+	_, err = p.HashTreeRoot(state)
+	require.EqualError(t, err, "BlockRoots: incorrect length 8191, expected 8192")
+
+	require.EqualError(t, p.UnmarshalSSZ(&phase0.BeaconBlock{}, data[:50]), "incorrect size 50, expected 84")
+	require.EqualError(t, p.UnmarshalSSZ(&phase0.BeaconBlock{}, append(data[:80:80], 0x00, 0x00, 0x00, 0x00)), "Body: invalid ssz encoding. first variable element offset indexes into fixed value data")
+	require.EqualError(t, p.UnmarshalSSZ(&phase0.Checkpoint{}, make([]byte, 41)), "incorrect size 41, expected 40")
+
+	type unsupported struct {
+		Value int
+	}
+	_, err = p.MarshalSSZ(&unsupported{})
+	require.EqualError(t, err, "unsupported.Value: unsupported type int")
+}
+
+func value(t *testing.T, p *Preset, name string) uint64 {
+	t.Helper()
+
+	val, exists := p.Value(name)
+	require.True(t, exists)
+
+	return val
+}
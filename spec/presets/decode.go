@@ -0,0 +1,234 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// UnmarshalSSZ decodes the SSZ encoding of an object in to the object, which
+// must be a pointer to a struct, with the sizes of preset-dependent fields taken
+// from the preset.
+func (p *Preset) UnmarshalSSZ(obj interface{}, data []byte) error {
+	val := reflect.ValueOf(obj)
+	typ, err := p.typeOf(val.Type())
+	if err != nil {
+		return err
+	}
+	if val.IsNil() {
+		return errors.New("nil object")
+	}
+
+	return decodeContainer(data, typ, val.Elem())
+}
+
+// decode decodes the data in to a settable value.
+func decode(data []byte, typ *sszType, val reflect.Value) error {
+	if typ.size != 0 && len(data) != typ.size {
+		return fmt.Errorf("incorrect size %d, expected %d", len(data), typ.size)
+	}
+
+	switch typ.kind {
+	case kindUint:
+		val.SetUint(decodeUint(data))
+	case kindBool:
+		switch data[0] {
+		case 0x00:
+			val.SetBool(false)
+		case 0x01:
+			val.SetBool(true)
+		default:
+			return fmt.Errorf("invalid boolean value %#x", data[0])
+		}
+	case kindUint256:
+		be := make([]byte, 32)
+		for i := 0; i < 32; i++ {
+			be[i] = data[31-i]
+		}
+		val.Set(reflect.ValueOf(new(uint256.Int).SetBytes32(be)))
+	case kindBytes:
+		decodeBytes(data, val)
+	case kindByteList:
+		if uint64(len(data)) > typ.length {
+			return fmt.Errorf("length %d exceeds maximum %d", len(data), typ.length)
+		}
+		decodeBytes(data, val)
+	case kindBitlist:
+		if err := ssz.ValidateBitlist(data, typ.length); err != nil {
+			return err
+		}
+		decodeBytes(data, val)
+	case kindVector, kindList:
+		return decodeSequence(data, typ, val)
+	case kindContainer:
+		container := reflect.New(val.Type().Elem())
+		if err := decodeContainer(data, typ, container.Elem()); err != nil {
+			return err
+		}
+		val.Set(container)
+	default:
+		return fmt.Errorf("unhandled kind %d", typ.kind)
+	}
+
+	return nil
+}
+
+// decodeUint decodes a little-endian unsigned integer.
+func decodeUint(data []byte) uint64 {
+	switch len(data) {
+	case 1:
+		return uint64(data[0])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(data))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(data))
+	default:
+		return binary.LittleEndian.Uint64(data)
+	}
+}
+
+// decodeBytes decodes data in to a settable byte array or slice.
+func decodeBytes(data []byte, val reflect.Value) {
+	if val.Kind() == reflect.Array {
+		copy(val.Bytes(), data)
+
+		return
+	}
+	res := reflect.MakeSlice(val.Type(), len(data), len(data))
+	copy(res.Bytes(), data)
+	val.Set(res)
+}
+
+// decodeSequence decodes a vector or list.
+func decodeSequence(data []byte, typ *sszType, val reflect.Value) error {
+	var offsets []int
+	count := 0
+	if typ.elem.size != 0 {
+		if len(data)%typ.elem.size != 0 {
+			return fmt.Errorf("incorrect size %d for elements of size %d", len(data), typ.elem.size)
+		}
+		count = len(data) / typ.elem.size
+	} else {
+		var err error
+		offsets, err = readOffsets(data)
+		if err != nil {
+			return err
+		}
+		count = len(offsets)
+	}
+
+	switch {
+	case typ.kind == kindVector && uint64(count) != typ.length:
+		return fmt.Errorf("incorrect length %d, expected %d", count, typ.length)
+	case typ.kind == kindList && uint64(count) > typ.length:
+		return fmt.Errorf("length %d exceeds maximum %d", count, typ.length)
+	}
+
+	res := val
+	if val.Kind() == reflect.Slice {
+		res = reflect.MakeSlice(val.Type(), count, count)
+	}
+	for i := 0; i < count; i++ {
+		var elemData []byte
+		if offsets == nil {
+			elemData = data[i*typ.elem.size : (i+1)*typ.elem.size]
+		} else {
+			end := len(data)
+			if i+1 < count {
+				end = offsets[i+1]
+			}
+			elemData = data[offsets[i]:end]
+		}
+		if err := decode(elemData, typ.elem, res.Index(i)); err != nil {
+			return errors.Wrapf(err, "element %d", i)
+		}
+	}
+	if val.Kind() == reflect.Slice {
+		val.Set(res)
+	}
+
+	return nil
+}
+
+// readOffsets reads the offsets of a sequence of variable-sized elements.
+func readOffsets(data []byte) ([]int, error) {
+	if len(data) == 0 {
+		return []int{}, nil
+	}
+	if len(data) < 4 {
+		return nil, ssz.ErrOffset
+	}
+	first := int(ssz.ReadOffset(data[0:4]))
+	if first%4 != 0 || first == 0 || first > len(data) {
+		return nil, ssz.ErrOffset
+	}
+	offsets := make([]int, first/4)
+	for i := range offsets {
+		offsets[i] = int(ssz.ReadOffset(data[i*4 : i*4+4]))
+		if offsets[i] > len(data) || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, ssz.ErrOffset
+		}
+	}
+
+	return offsets, nil
+}
+
+// decodeContainer decodes a container in to a settable struct.
+func decodeContainer(data []byte, typ *sszType, val reflect.Value) error {
+	if len(data) < typ.fixedSize || (typ.size != 0 && len(data) != typ.size) {
+		return fmt.Errorf("incorrect size %d, expected %d", len(data), typ.fixedSize)
+	}
+
+	variable := make([]*sszField, 0)
+	offsets := make([]int, 0)
+	pos := 0
+	for _, field := range typ.fields {
+		if field.typ.size == 0 {
+			offset := int(ssz.ReadOffset(data[pos : pos+4]))
+			switch {
+			case len(offsets) == 0 && offset != typ.fixedSize:
+				return errors.Wrap(ssz.ErrInvalidVariableOffset, field.name)
+			case offset > len(data), len(offsets) > 0 && offset < offsets[len(offsets)-1]:
+				return errors.Wrap(ssz.ErrOffset, field.name)
+			}
+			variable = append(variable, field)
+			offsets = append(offsets, offset)
+			pos += 4
+
+			continue
+		}
+		if err := decode(data[pos:pos+field.typ.size], field.typ, val.Field(field.index)); err != nil {
+			return errors.Wrap(err, field.name)
+		}
+		pos += field.typ.size
+	}
+
+	for i, field := range variable {
+		end := len(data)
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if err := decode(data[offsets[i]:end], field.typ, val.Field(field.index)); err != nil {
+			return errors.Wrap(err, field.name)
+		}
+	}
+
+	return nil
+}
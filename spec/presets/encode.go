@@ -0,0 +1,245 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// MarshalSSZ returns the SSZ encoding of an object, which must be a pointer to
+// a struct, with the sizes of preset-dependent fields taken from the preset.
+func (p *Preset) MarshalSSZ(obj interface{}) ([]byte, error) {
+	val := reflect.ValueOf(obj)
+	typ, err := p.typeOf(val.Type())
+	if err != nil {
+		return nil, err
+	}
+	if val.IsNil() {
+		return nil, errors.New("nil object")
+	}
+
+	return encode(make([]byte, 0, encodedSize(typ, val)), typ, val)
+}
+
+// SizeSSZ returns the size of the SSZ encoding of an object, which must be a
+// pointer to a struct, with the sizes of preset-dependent fields taken from the preset.
+func (p *Preset) SizeSSZ(obj interface{}) (int, error) {
+	val := reflect.ValueOf(obj)
+	typ, err := p.typeOf(val.Type())
+	if err != nil {
+		return 0, err
+	}
+	if val.IsNil() {
+		return 0, errors.New("nil object")
+	}
+
+	return encodedSize(typ, val), nil
+}
+
+// encodedSize returns the size of the encoding of a value.
+func encodedSize(typ *sszType, val reflect.Value) int {
+	if typ.size != 0 {
+		return typ.size
+	}
+
+	switch typ.kind {
+	case kindByteList, kindBitlist:
+		return val.Len()
+	case kindVector, kindList:
+		if typ.elem.size != 0 {
+			return val.Len() * typ.elem.size
+		}
+		res := 4 * val.Len()
+		for i := 0; i < val.Len(); i++ {
+			res += encodedSize(typ.elem, val.Index(i))
+		}
+
+		return res
+	case kindContainer:
+		val = containerValue(val)
+		res := typ.fixedSize
+		for _, field := range typ.fields {
+			if field.typ.size == 0 {
+				res += encodedSize(field.typ, val.Field(field.index))
+			}
+		}
+
+		return res
+	default:
+		return 0
+	}
+}
+
+// containerValue returns the struct referenced by a container pointer, using
+// an empty struct if the pointer is nil.
+func containerValue(val reflect.Value) reflect.Value {
+	if val.IsNil() {
+		return reflect.New(val.Type().Elem()).Elem()
+	}
+
+	return val.Elem()
+}
+
+// byteValues returns the contents of a byte array or slice.
+func byteValues(val reflect.Value) []byte {
+	if val.Kind() == reflect.Slice || val.CanAddr() {
+		return val.Bytes()
+	}
+	res := make([]byte, val.Len())
+	for i := range res {
+		res[i] = byte(val.Index(i).Uint())
+	}
+
+	return res
+}
+
+// encode appends the encoding of a value to the buffer.
+func encode(buf []byte, typ *sszType, val reflect.Value) ([]byte, error) {
+	switch typ.kind {
+	case kindUint:
+		return encodeUint(buf, typ.size, val.Uint()), nil
+	case kindBool:
+		if val.Bool() {
+			return append(buf, 0x01), nil
+		}
+
+		return append(buf, 0x00), nil
+	case kindUint256:
+		return append(buf, uint256Bytes(val)...), nil
+	case kindBytes:
+		data := byteValues(val)
+		if len(data) != typ.size {
+			return nil, fmt.Errorf("incorrect length %d, expected %d", len(data), typ.size)
+		}
+
+		return append(buf, data...), nil
+	case kindByteList:
+		data := byteValues(val)
+		if uint64(len(data)) > typ.length {
+			return nil, fmt.Errorf("length %d exceeds maximum %d", len(data), typ.length)
+		}
+
+		return append(buf, data...), nil
+	case kindBitlist:
+		data := byteValues(val)
+		if len(data) == 0 {
+			return nil, errors.New("bitlist is empty")
+		}
+		if bitfield.Bitlist(data).Len() > typ.length {
+			return nil, fmt.Errorf("bitlist length %d exceeds maximum %d", bitfield.Bitlist(data).Len(), typ.length)
+		}
+
+		return append(buf, data...), nil
+	case kindVector:
+		if uint64(val.Len()) != typ.length {
+			return nil, fmt.Errorf("incorrect length %d, expected %d", val.Len(), typ.length)
+		}
+
+		return encodeSequence(buf, typ.elem, val)
+	case kindList:
+		if uint64(val.Len()) > typ.length {
+			return nil, fmt.Errorf("length %d exceeds maximum %d", val.Len(), typ.length)
+		}
+
+		return encodeSequence(buf, typ.elem, val)
+	case kindContainer:
+		return encodeContainer(buf, typ, containerValue(val))
+	default:
+		return nil, fmt.Errorf("unhandled kind %d", typ.kind)
+	}
+}
+
+// encodeUint appends the little-endian encoding of an unsigned integer of the given size.
+func encodeUint(buf []byte, size int, val uint64) []byte {
+	switch size {
+	case 1:
+		return append(buf, byte(val))
+	case 2:
+		return binary.LittleEndian.AppendUint16(buf, uint16(val))
+	case 4:
+		return binary.LittleEndian.AppendUint32(buf, uint32(val))
+	default:
+		return binary.LittleEndian.AppendUint64(buf, val)
+	}
+}
+
+// uint256Bytes returns the little-endian encoding of a 256-bit unsigned integer.
+func uint256Bytes(val reflect.Value) []byte {
+	res := make([]byte, 32)
+	if val.IsNil() {
+		return res
+	}
+	be := val.Interface().(*uint256.Int).Bytes32()
+	for i := 0; i < 32; i++ {
+		res[i] = be[31-i]
+	}
+
+	return res
+}
+
+// encodeSequence appends the encoding of the elements of a vector or list.
+func encodeSequence(buf []byte, elem *sszType, val reflect.Value) ([]byte, error) {
+	var err error
+	if elem.size == 0 {
+		offset := 4 * val.Len()
+		for i := 0; i < val.Len(); i++ {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(offset))
+			offset += encodedSize(elem, val.Index(i))
+		}
+	}
+	for i := 0; i < val.Len(); i++ {
+		buf, err = encode(buf, elem, val.Index(i))
+		if err != nil {
+			return nil, errors.Wrapf(err, "element %d", i)
+		}
+	}
+
+	return buf, nil
+}
+
+// encodeContainer appends the encoding of a container.
+func encodeContainer(buf []byte, typ *sszType, val reflect.Value) ([]byte, error) {
+	var err error
+	offset := typ.fixedSize
+	for _, field := range typ.fields {
+		fieldVal := val.Field(field.index)
+		if field.typ.size == 0 {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(offset))
+			offset += encodedSize(field.typ, fieldVal)
+
+			continue
+		}
+		buf, err = encode(buf, field.typ, fieldVal)
+		if err != nil {
+			return nil, errors.Wrap(err, field.name)
+		}
+	}
+	for _, field := range typ.fields {
+		if field.typ.size != 0 {
+			continue
+		}
+		buf, err = encode(buf, field.typ, val.Field(field.index))
+		if err != nil {
+			return nil, errors.Wrap(err, field.name)
+		}
+	}
+
+	return buf, nil
+}
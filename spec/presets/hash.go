@@ -0,0 +1,137 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"fmt"
+	"reflect"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// HashTreeRoot returns the hash tree root of an object, which must be a pointer
+// to a struct, with the sizes of preset-dependent fields taken from the preset.
+func (p *Preset) HashTreeRoot(obj interface{}) ([32]byte, error) {
+	val := reflect.ValueOf(obj)
+	typ, err := p.typeOf(val.Type())
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if val.IsNil() {
+		return [32]byte{}, errors.New("nil object")
+	}
+
+	hh := ssz.NewHasher()
+	if err := hash(hh, typ, val); err != nil {
+		return [32]byte{}, err
+	}
+
+	return hh.HashRoot()
+}
+
+// hash appends the hash tree root of a value to the hasher.
+func hash(hh *ssz.Hasher, typ *sszType, val reflect.Value) error {
+	switch typ.kind {
+	case kindUint:
+		hh.AppendBytes32(encodeUint(nil, typ.size, val.Uint()))
+	case kindBool:
+		hh.PutBool(val.Bool())
+	case kindUint256:
+		hh.PutBytes(uint256Bytes(val))
+	case kindBytes:
+		data := byteValues(val)
+		if len(data) != typ.size {
+			return fmt.Errorf("incorrect length %d, expected %d", len(data), typ.size)
+		}
+		hh.PutBytes(data)
+	case kindByteList:
+		data := byteValues(val)
+		if uint64(len(data)) > typ.length {
+			return fmt.Errorf("length %d exceeds maximum %d", len(data), typ.length)
+		}
+		indx := hh.Index()
+		hh.AppendBytes32(data)
+		hh.MerkleizeWithMixin(indx, uint64(len(data)), (typ.length+31)/32)
+	case kindBitlist:
+		data := byteValues(val)
+		if len(data) == 0 {
+			return errors.New("bitlist is empty")
+		}
+		hh.PutBitlist(data, typ.length)
+	case kindVector:
+		if uint64(val.Len()) != typ.length {
+			return fmt.Errorf("incorrect length %d, expected %d", val.Len(), typ.length)
+		}
+		indx := hh.Index()
+		if err := hashElements(hh, typ.elem, val); err != nil {
+			return err
+		}
+		hh.Merkleize(indx)
+	case kindList:
+		count := uint64(val.Len())
+		if count > typ.length {
+			return fmt.Errorf("length %d exceeds maximum %d", count, typ.length)
+		}
+		indx := hh.Index()
+		if err := hashElements(hh, typ.elem, val); err != nil {
+			return err
+		}
+		limit := typ.length
+		if typ.elem.isBasic() {
+			limit = ssz.CalculateLimit(typ.length, count, uint64(typ.elem.size))
+		}
+		hh.MerkleizeWithMixin(indx, count, limit)
+	case kindContainer:
+		val = containerValue(val)
+		indx := hh.Index()
+		for _, field := range typ.fields {
+			if err := hash(hh, field.typ, val.Field(field.index)); err != nil {
+				return errors.Wrap(err, field.name)
+			}
+		}
+		hh.Merkleize(indx)
+	default:
+		return fmt.Errorf("unhandled kind %d", typ.kind)
+	}
+
+	return nil
+}
+
+// hashElements appends the elements of a vector or list to the hasher, packing
+// basic elements in to chunks and otherwise appending the root of each element.
+func hashElements(hh *ssz.Hasher, elem *sszType, val reflect.Value) error {
+	if elem.isBasic() {
+		data := make([]byte, 0, val.Len()*elem.size)
+		var err error
+		for i := 0; i < val.Len(); i++ {
+			data, err = encode(data, elem, val.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+		hh.Append(data)
+		hh.FillUpTo32()
+
+		return nil
+	}
+
+	for i := 0; i < val.Len(); i++ {
+		if err := hash(hh, elem, val.Index(i)); err != nil {
+			return errors.Wrapf(err, "element %d", i)
+		}
+	}
+
+	return nil
+}
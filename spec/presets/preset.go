@@ -0,0 +1,274 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// mainnetValues contains the values of the mainnet preset, along with the
+// constants that are required to calculate SSZ sizes.
+var mainnetValues = map[string]uint64{
+	// Constants.
+	"BYTES_PER_FIELD_ELEMENT":     32,
+	"DEPOSIT_CONTRACT_TREE_DEPTH": 32,
+	"SYNC_COMMITTEE_SUBNET_COUNT": 4,
+	"VALIDATOR_REGISTRY_LIMIT":    1099511627776,
+	// Phase 0.
+	"MAX_ATTESTATIONS":              128,
+	"MAX_ATTESTER_SLASHINGS":        2,
+	"MAX_COMMITTEES_PER_SLOT":       64,
+	"MAX_DEPOSITS":                  16,
+	"MAX_PROPOSER_SLASHINGS":        16,
+	"MAX_VALIDATORS_PER_COMMITTEE":  2048,
+	"MAX_VOLUNTARY_EXITS":           16,
+	"EPOCHS_PER_ETH1_VOTING_PERIOD": 64,
+	"EPOCHS_PER_HISTORICAL_VECTOR":  65536,
+	"EPOCHS_PER_SLASHINGS_VECTOR":   8192,
+	"HISTORICAL_ROOTS_LIMIT":        16777216,
+	"SLOTS_PER_EPOCH":               32,
+	"SLOTS_PER_HISTORICAL_ROOT":     8192,
+	// Altair.
+	"SYNC_COMMITTEE_SIZE": 512,
+	// Bellatrix.
+	"MAX_BYTES_PER_TRANSACTION":    1073741824,
+	"MAX_EXTRA_DATA_BYTES":         32,
+	"MAX_TRANSACTIONS_PER_PAYLOAD": 1048576,
+	// Capella.
+	"MAX_BLS_TO_EXECUTION_CHANGES": 16,
+	"MAX_WITHDRAWALS_PER_PAYLOAD":  16,
+	// Deneb.
+	"FIELD_ELEMENTS_PER_BLOB":        4096,
+	"MAX_BLOB_COMMITMENTS_PER_BLOCK": 4096,
+	"MAX_BLOBS_PER_BLOCK":            6,
+	// Electra.
+	"MAX_ATTESTATIONS_ELECTRA":               8,
+	"MAX_ATTESTER_SLASHINGS_ELECTRA":         1,
+	"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD": 2,
+	"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD":       8192,
+	"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD":    16,
+	"PENDING_CONSOLIDATIONS_LIMIT":           262144,
+	"PENDING_DEPOSITS_LIMIT":                 134217728,
+	"PENDING_PARTIAL_WITHDRAWALS_LIMIT":      134217728,
+}
+
+// minimalValues contains the values of the minimal preset that differ from mainnet.
+var minimalValues = map[string]uint64{
+	"EPOCHS_PER_ETH1_VOTING_PERIOD":          4,
+	"EPOCHS_PER_HISTORICAL_VECTOR":           64,
+	"EPOCHS_PER_SLASHINGS_VECTOR":            64,
+	"SLOTS_PER_EPOCH":                        8,
+	"SLOTS_PER_HISTORICAL_ROOT":              64,
+	"SYNC_COMMITTEE_SIZE":                    32,
+	"MAX_WITHDRAWALS_PER_PAYLOAD":            4,
+	"MAX_BLOB_COMMITMENTS_PER_BLOCK":         16,
+	"MAX_COMMITTEES_PER_SLOT":                4,
+	"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD": 1,
+	"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD":       4,
+	"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD":    2,
+	"PENDING_CONSOLIDATIONS_LIMIT":           64,
+	"PENDING_PARTIAL_WITHDRAWALS_LIMIT":      64,
+}
+
+// gnosisValues contains the values of the gnosis preset that differ from mainnet.
+var gnosisValues = map[string]uint64{
+	"SLOTS_PER_EPOCH":             16,
+	"MAX_WITHDRAWALS_PER_PAYLOAD": 8,
+	"MAX_BLOBS_PER_BLOCK":         2,
+}
+
+var (
+	mainnet = New("mainnet", nil)
+	minimal = New("minimal", minimalValues)
+	gnosis  = New("gnosis", gnosisValues)
+)
+
+// Preset contains the values of a preset, keyed by the names used in the
+// consensus specifications.
+// A preset provides the sizes of preset-dependent SSZ fields at runtime, allowing
+// objects to be encoded, decoded and hashed for presets other than the one that
+// the library was compiled for.
+type Preset struct {
+	name   string
+	values map[string]uint64
+	// types caches SSZ type information, keyed by Go type.
+	types sync.Map
+}
+
+// New creates a preset with the given name and values.
+// Values that are not supplied default to their mainnet values.
+func New(name string, values map[string]uint64) *Preset {
+	res := &Preset{
+		name:   name,
+		values: make(map[string]uint64, len(mainnetValues)),
+	}
+	for k, v := range mainnetValues {
+		res.values[k] = v
+	}
+	for k, v := range values {
+		res.values[k] = v
+	}
+
+	return res
+}
+
+// Mainnet returns the mainnet preset.
+func Mainnet() *Preset {
+	return mainnet
+}
+
+// Minimal returns the minimal preset.
+func Minimal() *Preset {
+	return minimal
+}
+
+// Gnosis returns the gnosis preset.
+func Gnosis() *Preset {
+	return gnosis
+}
+
+// FromSpec creates a preset from the spec values provided by a beacon node.
+// The preset starts from the built-in preset named by PRESET_BASE, if known, and
+// takes any unsigned integer values in the spec in preference.
+func FromSpec(spec map[string]interface{}) *Preset {
+	base := mainnet
+	name, _ := spec["PRESET_BASE"].(string)
+	if builtin, exists := builtin(name); exists {
+		base = builtin
+	}
+
+	values := base.Values()
+	for k, v := range spec {
+		if val, isUint := v.(uint64); isUint {
+			values[k] = val
+		}
+	}
+
+	return New(name, values)
+}
+
+// builtin returns the built-in preset with the given name.
+func builtin(name string) (*Preset, bool) {
+	switch name {
+	case "mainnet":
+		return mainnet, true
+	case "minimal":
+		return minimal, true
+	case "gnosis":
+		return gnosis, true
+	default:
+		return nil, false
+	}
+}
+
+// Load loads a preset.
+// The preset can be the name of a built-in preset ("mainnet", "minimal" or "gnosis"),
+// a preset YAML file, or a directory containing preset YAML files in the format used
+// by the consensus specifications.  Values that are not supplied by the preset default
+// to their mainnet values.
+func Load(preset string) (*Preset, error) {
+	if preset == "" {
+		return mainnet, nil
+	}
+	if res, exists := builtin(preset); exists {
+		return res, nil
+	}
+
+	info, err := os.Stat(preset)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to access preset")
+	}
+	files := []string{preset}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(preset, "*.yaml"))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list preset files")
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no preset files found in %s", preset)
+		}
+	}
+
+	name := preset
+	values := make(map[string]uint64)
+	for _, file := range files {
+		base, err := loadFile(file, values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load preset file %s", file)
+		}
+		if base != "" {
+			name = base
+		}
+	}
+
+	return New(name, values), nil
+}
+
+// loadFile loads the values in a preset file in to the supplied values,
+// returning the base name of the preset if present.
+func loadFile(file string, values map[string]uint64) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	contents := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &contents); err != nil {
+		return "", errors.Wrap(err, "invalid YAML")
+	}
+	name := ""
+	for k, v := range contents {
+		if k == "PRESET_BASE" {
+			name = fmt.Sprintf("%v", v)
+			continue
+		}
+		// Values may be provided as integers or strings; non-numeric values are not used for sizes.
+		val, err := strconv.ParseUint(strings.TrimSpace(fmt.Sprintf("%v", v)), 10, 64)
+		if err != nil {
+			continue
+		}
+		values[k] = val
+	}
+
+	return name, nil
+}
+
+// Name returns the name of the preset.
+func (p *Preset) Name() string {
+	return p.name
+}
+
+// Value returns the value of the preset with the given name.
+func (p *Preset) Value(name string) (uint64, bool) {
+	val, exists := p.values[name]
+
+	return val, exists
+}
+
+// Values returns a copy of the values of the preset.
+func (p *Preset) Values() map[string]uint64 {
+	res := make(map[string]uint64, len(p.values))
+	for k, v := range p.values {
+		res[k] = v
+	}
+
+	return res
+}
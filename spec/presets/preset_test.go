@@ -0,0 +1,133 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	presetFile := filepath.Join(dir, "phase0.yaml")
+	require.NoError(t, os.WriteFile(presetFile, []byte("PRESET_BASE: 'custom'\nSLOTS_PER_EPOCH: 16\nMAX_DEPOSITS: \"4\"\n"), 0o600))
+	badFile := filepath.Join(t.TempDir(), "bad.yaml")
+	require.NoError(t, os.WriteFile(badFile, []byte("[}"), 0o600))
+
+	tests := []struct {
+		name          string
+		preset        string
+		presetName    string
+		slotsPerEpoch uint64
+		maxDeposits   uint64
+		err           string
+	}{
+		{
+			name:          "Default",
+			presetName:    "mainnet",
+			slotsPerEpoch: 32,
+			maxDeposits:   16,
+		},
+		{
+			name:          "Minimal",
+			preset:        "minimal",
+			presetName:    "minimal",
+			slotsPerEpoch: 8,
+			maxDeposits:   16,
+		},
+		{
+			name:          "Gnosis",
+			preset:        "gnosis",
+			presetName:    "gnosis",
+			slotsPerEpoch: 16,
+			maxDeposits:   16,
+		},
+		{
+			name:          "File",
+			preset:        presetFile,
+			presetName:    "custom",
+			slotsPerEpoch: 16,
+			maxDeposits:   4,
+		},
+		{
+			name:          "Directory",
+			preset:        dir,
+			presetName:    "custom",
+			slotsPerEpoch: 16,
+			maxDeposits:   4,
+		},
+		{
+			name:   "Missing",
+			preset: filepath.Join(dir, "missing.yaml"),
+			err:    "failed to access preset: stat " + filepath.Join(dir, "missing.yaml") + ": no such file or directory",
+		},
+		{
+			name:   "EmptyDirectory",
+			preset: t.TempDir(),
+		},
+		{
+			name:   "Invalid",
+			preset: badFile,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preset, err := presets.Load(test.preset)
+			switch {
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			case test.slotsPerEpoch == 0:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, test.presetName, preset.Name())
+				require.Equal(t, test.slotsPerEpoch, value(t, preset, "SLOTS_PER_EPOCH"))
+				require.Equal(t, test.maxDeposits, value(t, preset, "MAX_DEPOSITS"))
+				require.Equal(t, uint64(1099511627776), value(t, preset, "VALIDATOR_REGISTRY_LIMIT"))
+			}
+		})
+	}
+}
+
+func TestFromSpec(t *testing.T) {
+	preset := presets.FromSpec(map[string]interface{}{
+		"PRESET_BASE":                 "minimal",
+		"SLOTS_PER_EPOCH":             uint64(6),
+		"MAX_WITHDRAWALS_PER_PAYLOAD": uint64(2),
+		"CONFIG_NAME":                 "testnet",
+	})
+	require.Equal(t, "minimal", preset.Name())
+	require.Equal(t, uint64(6), value(t, preset, "SLOTS_PER_EPOCH"))
+	require.Equal(t, uint64(2), value(t, preset, "MAX_WITHDRAWALS_PER_PAYLOAD"))
+	// Values not in the spec come from the base preset.
+	require.Equal(t, uint64(64), value(t, preset, "SLOTS_PER_HISTORICAL_ROOT"))
+	require.Equal(t, uint64(1099511627776), value(t, preset, "VALIDATOR_REGISTRY_LIMIT"))
+
+	// The base preset is unchanged.
+	require.Equal(t, uint64(8), value(t, presets.Minimal(), "SLOTS_PER_EPOCH"))
+}
+
+func value(t *testing.T, preset *presets.Preset, name string) uint64 {
+	t.Helper()
+
+	val, exists := preset.Value(name)
+	require.True(t, exists)
+
+	return val
+}
@@ -0,0 +1,173 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// FieldTags maps struct fields, in the form "Type.Field", to the SSZ tags
+// whose values depend on the preset.  Each tag value is a comma-separated list
+// of dimensions, where each dimension is either "?" or an expression built from
+// preset names, integers and the operators '+', '*' and '/'.
+// The same field name in different forks shares a single entry, unless there is
+// an entry for the field qualified by its package, in the form "package.Type.Field".
+var FieldTags = map[string]map[string]string{
+	"electra.Attestation.AggregationBits":                  {"ssz-max": "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT"},
+	"electra.BeaconBlockBody.AttesterSlashings":            {"ssz-max": "MAX_ATTESTER_SLASHINGS_ELECTRA"},
+	"electra.BeaconBlockBody.Attestations":                 {"ssz-max": "MAX_ATTESTATIONS_ELECTRA"},
+	"electra.BlindedBeaconBlockBody.AttesterSlashings":     {"ssz-max": "MAX_ATTESTER_SLASHINGS_ELECTRA"},
+	"electra.BlindedBeaconBlockBody.Attestations":          {"ssz-max": "MAX_ATTESTATIONS_ELECTRA"},
+	"electra.BlindedBeaconBlockBody.BlobKzgCommitments":    {"ssz-max": "MAX_BLOB_COMMITMENTS_PER_BLOCK"},
+	"electra.IndexedAttestation.AttestingIndices":          {"ssz-max": "MAX_VALIDATORS_PER_COMMITTEE*MAX_COMMITTEES_PER_SLOT"},
+	"Attestation.CommitteeBits":                            {"ssz-size": "MAX_COMMITTEES_PER_SLOT+7/8"},
+	"Attestation.AggregationBits":                          {"ssz-max": "MAX_VALIDATORS_PER_COMMITTEE"},
+	"BeaconBlockBody.ProposerSlashings":                    {"ssz-max": "MAX_PROPOSER_SLASHINGS"},
+	"BeaconBlockBody.AttesterSlashings":                    {"ssz-max": "MAX_ATTESTER_SLASHINGS"},
+	"BeaconBlockBody.Attestations":                         {"ssz-max": "MAX_ATTESTATIONS"},
+	"BeaconBlockBody.Deposits":                             {"ssz-max": "MAX_DEPOSITS"},
+	"BeaconBlockBody.VoluntaryExits":                       {"ssz-max": "MAX_VOLUNTARY_EXITS"},
+	"BeaconBlockBody.BLSToExecutionChanges":                {"ssz-max": "MAX_BLS_TO_EXECUTION_CHANGES"},
+	"BeaconBlockBody.BlobKzgCommitments":                   {"ssz-max": "MAX_BLOB_COMMITMENTS_PER_BLOCK"},
+	"BeaconState.BlockRoots":                               {"ssz-size": "SLOTS_PER_HISTORICAL_ROOT,32"},
+	"BeaconState.StateRoots":                               {"ssz-size": "SLOTS_PER_HISTORICAL_ROOT,32"},
+	"BeaconState.HistoricalRoots":                          {"ssz-max": "HISTORICAL_ROOTS_LIMIT"},
+	"BeaconState.ETH1DataVotes":                            {"ssz-max": "EPOCHS_PER_ETH1_VOTING_PERIOD*SLOTS_PER_EPOCH"},
+	"BeaconState.Validators":                               {"ssz-max": "VALIDATOR_REGISTRY_LIMIT"},
+	"BeaconState.Balances":                                 {"ssz-max": "VALIDATOR_REGISTRY_LIMIT"},
+	"BeaconState.RANDAOMixes":                              {"ssz-size": "EPOCHS_PER_HISTORICAL_VECTOR,32"},
+	"BeaconState.Slashings":                                {"ssz-size": "EPOCHS_PER_SLASHINGS_VECTOR"},
+	"BeaconState.PreviousEpochAttestations":                {"ssz-max": "MAX_ATTESTATIONS*SLOTS_PER_EPOCH"},
+	"BeaconState.CurrentEpochAttestations":                 {"ssz-max": "MAX_ATTESTATIONS*SLOTS_PER_EPOCH"},
+	"BeaconState.PreviousEpochParticipation":               {"ssz-max": "VALIDATOR_REGISTRY_LIMIT"},
+	"BeaconState.CurrentEpochParticipation":                {"ssz-max": "VALIDATOR_REGISTRY_LIMIT"},
+	"BeaconState.InactivityScores":                         {"ssz-max": "VALIDATOR_REGISTRY_LIMIT"},
+	"BeaconState.HistoricalSummaries":                      {"ssz-max": "HISTORICAL_ROOTS_LIMIT"},
+	"BeaconState.PendingDeposits":                          {"ssz-max": "PENDING_DEPOSITS_LIMIT"},
+	"BeaconState.PendingPartialWithdrawals":                {"ssz-max": "PENDING_PARTIAL_WITHDRAWALS_LIMIT"},
+	"BeaconState.PendingConsolidations":                    {"ssz-max": "PENDING_CONSOLIDATIONS_LIMIT"},
+	"BlindedBeaconBlockBody.ProposerSlashings":             {"ssz-max": "MAX_PROPOSER_SLASHINGS"},
+	"BlindedBeaconBlockBody.AttesterSlashings":             {"ssz-max": "MAX_ATTESTER_SLASHINGS"},
+	"BlindedBeaconBlockBody.Attestations":                  {"ssz-max": "MAX_ATTESTATIONS"},
+	"BlindedBeaconBlockBody.Deposits":                      {"ssz-max": "MAX_DEPOSITS"},
+	"BlindedBeaconBlockBody.VoluntaryExits":                {"ssz-max": "MAX_VOLUNTARY_EXITS"},
+	"BlindedBeaconBlockBody.BLSToExecutionChanges":         {"ssz-max": "MAX_BLS_TO_EXECUTION_CHANGES"},
+	"BlindedBeaconBlockBody.BlobKzgCommitments":            {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"BlindedBlockContents.BlindedBlobSidecars":             {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"BlobSidecar.Blob":                                     {"ssz-size": "BYTES_PER_FIELD_ELEMENT*FIELD_ELEMENTS_PER_BLOB"},
+	"BlobsBundle.Commitments":                              {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"BlobsBundle.Proofs":                                   {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"BlobsBundle.Blobs":                                    {"ssz-max": "MAX_BLOBS_PER_BLOCK", "ssz-size": "?,BYTES_PER_FIELD_ELEMENT*FIELD_ELEMENTS_PER_BLOB"},
+	"BlockContents.BlobSidecars":                           {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"Deposit.Proof":                                        {"ssz-size": "DEPOSIT_CONTRACT_TREE_DEPTH+1,32"},
+	"ExecutionPayload.ExtraData":                           {"ssz-max": "MAX_EXTRA_DATA_BYTES"},
+	"ExecutionPayload.Transactions":                        {"ssz-max": "MAX_TRANSACTIONS_PER_PAYLOAD,MAX_BYTES_PER_TRANSACTION"},
+	"ExecutionPayload.Withdrawals":                         {"ssz-max": "MAX_WITHDRAWALS_PER_PAYLOAD"},
+	"ExecutionPayloadHeader.ExtraData":                     {"ssz-max": "MAX_EXTRA_DATA_BYTES"},
+	"ExecutionRequests.Deposits":                           {"ssz-max": "MAX_DEPOSIT_REQUESTS_PER_PAYLOAD"},
+	"ExecutionRequests.Withdrawals":                        {"ssz-max": "MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD"},
+	"ExecutionRequests.Consolidations":                     {"ssz-max": "MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD"},
+	"IndexedAttestation.AttestingIndices":                  {"ssz-max": "MAX_VALIDATORS_PER_COMMITTEE"},
+	"PendingAttestation.AggregationBits":                   {"ssz-max": "MAX_VALIDATORS_PER_COMMITTEE"},
+	"SignedBlindedBlockContents.SignedBlindedBlobSidecars": {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"SignedBlockContents.SignedBlobSidecars":               {"ssz-max": "MAX_BLOBS_PER_BLOCK"},
+	"SyncAggregate.SyncCommitteeBits":                      {"ssz-size": "SYNC_COMMITTEE_SIZE/8"},
+	"SyncCommittee.Pubkeys":                                {"ssz-size": "SYNC_COMMITTEE_SIZE,48"},
+	"SyncCommitteeContribution.AggregationBits":            {"ssz-size": "SYNC_COMMITTEE_SIZE/SYNC_COMMITTEE_SUBNET_COUNT/8"},
+}
+
+// FieldTemplates returns the templates of the preset-dependent SSZ tags for the
+// given field of a type in a package, if the field depends on the preset.
+func FieldTemplates(pkg string, typ string, field string) (map[string]string, bool) {
+	templates, exists := FieldTags[pkg+"."+typ+"."+field]
+	if !exists {
+		templates, exists = FieldTags[typ+"."+field]
+	}
+
+	return templates, exists
+}
+
+// EvaluateDimensions evaluates a comma-separated list of dimensions with the
+// values of the preset, for example "?,SLOTS_PER_HISTORICAL_ROOT,32" evaluates
+// to "?,8192,32" with the mainnet preset.
+func (p *Preset) EvaluateDimensions(template string) (string, error) {
+	dimensions := strings.Split(template, ",")
+	res := make([]string, len(dimensions))
+	for i, dimension := range dimensions {
+		dimension = strings.TrimSpace(dimension)
+		if dimension == "?" {
+			res[i] = dimension
+			continue
+		}
+		val, err := p.evaluate(dimension)
+		if err != nil {
+			return "", err
+		}
+		res[i] = strconv.FormatUint(val, 10)
+	}
+
+	return strings.Join(res, ","), nil
+}
+
+// evaluate evaluates a simple expression, applying operators from left to right.
+func (p *Preset) evaluate(expression string) (uint64, error) {
+	res := uint64(0)
+	operator := '+'
+	start := 0
+	for i, c := range expression + "+" {
+		if c != '+' && c != '*' && c != '/' {
+			continue
+		}
+		val, err := p.evaluateTerm(strings.TrimSpace(expression[start:i]))
+		if err != nil {
+			return 0, err
+		}
+		switch operator {
+		case '+':
+			res += val
+		case '*':
+			res *= val
+		case '/':
+			if val == 0 {
+				return 0, fmt.Errorf("division by zero in %q", expression)
+			}
+			res /= val
+		}
+		operator = c
+		start = i + 1
+	}
+
+	return res, nil
+}
+
+// evaluateTerm evaluates a single term, which is either an integer or a preset name.
+func (p *Preset) evaluateTerm(term string) (uint64, error) {
+	if term == "" {
+		return 0, errors.New("missing term")
+	}
+	if unicode.IsDigit(rune(term[0])) {
+		return strconv.ParseUint(term, 10, 64)
+	}
+	val, exists := p.values[term]
+	if !exists {
+		return 0, fmt.Errorf("preset value %s not found", term)
+	}
+
+	return val, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/presets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateDimensions(t *testing.T) {
+	preset := presets.Mainnet()
+
+	tests := []struct {
+		name     string
+		template string
+		res      string
+		err      string
+	}{
+		{
+			name:     "Integer",
+			template: "32",
+			res:      "32",
+		},
+		{
+			name:     "Name",
+			template: "MAX_ATTESTATIONS",
+			res:      "128",
+		},
+		{
+			name:     "Multiple",
+			template: "?,SLOTS_PER_HISTORICAL_ROOT,32",
+			res:      "?,8192,32",
+		},
+		{
+			name:     "Operators",
+			template: "SYNC_COMMITTEE_SIZE/SYNC_COMMITTEE_SUBNET_COUNT/8,DEPOSIT_CONTRACT_TREE_DEPTH+1,MAX_ATTESTATIONS*SLOTS_PER_EPOCH",
+			res:      "16,33,4096",
+		},
+		{
+			name:     "Unknown",
+			template: "UNKNOWN",
+			err:      "preset value UNKNOWN not found",
+		},
+		{
+			name:     "MissingTerm",
+			template: "SLOTS_PER_EPOCH*",
+			err:      "missing term",
+		},
+		{
+			name:     "DivideByZero",
+			template: "SLOTS_PER_EPOCH/0",
+			err:      `division by zero in "SLOTS_PER_EPOCH/0"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := preset.EvaluateDimensions(test.template)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
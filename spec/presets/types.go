@@ -0,0 +1,272 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presets
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// sszKind is the kind of an SSZ type.
+type sszKind int
+
+const (
+	kindUint sszKind = iota
+	kindBool
+	kindUint256
+	kindBytes
+	kindByteList
+	kindBitlist
+	kindVector
+	kindList
+	kindContainer
+)
+
+var (
+	uint256Type = reflect.TypeOf(&uint256.Int{})
+	bitlistType = reflect.TypeOf(bitfield.Bitlist{})
+)
+
+// sszType contains the information required to encode, decode and hash a Go type.
+type sszType struct {
+	kind sszKind
+	// size is the size of the encoded type, or 0 if the type is variable-sized.
+	size int
+	// length is the number of elements in a vector, or the maximum number of
+	// elements in a list.  For byte lists and bitlists the elements are bytes
+	// and bits respectively.
+	length uint64
+	// elem is the type of the elements of a vector or list.
+	elem *sszType
+	// fields are the fields of a container.
+	fields []*sszField
+	// fixedSize is the size of the fixed part of a container.
+	fixedSize int
+}
+
+// sszField is a field of a container.
+type sszField struct {
+	name  string
+	index int
+	typ   *sszType
+}
+
+// dimension is a single dimension of an SSZ size tag.
+// A value of 0 means that the dimension is not supplied.
+type dimension struct {
+	size uint64
+	max  uint64
+}
+
+// isBasic returns true if the type is a basic SSZ type.
+func (t *sszType) isBasic() bool {
+	return t.kind == kindUint || t.kind == kindBool
+}
+
+// typeOf returns the SSZ type of an object, which must be a pointer to a struct.
+func (p *Preset) typeOf(t reflect.Type) (*sszType, error) {
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported type %v; must be a pointer to a struct", t)
+	}
+
+	return p.containerType(t.Elem())
+}
+
+// containerType returns the SSZ type of a struct.
+func (p *Preset) containerType(t reflect.Type) (*sszType, error) {
+	if cached, exists := p.types.Load(t); exists {
+		return cached.(*sszType), nil
+	}
+
+	res := &sszType{
+		kind:   kindContainer,
+		fields: make([]*sszField, 0, t.NumField()),
+	}
+	variable := false
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Tag.Get("ssz") == "-" {
+			continue
+		}
+		dimensions, err := p.fieldDimensions(t, field)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s.%s", t.Name(), field.Name)
+		}
+		fieldType, err := p.buildType(field.Type, dimensions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s.%s", t.Name(), field.Name)
+		}
+		res.fields = append(res.fields, &sszField{
+			name:  field.Name,
+			index: i,
+			typ:   fieldType,
+		})
+		if fieldType.size == 0 {
+			variable = true
+			res.fixedSize += 4
+		} else {
+			res.fixedSize += fieldType.size
+		}
+	}
+	if !variable {
+		res.size = res.fixedSize
+	}
+
+	cached, _ := p.types.LoadOrStore(t, res)
+
+	return cached.(*sszType), nil
+}
+
+// fieldDimensions returns the dimensions of a struct field, taking the values
+// of preset-dependent fields from the preset.
+func (p *Preset) fieldDimensions(t reflect.Type, field reflect.StructField) ([]dimension, error) {
+	tags := map[string]string{
+		"ssz-size": field.Tag.Get("ssz-size"),
+		"ssz-max":  field.Tag.Get("ssz-max"),
+	}
+	if templates, exists := FieldTemplates(path.Base(t.PkgPath()), t.Name(), field.Name); exists {
+		for key, template := range templates {
+			value, err := p.EvaluateDimensions(template)
+			if err != nil {
+				return nil, errors.Wrap(err, key)
+			}
+			tags[key] = value
+		}
+	}
+
+	sizes, err := parseDimensions(tags["ssz-size"])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ssz-size")
+	}
+	maxes, err := parseDimensions(tags["ssz-max"])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ssz-max")
+	}
+
+	count := len(sizes)
+	if len(maxes) > count {
+		count = len(maxes)
+	}
+	res := make([]dimension, count)
+	for i := range res {
+		if i < len(sizes) {
+			res[i].size = sizes[i]
+		}
+		if i < len(maxes) {
+			res[i].max = maxes[i]
+		}
+	}
+
+	return res, nil
+}
+
+// parseDimensions parses a comma-separated list of dimensions, where "?" is
+// returned as 0.
+func parseDimensions(input string) ([]uint64, error) {
+	if input == "" {
+		return nil, nil
+	}
+	values := strings.Split(input, ",")
+	res := make([]uint64, len(values))
+	for i, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "?" {
+			continue
+		}
+		val, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = val
+	}
+
+	return res, nil
+}
+
+// buildType builds the SSZ type for a Go type with the given dimensions.
+func (p *Preset) buildType(t reflect.Type, dimensions []dimension) (*sszType, error) {
+	var current dimension
+	var rest []dimension
+	if len(dimensions) > 0 {
+		current = dimensions[0]
+		rest = dimensions[1:]
+	}
+
+	switch {
+	case t == uint256Type:
+		return &sszType{kind: kindUint256, size: 32}, nil
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct:
+		return p.containerType(t.Elem())
+	case t.Kind() == reflect.Bool:
+		return &sszType{kind: kindBool, size: 1}, nil
+	case t.Kind() == reflect.Uint8, t.Kind() == reflect.Uint16, t.Kind() == reflect.Uint32, t.Kind() == reflect.Uint64:
+		return &sszType{kind: kindUint, size: int(t.Size())}, nil
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8:
+		return &sszType{kind: kindBytes, size: t.Len(), length: uint64(t.Len())}, nil
+	case t.Kind() == reflect.Array:
+		return p.sequenceType(kindVector, t.Elem(), uint64(t.Len()), rest)
+	case t == bitlistType:
+		if current.max == 0 {
+			return nil, errors.New("no maximum size for bitlist")
+		}
+
+		return &sszType{kind: kindBitlist, length: current.max}, nil
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		switch {
+		case current.size != 0:
+			return &sszType{kind: kindBytes, size: int(current.size), length: current.size}, nil
+		case current.max != 0:
+			return &sszType{kind: kindByteList, length: current.max}, nil
+		default:
+			return nil, errors.New("no size for byte slice")
+		}
+	case t.Kind() == reflect.Slice:
+		switch {
+		case current.size != 0:
+			return p.sequenceType(kindVector, t.Elem(), current.size, rest)
+		case current.max != 0:
+			return p.sequenceType(kindList, t.Elem(), current.max, rest)
+		default:
+			return nil, errors.New("no size for slice")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t)
+	}
+}
+
+// sequenceType builds the SSZ type for a vector or list.
+func (p *Preset) sequenceType(kind sszKind, elem reflect.Type, length uint64, dimensions []dimension) (*sszType, error) {
+	elemType, err := p.buildType(elem, dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sszType{
+		kind:   kind,
+		length: length,
+		elem:   elemType,
+	}
+	if kind == kindVector && elemType.size != 0 {
+		res.size = int(length) * elemType.size
+	}
+
+	return res, nil
+}
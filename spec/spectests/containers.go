@@ -0,0 +1,287 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Container is the interface for SSZ containers that can be checked against the test vectors.
+type Container interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// containers maps the containers in the SSZ static test vectors to the types
+// that implement them, by fork and then by container name.
+var containers = map[string]map[string]func() Container{
+	"phase0": {
+		"AggregateAndProof":       func() Container { return &phase0.AggregateAndProof{} },
+		"Attestation":             func() Container { return &phase0.Attestation{} },
+		"AttestationData":         func() Container { return &phase0.AttestationData{} },
+		"AttesterSlashing":        func() Container { return &phase0.AttesterSlashing{} },
+		"BeaconBlock":             func() Container { return &phase0.BeaconBlock{} },
+		"BeaconBlockBody":         func() Container { return &phase0.BeaconBlockBody{} },
+		"BeaconBlockHeader":       func() Container { return &phase0.BeaconBlockHeader{} },
+		"BeaconState":             func() Container { return &phase0.BeaconState{} },
+		"Checkpoint":              func() Container { return &phase0.Checkpoint{} },
+		"Deposit":                 func() Container { return &phase0.Deposit{} },
+		"DepositData":             func() Container { return &phase0.DepositData{} },
+		"DepositMessage":          func() Container { return &phase0.DepositMessage{} },
+		"Eth1Data":                func() Container { return &phase0.ETH1Data{} },
+		"Fork":                    func() Container { return &phase0.Fork{} },
+		"ForkData":                func() Container { return &phase0.ForkData{} },
+		"IndexedAttestation":      func() Container { return &phase0.IndexedAttestation{} },
+		"PendingAttestation":      func() Container { return &phase0.PendingAttestation{} },
+		"ProposerSlashing":        func() Container { return &phase0.ProposerSlashing{} },
+		"SignedAggregateAndProof": func() Container { return &phase0.SignedAggregateAndProof{} },
+		"SignedBeaconBlock":       func() Container { return &phase0.SignedBeaconBlock{} },
+		"SignedBeaconBlockHeader": func() Container { return &phase0.SignedBeaconBlockHeader{} },
+		"SignedVoluntaryExit":     func() Container { return &phase0.SignedVoluntaryExit{} },
+		"Validator":               func() Container { return &phase0.Validator{} },
+		"VoluntaryExit":           func() Container { return &phase0.VoluntaryExit{} },
+	},
+	"altair": {
+		"AggregateAndProof":          func() Container { return &phase0.AggregateAndProof{} },
+		"Attestation":                func() Container { return &phase0.Attestation{} },
+		"AttestationData":            func() Container { return &phase0.AttestationData{} },
+		"AttesterSlashing":           func() Container { return &phase0.AttesterSlashing{} },
+		"BeaconBlock":                func() Container { return &altair.BeaconBlock{} },
+		"BeaconBlockBody":            func() Container { return &altair.BeaconBlockBody{} },
+		"BeaconBlockHeader":          func() Container { return &phase0.BeaconBlockHeader{} },
+		"BeaconState":                func() Container { return &altair.BeaconState{} },
+		"Checkpoint":                 func() Container { return &phase0.Checkpoint{} },
+		"ContributionAndProof":       func() Container { return &altair.ContributionAndProof{} },
+		"Deposit":                    func() Container { return &phase0.Deposit{} },
+		"DepositData":                func() Container { return &phase0.DepositData{} },
+		"DepositMessage":             func() Container { return &phase0.DepositMessage{} },
+		"Eth1Data":                   func() Container { return &phase0.ETH1Data{} },
+		"Fork":                       func() Container { return &phase0.Fork{} },
+		"ForkData":                   func() Container { return &phase0.ForkData{} },
+		"IndexedAttestation":         func() Container { return &phase0.IndexedAttestation{} },
+		"PendingAttestation":         func() Container { return &phase0.PendingAttestation{} },
+		"ProposerSlashing":           func() Container { return &phase0.ProposerSlashing{} },
+		"SignedAggregateAndProof":    func() Container { return &phase0.SignedAggregateAndProof{} },
+		"SignedBeaconBlock":          func() Container { return &altair.SignedBeaconBlock{} },
+		"SignedBeaconBlockHeader":    func() Container { return &phase0.SignedBeaconBlockHeader{} },
+		"SignedContributionAndProof": func() Container { return &altair.SignedContributionAndProof{} },
+		"SignedVoluntaryExit":        func() Container { return &phase0.SignedVoluntaryExit{} },
+		"SyncAggregate":              func() Container { return &altair.SyncAggregate{} },
+		"SyncCommittee":              func() Container { return &altair.SyncCommittee{} },
+		"SyncCommitteeContribution":  func() Container { return &altair.SyncCommitteeContribution{} },
+		"SyncCommitteeMessage":       func() Container { return &altair.SyncCommitteeMessage{} },
+		"Validator":                  func() Container { return &phase0.Validator{} },
+		"VoluntaryExit":              func() Container { return &phase0.VoluntaryExit{} },
+	},
+	"bellatrix": {
+		"AggregateAndProof":          func() Container { return &phase0.AggregateAndProof{} },
+		"Attestation":                func() Container { return &phase0.Attestation{} },
+		"AttestationData":            func() Container { return &phase0.AttestationData{} },
+		"AttesterSlashing":           func() Container { return &phase0.AttesterSlashing{} },
+		"BeaconBlock":                func() Container { return &bellatrix.BeaconBlock{} },
+		"BeaconBlockBody":            func() Container { return &bellatrix.BeaconBlockBody{} },
+		"BeaconBlockHeader":          func() Container { return &phase0.BeaconBlockHeader{} },
+		"BeaconState":                func() Container { return &bellatrix.BeaconState{} },
+		"Checkpoint":                 func() Container { return &phase0.Checkpoint{} },
+		"ContributionAndProof":       func() Container { return &altair.ContributionAndProof{} },
+		"Deposit":                    func() Container { return &phase0.Deposit{} },
+		"DepositData":                func() Container { return &phase0.DepositData{} },
+		"DepositMessage":             func() Container { return &phase0.DepositMessage{} },
+		"Eth1Data":                   func() Container { return &phase0.ETH1Data{} },
+		"ExecutionPayload":           func() Container { return &bellatrix.ExecutionPayload{} },
+		"ExecutionPayloadHeader":     func() Container { return &bellatrix.ExecutionPayloadHeader{} },
+		"Fork":                       func() Container { return &phase0.Fork{} },
+		"ForkData":                   func() Container { return &phase0.ForkData{} },
+		"IndexedAttestation":         func() Container { return &phase0.IndexedAttestation{} },
+		"PendingAttestation":         func() Container { return &phase0.PendingAttestation{} },
+		"ProposerSlashing":           func() Container { return &phase0.ProposerSlashing{} },
+		"SignedAggregateAndProof":    func() Container { return &phase0.SignedAggregateAndProof{} },
+		"SignedBeaconBlock":          func() Container { return &bellatrix.SignedBeaconBlock{} },
+		"SignedBeaconBlockHeader":    func() Container { return &phase0.SignedBeaconBlockHeader{} },
+		"SignedContributionAndProof": func() Container { return &altair.SignedContributionAndProof{} },
+		"SignedVoluntaryExit":        func() Container { return &phase0.SignedVoluntaryExit{} },
+		"SyncAggregate":              func() Container { return &altair.SyncAggregate{} },
+		"SyncCommittee":              func() Container { return &altair.SyncCommittee{} },
+		"SyncCommitteeContribution":  func() Container { return &altair.SyncCommitteeContribution{} },
+		"SyncCommitteeMessage":       func() Container { return &altair.SyncCommitteeMessage{} },
+		"Validator":                  func() Container { return &phase0.Validator{} },
+		"VoluntaryExit":              func() Container { return &phase0.VoluntaryExit{} },
+	},
+	"capella": {
+		"AggregateAndProof":          func() Container { return &phase0.AggregateAndProof{} },
+		"Attestation":                func() Container { return &phase0.Attestation{} },
+		"AttestationData":            func() Container { return &phase0.AttestationData{} },
+		"AttesterSlashing":           func() Container { return &phase0.AttesterSlashing{} },
+		"BeaconBlock":                func() Container { return &capella.BeaconBlock{} },
+		"BeaconBlockBody":            func() Container { return &capella.BeaconBlockBody{} },
+		"BeaconBlockHeader":          func() Container { return &phase0.BeaconBlockHeader{} },
+		"BeaconState":                func() Container { return &capella.BeaconState{} },
+		"BLSToExecutionChange":       func() Container { return &capella.BLSToExecutionChange{} },
+		"Checkpoint":                 func() Container { return &phase0.Checkpoint{} },
+		"ContributionAndProof":       func() Container { return &altair.ContributionAndProof{} },
+		"Deposit":                    func() Container { return &phase0.Deposit{} },
+		"DepositData":                func() Container { return &phase0.DepositData{} },
+		"DepositMessage":             func() Container { return &phase0.DepositMessage{} },
+		"Eth1Data":                   func() Container { return &phase0.ETH1Data{} },
+		"ExecutionPayload":           func() Container { return &capella.ExecutionPayload{} },
+		"ExecutionPayloadHeader":     func() Container { return &capella.ExecutionPayloadHeader{} },
+		"Fork":                       func() Container { return &phase0.Fork{} },
+		"ForkData":                   func() Container { return &phase0.ForkData{} },
+		"HistoricalSummary":          func() Container { return &capella.HistoricalSummary{} },
+		"IndexedAttestation":         func() Container { return &phase0.IndexedAttestation{} },
+		"PendingAttestation":         func() Container { return &phase0.PendingAttestation{} },
+		"ProposerSlashing":           func() Container { return &phase0.ProposerSlashing{} },
+		"SignedAggregateAndProof":    func() Container { return &phase0.SignedAggregateAndProof{} },
+		"SignedBeaconBlock":          func() Container { return &capella.SignedBeaconBlock{} },
+		"SignedBeaconBlockHeader":    func() Container { return &phase0.SignedBeaconBlockHeader{} },
+		"SignedBLSToExecutionChange": func() Container { return &capella.SignedBLSToExecutionChange{} },
+		"SignedContributionAndProof": func() Container { return &altair.SignedContributionAndProof{} },
+		"SignedVoluntaryExit":        func() Container { return &phase0.SignedVoluntaryExit{} },
+		"SyncAggregate":              func() Container { return &altair.SyncAggregate{} },
+		"SyncCommittee":              func() Container { return &altair.SyncCommittee{} },
+		"SyncCommitteeContribution":  func() Container { return &altair.SyncCommitteeContribution{} },
+		"SyncCommitteeMessage":       func() Container { return &altair.SyncCommitteeMessage{} },
+		"Validator":                  func() Container { return &phase0.Validator{} },
+		"VoluntaryExit":              func() Container { return &phase0.VoluntaryExit{} },
+		"Withdrawal":                 func() Container { return &capella.Withdrawal{} },
+	},
+	"deneb": {
+		"AggregateAndProof":          func() Container { return &phase0.AggregateAndProof{} },
+		"Attestation":                func() Container { return &phase0.Attestation{} },
+		"AttestationData":            func() Container { return &phase0.AttestationData{} },
+		"AttesterSlashing":           func() Container { return &phase0.AttesterSlashing{} },
+		"BeaconBlock":                func() Container { return &deneb.BeaconBlock{} },
+		"BeaconBlockBody":            func() Container { return &deneb.BeaconBlockBody{} },
+		"BeaconBlockHeader":          func() Container { return &phase0.BeaconBlockHeader{} },
+		"BeaconState":                func() Container { return &deneb.BeaconState{} },
+		"BlobIdentifier":             func() Container { return &deneb.BlobIdentifier{} },
+		"BlobSidecar":                func() Container { return &deneb.BlobSidecar{} },
+		"BLSToExecutionChange":       func() Container { return &capella.BLSToExecutionChange{} },
+		"Checkpoint":                 func() Container { return &phase0.Checkpoint{} },
+		"ContributionAndProof":       func() Container { return &altair.ContributionAndProof{} },
+		"Deposit":                    func() Container { return &phase0.Deposit{} },
+		"DepositData":                func() Container { return &phase0.DepositData{} },
+		"DepositMessage":             func() Container { return &phase0.DepositMessage{} },
+		"Eth1Data":                   func() Container { return &phase0.ETH1Data{} },
+		"ExecutionPayload":           func() Container { return &deneb.ExecutionPayload{} },
+		"ExecutionPayloadHeader":     func() Container { return &deneb.ExecutionPayloadHeader{} },
+		"Fork":                       func() Container { return &phase0.Fork{} },
+		"ForkData":                   func() Container { return &phase0.ForkData{} },
+		"HistoricalSummary":          func() Container { return &capella.HistoricalSummary{} },
+		"IndexedAttestation":         func() Container { return &phase0.IndexedAttestation{} },
+		"PendingAttestation":         func() Container { return &phase0.PendingAttestation{} },
+		"ProposerSlashing":           func() Container { return &phase0.ProposerSlashing{} },
+		"SignedAggregateAndProof":    func() Container { return &phase0.SignedAggregateAndProof{} },
+		"SignedBeaconBlock":          func() Container { return &deneb.SignedBeaconBlock{} },
+		"SignedBeaconBlockHeader":    func() Container { return &phase0.SignedBeaconBlockHeader{} },
+		"SignedBlobSidecar":          func() Container { return &deneb.SignedBlobSidecar{} },
+		"SignedBLSToExecutionChange": func() Container { return &capella.SignedBLSToExecutionChange{} },
+		"SignedContributionAndProof": func() Container { return &altair.SignedContributionAndProof{} },
+		"SignedVoluntaryExit":        func() Container { return &phase0.SignedVoluntaryExit{} },
+		"SyncAggregate":              func() Container { return &altair.SyncAggregate{} },
+		"SyncCommittee":              func() Container { return &altair.SyncCommittee{} },
+		"SyncCommitteeContribution":  func() Container { return &altair.SyncCommitteeContribution{} },
+		"SyncCommitteeMessage":       func() Container { return &altair.SyncCommitteeMessage{} },
+		"Validator":                  func() Container { return &phase0.Validator{} },
+		"VoluntaryExit":              func() Container { return &phase0.VoluntaryExit{} },
+		"Withdrawal":                 func() Container { return &capella.Withdrawal{} },
+	},
+	"electra": {
+		"Attestation":                func() Container { return &electra.Attestation{} },
+		"AttestationData":            func() Container { return &phase0.AttestationData{} },
+		"AttesterSlashing":           func() Container { return &electra.AttesterSlashing{} },
+		"BeaconBlock":                func() Container { return &electra.BeaconBlock{} },
+		"BeaconBlockBody":            func() Container { return &electra.BeaconBlockBody{} },
+		"BeaconBlockHeader":          func() Container { return &phase0.BeaconBlockHeader{} },
+		"BeaconState":                func() Container { return &electra.BeaconState{} },
+		"BlobIdentifier":             func() Container { return &deneb.BlobIdentifier{} },
+		"BlobSidecar":                func() Container { return &deneb.BlobSidecar{} },
+		"BLSToExecutionChange":       func() Container { return &capella.BLSToExecutionChange{} },
+		"Checkpoint":                 func() Container { return &phase0.Checkpoint{} },
+		"ConsolidationRequest":       func() Container { return &electra.ConsolidationRequest{} },
+		"ContributionAndProof":       func() Container { return &altair.ContributionAndProof{} },
+		"Deposit":                    func() Container { return &phase0.Deposit{} },
+		"DepositData":                func() Container { return &phase0.DepositData{} },
+		"DepositMessage":             func() Container { return &phase0.DepositMessage{} },
+		"DepositRequest":             func() Container { return &electra.DepositRequest{} },
+		"Eth1Data":                   func() Container { return &phase0.ETH1Data{} },
+		"ExecutionPayload":           func() Container { return &deneb.ExecutionPayload{} },
+		"ExecutionPayloadHeader":     func() Container { return &deneb.ExecutionPayloadHeader{} },
+		"ExecutionRequests":          func() Container { return &electra.ExecutionRequests{} },
+		"Fork":                       func() Container { return &phase0.Fork{} },
+		"ForkData":                   func() Container { return &phase0.ForkData{} },
+		"HistoricalSummary":          func() Container { return &capella.HistoricalSummary{} },
+		"IndexedAttestation":         func() Container { return &electra.IndexedAttestation{} },
+		"PendingAttestation":         func() Container { return &phase0.PendingAttestation{} },
+		"PendingConsolidation":       func() Container { return &electra.PendingConsolidation{} },
+		"PendingDeposit":             func() Container { return &electra.PendingDeposit{} },
+		"PendingPartialWithdrawal":   func() Container { return &electra.PendingPartialWithdrawal{} },
+		"ProposerSlashing":           func() Container { return &phase0.ProposerSlashing{} },
+		"SignedBeaconBlock":          func() Container { return &electra.SignedBeaconBlock{} },
+		"SignedBeaconBlockHeader":    func() Container { return &phase0.SignedBeaconBlockHeader{} },
+		"SignedBLSToExecutionChange": func() Container { return &capella.SignedBLSToExecutionChange{} },
+		"SignedContributionAndProof": func() Container { return &altair.SignedContributionAndProof{} },
+		"SignedVoluntaryExit":        func() Container { return &phase0.SignedVoluntaryExit{} },
+		"SyncAggregate":              func() Container { return &altair.SyncAggregate{} },
+		"SyncCommittee":              func() Container { return &altair.SyncCommittee{} },
+		"SyncCommitteeContribution":  func() Container { return &altair.SyncCommitteeContribution{} },
+		"SyncCommitteeMessage":       func() Container { return &altair.SyncCommitteeMessage{} },
+		"Validator":                  func() Container { return &phase0.Validator{} },
+		"VoluntaryExit":              func() Container { return &phase0.VoluntaryExit{} },
+		"Withdrawal":                 func() Container { return &capella.Withdrawal{} },
+		"WithdrawalRequest":          func() Container { return &electra.WithdrawalRequest{} },
+	},
+}
+
+// Forks returns the forks for which containers are supported.
+func Forks() []string {
+	res := make([]string, 0, len(containers))
+	for fork := range containers {
+		res = append(res, fork)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// Containers returns the names of the containers supported for the given fork.
+func Containers(fork string) []string {
+	res := make([]string, 0, len(containers[fork]))
+	for name := range containers[fork] {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// NewContainer returns a new instance of the named container for the given fork.
+// It returns nil if the container is not supported.
+func NewContainer(fork string, name string) Container {
+	newContainer, exists := containers[fork][name]
+	if !exists {
+		return nil
+	}
+
+	return newContainer()
+}
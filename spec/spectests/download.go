@@ -0,0 +1,120 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBaseURL is the base URL of the releases of the consensus spec tests.
+const DefaultBaseURL = "https://github.com/ethereum/consensus-spec-tests/releases/download"
+
+// Download downloads the test vectors for the given release (for example "v1.4.0")
+// and preset (for example "mainnet") and extracts the SSZ static vectors to the
+// given directory.
+func Download(ctx context.Context, baseURL string, release string, preset string, dir string) error {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	url := fmt.Sprintf("%s/%s/%s.tar.gz", strings.TrimSuffix(baseURL, "/"), release, preset)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to download test vectors")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download test vectors from %s: status code %d", url, resp.StatusCode)
+	}
+
+	return Extract(resp.Body, dir)
+}
+
+// ExtractFile extracts the SSZ static vectors from a downloaded tarball to the given directory.
+func ExtractFile(path string, dir string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open tarball")
+	}
+	defer f.Close()
+
+	return Extract(f, dir)
+}
+
+// Extract extracts the SSZ static vectors from a gzipped tarball of test vectors
+// to the given directory.  Other vectors are ignored.
+func Extract(reader io.Reader, dir string) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to decompress tarball")
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to read tarball")
+		}
+		if header.Typeflag != tar.TypeReg {
+			// Directories are created as required.
+			continue
+		}
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if !strings.Contains(name, string(filepath.Separator)+"ssz_static"+string(filepath.Separator)) {
+			continue
+		}
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid path %s in tarball", header.Name)
+		}
+
+		if err := extractFile(tarReader, filepath.Join(dir, name)); err != nil {
+			return errors.Wrapf(err, "failed to extract %s", header.Name)
+		}
+	}
+}
+
+func extractFile(reader io.Reader, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	// #nosec G110
+	if _, err := io.Copy(f, reader); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,212 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// Result is the result of a failed test case.
+type Result struct {
+	Preset    string
+	Fork      string
+	Container string
+	Handler   string
+	Case      string
+	Err       error
+}
+
+// String returns a string version of the structure.
+func (r *Result) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s: %v", r.Preset, r.Fork, r.Container, r.Handler, r.Case, r.Err)
+}
+
+// Report is the report of a run of the test vectors.
+type Report struct {
+	// Passed is the number of test cases that passed.
+	Passed int
+	// Failed contains the test cases that failed.
+	Failed []*Result
+	// Unsupported contains the containers present in the test vectors for which
+	// there is no type, in the form preset/fork/container.
+	Unsupported []string
+}
+
+// String returns a string version of the structure.
+func (r *Report) String() string {
+	builder := new(strings.Builder)
+	fmt.Fprintf(builder, "%d passed, %d failed, %d unsupported containers\n", r.Passed, len(r.Failed), len(r.Unsupported))
+	for _, result := range r.Failed {
+		fmt.Fprintf(builder, "FAIL %s\n", result.String())
+	}
+
+	return builder.String()
+}
+
+// Run runs the SSZ static test vectors in the given directory for the given presets.
+// The directory is the root of the extracted test vectors, containing the "tests"
+// directory.  Forks in the test vectors that are not supported are reported as
+// unsupported.
+//
+// The types in the spec packages are generated for the mainnet preset, so containers
+// whose encoding depends on the preset will fail the vectors of other presets unless
+// their code has been regenerated for that preset with presetsszgen.
+func Run(ctx context.Context, dir string, presets []string) (*Report, error) {
+	if len(presets) == 0 {
+		return nil, errors.New("no presets specified")
+	}
+
+	report := &Report{
+		Failed:      make([]*Result, 0),
+		Unsupported: make([]string, 0),
+	}
+	for _, preset := range presets {
+		presetDir := filepath.Join(dir, "tests", preset)
+		forks, err := subdirs(presetDir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain forks for preset %s", preset)
+		}
+		for _, fork := range forks {
+			if err := runFork(ctx, report, preset, fork, filepath.Join(presetDir, fork, "ssz_static")); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func runFork(ctx context.Context, report *Report, preset string, fork string, dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		// No SSZ static vectors for this fork.
+		return nil
+	}
+	names, err := subdirs(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to obtain containers for fork %s", fork)
+	}
+
+	for _, name := range names {
+		if _, exists := containers[fork][name]; !exists {
+			report.Unsupported = append(report.Unsupported, fmt.Sprintf("%s/%s/%s", preset, fork, name))
+			continue
+		}
+		handlers, err := subdirs(filepath.Join(dir, name))
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain handlers for %s", name)
+		}
+		for _, handler := range handlers {
+			cases, err := subdirs(filepath.Join(dir, name, handler))
+			if err != nil {
+				return errors.Wrapf(err, "failed to obtain cases for %s/%s", name, handler)
+			}
+			for _, testCase := range cases {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				err := RunCase(NewContainer(fork, name), filepath.Join(dir, name, handler, testCase))
+				if err != nil {
+					report.Failed = append(report.Failed, &Result{
+						Preset:    preset,
+						Fork:      fork,
+						Container: name,
+						Handler:   handler,
+						Case:      testCase,
+						Err:       err,
+					})
+					continue
+				}
+				report.Passed++
+			}
+		}
+	}
+
+	return nil
+}
+
+type rootsYAML struct {
+	Root string `yaml:"root"`
+}
+
+// RunCase checks a container against a single SSZ static test case in the given
+// directory, for example tests/mainnet/deneb/ssz_static/BeaconBlock/ssz_random/case_0.
+// The contents of serialized.ssz_snappy must decode to the container and encode back
+// to the same SSZ, and the hash tree root of the container must match roots.yaml.
+func RunCase(container Container, dir string) error {
+	compressed, err := os.ReadFile(filepath.Join(dir, "serialized.ssz_snappy"))
+	if err != nil {
+		return errors.Wrap(err, "failed to read serialized data")
+	}
+	serialized, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return errors.Wrap(err, "failed to decompress serialized data")
+	}
+
+	if err := container.UnmarshalSSZ(serialized); err != nil {
+		return errors.Wrap(err, "failed to unmarshal SSZ")
+	}
+	remarshalled, err := container.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal SSZ")
+	}
+	if !bytes.Equal(serialized, remarshalled) {
+		return errors.New("re-encoded SSZ does not match")
+	}
+	if container.SizeSSZ() != len(serialized) {
+		return errors.New("SSZ size does not match")
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "roots.yaml"))
+	if err != nil {
+		return errors.Wrap(err, "failed to read roots")
+	}
+	var roots rootsYAML
+	if err := yaml.Unmarshal(data, &roots); err != nil {
+		return errors.Wrap(err, "failed to parse roots")
+	}
+	root, err := container.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate root")
+	}
+	if !strings.EqualFold(roots.Root, fmt.Sprintf("%#x", root)) {
+		return fmt.Errorf("root mismatch: expected %s, calculated %#x", roots.Root, root)
+	}
+
+	return nil
+}
+
+// subdirs returns the names, in order, of the subdirectories of the given directory.
+func subdirs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			res = append(res, entry.Name())
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,209 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/spectests"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCase writes a test case for the container to the given directory.
+func writeCase(t *testing.T, dir string, container spectests.Container, root string) {
+	t.Helper()
+
+	serialized, err := container.MarshalSSZ()
+	require.NoError(t, err)
+	if root == "" {
+		calculated, err := container.HashTreeRoot()
+		require.NoError(t, err)
+		root = fmt.Sprintf("%#x", calculated)
+	}
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "serialized.ssz_snappy"), snappy.Encode(nil, serialized), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roots.yaml"), []byte(fmt.Sprintf("{root: '%s'}\n", root)), 0o600))
+}
+
+func TestContainers(t *testing.T) {
+	require.Equal(t, []string{"altair", "bellatrix", "capella", "deneb", "electra", "phase0"}, spectests.Forks())
+	for _, fork := range spectests.Forks() {
+		for _, name := range spectests.Containers(fork) {
+			require.NotNil(t, spectests.NewContainer(fork, name), fmt.Sprintf("%s/%s", fork, name))
+		}
+	}
+	require.Nil(t, spectests.NewContainer("phase0", "Unknown"))
+	require.Nil(t, spectests.NewContainer("unknown", "Checkpoint"))
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	staticDir := filepath.Join(dir, "tests", "mainnet", "phase0", "ssz_static")
+
+	checkpoint := &phase0.Checkpoint{
+		Epoch: 12345,
+		Root:  phase0.Root{0x01, 0x02},
+	}
+	writeCase(t, filepath.Join(staticDir, "Checkpoint", "ssz_random", "case_0"), checkpoint, "")
+	writeCase(t, filepath.Join(staticDir, "Checkpoint", "ssz_zero", "case_0"), &phase0.Checkpoint{}, "")
+	writeCase(t, filepath.Join(staticDir, "Checkpoint", "ssz_random", "case_1"), checkpoint,
+		"0x0000000000000000000000000000000000000000000000000000000000000000")
+	// A checkpoint is the wrong size for a fork.
+	writeCase(t, filepath.Join(staticDir, "Fork", "ssz_random", "case_0"), &phase0.Checkpoint{}, "")
+	writeCase(t, filepath.Join(staticDir, "Unknown", "ssz_random", "case_0"), checkpoint, "")
+	writeCase(t, filepath.Join(dir, "tests", "mainnet", "future", "ssz_static", "Checkpoint", "ssz_random", "case_0"), checkpoint, "")
+	// Forks without SSZ static vectors are ignored.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "tests", "mainnet", "bellatrix", "operations"), 0o755))
+
+	_, err := spectests.Run(ctx, dir, nil)
+	require.EqualError(t, err, "no presets specified")
+
+	_, err = spectests.Run(ctx, dir, []string{"minimal"})
+	require.ErrorContains(t, err, "failed to obtain forks for preset minimal")
+
+	report, err := spectests.Run(ctx, dir, []string{"mainnet"})
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Passed)
+	require.Len(t, report.Failed, 2)
+	root, err := checkpoint.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("mainnet/phase0/Checkpoint/ssz_random/case_1: root mismatch: expected 0x0000000000000000000000000000000000000000000000000000000000000000, calculated %#x", root),
+		report.Failed[0].String())
+	require.Equal(t, "mainnet/phase0/Fork/ssz_random/case_0: failed to unmarshal SSZ: incorrect size", report.Failed[1].String())
+	require.Equal(t, []string{"mainnet/future/Checkpoint", "mainnet/phase0/Unknown"}, report.Unsupported)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = spectests.Run(cancelledCtx, dir, []string{"mainnet"})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// testTarball returns a gzipped tarball containing the given files.
+func testTarball(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, data := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return buf.Bytes()
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []byte
+		extracted []string
+		ignored   []string
+		err       string
+	}{
+		{
+			name:  "NotGzip",
+			input: []byte("data"),
+			err:   "failed to decompress tarball: unexpected EOF",
+		},
+		{
+			name: "Good",
+			input: testTarball(t, map[string][]byte{
+				"tests/mainnet/phase0/ssz_static/Checkpoint/ssz_random/case_0/roots.yaml": []byte("{root: '0x00'}"),
+				"tests/mainnet/phase0/operations/attestation/pyspec_tests/case_0/pre.ssz": []byte("data"),
+			}),
+			extracted: []string{"tests/mainnet/phase0/ssz_static/Checkpoint/ssz_random/case_0/roots.yaml"},
+			ignored:   []string{"tests/mainnet/phase0/operations/attestation/pyspec_tests/case_0/pre.ssz"},
+		},
+		{
+			name: "Traversal",
+			input: testTarball(t, map[string][]byte{
+				"../tests/mainnet/phase0/ssz_static/Checkpoint/ssz_random/case_0/roots.yaml": []byte("{root: '0x00'}"),
+			}),
+			err: "invalid path ../tests/mainnet/phase0/ssz_static/Checkpoint/ssz_random/case_0/roots.yaml in tarball",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := spectests.Extract(bytes.NewReader(test.input), dir)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				for _, name := range test.extracted {
+					require.FileExists(t, filepath.Join(dir, name))
+				}
+				for _, name := range test.ignored {
+					require.NoFileExists(t, filepath.Join(dir, name))
+				}
+			}
+		})
+	}
+}
+
+func TestDownload(t *testing.T) {
+	tarball := testTarball(t, map[string][]byte{
+		"tests/minimal/phase0/ssz_static/Checkpoint/ssz_random/case_0/roots.yaml": []byte("{root: '0x00'}"),
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.4.0/minimal.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(tarball)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, spectests.Download(ctx, srv.URL, "v1.4.0", "minimal", dir))
+	require.FileExists(t, filepath.Join(dir, "tests/minimal/phase0/ssz_static/Checkpoint/ssz_random/case_0/roots.yaml"))
+
+	err := spectests.Download(ctx, srv.URL, "v1.4.0", "mainnet", dir)
+	require.EqualError(t, err, fmt.Sprintf("failed to download test vectors from %s/v1.4.0/mainnet.tar.gz: status code 404", srv.URL))
+}
+
+// TestConsensusSpec runs the consensus spec tests, if available.
+func TestConsensusSpec(t *testing.T) {
+	if os.Getenv("CONSENSUS_SPEC_TESTS_DIR") == "" {
+		t.Skip("CONSENSUS_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+
+	report, err := spectests.Run(context.Background(), os.Getenv("CONSENSUS_SPEC_TESTS_DIR"), []string{"mainnet"})
+	require.NoError(t, err)
+	require.Empty(t, report.Failed, report.String())
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"github.com/pkg/errors"
+)
+
+// MaxGossipSize is the maximum size of an uncompressed message on the gossip
+// network, as given by GOSSIP_MAX_SIZE.  Blocks larger than this cannot be
+// published.
+const MaxGossipSize = 10 * 1024 * 1024
+
+// SSZSizer is the interface for versioned containers that provide the size of
+// their SSZ encoding.
+type SSZSizer interface {
+	SizeSSZ() (int, error)
+}
+
+// CheckSSZSize returns the size of the SSZ encoding of the object, returning
+// an error if the size cannot be obtained or exceeds the given maximum size.
+// The returned size can be used to pre-allocate a buffer for the encoding.
+func CheckSSZSize(obj SSZSizer, maxSize int) (int, error) {
+	if obj == nil {
+		return 0, errors.New("no object supplied")
+	}
+	size, err := obj.SizeSSZ()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain size")
+	}
+	if size > maxSize {
+		return 0, errors.Errorf("size %d exceeds maximum %d", size, maxSize)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSSZSize(t *testing.T) {
+	block := &phase0.SignedBeaconBlock{
+		Message: &phase0.BeaconBlock{
+			Slot: 1,
+			Body: &phase0.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+			},
+		},
+	}
+	encoded, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		obj      spec.SSZSizer
+		maxSize  int
+		expected int
+		err      string
+	}{
+		{
+			name:    "Nil",
+			maxSize: spec.MaxGossipSize,
+			err:     "no object supplied",
+		},
+		{
+			name:    "Empty",
+			obj:     &spec.VersionedSignedBeaconBlock{},
+			maxSize: spec.MaxGossipSize,
+			err:     "failed to obtain size: unknown version",
+		},
+		{
+			name: "BlockMissing",
+			obj: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+			},
+			maxSize: spec.MaxGossipSize,
+			err:     "failed to obtain size: no phase0 signed beacon block",
+		},
+		{
+			name: "TooLarge",
+			obj: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0:  block,
+			},
+			maxSize: len(encoded) - 1,
+			err:     "size 404 exceeds maximum 403",
+		},
+		{
+			name: "Good",
+			obj: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0:  block,
+			},
+			maxSize:  spec.MaxGossipSize,
+			expected: len(encoded),
+		},
+		{
+			name: "GoodExact",
+			obj: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0:  block,
+			},
+			maxSize:  len(encoded),
+			expected: len(encoded),
+		},
+		{
+			name: "Unknown",
+			obj: &spec.VersionedSignedBeaconBlock{
+				Unknown: &spec.UnknownVersionData{
+					Version: "future",
+					SSZ:     []byte{0x01, 0x02, 0x03},
+				},
+			},
+			maxSize:  spec.MaxGossipSize,
+			expected: 3,
+		},
+		{
+			name: "State",
+			obj: &spec.VersionedBeaconState{
+				Version: spec.DataVersionPhase0,
+				Phase0:  testBeaconState(10),
+			},
+			maxSize:  spec.MaxGossipSize,
+			expected: testBeaconState(10).SizeSSZ(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			size, err := spec.CheckSSZSize(test.obj, test.maxSize)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, size)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnknownVersionData contains data for a consensus version that is not known
+// to this library.  It is provided so that data from new forks can be stored
+// and processed later, rather than being lost.
+type UnknownVersionData struct {
+	// Version is the consensus version supplied with the data.
+	Version string
+	// SSZ is the SSZ-encoded data, if the data was supplied as SSZ.
+	SSZ []byte
+	// JSON is the JSON-encoded data, if the data was supplied as JSON.
+	JSON json.RawMessage
+}
+
+// String returns a string version of the structure.
+func (u *UnknownVersionData) String() string {
+	if u.JSON != nil {
+		return fmt.Sprintf("unknown version %s: %s", u.Version, string(u.JSON))
+	}
+
+	return fmt.Sprintf("unknown version %s: %#x", u.Version, u.SSZ)
+}
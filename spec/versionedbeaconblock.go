@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,11 +32,12 @@ type VersionedBeaconBlock struct {
 	Bellatrix *bellatrix.BeaconBlock
 	Capella   *capella.BeaconBlock
 	Deneb     *deneb.BeaconBlock
+	Electra   *electra.BeaconBlock
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconBlock) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil
 }
 
 // Slot returns the slot of the beacon block.
@@ -66,6 +68,11 @@ func (v *VersionedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -99,6 +106,11 @@ func (v *VersionedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
 			return 0, errors.New("no deneb block")
 		}
 		return v.Deneb.ProposerIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.ProposerIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -132,6 +144,11 @@ func (v *VersionedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -165,6 +182,11 @@ func (v *VersionedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -198,6 +220,11 @@ func (v *VersionedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.ParentRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -231,6 +258,11 @@ func (v *VersionedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.StateRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -264,6 +296,8 @@ func (v *VersionedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.Attestations, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra block does not provide phase0 attestations")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -297,6 +331,8 @@ func (v *VersionedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlashing,
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.AttesterSlashings, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra block does not provide phase0 attester slashings")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -330,11 +366,54 @@ func (v *VersionedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlashing,
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Body.ProposerSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Body == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
+// SizeSSZ returns the size of the SSZ encoding of the beacon block.
+func (v *VersionedBeaconBlock) SizeSSZ() (int, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, errors.New("no phase0 beacon block")
+		}
+		return v.Phase0.SizeSSZ(), nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair beacon block")
+		}
+		return v.Altair.SizeSSZ(), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix beacon block")
+		}
+		return v.Bellatrix.SizeSSZ(), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella beacon block")
+		}
+		return v.Capella.SizeSSZ(), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb beacon block")
+		}
+		return v.Deneb.SizeSSZ(), nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra beacon block")
+		}
+		return v.Electra.SizeSSZ(), nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconBlock) String() string {
 	switch v.Version {
@@ -363,6 +442,11 @@ func (v *VersionedBeaconBlock) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -14,10 +14,13 @@
 package spec
 
 import (
+	"errors"
+
 	"github.com/attestantio/go-eth2-client/spec/altair"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -29,6 +32,45 @@ type VersionedBeaconBlockBody struct {
 	Bellatrix *bellatrix.BeaconBlockBody
 	Capella   *capella.BeaconBlockBody
 	Deneb     *deneb.BeaconBlockBody
+	Electra   *electra.BeaconBlockBody
+}
+
+// SizeSSZ returns the size of the SSZ encoding of the beacon block body.
+func (v *VersionedBeaconBlockBody) SizeSSZ() (int, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, errors.New("no phase0 beacon block body")
+		}
+		return v.Phase0.SizeSSZ(), nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair beacon block body")
+		}
+		return v.Altair.SizeSSZ(), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix beacon block body")
+		}
+		return v.Bellatrix.SizeSSZ(), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella beacon block body")
+		}
+		return v.Capella.SizeSSZ(), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb beacon block body")
+		}
+		return v.Deneb.SizeSSZ(), nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra beacon block body")
+		}
+		return v.Electra.SizeSSZ(), nil
+	default:
+		return 0, errors.New("unknown version")
+	}
 }
 
 // String returns a string version of the structure.
@@ -59,6 +101,11 @@ func (v *VersionedBeaconBlockBody) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
 		return "unknown version"
 	}
@@ -20,7 +20,9 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
 )
 
 // VersionedBeaconState contains a versioned beacon state.
@@ -31,11 +33,14 @@ type VersionedBeaconState struct {
 	Bellatrix *bellatrix.BeaconState
 	Capella   *capella.BeaconState
 	Deneb     *deneb.BeaconState
+	Electra   *electra.BeaconState
+	// Unknown contains the raw data if the version is not known to this library.
+	Unknown *UnknownVersionData
 }
 
 // IsEmpty returns true if there is no block.
 func (v *VersionedBeaconState) IsEmpty() bool {
-	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil
+	return v.Phase0 == nil && v.Altair == nil && v.Bellatrix == nil && v.Capella == nil && v.Deneb == nil && v.Electra == nil && v.Unknown == nil
 }
 
 // Slot returns the slot of the state.
@@ -66,11 +71,362 @@ func (v *VersionedBeaconState) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no Deneb state")
 		}
 		return v.Deneb.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+		return v.Electra.Slot, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// GenesisTime returns the genesis time of the state.
+func (v *VersionedBeaconState) GenesisTime() (uint64, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, errors.New("no Phase0 state")
+		}
+		return v.Phase0.GenesisTime, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no Altair state")
+		}
+		return v.Altair.GenesisTime, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.GenesisTime, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no Capella state")
+		}
+		return v.Capella.GenesisTime, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no Deneb state")
+		}
+		return v.Deneb.GenesisTime, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+		return v.Electra.GenesisTime, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
 }
 
+// GenesisValidatorsRoot returns the genesis validators root of the state.
+func (v *VersionedBeaconState) GenesisValidatorsRoot() (phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return phase0.Root{}, errors.New("no Phase0 state")
+		}
+		return v.Phase0.GenesisValidatorsRoot, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return phase0.Root{}, errors.New("no Altair state")
+		}
+		return v.Altair.GenesisValidatorsRoot, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Root{}, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.GenesisValidatorsRoot, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Root{}, errors.New("no Capella state")
+		}
+		return v.Capella.GenesisValidatorsRoot, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Root{}, errors.New("no Deneb state")
+		}
+		return v.Deneb.GenesisValidatorsRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no Electra state")
+		}
+		return v.Electra.GenesisValidatorsRoot, nil
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+}
+
+// Fork returns the fork of the state.
+func (v *VersionedBeaconState) Fork() (*phase0.Fork, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.Fork, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.Fork, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.Fork, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.Fork, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.Fork, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.Fork, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// LatestBlockHeader returns the latest block header of the state.
+func (v *VersionedBeaconState) LatestBlockHeader() (*phase0.BeaconBlockHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.LatestBlockHeader, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.LatestBlockHeader, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.LatestBlockHeader, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.LatestBlockHeader, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.LatestBlockHeader, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.LatestBlockHeader, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// ETH1DepositIndex returns the ETH1 deposit index of the state.
+func (v *VersionedBeaconState) ETH1DepositIndex() (uint64, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, errors.New("no Phase0 state")
+		}
+		return v.Phase0.ETH1DepositIndex, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no Altair state")
+		}
+		return v.Altair.ETH1DepositIndex, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.ETH1DepositIndex, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no Capella state")
+		}
+		return v.Capella.ETH1DepositIndex, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no Deneb state")
+		}
+		return v.Deneb.ETH1DepositIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+		return v.Electra.ETH1DepositIndex, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// PreviousJustifiedCheckpoint returns the previous justified checkpoint of the state.
+func (v *VersionedBeaconState) PreviousJustifiedCheckpoint() (*phase0.Checkpoint, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.PreviousJustifiedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.PreviousJustifiedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.PreviousJustifiedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.PreviousJustifiedCheckpoint, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.PreviousJustifiedCheckpoint, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.PreviousJustifiedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentJustifiedCheckpoint returns the current justified checkpoint of the state.
+func (v *VersionedBeaconState) CurrentJustifiedCheckpoint() (*phase0.Checkpoint, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.CurrentJustifiedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.CurrentJustifiedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.CurrentJustifiedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.CurrentJustifiedCheckpoint, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.CurrentJustifiedCheckpoint, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.CurrentJustifiedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the state.
+func (v *VersionedBeaconState) FinalizedCheckpoint() (*phase0.Checkpoint, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.FinalizedCheckpoint, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.FinalizedCheckpoint, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.FinalizedCheckpoint, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.FinalizedCheckpoint, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.FinalizedCheckpoint, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.FinalizedCheckpoint, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// LatestExecutionPayloadHeader returns the latest execution payload header of the state.
+func (v *VersionedBeaconState) LatestExecutionPayloadHeader() (*VersionedExecutionPayloadHeader, error) {
+	switch v.Version {
+	case DataVersionPhase0, DataVersionAltair:
+		return nil, errors.New("state does not provide latest execution payload header")
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version:   v.Version,
+			Bellatrix: v.Bellatrix.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Capella: v.Capella.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Deneb:   v.Deneb.LatestExecutionPayloadHeader,
+		}, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return &VersionedExecutionPayloadHeader{
+			Version: v.Version,
+			Electra: v.Electra.LatestExecutionPayloadHeader,
+		}, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
 // NextWithdrawalValidatorIndex returns the next withdrawal validator index of the state.
 func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorIndex, error) {
 	switch v.Version {
@@ -86,6 +442,11 @@ func (v *VersionedBeaconState) NextWithdrawalValidatorIndex() (phase0.ValidatorI
 			return 0, errors.New("no Deneb state")
 		}
 		return v.Deneb.NextWithdrawalValidatorIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no Electra state")
+		}
+		return v.Electra.NextWithdrawalValidatorIndex, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -119,6 +480,11 @@ func (v *VersionedBeaconState) Validators() ([]*phase0.Validator, error) {
 			return nil, errors.New("no Deneb state")
 		}
 		return v.Deneb.Validators, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.Validators, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -152,11 +518,203 @@ func (v *VersionedBeaconState) ValidatorBalances() ([]phase0.Gwei, error) {
 			return nil, errors.New("no Deneb state")
 		}
 		return v.Deneb.Balances, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.Balances, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// RANDAOMixes returns the RANDAO mixes of the state.
+func (v *VersionedBeaconState) RANDAOMixes() ([]phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.RANDAOMixes, nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.RANDAOMixes, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.RANDAOMixes, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.RANDAOMixes, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.RANDAOMixes, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.RANDAOMixes, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// CurrentSyncCommittee returns the current sync committee of the state.
+func (v *VersionedBeaconState) CurrentSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide current sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.CurrentSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.CurrentSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.CurrentSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.CurrentSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.CurrentSyncCommittee, nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+// NextSyncCommittee returns the next sync committee of the state.
+func (v *VersionedBeaconState) NextSyncCommittee() (*altair.SyncCommittee, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		return nil, errors.New("state does not provide next sync committee")
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.NextSyncCommittee, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.NextSyncCommittee, nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.NextSyncCommittee, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.NextSyncCommittee, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.NextSyncCommittee, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
+// SizeSSZ returns the size of the SSZ encoding of the beacon state.
+func (v *VersionedBeaconState) SizeSSZ() (int, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, errors.New("no phase0 beacon state")
+		}
+		return v.Phase0.SizeSSZ(), nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair beacon state")
+		}
+		return v.Altair.SizeSSZ(), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix beacon state")
+		}
+		return v.Bellatrix.SizeSSZ(), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella beacon state")
+		}
+		return v.Capella.SizeSSZ(), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb beacon state")
+		}
+		return v.Deneb.SizeSSZ(), nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra beacon state")
+		}
+		return v.Electra.SizeSSZ(), nil
+	default:
+		if v.Unknown == nil || v.Unknown.SSZ == nil {
+			return 0, errors.New("unknown version")
+		}
+		return len(v.Unknown.SSZ), nil
+	}
+}
+
+// HashTreeRoot returns the hash tree root of the beacon state.
+func (v *VersionedBeaconState) HashTreeRoot() (phase0.Root, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return phase0.Root{}, errors.New("no phase0 beacon state")
+		}
+		return v.Phase0.HashTreeRoot()
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return phase0.Root{}, errors.New("no altair beacon state")
+		}
+		return v.Altair.HashTreeRoot()
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return phase0.Root{}, errors.New("no bellatrix beacon state")
+		}
+		return v.Bellatrix.HashTreeRoot()
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return phase0.Root{}, errors.New("no capella beacon state")
+		}
+		return v.Capella.HashTreeRoot()
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return phase0.Root{}, errors.New("no deneb beacon state")
+		}
+		return v.Deneb.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra beacon state")
+		}
+		return v.Electra.HashTreeRoot()
+	default:
+		return phase0.Root{}, errors.New("unknown version")
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedBeaconState) String() string {
 	switch v.Version {
@@ -185,7 +743,55 @@ func (v *VersionedBeaconState) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
-		return "unknown version"
+		if v.Unknown == nil {
+			return "unknown version"
+		}
+		return v.Unknown.String()
+	}
+}
+
+// Prove generates a proof for the given generalized index of the state.
+// Generalized indices depend on the version of the state: use those in phase0 for
+// states up to and including deneb, and those in electra for electra states.
+func (v *VersionedBeaconState) Prove(generalizedIndex uint64) (*ssz.Proof, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no Phase0 state")
+		}
+		return v.Phase0.Prove(generalizedIndex)
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no Altair state")
+		}
+		return v.Altair.Prove(generalizedIndex)
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no Bellatrix state")
+		}
+		return v.Bellatrix.Prove(generalizedIndex)
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no Capella state")
+		}
+		return v.Capella.Prove(generalizedIndex)
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no Deneb state")
+		}
+		return v.Deneb.Prove(generalizedIndex)
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no Electra state")
+		}
+		return v.Electra.Prove(generalizedIndex)
+	default:
+		return nil, errors.New("unknown version")
 	}
 }
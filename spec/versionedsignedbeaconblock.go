@@ -20,6 +20,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -31,6 +32,9 @@ type VersionedSignedBeaconBlock struct {
 	Bellatrix *bellatrix.SignedBeaconBlock
 	Capella   *capella.SignedBeaconBlock
 	Deneb     *deneb.SignedBeaconBlock
+	Electra   *electra.SignedBeaconBlock
+	// Unknown contains the raw data if the version is not known to this library.
+	Unknown *UnknownVersionData
 }
 
 // Slot returns the slot of the signed beacon block.
@@ -61,6 +65,11 @@ func (v *VersionedSignedBeaconBlock) Slot() (phase0.Slot, error) {
 			return 0, errors.New("no denb block")
 		}
 		return v.Deneb.Message.Slot, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.Slot, nil
 	default:
 		return 0, errors.New("unknown version")
 	}
@@ -78,17 +87,116 @@ func (v *VersionedSignedBeaconBlock) ExecutionBlockHash() (phase0.Hash32, error)
 		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
 			return phase0.Hash32{}, errors.New("no capella block")
 		}
-		return v.Bellatrix.Message.Body.ExecutionPayload.BlockHash, nil
+		return v.Capella.Message.Body.ExecutionPayload.BlockHash, nil
 	case DataVersionDeneb:
 		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
 			return phase0.Hash32{}, errors.New("no denb block")
 		}
-		return v.Bellatrix.Message.Body.ExecutionPayload.BlockHash, nil
+		return v.Deneb.Message.Body.ExecutionPayload.BlockHash, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return phase0.Hash32{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.BlockHash, nil
 	default:
 		return phase0.Hash32{}, errors.New("unknown version")
 	}
 }
 
+// ProposerIndex returns the proposer index of the beacon block.
+func (v *VersionedSignedBeaconBlock) ProposerIndex() (phase0.ValidatorIndex, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil || v.Phase0.Message == nil {
+			return 0, errors.New("no phase0 block")
+		}
+		return v.Phase0.Message.ProposerIndex, nil
+	case DataVersionAltair:
+		if v.Altair == nil || v.Altair.Message == nil {
+			return 0, errors.New("no altair block")
+		}
+		return v.Altair.Message.ProposerIndex, nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil {
+			return 0, errors.New("no bellatrix block")
+		}
+		return v.Bellatrix.Message.ProposerIndex, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil {
+			return 0, errors.New("no capella block")
+		}
+		return v.Capella.Message.ProposerIndex, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil {
+			return 0, errors.New("no deneb block")
+		}
+		return v.Deneb.Message.ProposerIndex, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil {
+			return 0, errors.New("no electra block")
+		}
+		return v.Electra.Message.ProposerIndex, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// FeeRecipient returns the fee recipient of the execution payload of the beacon block.
+func (v *VersionedSignedBeaconBlock) FeeRecipient() (bellatrix.ExecutionAddress, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no bellatrix block")
+		}
+		return v.Bellatrix.Message.Body.ExecutionPayload.FeeRecipient, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no capella block")
+		}
+		return v.Capella.Message.Body.ExecutionPayload.FeeRecipient, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no deneb block")
+		}
+		return v.Deneb.Message.Body.ExecutionPayload.FeeRecipient, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return bellatrix.ExecutionAddress{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.FeeRecipient, nil
+	default:
+		return bellatrix.ExecutionAddress{}, errors.New("unsupported version")
+	}
+}
+
+// ExecutionTransactions returns the execution payload transactions of the beacon block.
+func (v *VersionedSignedBeaconBlock) ExecutionTransactions() ([]bellatrix.Transaction, error) {
+	switch v.Version {
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		return v.Bellatrix.Message.Body.ExecutionPayload.Transactions, nil
+	case DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella block")
+		}
+		return v.Capella.Message.Body.ExecutionPayload.Transactions, nil
+	case DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no deneb block")
+		}
+		return v.Deneb.Message.Body.ExecutionPayload.Transactions, nil
+	case DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ExecutionPayload.Transactions, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
 // Attestations returns the attestations of the beacon block.
 func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, error) {
 	switch v.Version {
@@ -117,6 +225,8 @@ func (v *VersionedSignedBeaconBlock) Attestations() ([]*phase0.Attestation, erro
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.Attestations, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra block does not provide phase0 attestations")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -150,6 +260,11 @@ func (v *VersionedSignedBeaconBlock) Root() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -183,6 +298,11 @@ func (v *VersionedSignedBeaconBlock) BodyRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.HashTreeRoot()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.HashTreeRoot()
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -216,6 +336,11 @@ func (v *VersionedSignedBeaconBlock) ParentRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.ParentRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.ParentRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -249,6 +374,11 @@ func (v *VersionedSignedBeaconBlock) StateRoot() (phase0.Root, error) {
 			return phase0.Root{}, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.StateRoot, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return phase0.Root{}, errors.New("no electra block")
+		}
+		return v.Electra.Message.StateRoot, nil
 	default:
 		return phase0.Root{}, errors.New("unknown version")
 	}
@@ -282,6 +412,8 @@ func (v *VersionedSignedBeaconBlock) AttesterSlashings() ([]*phase0.AttesterSlas
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.AttesterSlashings, nil
+	case DataVersionElectra:
+		return nil, errors.New("electra block does not provide phase0 attester slashings")
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -315,6 +447,11 @@ func (v *VersionedSignedBeaconBlock) ProposerSlashings() ([]*phase0.ProposerSlas
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.ProposerSlashings, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.ProposerSlashings, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
@@ -345,11 +482,57 @@ func (v *VersionedSignedBeaconBlock) SyncAggregate() (*altair.SyncAggregate, err
 			return nil, errors.New("no deneb block")
 		}
 		return v.Deneb.Message.Body.SyncAggregate, nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		return v.Electra.Message.Body.SyncAggregate, nil
 	default:
 		return nil, errors.New("unknown version")
 	}
 }
 
+// SizeSSZ returns the size of the SSZ encoding of the signed beacon block.
+func (v *VersionedSignedBeaconBlock) SizeSSZ() (int, error) {
+	switch v.Version {
+	case DataVersionPhase0:
+		if v.Phase0 == nil {
+			return 0, errors.New("no phase0 signed beacon block")
+		}
+		return v.Phase0.SizeSSZ(), nil
+	case DataVersionAltair:
+		if v.Altair == nil {
+			return 0, errors.New("no altair signed beacon block")
+		}
+		return v.Altair.SizeSSZ(), nil
+	case DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return 0, errors.New("no bellatrix signed beacon block")
+		}
+		return v.Bellatrix.SizeSSZ(), nil
+	case DataVersionCapella:
+		if v.Capella == nil {
+			return 0, errors.New("no capella signed beacon block")
+		}
+		return v.Capella.SizeSSZ(), nil
+	case DataVersionDeneb:
+		if v.Deneb == nil {
+			return 0, errors.New("no deneb signed beacon block")
+		}
+		return v.Deneb.SizeSSZ(), nil
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return 0, errors.New("no electra signed beacon block")
+		}
+		return v.Electra.SizeSSZ(), nil
+	default:
+		if v.Unknown == nil || v.Unknown.SSZ == nil {
+			return 0, errors.New("unknown version")
+		}
+		return len(v.Unknown.SSZ), nil
+	}
+}
+
 // String returns a string version of the structure.
 func (v *VersionedSignedBeaconBlock) String() string {
 	switch v.Version {
@@ -378,7 +561,15 @@ func (v *VersionedSignedBeaconBlock) String() string {
 			return ""
 		}
 		return v.Deneb.String()
+	case DataVersionElectra:
+		if v.Electra == nil {
+			return ""
+		}
+		return v.Electra.String()
 	default:
-		return "unknown version"
+		if v.Unknown == nil {
+			return "unknown version"
+		}
+		return v.Unknown.String()
 	}
 }
@@ -0,0 +1,110 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subnets
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// SelectionProofSigner signs the slot selection proof for the validator with the given public key.
+type SelectionProofSigner func(ctx context.Context, pubKey phase0.BLSPubKey, slot phase0.Slot) (phase0.BLSSignature, error)
+
+type parameters struct {
+	logLevel zerolog.Level
+	client   consensusclient.Service
+	epochs   uint64
+	signer   SelectionProofSigner
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithClient sets the client from which duties are obtained.  The client must provide
+// attester duties, slots per epoch and target aggregators per committee.
+func WithClient(client consensusclient.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.client = client
+	})
+}
+
+// WithEpochs sets the number of epochs, starting with the current epoch, for which
+// assignments are held.  Beacon nodes do not provide duties beyond the next epoch, so
+// this can be at most 2.
+func WithEpochs(epochs uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.epochs = epochs
+	})
+}
+
+// WithSelectionProofSigner sets the signer used to obtain selection proofs, from which
+// aggregator assignments are calculated.  If not set, no validator is an aggregator.
+func WithSelectionProofSigner(signer SelectionProofSigner) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signer = signer
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		epochs:   2,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.client == nil {
+		return nil, errors.New("no client specified")
+	}
+	if _, isProvider := parameters.client.(consensusclient.AttesterDutiesProvider); !isProvider {
+		return nil, errors.New("client does not provide attester duties")
+	}
+	if _, isProvider := parameters.client.(consensusclient.SlotsPerEpochProvider); !isProvider {
+		return nil, errors.New("client does not provide slots per epoch")
+	}
+	if _, isProvider := parameters.client.(consensusclient.TargetAggregatorsPerCommitteeProvider); !isProvider {
+		return nil, errors.New("client does not provide target aggregators per committee")
+	}
+	if parameters.epochs == 0 {
+		return nil, errors.New("no epochs specified")
+	}
+	if parameters.epochs > 2 {
+		return nil, errors.New("epochs cannot be more than 2")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,301 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subnets
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Assignment is the subnet and aggregator assignment for a validator's attestation duty.
+type Assignment struct {
+	ValidatorIndex   phase0.ValidatorIndex
+	PubKey           phase0.BLSPubKey
+	Slot             phase0.Slot
+	CommitteeIndex   phase0.CommitteeIndex
+	CommitteesAtSlot uint64
+	CommitteeLength  uint64
+	// Subnet is the attestation subnet for the committee.
+	Subnet uint64
+	// IsAggregator is true if the validator is an aggregator for the committee.
+	IsAggregator bool
+	// SelectionProof is the selection proof for the slot, if a signer is configured.
+	SelectionProof *phase0.BLSSignature
+}
+
+// Service holds the subnet and aggregator assignments of a set of validators for the
+// current and upcoming epochs, refreshing them when the duties change.
+type Service struct {
+	log                           zerolog.Logger
+	dutiesProvider                consensusclient.AttesterDutiesProvider
+	signer                        SelectionProofSigner
+	epochs                        uint64
+	slotsPerEpoch                 uint64
+	targetAggregatorsPerCommittee uint64
+
+	mu         sync.RWMutex
+	validators []phase0.ValidatorIndex
+	// generation increments each time the validators change, so that duties obtained
+	// for a previous set of validators are not stored.
+	generation  uint64
+	assignments map[phase0.Epoch]*epochAssignments
+}
+
+type epochAssignments struct {
+	// dependentRoot is the root on which the duties depend, if known.
+	dependentRoot phase0.Root
+	assignments   []*Assignment
+}
+
+// New creates a new subnets service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "subnets").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	slotsPerEpoch, err := parameters.client.(consensusclient.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch of 0 not supported")
+	}
+	targetAggregatorsPerCommittee, err := parameters.client.(consensusclient.TargetAggregatorsPerCommitteeProvider).TargetAggregatorsPerCommittee(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain target aggregators per committee")
+	}
+
+	return &Service{
+		log:                           log,
+		dutiesProvider:                parameters.client.(consensusclient.AttesterDutiesProvider),
+		signer:                        parameters.signer,
+		epochs:                        parameters.epochs,
+		slotsPerEpoch:                 slotsPerEpoch,
+		targetAggregatorsPerCommittee: targetAggregatorsPerCommittee,
+		assignments:                   make(map[phase0.Epoch]*epochAssignments),
+	}, nil
+}
+
+// SetValidators sets the validators for which assignments are held, replacing any that
+// were previously set.  Existing assignments are discarded, and are obtained again on
+// the next refresh.
+func (s *Service) SetValidators(validators []phase0.ValidatorIndex) {
+	indices := make([]phase0.ValidatorIndex, len(validators))
+	copy(indices, validators)
+
+	s.mu.Lock()
+	s.validators = indices
+	s.generation++
+	s.assignments = make(map[phase0.Epoch]*epochAssignments)
+	s.mu.Unlock()
+}
+
+// Refresh ensures that assignments are held for the given epoch and those following it,
+// and discards assignments for earlier epochs.
+func (s *Service) Refresh(ctx context.Context, epoch phase0.Epoch) error {
+	return s.refresh(ctx, epoch, nil)
+}
+
+// HandleHeadEvent refreshes assignments whose duties have changed as a result of the new
+// head, as shown by a change in their dependent root.  It is intended to be called from a
+// handler for head events.
+func (s *Service) HandleHeadEvent(ctx context.Context, event *apiv1.HeadEvent) error {
+	if event == nil {
+		return errors.New("no event supplied")
+	}
+
+	// Attester duties for an epoch depend on the block at the end of the epoch two before it,
+	// so the previous duty dependent root applies to the current epoch and the current duty
+	// dependent root applies to the next epoch.
+	epoch := phase0.Epoch(uint64(event.Slot) / s.slotsPerEpoch)
+	dependentRoots := map[phase0.Epoch]phase0.Root{
+		epoch:     event.PreviousDutyDependentRoot,
+		epoch + 1: event.CurrentDutyDependentRoot,
+	}
+
+	return s.refresh(ctx, epoch, dependentRoots)
+}
+
+// refresh obtains assignments for epochs that are missing, or whose dependent root differs
+// from that supplied.
+func (s *Service) refresh(ctx context.Context, epoch phase0.Epoch, dependentRoots map[phase0.Epoch]phase0.Root) error {
+	s.mu.Lock()
+	for assignmentsEpoch := range s.assignments {
+		if assignmentsEpoch < epoch {
+			delete(s.assignments, assignmentsEpoch)
+		}
+	}
+	validators := s.validators
+	generation := s.generation
+	required := make([]phase0.Epoch, 0, s.epochs)
+	for i := uint64(0); i < s.epochs; i++ {
+		requiredEpoch := epoch + phase0.Epoch(i)
+		existing, exists := s.assignments[requiredEpoch]
+		dependentRoot, known := dependentRoots[requiredEpoch]
+		switch {
+		case !exists:
+			required = append(required, requiredEpoch)
+		case !known:
+		case existing.dependentRoot == (phase0.Root{}):
+			// Assignments were obtained before the dependent root was known.
+			existing.dependentRoot = dependentRoot
+		case existing.dependentRoot != dependentRoot:
+			s.log.Trace().Uint64("epoch", uint64(requiredEpoch)).Msg("Dependent root changed")
+			required = append(required, requiredEpoch)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, requiredEpoch := range required {
+		assignments, err := s.fetchAssignments(ctx, requiredEpoch, validators)
+		if err != nil {
+			return errors.Wrapf(err, "failed to obtain assignments for epoch %d", requiredEpoch)
+		}
+		assignments.dependentRoot = dependentRoots[requiredEpoch]
+
+		s.mu.Lock()
+		if s.generation == generation {
+			s.assignments[requiredEpoch] = assignments
+		}
+		s.mu.Unlock()
+	}
+
+	return nil
+}
+
+// fetchAssignments obtains the assignments for the given validators in the given epoch.
+func (s *Service) fetchAssignments(ctx context.Context,
+	epoch phase0.Epoch,
+	validators []phase0.ValidatorIndex,
+) (
+	*epochAssignments,
+	error,
+) {
+	res := &epochAssignments{
+		assignments: make([]*Assignment, 0, len(validators)),
+	}
+	if len(validators) == 0 {
+		return res, nil
+	}
+
+	duties, err := s.dutiesProvider.AttesterDuties(ctx, epoch, validators)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain attester duties")
+	}
+
+	for _, duty := range duties {
+		assignment := &Assignment{
+			ValidatorIndex:   duty.ValidatorIndex,
+			PubKey:           duty.PubKey,
+			Slot:             duty.Slot,
+			CommitteeIndex:   duty.CommitteeIndex,
+			CommitteesAtSlot: duty.CommitteesAtSlot,
+			CommitteeLength:  duty.CommitteeLength,
+			Subnet:           ComputeSubnet(s.slotsPerEpoch, duty.CommitteesAtSlot, duty.Slot, duty.CommitteeIndex),
+		}
+		if s.signer != nil {
+			selectionProof, err := s.signer(ctx, duty.PubKey, duty.Slot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to obtain selection proof for validator %d", duty.ValidatorIndex)
+			}
+			assignment.SelectionProof = &selectionProof
+			assignment.IsAggregator = IsAggregator(duty.CommitteeLength, s.targetAggregatorsPerCommittee, selectionProof)
+		}
+		res.assignments = append(res.assignments, assignment)
+	}
+	sort.Slice(res.assignments, func(i, j int) bool {
+		if res.assignments[i].Slot != res.assignments[j].Slot {
+			return res.assignments[i].Slot < res.assignments[j].Slot
+		}
+		return res.assignments[i].ValidatorIndex < res.assignments[j].ValidatorIndex
+	})
+
+	return res, nil
+}
+
+// Assignments returns the assignments for the given slot, ordered by validator index.
+func (s *Service) Assignments(slot phase0.Slot) []*Assignment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	epochAssignments, exists := s.assignments[phase0.Epoch(uint64(slot)/s.slotsPerEpoch)]
+	if !exists {
+		return nil
+	}
+	res := make([]*Assignment, 0)
+	for _, assignment := range epochAssignments.assignments {
+		if assignment.Slot == slot {
+			res = append(res, assignment)
+		}
+	}
+
+	return res
+}
+
+// Subnets returns the subnets required by the validators in the given epoch.  The value
+// for each subnet is true if at least one validator aggregates on it.
+func (s *Service) Subnets(epoch phase0.Epoch) map[uint64]bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make(map[uint64]bool)
+	epochAssignments, exists := s.assignments[epoch]
+	if !exists {
+		return res
+	}
+	for _, assignment := range epochAssignments.assignments {
+		res[assignment.Subnet] = res[assignment.Subnet] || assignment.IsAggregator
+	}
+
+	return res
+}
+
+// Subscriptions returns the beacon committee subscriptions for the validators in the
+// given epoch, suitable for submission to a beacon node.
+func (s *Service) Subscriptions(epoch phase0.Epoch) []*apiv1.BeaconCommitteeSubscription {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	epochAssignments, exists := s.assignments[epoch]
+	if !exists {
+		return nil
+	}
+	res := make([]*apiv1.BeaconCommitteeSubscription, 0, len(epochAssignments.assignments))
+	for _, assignment := range epochAssignments.assignments {
+		res = append(res, &apiv1.BeaconCommitteeSubscription{
+			ValidatorIndex:   assignment.ValidatorIndex,
+			Slot:             assignment.Slot,
+			CommitteeIndex:   assignment.CommitteeIndex,
+			CommitteesAtSlot: assignment.CommitteesAtSlot,
+			IsAggregator:     assignment.IsAggregator,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subnets_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/subnets"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// dutiesClient provides an attester duty for each validator at the start of each epoch.
+type dutiesClient struct {
+	mu    sync.Mutex
+	calls []phase0.Epoch
+}
+
+func (*dutiesClient) Name() string { return "duties" }
+
+func (*dutiesClient) Address() string { return "" }
+
+func (*dutiesClient) SlotsPerEpoch(_ context.Context) (uint64, error) {
+	return 32, nil
+}
+
+func (*dutiesClient) TargetAggregatorsPerCommittee(_ context.Context) (uint64, error) {
+	return 16, nil
+}
+
+func (c *dutiesClient) AttesterDuties(_ context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) ([]*apiv1.AttesterDuty, error) {
+	c.mu.Lock()
+	c.calls = append(c.calls, epoch)
+	c.mu.Unlock()
+
+	res := make([]*apiv1.AttesterDuty, 0, len(indices))
+	for _, index := range indices {
+		res = append(res, &apiv1.AttesterDuty{
+			PubKey:           phase0.BLSPubKey{byte(index)},
+			Slot:             phase0.Slot(uint64(epoch)*32 + uint64(index)%2),
+			ValidatorIndex:   index,
+			CommitteeIndex:   phase0.CommitteeIndex(index),
+			CommitteeLength:  128,
+			CommitteesAtSlot: 4,
+		})
+	}
+
+	return res, nil
+}
+
+func (c *dutiesClient) epochCalls() []phase0.Epoch {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]phase0.Epoch{}, c.calls...)
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []subnets.Parameter
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			params: []subnets.Parameter{
+				subnets.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no client specified",
+		},
+		{
+			name: "EpochsZero",
+			params: []subnets.Parameter{
+				subnets.WithLogLevel(zerolog.Disabled),
+				subnets.WithClient(&dutiesClient{}),
+				subnets.WithEpochs(0),
+			},
+			err: "problem with parameters: no epochs specified",
+		},
+		{
+			name: "EpochsTooLarge",
+			params: []subnets.Parameter{
+				subnets.WithLogLevel(zerolog.Disabled),
+				subnets.WithClient(&dutiesClient{}),
+				subnets.WithEpochs(3),
+			},
+			err: "problem with parameters: epochs cannot be more than 2",
+		},
+		{
+			name: "Good",
+			params: []subnets.Parameter{
+				subnets.WithLogLevel(zerolog.Disabled),
+				subnets.WithClient(&dutiesClient{}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := subnets.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestComputeSubnet(t *testing.T) {
+	require.Equal(t, uint64(0), subnets.ComputeSubnet(32, 4, 0, 0))
+	require.Equal(t, uint64(3), subnets.ComputeSubnet(32, 4, 0, 3))
+	require.Equal(t, uint64(7), subnets.ComputeSubnet(32, 4, 33, 3))
+	// Wraps around the number of subnets.
+	require.Equal(t, uint64(60), subnets.ComputeSubnet(32, 64, 31, 60))
+	require.Equal(t, uint64(4), subnets.ComputeSubnet(32, 3, 22, 2))
+}
+
+func TestIsAggregator(t *testing.T) {
+	// Small committees always aggregate.
+	require.True(t, subnets.IsAggregator(16, 16, phase0.BLSSignature{0x01}))
+	require.True(t, subnets.IsAggregator(8, 16, phase0.BLSSignature{0x02}))
+
+	// Large committees aggregate for a proportion of selection proofs.
+	aggregators := 0
+	for i := 0; i < 1024; i++ {
+		if subnets.IsAggregator(1024, 16, phase0.BLSSignature{byte(i), byte(i >> 8)}) {
+			aggregators++
+		}
+	}
+	require.Greater(t, aggregators, 0)
+	require.Less(t, aggregators, 64)
+}
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	client := &dutiesClient{}
+	s, err := subnets.New(ctx,
+		subnets.WithLogLevel(zerolog.Disabled),
+		subnets.WithClient(client),
+		subnets.WithSelectionProofSigner(func(_ context.Context, pubKey phase0.BLSPubKey, _ phase0.Slot) (phase0.BLSSignature, error) {
+			return phase0.BLSSignature{pubKey[0]}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	// No validators, so no duties requested.
+	require.NoError(t, s.Refresh(ctx, 10))
+	require.Empty(t, client.epochCalls())
+	require.Empty(t, s.Assignments(320))
+
+	s.SetValidators([]phase0.ValidatorIndex{1, 2, 3})
+	require.NoError(t, s.Refresh(ctx, 10))
+	require.Equal(t, []phase0.Epoch{10, 11}, client.epochCalls())
+
+	// Validator 2 attests at the first slot, and 1 and 3 at the second.
+	assignments := s.Assignments(321)
+	require.Len(t, assignments, 2)
+	require.Equal(t, phase0.ValidatorIndex(1), assignments[0].ValidatorIndex)
+	require.Equal(t, phase0.ValidatorIndex(3), assignments[1].ValidatorIndex)
+	require.Equal(t, uint64(7), assignments[1].Subnet)
+	require.NotNil(t, assignments[1].SelectionProof)
+	require.Equal(t, map[uint64]bool{
+		2: subnets.IsAggregator(128, 16, phase0.BLSSignature{0x02}),
+		5: subnets.IsAggregator(128, 16, phase0.BLSSignature{0x01}),
+		7: subnets.IsAggregator(128, 16, phase0.BLSSignature{0x03}),
+	}, s.Subnets(10))
+	require.Len(t, s.Subscriptions(11), 3)
+	require.Nil(t, s.Subscriptions(12))
+
+	// Refreshing again does not obtain duties.
+	require.NoError(t, s.Refresh(ctx, 10))
+	require.Len(t, client.epochCalls(), 2)
+
+	// The first head event records the dependent roots without obtaining duties.
+	require.NoError(t, s.HandleHeadEvent(ctx, &apiv1.HeadEvent{
+		Slot:                      330,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x02},
+	}))
+	require.Len(t, client.epochCalls(), 2)
+
+	// A change in the current duty dependent root obtains duties for the next epoch.
+	require.NoError(t, s.HandleHeadEvent(ctx, &apiv1.HeadEvent{
+		Slot:                      331,
+		PreviousDutyDependentRoot: phase0.Root{0x01},
+		CurrentDutyDependentRoot:  phase0.Root{0x03},
+	}))
+	require.Equal(t, []phase0.Epoch{10, 11, 11}, client.epochCalls())
+
+	// Moving to the next epoch discards the old epoch and obtains the new one.
+	require.NoError(t, s.HandleHeadEvent(ctx, &apiv1.HeadEvent{
+		Slot:                      352,
+		PreviousDutyDependentRoot: phase0.Root{0x03},
+		CurrentDutyDependentRoot:  phase0.Root{0x04},
+	}))
+	require.Equal(t, []phase0.Epoch{10, 11, 11, 12}, client.epochCalls())
+	require.Nil(t, s.Subscriptions(10))
+
+	// Changing validators discards existing assignments.
+	s.SetValidators([]phase0.ValidatorIndex{4})
+	require.Nil(t, s.Subscriptions(11))
+	require.NoError(t, s.Refresh(ctx, 11))
+	require.Len(t, s.Subscriptions(11), 1)
+}
@@ -0,0 +1,51 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subnets
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttestationSubnetCount is the number of attestation subnets.
+const AttestationSubnetCount = 64
+
+// ComputeSubnet returns the attestation subnet for the committee with the given index
+// at the given slot.
+func ComputeSubnet(slotsPerEpoch uint64,
+	committeesAtSlot uint64,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) uint64 {
+	committeesSinceEpochStart := committeesAtSlot * (uint64(slot) % slotsPerEpoch)
+
+	return (committeesSinceEpochStart + uint64(committeeIndex)) % AttestationSubnetCount
+}
+
+// IsAggregator returns true if the validator with the given selection proof is an
+// aggregator for a committee of the given length.
+func IsAggregator(committeeLength uint64,
+	targetAggregatorsPerCommittee uint64,
+	selectionProof phase0.BLSSignature,
+) bool {
+	modulo := uint64(1)
+	if targetAggregatorsPerCommittee > 0 && committeeLength/targetAggregatorsPerCommittee > 1 {
+		modulo = committeeLength / targetAggregatorsPerCommittee
+	}
+	hash := sha256.Sum256(selectionProof[:])
+
+	return binary.LittleEndian.Uint64(hash[:8])%modulo == 0
+}
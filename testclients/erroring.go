@@ -25,7 +25,9 @@ import (
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -222,6 +224,50 @@ func (s *Erroring) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*ph
 	return next.AttestationPool(ctx, slot)
 }
 
+// AttestationPoolPage obtains a single page of the attestation pool for a given slot.
+func (s *Erroring) AttestationPoolPage(ctx context.Context, slot phase0.Slot, opts *api.PageOpts) (*api.Page[*phase0.Attestation], error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationPoolPageProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationPoolPage(ctx, slot, opts)
+}
+
+// StreamAttestationPool fetches the attestation pool, passing each attestation to the handler.
+func (s *Erroring) StreamAttestationPool(ctx context.Context,
+	slot *phase0.Slot,
+	committeeIndex *phase0.CommitteeIndex,
+	handler func(*phase0.Attestation) error,
+) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.AttestationPoolStreamer)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.StreamAttestationPool(ctx, slot, committeeIndex, handler)
+}
+
+// StreamValidators fetches the validators, passing each validator to the handler.
+func (s *Erroring) StreamValidators(ctx context.Context,
+	stateID string,
+	validatorIndices []phase0.ValidatorIndex,
+	handler func(*apiv1.Validator) error,
+) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.ValidatorsStreamer)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.StreamValidators(ctx, stateID, validatorIndices, handler)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Erroring) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -343,6 +389,26 @@ func (s *Erroring) BeaconBlockProposal(ctx context.Context, slot phase0.Slot, ra
 	return next.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
 }
 
+// Proposal fetches a proposal for signing.
+func (s *Erroring) Proposal(ctx context.Context,
+	slot phase0.Slot,
+	randaoReveal phase0.BLSSignature,
+	graffiti []byte,
+	builderBoostFactor *uint64,
+) (
+	*api.VersionedProposal,
+	error,
+) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ProposalProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Proposal(ctx, slot, randaoReveal, graffiti, builderBoostFactor)
+}
+
 // SubmitBeaconBlock submits a beacon block.
 func (s *Erroring) SubmitBeaconBlock(ctx context.Context, block *spec.VersionedSignedBeaconBlock) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -379,6 +445,30 @@ func (s *Erroring) SubmitBlindedBeaconBlock(ctx context.Context, block *api.Vers
 	return next.SubmitBlindedBeaconBlock(ctx, block)
 }
 
+// SubmitBeaconBlockRaw submits a beacon block that has already been serialized.
+func (s *Erroring) SubmitBeaconBlockRaw(ctx context.Context, block *api.RawSignedBeaconBlock) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BeaconBlockRawSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBeaconBlockRaw(ctx, block)
+}
+
+// SubmitBlindedBeaconBlockRaw submits a blinded beacon block that has already been serialized.
+func (s *Erroring) SubmitBlindedBeaconBlockRaw(ctx context.Context, block *api.RawSignedBeaconBlock) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BlindedBeaconBlockRawSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBlindedBeaconBlockRaw(ctx, block)
+}
+
 // SubmitValidatorRegistrations submits a validator registration.
 func (s *Erroring) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) error {
 	if err := s.maybeError(ctx); err != nil {
@@ -487,6 +577,78 @@ func (s *Erroring) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
 	return next.NodeSyncing(ctx)
 }
 
+// Peer fetches the peer of the node with the given peer ID.
+func (s *Erroring) Peer(ctx context.Context, peerID string) (*apiv1.Peer, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PeerProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Peer(ctx, peerID)
+}
+
+// PeerCount fetches the number of peers of the node in each connection state.
+func (s *Erroring) PeerCount(ctx context.Context) (*apiv1.PeerCount, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PeerCountProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.PeerCount(ctx)
+}
+
+// NodeIdentity fetches the network identity of the node.
+func (s *Erroring) NodeIdentity(ctx context.Context) (*apiv1.NodeIdentity, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.NodeIdentityProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeIdentity(ctx)
+}
+
+// Peers fetches the peers of the node.
+func (s *Erroring) Peers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PeersProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.Peers(ctx, states, directions)
+}
+
+// PeersPage fetches a single page of the peers of the node.
+func (s *Erroring) PeersPage(ctx context.Context, states []string, directions []string, opts *api.PageOpts) (*api.Page[*apiv1.Peer], error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PeersPageProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.PeersPage(ctx, states, directions, opts)
+}
+
+// IsOptimisticHead returns true if the node's head block has not been fully verified by an execution client.
+func (s *Erroring) IsOptimisticHead(ctx context.Context) (bool, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return false, err
+	}
+	next, isNext := s.next.(consensusclient.OptimisticHeadProvider)
+	if !isNext {
+		return false, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.IsOptimisticHead(ctx)
+}
+
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
 func (s *Erroring) ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
@@ -690,6 +852,18 @@ func (s *Erroring) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*den
 	return next.BeaconBlockBlobs(ctx, blockID)
 }
 
+// BlobSidecars fetches the blob sidecars given a block ID.
+func (s *Erroring) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlobSidecars(ctx, blockID, indices)
+}
+
 // BeaconStateRoot fetches a beacon state root given a state ID.
 func (s *Erroring) BeaconStateRoot(ctx context.Context, stateID string) (*phase0.Root, error) {
 	if err := s.maybeError(ctx); err != nil {
@@ -713,3 +887,125 @@ func (s *Erroring) ForkChoice(ctx context.Context) (*apiv1.ForkChoice, error) {
 	}
 	return next.ForkChoice(ctx)
 }
+
+// EstimateExit estimates the exit and withdrawable epochs for a validator.
+func (s *Erroring) EstimateExit(ctx context.Context, validatorIndex phase0.ValidatorIndex) (*apiv1.ExitEstimate, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.ExitEstimateProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.EstimateExit(ctx, validatorIndex)
+}
+
+// AttestationRewards provides the ideal and actual attestation rewards for the given epoch
+// and validator indices.  If no indices are supplied rewards are returned for all validators.
+func (s *Erroring) AttestationRewards(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.AttestationRewards(ctx, epoch, indices)
+}
+
+// BlockRewards provides the rewards received by the proposer of a given block ID.
+func (s *Erroring) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BlockRewards(ctx, blockID)
+}
+
+// PendingDeposits provides the pending deposits for a given state.
+func (s *Erroring) PendingDeposits(ctx context.Context, stateID string) ([]*electra.PendingDeposit, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PendingDepositsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.PendingDeposits(ctx, stateID)
+}
+
+// PendingPartialWithdrawals provides the pending partial withdrawals for a given state.
+func (s *Erroring) PendingPartialWithdrawals(ctx context.Context, stateID string) ([]*electra.PendingPartialWithdrawal, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PendingPartialWithdrawalsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.PendingPartialWithdrawals(ctx, stateID)
+}
+
+// PendingConsolidations provides the pending consolidations for a given state.
+func (s *Erroring) PendingConsolidations(ctx context.Context, stateID string) ([]*electra.PendingConsolidation, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.PendingConsolidationsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.PendingConsolidations(ctx, stateID)
+}
+
+// SyncCommitteeRewards provides the rewards received by sync committee members for their
+// participation in the given block ID.
+func (s *Erroring) SyncCommitteeRewards(ctx context.Context, blockID string, indices []phase0.ValidatorIndex) ([]*apiv1.SyncCommitteeReward, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.SyncCommitteeRewardsProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SyncCommitteeRewards(ctx, blockID, indices)
+}
+
+// BeaconStateRandao fetches a beacon state RANDAO given a state ID.
+func (s *Erroring) BeaconStateRandao(ctx context.Context, stateID string) (*phase0.Root, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return nil, err
+	}
+	next, isNext := s.next.(consensusclient.BeaconStateRandaoProvider)
+	if !isNext {
+		return nil, fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.BeaconStateRandao(ctx, stateID)
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution address change operations.
+func (s *Erroring) SubmitBLSToExecutionChanges(ctx context.Context, blsToExecutionChanges []*capella.SignedBLSToExecutionChange) error {
+	if err := s.maybeError(ctx); err != nil {
+		return err
+	}
+	next, isNext := s.next.(consensusclient.BLSToExecutionChangesSubmitter)
+	if !isNext {
+		return fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.SubmitBLSToExecutionChanges(ctx, blsToExecutionChanges)
+}
+
+// NodeClient provides the client for the node.
+func (s *Erroring) NodeClient(ctx context.Context) (string, error) {
+	if err := s.maybeError(ctx); err != nil {
+		return "", err
+	}
+	next, isNext := s.next.(consensusclient.NodeClientProvider)
+	if !isNext {
+		return "", fmt.Errorf("%s@%s does not support this call", s.next.Name(), s.next.Address())
+	}
+	return next.NodeClient(ctx)
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebeacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// subscriberBufferSize is the number of events buffered for each subscriber.
+const subscriberBufferSize = 256
+
+type subscriber struct {
+	topics map[string]bool
+	events chan *sseEvent
+}
+
+type sseEvent struct {
+	topic string
+	data  []byte
+}
+
+// PublishEvent publishes an event to the subscribers to its topic.
+// The data is marshalled to JSON, so should be the API type for the topic, for example
+// *apiv1.HeadEvent for the "head" topic.
+func (s *Server) PublishEvent(topic string, data interface{}) error {
+	if _, exists := apiv1.SupportedEventTopics[topic]; !exists {
+		return errors.Errorf("unsupported topic %s", topic)
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+	event := &sseEvent{
+		topic: topic,
+		data:  encoded,
+	}
+
+	s.subscribersMu.Lock()
+	defer s.subscribersMu.Unlock()
+	for sub := range s.subscribers {
+		if !sub.topics[topic] {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			s.log.Warn().Str("topic", topic).Msg("Subscriber buffer full; dropping event")
+		}
+	}
+
+	return nil
+}
+
+// Subscribers provides the number of open event streams subscribed to the topic.
+func (s *Server) Subscribers(topic string) int {
+	s.subscribersMu.Lock()
+	defer s.subscribersMu.Unlock()
+
+	count := 0
+	for sub := range s.subscribers {
+		if sub.topics[topic] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// events serves an event stream.
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	topics := splitQuery(r.URL.Query()["topics"])
+	if len(topics) == 0 {
+		writeError(w, http.StatusBadRequest, "no topics specified")
+
+		return
+	}
+	sub := &subscriber{
+		topics: make(map[string]bool, len(topics)),
+		events: make(chan *sseEvent, subscriberBufferSize),
+	}
+	for _, topic := range topics {
+		if _, exists := apiv1.SupportedEventTopics[topic]; !exists {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported topic %s", topic))
+
+			return
+		}
+		sub.topics[topic] = true
+	}
+	flusher, isFlusher := w.(http.Flusher)
+	if !isFlusher {
+		writeError(w, http.StatusInternalServerError, "streaming not supported")
+
+		return
+	}
+
+	s.subscribersMu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.subscribersMu.Unlock()
+	defer func() {
+		s.subscribersMu.Lock()
+		delete(s.subscribers, sub)
+		s.subscribersMu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.done:
+			return
+		case event := <-sub.events:
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.topic, event.data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
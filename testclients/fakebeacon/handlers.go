@@ -0,0 +1,555 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebeacon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// forkNames are the spec names of the forks, in order.
+var forkNames = []string{"GENESIS", "ALTAIR", "BELLATRIX", "CAPELLA", "DENEB", "ELECTRA"}
+
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type validatorsRequest struct {
+	IDs      []string `json:"ids"`
+	Statuses []string `json:"statuses"`
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	s.mu.Unlock()
+	s.log.Trace().Str("method", r.Method).Str("path", r.URL.Path).Msg("Received request")
+
+	path := r.URL.Path
+	switch {
+	case path == "/eth/v1/beacon/genesis":
+		s.handle(w, r, http.MethodGet, s.genesis)
+	case path == "/eth/v1/config/spec":
+		s.handle(w, r, http.MethodGet, s.specValues)
+	case path == "/eth/v1/config/deposit_contract":
+		s.handle(w, r, http.MethodGet, s.depositContract)
+	case path == "/eth/v1/config/fork_schedule":
+		s.handle(w, r, http.MethodGet, s.forkSchedule)
+	case path == "/eth/v1/node/version":
+		s.handle(w, r, http.MethodGet, s.version)
+	case path == "/eth/v1/node/syncing":
+		s.handle(w, r, http.MethodGet, s.syncing)
+	case path == "/eth/v1/events":
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+
+			return
+		}
+		s.events(w, r)
+	case strings.HasPrefix(path, "/eth/v1/beacon/headers/"):
+		s.handle(w, r, http.MethodGet, s.header)
+	case strings.HasPrefix(path, "/eth/v1/beacon/states/") && strings.HasSuffix(path, "/validators"):
+		if r.Method == http.MethodPost {
+			s.handle(w, r, http.MethodPost, s.validatorsList)
+		} else {
+			s.handle(w, r, http.MethodGet, s.validatorsList)
+		}
+	case strings.HasPrefix(path, "/eth/v1/validator/duties/proposer/"):
+		s.handle(w, r, http.MethodGet, s.proposerDutiesList)
+	case strings.HasPrefix(path, "/eth/v1/validator/duties/attester/"):
+		s.handle(w, r, http.MethodPost, s.attesterDutiesList)
+	case strings.HasPrefix(path, "/eth/v1/validator/duties/sync/"):
+		s.handle(w, r, http.MethodPost, s.syncCommitteeDutiesList)
+	default:
+		writeError(w, http.StatusNotFound, "endpoint not supported")
+	}
+}
+
+// handlerFunc provides the body of a response, or a status and error.
+type handlerFunc func(r *http.Request) (interface{}, int, error)
+
+// handle checks the method of the request and writes the result of the handler.
+func (s *Server) handle(w http.ResponseWriter, r *http.Request, method string, handler handlerFunc) {
+	if r.Method != method {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+
+		return
+	}
+
+	res, status, err := handler(r)
+	if err != nil {
+		writeError(w, status, err.Error())
+
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	data, err := json.Marshal(&errorResponse{
+		Code:    status,
+		Message: message,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+func (s *Server) genesis(_ *http.Request) (interface{}, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	forkVersion, err := s.forkVersion("GENESIS")
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return map[string]interface{}{
+		"data": &apiv1.Genesis{
+			GenesisTime:           s.genesisTime,
+			GenesisValidatorsRoot: s.genesisValidatorsRoot,
+			GenesisForkVersion:    forkVersion,
+		},
+	}, http.StatusOK, nil
+}
+
+func (s *Server) specValues(_ *http.Request) (interface{}, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data := make(map[string]string, len(s.spec))
+	for k, v := range s.spec {
+		data[k] = v
+	}
+
+	return map[string]interface{}{
+		"data": data,
+	}, http.StatusOK, nil
+}
+
+func (s *Server) depositContract(_ *http.Request) (interface{}, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return map[string]interface{}{
+		"data": map[string]string{
+			"chain_id": s.spec["DEPOSIT_CHAIN_ID"],
+			"address":  s.spec["DEPOSIT_CONTRACT_ADDRESS"],
+		},
+	}, http.StatusOK, nil
+}
+
+func (s *Server) forkSchedule(_ *http.Request) (interface{}, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	farFutureEpoch, err := strconv.ParseUint(s.spec["FAR_FUTURE_EPOCH"], 10, 64)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.Wrap(err, "invalid FAR_FUTURE_EPOCH")
+	}
+
+	forks := make([]*phase0.Fork, 0, len(forkNames))
+	var previousVersion phase0.Version
+	for i, name := range forkNames {
+		if _, exists := s.spec[fmt.Sprintf("%s_FORK_VERSION", name)]; !exists {
+			break
+		}
+		version, err := s.forkVersion(name)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		epoch := uint64(0)
+		if i > 0 {
+			epoch, err = strconv.ParseUint(s.spec[fmt.Sprintf("%s_FORK_EPOCH", name)], 10, 64)
+			if err != nil {
+				return nil, http.StatusInternalServerError, errors.Wrapf(err, "invalid %s_FORK_EPOCH", name)
+			}
+		}
+		if epoch == farFutureEpoch {
+			break
+		}
+		if i == 0 {
+			previousVersion = version
+		}
+		forks = append(forks, &phase0.Fork{
+			PreviousVersion: previousVersion,
+			CurrentVersion:  version,
+			Epoch:           phase0.Epoch(epoch),
+		})
+		previousVersion = version
+	}
+
+	return map[string]interface{}{
+		"data": forks,
+	}, http.StatusOK, nil
+}
+
+// forkVersion parses the version of the named fork from the spec.
+// The read lock must be held.
+func (s *Server) forkVersion(name string) (phase0.Version, error) {
+	key := fmt.Sprintf("%s_FORK_VERSION", name)
+	data, err := hex.DecodeString(strings.TrimPrefix(s.spec[key], "0x"))
+	if err != nil {
+		return phase0.Version{}, errors.Wrapf(err, "invalid %s", key)
+	}
+	if len(data) != phase0.ForkVersionLength {
+		return phase0.Version{}, errors.Errorf("invalid length for %s", key)
+	}
+
+	var version phase0.Version
+	copy(version[:], data)
+
+	return version, nil
+}
+
+func (s *Server) version(_ *http.Request) (interface{}, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return map[string]interface{}{
+		"data": map[string]string{
+			"version": s.nodeVersion,
+		},
+	}, http.StatusOK, nil
+}
+
+func (s *Server) syncing(_ *http.Request) (interface{}, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	syncState := s.syncState
+	if syncState == nil {
+		syncState = &apiv1.SyncState{}
+		if s.head != nil {
+			syncState.HeadSlot = s.head.Header.Message.Slot
+		}
+	}
+
+	return map[string]interface{}{
+		"data": syncState,
+	}, http.StatusOK, nil
+}
+
+func (s *Server) header(r *http.Request) (interface{}, int, error) {
+	blockID := strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/headers/")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	header, status, err := s.resolveHeader(blockID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return map[string]interface{}{
+		"data":                 header,
+		"execution_optimistic": false,
+		"finalized":            s.isFinalized(header),
+	}, http.StatusOK, nil
+}
+
+// resolveHeader resolves a block ID to a header.
+// The read lock must be held.
+func (s *Server) resolveHeader(blockID string) (*apiv1.BeaconBlockHeader, int, error) {
+	var header *apiv1.BeaconBlockHeader
+	switch {
+	case blockID == "head":
+		header = s.head
+	case blockID == "finalized":
+		header = s.finalized
+	case blockID == "genesis":
+		header = s.headerAtSlot(0)
+	case strings.HasPrefix(blockID, "0x"):
+		data, err := hex.DecodeString(strings.TrimPrefix(blockID, "0x"))
+		if err != nil || len(data) != phase0.RootLength {
+			return nil, http.StatusBadRequest, errors.Errorf("invalid block ID %s", blockID)
+		}
+		var root phase0.Root
+		copy(root[:], data)
+		header = s.headers[root]
+	default:
+		slot, err := strconv.ParseUint(blockID, 10, 64)
+		if err != nil {
+			return nil, http.StatusBadRequest, errors.Errorf("invalid block ID %s", blockID)
+		}
+		header = s.headerAtSlot(phase0.Slot(slot))
+	}
+	if header == nil {
+		return nil, http.StatusNotFound, errors.New("block not found")
+	}
+
+	return header, http.StatusOK, nil
+}
+
+// headerAtSlot returns the header at the given slot, preferring canonical headers.
+// The read lock must be held.
+func (s *Server) headerAtSlot(slot phase0.Slot) *apiv1.BeaconBlockHeader {
+	var res *apiv1.BeaconBlockHeader
+	for _, header := range s.headers {
+		if header.Header.Message.Slot != slot {
+			continue
+		}
+		if res == nil || (header.Canonical && !res.Canonical) {
+			res = header
+		}
+	}
+
+	return res
+}
+
+// isFinalized returns true if the header is at or before the finalized header.
+// The read lock must be held.
+func (s *Server) isFinalized(header *apiv1.BeaconBlockHeader) bool {
+	return s.finalized != nil && header.Header.Message.Slot <= s.finalized.Header.Message.Slot
+}
+
+func (s *Server) validatorsList(r *http.Request) (interface{}, int, error) {
+	var ids []string
+	var statuses []string
+	if r.Method == http.MethodPost {
+		request := &validatorsRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			return nil, http.StatusBadRequest, errors.Wrap(err, "invalid request body")
+		}
+		ids = request.IDs
+		statuses = request.Statuses
+	} else {
+		ids = splitQuery(r.URL.Query()["id"])
+		statuses = splitQuery(r.URL.Query()["status"])
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var candidates []*apiv1.Validator
+	if len(ids) == 0 {
+		candidates = make([]*apiv1.Validator, 0, len(s.validators))
+		for _, validator := range s.validators {
+			candidates = append(candidates, validator)
+		}
+	} else {
+		candidates = make([]*apiv1.Validator, 0, len(ids))
+		for _, id := range ids {
+			validator, err := s.validatorByID(id)
+			if err != nil {
+				return nil, http.StatusBadRequest, err
+			}
+			if validator != nil {
+				candidates = append(candidates, validator)
+			}
+		}
+	}
+
+	validators := make([]*apiv1.Validator, 0, len(candidates))
+	for _, validator := range candidates {
+		if matchesStatus(validator, statuses) {
+			validators = append(validators, validator)
+		}
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Index < validators[j].Index
+	})
+
+	return map[string]interface{}{
+		"data":                 validators,
+		"execution_optimistic": false,
+		"finalized":            false,
+	}, http.StatusOK, nil
+}
+
+// validatorByID returns the validator with the given index or public key, or nil if there is none.
+// The read lock must be held.
+func (s *Server) validatorByID(id string) (*apiv1.Validator, error) {
+	if !strings.HasPrefix(id, "0x") {
+		index, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid validator ID %s", id)
+		}
+
+		return s.validators[phase0.ValidatorIndex(index)], nil
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(id, "0x"))
+	if err != nil || len(data) != phase0.PublicKeyLength {
+		return nil, errors.Errorf("invalid validator ID %s", id)
+	}
+	var pubKey phase0.BLSPubKey
+	copy(pubKey[:], data)
+	for _, validator := range s.validators {
+		if validator.Validator != nil && validator.Validator.PublicKey == pubKey {
+			return validator, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// matchesStatus returns true if the validator has one of the statuses, or if no statuses are supplied.
+// Statuses can be either specific states such as "active_ongoing" or general states such as "active".
+func matchesStatus(validator *apiv1.Validator, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	state := validator.Status.String()
+	for _, status := range statuses {
+		if state == status || strings.HasPrefix(state, status+"_") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) proposerDutiesList(r *http.Request) (interface{}, int, error) {
+	epoch, err := pathEpoch(r, "/eth/v1/validator/duties/proposer/")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	duties := s.proposerDuties[epoch]
+	if duties == nil {
+		duties = make([]*apiv1.ProposerDuty, 0)
+	}
+
+	return map[string]interface{}{
+		"data":                 duties,
+		"dependent_root":       fmt.Sprintf("%#x", phase0.Root{}),
+		"execution_optimistic": false,
+	}, http.StatusOK, nil
+}
+
+func (s *Server) attesterDutiesList(r *http.Request) (interface{}, int, error) {
+	epoch, err := pathEpoch(r, "/eth/v1/validator/duties/attester/")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	indices, err := requestIndices(r)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	duties := make([]*apiv1.AttesterDuty, 0)
+	for _, duty := range s.attesterDuties[epoch] {
+		if _, exists := indices[duty.ValidatorIndex]; exists {
+			duties = append(duties, duty)
+		}
+	}
+
+	return map[string]interface{}{
+		"data":                 duties,
+		"dependent_root":       fmt.Sprintf("%#x", phase0.Root{}),
+		"execution_optimistic": false,
+	}, http.StatusOK, nil
+}
+
+func (s *Server) syncCommitteeDutiesList(r *http.Request) (interface{}, int, error) {
+	epoch, err := pathEpoch(r, "/eth/v1/validator/duties/sync/")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	indices, err := requestIndices(r)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	duties := make([]*apiv1.SyncCommitteeDuty, 0)
+	for _, duty := range s.syncCommitteeDuties[epoch] {
+		if _, exists := indices[duty.ValidatorIndex]; exists {
+			duties = append(duties, duty)
+		}
+	}
+
+	return map[string]interface{}{
+		"data":                 duties,
+		"execution_optimistic": false,
+	}, http.StatusOK, nil
+}
+
+// pathEpoch parses the epoch that follows the prefix in the path of the request.
+func pathEpoch(r *http.Request, prefix string) (phase0.Epoch, error) {
+	epochStr := strings.TrimPrefix(r.URL.Path, prefix)
+	epoch, err := strconv.ParseUint(epochStr, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid epoch %s", epochStr)
+	}
+
+	return phase0.Epoch(epoch), nil
+}
+
+// requestIndices parses the validator indices in the body of the request.
+func requestIndices(r *http.Request) (map[phase0.ValidatorIndex]struct{}, error) {
+	var indexStrs []string
+	if err := json.NewDecoder(r.Body).Decode(&indexStrs); err != nil {
+		return nil, errors.Wrap(err, "invalid request body")
+	}
+
+	indices := make(map[phase0.ValidatorIndex]struct{}, len(indexStrs))
+	for _, indexStr := range indexStrs {
+		index, err := strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid validator index %s", indexStr)
+		}
+		indices[phase0.ValidatorIndex(index)] = struct{}{}
+	}
+
+	return indices, nil
+}
+
+// splitQuery splits query values that may be repeated or comma-separated.
+func splitQuery(values []string) []string {
+	res := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item != "" {
+				res = append(res, item)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebeacon
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel              zerolog.Level
+	genesisTime           time.Time
+	genesisValidatorsRoot phase0.Root
+	nodeVersion           string
+	spec                  map[string]string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithGenesisValidatorsRoot sets the genesis validators root of the chain.
+func WithGenesisValidatorsRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisValidatorsRoot = root
+	})
+}
+
+// WithNodeVersion sets the version string returned by the node.
+func WithNodeVersion(nodeVersion string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeVersion = nodeVersion
+	})
+}
+
+// WithSpec sets spec values, overriding the defaults.
+// Values are in the string format returned by the spec endpoint.
+func WithSpec(spec map[string]string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.spec = spec
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:    zerolog.GlobalLevel(),
+		genesisTime: time.Now().Truncate(time.Second),
+		nodeVersion: "fakebeacon/v0.0.0",
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.genesisTime.IsZero() {
+		return nil, errors.New("no genesis time specified")
+	}
+	if parameters.nodeVersion == "" {
+		return nil, errors.New("no node version specified")
+	}
+
+	return &parameters, nil
+}
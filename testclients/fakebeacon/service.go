@@ -0,0 +1,165 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakebeacon provides a fake beacon node that serves a subset of the beacon node
+// REST API from in-memory state over an httptest server.  Tests can mutate the state
+// while the server is running, allowing end-to-end testing of clients.
+package fakebeacon
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Server is a fake beacon node.
+type Server struct {
+	log       zerolog.Logger
+	server    *httptest.Server
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu                    sync.RWMutex
+	genesisTime           time.Time
+	genesisValidatorsRoot phase0.Root
+	nodeVersion           string
+	spec                  map[string]string
+	headers               map[phase0.Root]*apiv1.BeaconBlockHeader
+	head                  *apiv1.BeaconBlockHeader
+	finalized             *apiv1.BeaconBlockHeader
+	validators            map[phase0.ValidatorIndex]*apiv1.Validator
+	proposerDuties        map[phase0.Epoch][]*apiv1.ProposerDuty
+	attesterDuties        map[phase0.Epoch][]*apiv1.AttesterDuty
+	syncCommitteeDuties   map[phase0.Epoch][]*apiv1.SyncCommitteeDuty
+	syncState             *apiv1.SyncState
+	requests              []string
+
+	subscribersMu sync.Mutex
+	subscribers   map[*subscriber]struct{}
+}
+
+// New creates and starts a new fake beacon node.
+// The node is closed when the context is done, or when Close is called.
+func New(ctx context.Context, params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "fakebeacon").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	specValues := defaultSpec()
+	for k, v := range parameters.spec {
+		specValues[k] = v
+	}
+
+	s := &Server{
+		log:                   log,
+		done:                  make(chan struct{}),
+		genesisTime:           parameters.genesisTime,
+		genesisValidatorsRoot: parameters.genesisValidatorsRoot,
+		nodeVersion:           parameters.nodeVersion,
+		spec:                  specValues,
+		headers:               make(map[phase0.Root]*apiv1.BeaconBlockHeader),
+		validators:            make(map[phase0.ValidatorIndex]*apiv1.Validator),
+		proposerDuties:        make(map[phase0.Epoch][]*apiv1.ProposerDuty),
+		attesterDuties:        make(map[phase0.Epoch][]*apiv1.AttesterDuty),
+		syncCommitteeDuties:   make(map[phase0.Epoch][]*apiv1.SyncCommitteeDuty),
+		subscribers:           make(map[*subscriber]struct{}),
+	}
+	s.server = httptest.NewServer(s)
+
+	go func(ctx context.Context, s *Server) {
+		select {
+		case <-ctx.Done():
+			s.Close()
+		case <-s.done:
+		}
+	}(ctx, s)
+
+	return s, nil
+}
+
+// Address provides the address of the node.
+func (s *Server) Address() string {
+	return s.server.URL
+}
+
+// Close shuts down the node, ending any open event streams.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.server.Close()
+	})
+}
+
+// defaultSpec provides the default spec values, which are those of mainnet with all forks active from genesis.
+func defaultSpec() map[string]string {
+	versions := spec.MainnetForkVersions
+
+	return map[string]string{
+		"CONFIG_NAME":                              "fakebeacon",
+		"PRESET_BASE":                              "mainnet",
+		"SECONDS_PER_SLOT":                         "12",
+		"SLOTS_PER_EPOCH":                          "32",
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD":         "256",
+		"SYNC_COMMITTEE_SIZE":                      "512",
+		"SYNC_COMMITTEE_SUBNET_COUNT":              "4",
+		"TARGET_AGGREGATORS_PER_COMMITTEE":         "16",
+		"TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE": "16",
+		"MAX_COMMITTEES_PER_SLOT":                  "64",
+		"MAX_EFFECTIVE_BALANCE":                    "32000000000",
+		"FAR_FUTURE_EPOCH":                         "18446744073709551615",
+		"DEPOSIT_CHAIN_ID":                         "1",
+		"DEPOSIT_NETWORK_ID":                       "1",
+		"DEPOSIT_CONTRACT_ADDRESS":                 "0x00000000219ab540356cbb839cbe05303d7705fa",
+		"GENESIS_FORK_VERSION":                     fmt.Sprintf("%#x", versions.Phase0),
+		"ALTAIR_FORK_VERSION":                      fmt.Sprintf("%#x", versions.Altair),
+		"ALTAIR_FORK_EPOCH":                        "0",
+		"BELLATRIX_FORK_VERSION":                   fmt.Sprintf("%#x", versions.Bellatrix),
+		"BELLATRIX_FORK_EPOCH":                     "0",
+		"CAPELLA_FORK_VERSION":                     fmt.Sprintf("%#x", versions.Capella),
+		"CAPELLA_FORK_EPOCH":                       "0",
+		"DENEB_FORK_VERSION":                       fmt.Sprintf("%#x", versions.Deneb),
+		"DENEB_FORK_EPOCH":                         "0",
+		"ELECTRA_FORK_VERSION":                     fmt.Sprintf("%#x", versions.Electra),
+		"ELECTRA_FORK_EPOCH":                       "0",
+		"DOMAIN_BEACON_PROPOSER":                   fmt.Sprintf("%#x", phase0.DomainBeaconProposer),
+		"DOMAIN_BEACON_ATTESTER":                   fmt.Sprintf("%#x", phase0.DomainBeaconAttester),
+		"DOMAIN_RANDAO":                            fmt.Sprintf("%#x", phase0.DomainRANDAO),
+		"DOMAIN_DEPOSIT":                           fmt.Sprintf("%#x", phase0.DomainDeposit),
+		"DOMAIN_VOLUNTARY_EXIT":                    fmt.Sprintf("%#x", phase0.DomainVoluntaryExit),
+		"DOMAIN_SELECTION_PROOF":                   fmt.Sprintf("%#x", phase0.DomainSelectionProof),
+		"DOMAIN_AGGREGATE_AND_PROOF":               fmt.Sprintf("%#x", phase0.DomainAggregateAndProof),
+		"DOMAIN_APPLICATION_MASK":                  fmt.Sprintf("%#x", phase0.DomainApplicationMask),
+		"DOMAIN_SYNC_COMMITTEE":                    fmt.Sprintf("%#x", altair.DomainSyncCommittee),
+		"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF":    fmt.Sprintf("%#x", altair.DomainSyncCommitteeSelectionProof),
+		"DOMAIN_CONTRIBUTION_AND_PROOF":            fmt.Sprintf("%#x", altair.DomainContributionAndProof),
+		"DOMAIN_BLS_TO_EXECUTION_CHANGE":           fmt.Sprintf("%#x", capella.DomainBLSToExecutionChange),
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebeacon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	ethhttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients/fakebeacon"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func header(slot phase0.Slot, root byte) *apiv1.BeaconBlockHeader {
+	return &apiv1.BeaconBlockHeader{
+		Root:      phase0.Root{root},
+		Canonical: true,
+		Header: &phase0.SignedBeaconBlockHeader{
+			Message: &phase0.BeaconBlockHeader{
+				Slot:      slot,
+				StateRoot: phase0.Root{root, 0x01},
+				BodyRoot:  phase0.Root{root, 0x02},
+			},
+		},
+	}
+}
+
+func newClient(ctx context.Context, t *testing.T, server *fakebeacon.Server) consensusclient.Service {
+	t.Helper()
+
+	client, err := ethhttp.New(ctx,
+		ethhttp.WithLogLevel(zerolog.Disabled),
+		ethhttp.WithAddress(server.Address()),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestNew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name   string
+		params []fakebeacon.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisTimeZero",
+			params: []fakebeacon.Parameter{
+				fakebeacon.WithLogLevel(zerolog.Disabled),
+				fakebeacon.WithGenesisTime(time.Time{}),
+			},
+			err: "problem with parameters: no genesis time specified",
+		},
+		{
+			name: "NodeVersionMissing",
+			params: []fakebeacon.Parameter{
+				fakebeacon.WithLogLevel(zerolog.Disabled),
+				fakebeacon.WithNodeVersion(""),
+			},
+			err: "problem with parameters: no node version specified",
+		},
+		{
+			name: "Good",
+			params: []fakebeacon.Parameter{
+				fakebeacon.WithLogLevel(zerolog.Disabled),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, err := fakebeacon.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				server.Close()
+			}
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	genesisTime := time.Unix(1606824023, 0)
+	server, err := fakebeacon.New(ctx,
+		fakebeacon.WithLogLevel(zerolog.Disabled),
+		fakebeacon.WithGenesisTime(genesisTime),
+		fakebeacon.WithGenesisValidatorsRoot(phase0.Root{0x4b, 0x36}),
+		fakebeacon.WithNodeVersion("Lighthouse/v4.5.0"),
+		fakebeacon.WithSpec(map[string]string{
+			"SLOTS_PER_EPOCH":    "8",
+			"ELECTRA_FORK_EPOCH": "18446744073709551615",
+		}),
+	)
+	require.NoError(t, err)
+	defer server.Close()
+	client := newClient(ctx, t, server)
+
+	genesis, err := client.(consensusclient.GenesisProvider).Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisTime, genesis.GenesisTime)
+	require.Equal(t, phase0.Root{0x4b, 0x36}, genesis.GenesisValidatorsRoot)
+
+	slotsPerEpoch, err := client.(consensusclient.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), slotsPerEpoch)
+
+	forkSchedule, err := client.(consensusclient.ForkScheduleProvider).ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.Len(t, forkSchedule, 5)
+	require.Equal(t, phase0.Version{0x03, 0x00, 0x00, 0x00}, forkSchedule[4].PreviousVersion)
+	require.Equal(t, phase0.Version{0x04, 0x00, 0x00, 0x00}, forkSchedule[4].CurrentVersion)
+
+	nodeVersion, err := client.(consensusclient.NodeVersionProvider).NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Lighthouse/v4.5.0", nodeVersion)
+}
+
+func TestHeaders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, err := fakebeacon.New(ctx, fakebeacon.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	defer server.Close()
+	client := newClient(ctx, t, server)
+	provider := client.(consensusclient.BeaconBlockHeadersProvider)
+
+	require.NoError(t, server.AddHeader(header(0, 0x01)))
+	require.NoError(t, server.AddHeader(header(1, 0x02)))
+	require.NoError(t, server.SetFinalized(phase0.Root{0x01}))
+	require.EqualError(t, server.SetHead(phase0.Root{0x03}), "unknown header 0x0300000000000000000000000000000000000000000000000000000000000000")
+
+	tests := []struct {
+		name    string
+		blockID string
+		root    phase0.Root
+		missing bool
+	}{
+		{
+			name:    "Head",
+			blockID: "head",
+			root:    phase0.Root{0x02},
+		},
+		{
+			name:    "Genesis",
+			blockID: "genesis",
+			root:    phase0.Root{0x01},
+		},
+		{
+			name:    "Finalized",
+			blockID: "finalized",
+			root:    phase0.Root{0x01},
+		},
+		{
+			name:    "Slot",
+			blockID: "1",
+			root:    phase0.Root{0x02},
+		},
+		{
+			name:    "Root",
+			blockID: "0x0100000000000000000000000000000000000000000000000000000000000000",
+			root:    phase0.Root{0x01},
+		},
+		{
+			name:    "Missing",
+			blockID: "5",
+			missing: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := provider.BeaconBlockHeader(ctx, test.blockID)
+			require.NoError(t, err)
+			if test.missing {
+				require.Nil(t, res)
+			} else {
+				require.NotNil(t, res)
+				require.Equal(t, test.root, res.Root)
+			}
+		})
+	}
+
+	// Move the head.
+	require.NoError(t, server.AddHeader(header(2, 0x03)))
+	res, err := provider.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, phase0.Root{0x03}, res.Root)
+}
+
+func TestValidatorsAndDuties(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, err := fakebeacon.New(ctx, fakebeacon.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	defer server.Close()
+	client := newClient(ctx, t, server)
+
+	server.SetValidators([]*apiv1.Validator{
+		{
+			Index:   1,
+			Balance: 32000000000,
+			Status:  apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{
+				PublicKey:             phase0.BLSPubKey{0x01},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      32000000000,
+			},
+		},
+		{
+			Index:   2,
+			Balance: 31000000000,
+			Status:  apiv1.ValidatorStateActiveOngoing,
+			Validator: &phase0.Validator{
+				PublicKey:             phase0.BLSPubKey{0x02},
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      31000000000,
+			},
+		},
+	})
+
+	validators, err := client.(consensusclient.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{2, 3})
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, phase0.Gwei(31000000000), validators[2].Balance)
+
+	// Mutate the state.
+	server.UpdateValidator(&apiv1.Validator{
+		Index:   2,
+		Balance: 0,
+		Status:  apiv1.ValidatorStateWithdrawalDone,
+		Validator: &phase0.Validator{
+			PublicKey:             phase0.BLSPubKey{0x02},
+			WithdrawalCredentials: make([]byte, 32),
+		},
+	})
+	validators, err = client.(consensusclient.ValidatorsProvider).Validators(ctx, "head", []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.ValidatorStateWithdrawalDone, validators[2].Status)
+
+	server.SetAttesterDuties(1, []*apiv1.AttesterDuty{
+		{Slot: 32, ValidatorIndex: 1, CommitteeIndex: 3, CommitteeLength: 128, CommitteesAtSlot: 4},
+		{Slot: 40, ValidatorIndex: 2, CommitteeIndex: 1, CommitteeLength: 128, CommitteesAtSlot: 4},
+	})
+	attesterDuties, err := client.(consensusclient.AttesterDutiesProvider).AttesterDuties(ctx, 1, []phase0.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Len(t, attesterDuties, 1)
+	require.Equal(t, phase0.Slot(40), attesterDuties[0].Slot)
+
+	server.SetProposerDuties(1, []*apiv1.ProposerDuty{
+		{Slot: 33, ValidatorIndex: 1},
+		{Slot: 34, ValidatorIndex: 2},
+	})
+	proposerDuties, err := client.(consensusclient.ProposerDutiesProvider).ProposerDuties(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, proposerDuties, 2)
+
+	server.SetSyncCommitteeDuties(1, []*apiv1.SyncCommitteeDuty{
+		{ValidatorIndex: 1, ValidatorSyncCommitteeIndices: []phase0.CommitteeIndex{5}},
+	})
+	syncCommitteeDuties, err := client.(consensusclient.SyncCommitteeDutiesProvider).SyncCommitteeDuties(ctx, 1, []phase0.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Len(t, syncCommitteeDuties, 1)
+	require.Equal(t, phase0.ValidatorIndex(1), syncCommitteeDuties[0].ValidatorIndex)
+}
+
+func TestEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, err := fakebeacon.New(ctx, fakebeacon.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	defer server.Close()
+	client := newClient(ctx, t, server)
+
+	events := make(chan *apiv1.Event, 4)
+	require.NoError(t, client.(consensusclient.EventsProvider).Events(ctx, []string{"head"}, func(event *apiv1.Event) {
+		events <- event
+	}))
+	require.Eventually(t, func() bool { return server.Subscribers("head") > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	require.EqualError(t, server.PublishEvent("unknown", nil), "unsupported topic unknown")
+	require.NoError(t, server.PublishEvent("head", &apiv1.HeadEvent{
+		Slot:  5,
+		Block: phase0.Root{0x05},
+		State: phase0.Root{0x06},
+	}))
+
+	select {
+	case event := <-events:
+		require.Equal(t, "head", event.Topic)
+		headEvent, isHeadEvent := event.Data.(*apiv1.HeadEvent)
+		require.True(t, isHeadEvent)
+		require.Equal(t, phase0.Slot(5), headEvent.Slot)
+		require.Equal(t, phase0.Root{0x05}, headEvent.Block)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no event received")
+	}
+}
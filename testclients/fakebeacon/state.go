@@ -0,0 +1,148 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebeacon
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SetSpecValue sets a spec value, in the string format returned by the spec endpoint.
+func (s *Server) SetSpecValue(key string, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.spec[key] = value
+}
+
+// SetNodeVersion sets the version string returned by the node.
+func (s *Server) SetNodeVersion(nodeVersion string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nodeVersion = nodeVersion
+}
+
+// AddHeader adds a block header.
+// The header becomes the head of the chain if its slot is at least that of the current head.
+func (s *Server) AddHeader(header *apiv1.BeaconBlockHeader) error {
+	if header == nil {
+		return errors.New("no header supplied")
+	}
+	if header.Header == nil || header.Header.Message == nil {
+		return errors.New("no header message supplied")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.headers[header.Root] = header
+	if s.head == nil || header.Header.Message.Slot >= s.head.Header.Message.Slot {
+		s.head = header
+	}
+
+	return nil
+}
+
+// SetHead sets the head of the chain to a previously added header.
+func (s *Server) SetHead(root phase0.Root) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	header, exists := s.headers[root]
+	if !exists {
+		return errors.Errorf("unknown header %#x", root)
+	}
+	s.head = header
+
+	return nil
+}
+
+// SetFinalized sets the finalized block of the chain to a previously added header.
+func (s *Server) SetFinalized(root phase0.Root) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	header, exists := s.headers[root]
+	if !exists {
+		return errors.Errorf("unknown header %#x", root)
+	}
+	s.finalized = header
+
+	return nil
+}
+
+// SetValidators replaces the validators.
+func (s *Server) SetValidators(validators []*apiv1.Validator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.validators = make(map[phase0.ValidatorIndex]*apiv1.Validator, len(validators))
+	for _, validator := range validators {
+		s.validators[validator.Index] = validator
+	}
+}
+
+// UpdateValidator adds or replaces a single validator.
+func (s *Server) UpdateValidator(validator *apiv1.Validator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.validators[validator.Index] = validator
+}
+
+// SetProposerDuties sets the proposer duties for an epoch.
+func (s *Server) SetProposerDuties(epoch phase0.Epoch, duties []*apiv1.ProposerDuty) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.proposerDuties[epoch] = duties
+}
+
+// SetAttesterDuties sets the attester duties for an epoch.
+func (s *Server) SetAttesterDuties(epoch phase0.Epoch, duties []*apiv1.AttesterDuty) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attesterDuties[epoch] = duties
+}
+
+// SetSyncCommitteeDuties sets the sync committee duties for an epoch.
+func (s *Server) SetSyncCommitteeDuties(epoch phase0.Epoch, duties []*apiv1.SyncCommitteeDuty) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.syncCommitteeDuties[epoch] = duties
+}
+
+// SetSyncState sets the sync state returned by the node.
+// If not set, the node reports itself as synced to the slot of its head.
+func (s *Server) SetSyncState(syncState *apiv1.SyncState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.syncState = syncState
+}
+
+// Requests provides the requests received by the node, as "METHOD path" strings.
+func (s *Server) Requests() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]string, len(s.requests))
+	copy(res, s.requests)
+
+	return res
+}
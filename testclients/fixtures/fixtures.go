@@ -0,0 +1,107 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fixtures records the responses of a beacon node to disk and replays them,
+// allowing tests to run against real network data without a live beacon node.
+//
+// Responses are recorded at the HTTP level, so fixtures hold the JSON or SSZ exactly
+// as served.  Each response is stored as a metadata file and a body file, named after
+// the request; if a request is made more than once the last response is kept.
+// Event streams are not recorded.
+package fixtures
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	ethhttp "github.com/attestantio/go-eth2-client/http"
+)
+
+// replayAddress is the address used by replaying clients if none is supplied.
+const replayAddress = "http://replay.invalid"
+
+// fixture is the metadata of a recorded response.
+type fixture struct {
+	Method     string      `json:"method"`
+	URI        string      `json:"uri"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// Record creates an HTTP client with the given parameters that records all responses
+// from its beacon node to the given directory.
+func Record(ctx context.Context, dir string, params ...ethhttp.Parameter) (consensusclient.Service, error) {
+	recorder, err := NewRecorder(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethhttp.New(ctx, append(params, ethhttp.WithInterceptor(recorder.Intercept))...)
+}
+
+// Replay creates an HTTP client with the given parameters that serves all responses
+// from fixtures in the given directory, rather than from a beacon node.  An address
+// does not need to be supplied.
+func Replay(ctx context.Context, dir string, params ...ethhttp.Parameter) (consensusclient.Service, error) {
+	replayer, err := NewReplayer(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	params = append([]ethhttp.Parameter{ethhttp.WithAddress(replayAddress)}, params...)
+
+	return ethhttp.New(ctx, append(params, ethhttp.WithInterceptor(replayer.Intercept))...)
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// maxNameLen is the maximum length of the readable part of a fixture name.
+const maxNameLen = 96
+
+// fixtureName returns the name of the fixture for a request.  It is readable, and
+// unique to the method, URI, accepted content type and body of the request.
+func fixtureName(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n", req.Method, req.URL.RequestURI(), req.Header.Get("Accept"))
+	hash.Write(body)
+
+	name := strings.Trim(unsafeChars.ReplaceAllString(req.URL.Path, "_"), "_")
+	if len(name) > maxNameLen {
+		name = name[:maxNameLen]
+	}
+
+	return fmt.Sprintf("%s_%s_%x", req.Method, name, hash.Sum(nil)[:4])
+}
+
+// bodyExtension returns the file extension for a body of the given content type.
+func bodyExtension(contentType string) string {
+	switch {
+	case strings.HasPrefix(contentType, "application/json"):
+		return ".json"
+	case strings.HasPrefix(contentType, "application/octet-stream"):
+		return ".ssz"
+	default:
+		return ".body"
+	}
+}
+
+// isStream returns true if the request is for an event stream.
+func isStream(req *http.Request) bool {
+	return strings.Contains(req.Header.Get("Accept"), "text/event-stream")
+}
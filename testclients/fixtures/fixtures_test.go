@@ -0,0 +1,135 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	ethhttp "github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients/fixtures"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var responses = map[string]string{
+	"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}}`,
+	"/eth/v1/config/spec":             `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}}`,
+	"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"1","address":"0x00000000219ab540356cbb839cbe05303d7705fa"}}`,
+	"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"}]}`,
+	"/eth/v1/node/version":            `{"data":{"version":"Lighthouse/v4.5.0"}}`,
+	"/eth/v1/beacon/blocks/head/root": `{"execution_optimistic":false,"finalized":false,"data":{"root":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"}}`,
+}
+
+// newNode returns a server that serves fixed responses, and records the requests it receives.
+func newNode(t *testing.T, requests *[]string, mu *sync.Mutex) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusOK)
+
+			return
+		}
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	var mu sync.Mutex
+	var requests []string
+	srv := newNode(t, &requests, &mu)
+
+	recording, err := fixtures.Record(ctx, dir,
+		ethhttp.WithAddress(srv.URL),
+		ethhttp.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	root, err := recording.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+	preparations := []*apiv1.ProposalPreparation{
+		{
+			ValidatorIndex: 1,
+			FeeRecipient:   bellatrix.ExecutionAddress{0x01},
+		},
+	}
+	require.NoError(t, recording.(consensusclient.ProposalPreparationsSubmitter).SubmitProposalPreparations(ctx, preparations))
+	srv.Close()
+
+	// Fixtures are readable.
+	files, err := filepath.Glob(filepath.Join(dir, "GET_eth_v1_beacon_blocks_head_root_*"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	data, err := os.ReadFile(filepath.Join(dir, filepath.Base(files[0])))
+	require.NoError(t, err)
+	require.NotEmpty(t, data)
+
+	mu.Lock()
+	recorded := len(requests)
+	mu.Unlock()
+
+	// Replay without the node.
+	replaying, err := fixtures.Replay(ctx, dir, ethhttp.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+	replayedRoot, err := replaying.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, root, replayedRoot)
+	require.Equal(t, phase0.Root{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}, *replayedRoot)
+	require.NoError(t, replaying.(consensusclient.ProposalPreparationsSubmitter).SubmitProposalPreparations(ctx, preparations))
+
+	// Unrecorded requests fail.
+	_, err = replaying.(consensusclient.BeaconBlockRootProvider).BeaconBlockRoot(ctx, "finalized")
+	require.ErrorContains(t, err, "no recorded response for GET /eth/v1/beacon/blocks/finalized/root")
+
+	// Submissions with a different body are different requests.
+	preparations[0].ValidatorIndex = 2
+	require.ErrorContains(t, replaying.(consensusclient.ProposalPreparationsSubmitter).SubmitProposalPreparations(ctx, preparations), "no recorded response")
+
+	mu.Lock()
+	require.Len(t, requests, recorded)
+	mu.Unlock()
+}
+
+func TestNew(t *testing.T) {
+	_, err := fixtures.NewRecorder("")
+	require.EqualError(t, err, "no directory specified")
+	_, err = fixtures.NewReplayer("")
+	require.EqualError(t, err, "no directory specified")
+	_, err = fixtures.NewReplayer(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "failed to access directory")
+}
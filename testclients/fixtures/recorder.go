@@ -0,0 +1,133 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Recorder records HTTP responses to a directory.
+type Recorder struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewRecorder creates a recorder that records to the given directory, creating it if required.
+func NewRecorder(dir string) (*Recorder, error) {
+	if dir == "" {
+		return nil, errors.New("no directory specified")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.Wrap(err, "failed to create directory")
+	}
+
+	return &Recorder{
+		dir: dir,
+	}, nil
+}
+
+// Intercept returns a round tripper that records the responses of the next round
+// tripper.  It can be supplied to an HTTP client with http.WithInterceptor.
+func (r *Recorder) Intercept(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if isStream(req) {
+			return next.RoundTrip(req)
+		}
+
+		reqBody, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read response body")
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := r.record(fixtureName(req, reqBody), req, resp, body); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	})
+}
+
+// record writes a response to disk.
+func (r *Recorder) record(name string, req *http.Request, resp *http.Response, body []byte) error {
+	header := resp.Header.Clone()
+	header.Del("Date")
+	header.Del("Content-Length")
+
+	f := &fixture{
+		Method:     req.Method,
+		URI:        req.URL.RequestURI(),
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       name + bodyExtension(resp.Header.Get("Content-Type")),
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal fixture")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.WriteFile(filepath.Join(r.dir, f.Body), body, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write fixture body")
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, name+".meta.json"), data, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write fixture")
+	}
+
+	return nil
+}
+
+// readRequestBody reads the body of the request, replacing it so that it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read request body")
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// roundTripperFunc is an adapter to allow the use of ordinary functions as round trippers.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
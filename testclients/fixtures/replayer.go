@@ -0,0 +1,99 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Replayer serves HTTP responses from a directory of recorded fixtures.
+type Replayer struct {
+	dir string
+}
+
+// NewReplayer creates a replayer that serves fixtures from the given directory.
+func NewReplayer(dir string) (*Replayer, error) {
+	if dir == "" {
+		return nil, errors.New("no directory specified")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to access directory")
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	return &Replayer{
+		dir: dir,
+	}, nil
+}
+
+// Intercept returns a round tripper that serves recorded responses in place of the
+// next round tripper, which is never called.  Requests without a recorded response fail.
+// It can be supplied to an HTTP client with http.WithInterceptor.
+func (r *Replayer) Intercept(_ http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if isStream(req) {
+			return nil, errors.New("event streams cannot be replayed")
+		}
+
+		reqBody, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.replay(fixtureName(req, reqBody), req)
+	})
+}
+
+// replay reads a response from disk.
+func (r *Replayer) replay(name string, req *http.Request) (*http.Response, error) {
+	data, err := os.ReadFile(filepath.Join(r.dir, name+".meta.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL.RequestURI())
+		}
+
+		return nil, errors.Wrap(err, "failed to read fixture")
+	}
+	f := &fixture{}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal fixture")
+	}
+	body, err := os.ReadFile(filepath.Join(r.dir, f.Body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read fixture body")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -196,6 +196,16 @@ func (s *Sleepy) AttestationPool(ctx context.Context, slot phase0.Slot) ([]*phas
 	return next.AttestationPool(ctx, slot)
 }
 
+// AttestationPoolPage obtains a single page of the attestation pool for a given slot.
+func (s *Sleepy) AttestationPoolPage(ctx context.Context, slot phase0.Slot, opts *api.PageOpts) (*api.Page[*phase0.Attestation], error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationPoolPageProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationPoolPage(ctx, slot, opts)
+}
+
 // SubmitAttestations submits attestations.
 func (s *Sleepy) SubmitAttestations(ctx context.Context, attestations []*phase0.Attestation) error {
 	s.sleep(ctx)
@@ -357,6 +367,26 @@ func (s *Sleepy) NodeSyncing(ctx context.Context) (*apiv1.SyncState, error) {
 	return next.NodeSyncing(ctx)
 }
 
+// Peers fetches the peers of the node.
+func (s *Sleepy) Peers(ctx context.Context, states []string, directions []string) ([]*apiv1.Peer, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.PeersProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.Peers(ctx, states, directions)
+}
+
+// PeersPage fetches a single page of the peers of the node.
+func (s *Sleepy) PeersPage(ctx context.Context, states []string, directions []string, opts *api.PageOpts) (*api.Page[*apiv1.Peer], error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.PeersPageProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.PeersPage(ctx, states, directions, opts)
+}
+
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validatorIndices is empty all duties are returned, otherwise only matching duties are returned.
 func (s *Sleepy) ProposerDuties(ctx context.Context, epoch phase0.Epoch, validatorIndices []phase0.ValidatorIndex) ([]*apiv1.ProposerDuty, error) {
@@ -476,3 +506,44 @@ func (s *Sleepy) BeaconBlockBlobs(ctx context.Context, blockID string) ([]*deneb
 	}
 	return next.BeaconBlockBlobs(ctx, blockID)
 }
+
+// BlobSidecars fetches the blob sidecars given a block ID.
+func (s *Sleepy) BlobSidecars(ctx context.Context, blockID string, indices []deneb.BlobIndex) ([]*deneb.BlobSidecar, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlobSidecarsProvider)
+	if !isNext {
+		return []*deneb.BlobSidecar{}, errors.New("next does not support this call")
+	}
+	return next.BlobSidecars(ctx, blockID, indices)
+}
+
+// EstimateExit estimates the exit and withdrawable epochs for a validator.
+func (s *Sleepy) EstimateExit(ctx context.Context, validatorIndex phase0.ValidatorIndex) (*apiv1.ExitEstimate, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.ExitEstimateProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.EstimateExit(ctx, validatorIndex)
+}
+
+// AttestationRewards provides the ideal and actual attestation rewards for the given epoch
+// and validator indices.  If no indices are supplied rewards are returned for all validators.
+func (s *Sleepy) AttestationRewards(ctx context.Context, epoch phase0.Epoch, indices []phase0.ValidatorIndex) (*apiv1.AttestationRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.AttestationRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.AttestationRewards(ctx, epoch, indices)
+}
+
+// BlockRewards provides the rewards received by the proposer of a given block ID.
+func (s *Sleepy) BlockRewards(ctx context.Context, blockID string) (*apiv1.BlockRewards, error) {
+	s.sleep(ctx)
+	next, isNext := s.next.(consensusclient.BlockRewardsProvider)
+	if !isNext {
+		return nil, errors.New("next does not support this call")
+	}
+	return next.BlockRewards(ctx, blockID)
+}
@@ -0,0 +1,233 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+// RecordingTracerProvider is a tracer provider that records the spans that it creates.
+type RecordingTracerProvider struct {
+	embedded.TracerProvider
+
+	mu     sync.Mutex
+	spans  []*RecordingSpan
+	nextID uint64
+}
+
+// NewRecordingTracerProvider creates a new recording tracer provider.
+func NewRecordingTracerProvider() *RecordingTracerProvider {
+	return &RecordingTracerProvider{}
+}
+
+// Tracer returns a tracer.
+func (p *RecordingTracerProvider) Tracer(_ string, _ ...trace.TracerOption) trace.Tracer {
+	return &recordingTracer{
+		provider: p,
+	}
+}
+
+// Spans returns the spans that have been created, in order of creation.
+func (p *RecordingTracerProvider) Spans() []*RecordingSpan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*RecordingSpan{}, p.spans...)
+}
+
+type recordingTracer struct {
+	embedded.Tracer
+
+	provider *RecordingTracerProvider
+}
+
+// Start creates a span.
+func (t *recordingTracer) Start(ctx context.Context,
+	spanName string,
+	opts ...trace.SpanStartOption,
+) (
+	context.Context,
+	trace.Span,
+) {
+	p := t.provider
+	p.mu.Lock()
+	p.nextID++
+	id := p.nextID
+	p.mu.Unlock()
+
+	parent := trace.SpanContextFromContext(ctx)
+	traceID := parent.TraceID()
+	if !parent.IsValid() {
+		binary.BigEndian.PutUint64(traceID[8:], id)
+	}
+	var spanID trace.SpanID
+	binary.BigEndian.PutUint64(spanID[:], id)
+
+	config := trace.NewSpanStartConfig(opts...)
+	span := &RecordingSpan{
+		provider: p,
+		name:     spanName,
+		parent:   parent,
+		spanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+		attributes: make(map[attribute.Key]attribute.Value),
+	}
+	span.SetAttributes(config.Attributes()...)
+
+	p.mu.Lock()
+	p.spans = append(p.spans, span)
+	p.mu.Unlock()
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+// RecordingSpan is a span created by a recording tracer provider.
+type RecordingSpan struct {
+	embedded.Span
+
+	provider    *RecordingTracerProvider
+	name        string
+	parent      trace.SpanContext
+	spanContext trace.SpanContext
+
+	mu         sync.Mutex
+	attributes map[attribute.Key]attribute.Value
+	events     []string
+	errs       []error
+	statusCode codes.Code
+	ended      bool
+}
+
+// Name returns the name of the span.
+func (s *RecordingSpan) Name() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.name
+}
+
+// Parent returns the span context of the parent of the span.
+func (s *RecordingSpan) Parent() trace.SpanContext {
+	return s.parent
+}
+
+// Attribute returns the value of an attribute of the span.
+func (s *RecordingSpan) Attribute(key string) (attribute.Value, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, exists := s.attributes[attribute.Key(key)]
+
+	return value, exists
+}
+
+// Events returns the names of the events added to the span.
+func (s *RecordingSpan) Events() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.events...)
+}
+
+// Errors returns the errors recorded on the span.
+func (s *RecordingSpan) Errors() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]error{}, s.errs...)
+}
+
+// StatusCode returns the status code of the span.
+func (s *RecordingSpan) StatusCode() codes.Code {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.statusCode
+}
+
+// Ended returns true if the span has ended.
+func (s *RecordingSpan) Ended() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ended
+}
+
+// End ends the span.
+func (s *RecordingSpan) End(_ ...trace.SpanEndOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ended = true
+}
+
+// AddEvent adds an event to the span.
+func (s *RecordingSpan) AddEvent(name string, _ ...trace.EventOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, name)
+}
+
+// IsRecording returns true if the span has not ended.
+func (s *RecordingSpan) IsRecording() bool {
+	return !s.Ended()
+}
+
+// RecordError records an error on the span.
+func (s *RecordingSpan) RecordError(err error, _ ...trace.EventOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, err)
+}
+
+// SpanContext returns the span context of the span.
+func (s *RecordingSpan) SpanContext() trace.SpanContext {
+	return s.spanContext
+}
+
+// SetStatus sets the status of the span.
+func (s *RecordingSpan) SetStatus(code codes.Code, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statusCode = code
+}
+
+// SetName sets the name of the span.
+func (s *RecordingSpan) SetName(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.name = name
+}
+
+// SetAttributes sets attributes of the span.
+func (s *RecordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, attr := range kv {
+		s.attributes[attr.Key] = attr.Value
+	}
+}
+
+// TracerProvider returns the tracer provider that created the span.
+func (s *RecordingSpan) TracerProvider() trace.TracerProvider {
+	return s.provider
+}
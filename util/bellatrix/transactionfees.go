@@ -0,0 +1,90 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// TransactionFees contains the fee parameters of an execution transaction.
+type TransactionFees struct {
+	// GasLimit is the maximum gas the transaction can use.
+	GasLimit uint64
+	// MaxFeePerGas is the maximum the sender will pay per gas, in wei.
+	// For legacy and access list transactions this is the gas price.
+	MaxFeePerGas *uint256.Int
+	// MaxPriorityFeePerGas is the maximum the sender will pay per gas above the base fee, in wei.
+	// For legacy and access list transactions this is the gas price.
+	MaxPriorityFeePerGas *uint256.Int
+}
+
+// EffectivePriorityFeePerGas returns the priority fee per gas paid by the transaction
+// to the fee recipient given the base fee of its block, in wei.
+// It returns zero if the transaction cannot pay the base fee.
+func (f *TransactionFees) EffectivePriorityFeePerGas(baseFeePerGas *uint256.Int) *uint256.Int {
+	if f.MaxFeePerGas.Lt(baseFeePerGas) {
+		return uint256.NewInt(0)
+	}
+	res := new(uint256.Int).Sub(f.MaxFeePerGas, baseFeePerGas)
+	if f.MaxPriorityFeePerGas.Lt(res) {
+		res.Set(f.MaxPriorityFeePerGas)
+	}
+
+	return res
+}
+
+// DecodeTransactionFees decodes the fee parameters of an execution transaction.
+// Legacy, access list (EIP-2930), dynamic fee (EIP-1559), blob (EIP-4844) and set code
+// (EIP-7702) transactions are supported.
+func DecodeTransactionFees(tx bellatrix.Transaction) (*TransactionFees, error) {
+	txType, items, err := transactionItems(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxPriorityFeeIndex, maxFeeIndex, gasLimitIndex int
+	switch txType {
+	case legacyTxType:
+		// Legacy transaction: [nonce, gasPrice, gasLimit, ...].
+		maxPriorityFeeIndex, maxFeeIndex, gasLimitIndex = 1, 1, 2
+	case accessListTxType:
+		// Access list transaction: [chainId, nonce, gasPrice, gasLimit, ...].
+		maxPriorityFeeIndex, maxFeeIndex, gasLimitIndex = 2, 2, 3
+	default:
+		// Dynamic fee, blob and set code transactions: [chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit, ...].
+		maxPriorityFeeIndex, maxFeeIndex, gasLimitIndex = 2, 3, 4
+	}
+	if len(items) <= gasLimitIndex {
+		return nil, errors.New("transaction has too few fields")
+	}
+
+	if len(items[maxPriorityFeeIndex]) > 32 || len(items[maxFeeIndex]) > 32 {
+		return nil, errors.New("invalid fee")
+	}
+	if len(items[gasLimitIndex]) > 8 {
+		return nil, errors.New("invalid gas limit")
+	}
+	gasLimit := uint64(0)
+	for _, b := range items[gasLimitIndex] {
+		gasLimit = gasLimit<<8 | uint64(b)
+	}
+
+	return &TransactionFees{
+		GasLimit:             gasLimit,
+		MaxFeePerGas:         new(uint256.Int).SetBytes(items[maxFeeIndex]),
+		MaxPriorityFeePerGas: new(uint256.Int).SetBytes(items[maxPriorityFeeIndex]),
+	}, nil
+}
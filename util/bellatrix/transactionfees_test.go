@@ -0,0 +1,125 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTransactionFees(t *testing.T) {
+	tests := []struct {
+		name           string
+		tx             bellatrix.Transaction
+		gasLimit       uint64
+		maxFee         *uint256.Int
+		maxPriorityFee *uint256.Int
+		err            string
+	}{
+		{
+			name: "Empty",
+			err:  "empty transaction",
+		},
+		{
+			name: "UnsupportedType",
+			tx:   hexToBytes("0x7e"),
+			err:  "unsupported transaction type 0x7e",
+		},
+		{
+			name: "TooFewFields",
+			tx:   hexToBytes("0x02c3010203"),
+			err:  "transaction has too few fields",
+		},
+		{
+			name: "InvalidGasLimit",
+			tx:   hexToBytes("0x02ce0180010289010000000000000000"),
+			err:  "invalid gas limit",
+		},
+		{
+			name:           "Legacy",
+			tx:             hexToBytes("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"),
+			gasLimit:       21000,
+			maxFee:         uint256.NewInt(20000000000),
+			maxPriorityFee: uint256.NewInt(20000000000),
+		},
+		{
+			name:           "DynamicFee",
+			tx:             hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297880b1a2bc2ec50000080c0800101"),
+			gasLimit:       21000,
+			maxFee:         uint256.NewInt(2),
+			maxPriorityFee: uint256.NewInt(1),
+		},
+		{
+			name:           "SetCode",
+			tx:             hexToBytes("0x04f84e0180843b9aca00847735940082c35094388c818ca8b9251b393131c08a736a67ccb19297880de0b6b3a764000080c0dbda0194000000000000000000000000000000000000000180800101800101"),
+			gasLimit:       50000,
+			maxFee:         uint256.NewInt(2000000000),
+			maxPriorityFee: uint256.NewInt(1000000000),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := utilbellatrix.DecodeTransactionFees(test.tx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.gasLimit, res.GasLimit)
+				require.Equal(t, test.maxFee, res.MaxFeePerGas)
+				require.Equal(t, test.maxPriorityFee, res.MaxPriorityFeePerGas)
+			}
+		})
+	}
+}
+
+func TestEffectivePriorityFeePerGas(t *testing.T) {
+	fees := &utilbellatrix.TransactionFees{
+		GasLimit:             21000,
+		MaxFeePerGas:         uint256.NewInt(100),
+		MaxPriorityFeePerGas: uint256.NewInt(10),
+	}
+
+	tests := []struct {
+		name     string
+		baseFee  uint64
+		expected uint64
+	}{
+		{
+			name:     "Capped",
+			baseFee:  50,
+			expected: 10,
+		},
+		{
+			name:     "Squeezed",
+			baseFee:  95,
+			expected: 5,
+		},
+		{
+			name:     "BaseFeeTooHigh",
+			baseFee:  101,
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, uint256.NewInt(test.expected), fees.EffectivePriorityFeePerGas(uint256.NewInt(test.baseFee)))
+		})
+	}
+}
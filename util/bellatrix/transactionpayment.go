@@ -0,0 +1,188 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// TransactionPayment contains the recipient and value of an execution transaction.
+type TransactionPayment struct {
+	// To is the recipient of the transaction; nil for contract creation.
+	To *bellatrix.ExecutionAddress
+	// Value is the value transferred by the transaction, in wei.
+	Value *uint256.Int
+}
+
+// DecodeTransactionPayment decodes the recipient and value of an execution transaction.
+// Legacy, access list (EIP-2930), dynamic fee (EIP-1559), blob (EIP-4844) and set code
+// (EIP-7702) transactions are supported.
+func DecodeTransactionPayment(tx bellatrix.Transaction) (*TransactionPayment, error) {
+	txType, items, err := transactionItems(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var toIndex int
+	switch txType {
+	case legacyTxType:
+		// Legacy transaction: [nonce, gasPrice, gasLimit, to, value, ...].
+		toIndex = 3
+	case accessListTxType:
+		// Access list transaction: [chainId, nonce, gasPrice, gasLimit, to, value, ...].
+		toIndex = 4
+	default:
+		// Dynamic fee, blob and set code transactions: [chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gasLimit, to, value, ...].
+		toIndex = 5
+	}
+	if len(items) <= toIndex+1 {
+		return nil, errors.New("transaction has too few fields")
+	}
+
+	res := &TransactionPayment{}
+	switch len(items[toIndex]) {
+	case 0:
+		// Contract creation.
+	case bellatrix.ExecutionAddressLength:
+		res.To = &bellatrix.ExecutionAddress{}
+		copy(res.To[:], items[toIndex])
+	default:
+		return nil, errors.New("invalid recipient")
+	}
+	if len(items[toIndex+1]) > 32 {
+		return nil, errors.New("invalid value")
+	}
+	res.Value = new(uint256.Int).SetBytes(items[toIndex+1])
+
+	return res, nil
+}
+
+const (
+	legacyTxType     = byte(0x00)
+	accessListTxType = byte(0x01)
+	dynamicFeeTxType = byte(0x02)
+	blobTxType       = byte(0x03)
+	setCodeTxType    = byte(0x04)
+)
+
+// transactionItems returns the type of an execution transaction and the top-level items of its payload.
+// Legacy, access list (EIP-2930), dynamic fee (EIP-1559), blob (EIP-4844) and set code
+// (EIP-7702) transactions are supported.
+func transactionItems(tx bellatrix.Transaction) (byte, [][]byte, error) {
+	if len(tx) == 0 {
+		return 0, nil, errors.New("empty transaction")
+	}
+
+	txType := legacyTxType
+	payload := []byte(tx)
+	switch {
+	case tx[0] >= 0xc0:
+		// Legacy transactions are an untyped RLP list.
+	case tx[0] == accessListTxType, tx[0] == dynamicFeeTxType, tx[0] == blobTxType, tx[0] == setCodeTxType:
+		txType = tx[0]
+		payload = payload[1:]
+	default:
+		return 0, nil, errors.Errorf("unsupported transaction type %#02x", tx[0])
+	}
+
+	items, err := rlpListItems(payload)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to decode transaction")
+	}
+
+	return txType, items, nil
+}
+
+// rlpListItems decodes an RLP list, returning the contents of its top-level items.
+// Items that are themselves lists are returned as their raw encoded contents.
+func rlpListItems(input []byte) ([][]byte, error) {
+	content, rest, err := rlpSplit(input, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after list")
+	}
+
+	items := make([][]byte, 0)
+	for len(content) > 0 {
+		var item []byte
+		item, content, err = rlpSplit(content, false)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// rlpSplit splits the first RLP item from the input, returning its content and the remaining input.
+func rlpSplit(input []byte, requireList bool) ([]byte, []byte, error) {
+	if len(input) == 0 {
+		return nil, nil, errors.New("unexpected end of input")
+	}
+
+	prefix := input[0]
+	var offset, length uint64
+	isList := false
+	switch {
+	case prefix < 0x80:
+		// Single byte.
+		offset, length = 0, 1
+	case prefix < 0xb8:
+		offset, length = 1, uint64(prefix-0x80)
+	case prefix < 0xc0:
+		lenOfLen := uint64(prefix - 0xb7)
+		l, err := rlpLength(input, lenOfLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		offset, length = 1+lenOfLen, l
+	case prefix < 0xf8:
+		isList = true
+		offset, length = 1, uint64(prefix-0xc0)
+	default:
+		isList = true
+		lenOfLen := uint64(prefix - 0xf7)
+		l, err := rlpLength(input, lenOfLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		offset, length = 1+lenOfLen, l
+	}
+	if requireList && !isList {
+		return nil, nil, errors.New("expected list")
+	}
+	if offset+length < offset || offset+length > uint64(len(input)) {
+		return nil, nil, errors.New("item length exceeds input")
+	}
+
+	return input[offset : offset+length], input[offset+length:], nil
+}
+
+// rlpLength decodes a big-endian length of the given number of bytes following the prefix.
+func rlpLength(input []byte, lenOfLen uint64) (uint64, error) {
+	if lenOfLen > 8 || uint64(len(input)) < 1+lenOfLen {
+		return 0, errors.New("invalid length prefix")
+	}
+	length := uint64(0)
+	for _, b := range input[1 : 1+lenOfLen] {
+		length = length<<8 | uint64(b)
+	}
+
+	return length, nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bellatrix_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	utilbellatrix "github.com/attestantio/go-eth2-client/util/bellatrix"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func hexToBytes(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func hexToAddress(input string) *bellatrix.ExecutionAddress {
+	res := bellatrix.ExecutionAddress{}
+	copy(res[:], hexToBytes(input))
+	return &res
+}
+
+func TestDecodeTransactionPayment(t *testing.T) {
+	tests := []struct {
+		name  string
+		tx    bellatrix.Transaction
+		to    *bellatrix.ExecutionAddress
+		value *uint256.Int
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "empty transaction",
+		},
+		{
+			name: "UnsupportedType",
+			tx:   hexToBytes("0x7e"),
+			err:  "unsupported transaction type 0x7e",
+		},
+		{
+			name: "Truncated",
+			tx:   hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297"),
+			err:  "failed to decode transaction: item length exceeds input",
+		},
+		{
+			name: "TooFewFields",
+			tx:   hexToBytes("0x02c3010203"),
+			err:  "transaction has too few fields",
+		},
+		{
+			name:  "Legacy",
+			tx:    hexToBytes("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"),
+			to:    hexToAddress("0x3535353535353535353535353535353535353535"),
+			value: uint256.NewInt(1000000000000000000),
+		},
+		{
+			name:  "DynamicFee",
+			tx:    hexToBytes("0x02ea0180010282520894388c818ca8b9251b393131c08a736a67ccb19297880b1a2bc2ec50000080c0800101"),
+			to:    hexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297"),
+			value: uint256.NewInt(800000000000000000),
+		},
+		{
+			name:  "SetCode",
+			tx:    hexToBytes("0x04f84e0180843b9aca00847735940082c35094388c818ca8b9251b393131c08a736a67ccb19297880de0b6b3a764000080c0dbda0194000000000000000000000000000000000000000180800101800101"),
+			to:    hexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297"),
+			value: uint256.NewInt(1000000000000000000),
+		},
+		{
+			name:  "ContractCreation",
+			tx:    hexToBytes("0x02d0018001028252088080826000c0800101"),
+			value: uint256.NewInt(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := utilbellatrix.DecodeTransactionPayment(test.tx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.to, res.To)
+				require.Equal(t, test.value, res.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// hashRooter is an object that can provide its SSZ hash tree root.
+type hashRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// header is a light client header independent of its fork.
+type header struct {
+	beacon *phase0.BeaconBlockHeader
+	// hasExecution is true if the header carries an execution payload header.
+	hasExecution    bool
+	execution       hashRooter
+	executionBranch []phase0.Root
+}
+
+// bootstrap is a light client bootstrap independent of its fork.
+type bootstrap struct {
+	header                     *header
+	currentSyncCommittee       *altair.SyncCommittee
+	currentSyncCommitteeBranch []phase0.Root
+	syncCommitteeDepth         uint64
+}
+
+// update is a light client update independent of its fork.  Finality updates are
+// updates without a next sync committee.
+type update struct {
+	attestedHeader          *header
+	nextSyncCommittee       *altair.SyncCommittee
+	nextSyncCommitteeBranch []phase0.Root
+	syncCommitteeDepth      uint64
+	finalizedHeader         *header
+	finalityBranch          []phase0.Root
+	finalizedRootDepth      uint64
+	syncAggregate           *altair.SyncAggregate
+	signatureSlot           phase0.Slot
+}
+
+func altairHeader(h *apiv1altair.LightClientHeader) *header {
+	if h == nil {
+		return nil
+	}
+
+	return &header{
+		beacon: h.Beacon,
+	}
+}
+
+func capellaHeader(h *apiv1capella.LightClientHeader) *header {
+	if h == nil {
+		return nil
+	}
+	res := &header{
+		beacon:          h.Beacon,
+		hasExecution:    true,
+		executionBranch: h.ExecutionBranch,
+	}
+	if h.Execution != nil {
+		res.execution = h.Execution
+	}
+
+	return res
+}
+
+func denebHeader(h *apiv1deneb.LightClientHeader) *header {
+	if h == nil {
+		return nil
+	}
+	res := &header{
+		beacon:          h.Beacon,
+		hasExecution:    true,
+		executionBranch: h.ExecutionBranch,
+	}
+	if h.Execution != nil {
+		res.execution = h.Execution
+	}
+
+	return res
+}
+
+// bootstrapFromVersioned obtains a bootstrap from a versioned bootstrap.
+func bootstrapFromVersioned(v *api.VersionedLightClientBootstrap) (*bootstrap, error) {
+	if v == nil {
+		return nil, errors.New("no bootstrap supplied")
+	}
+
+	switch v.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		data := v.Altair
+		if v.Version == spec.DataVersionBellatrix {
+			data = v.Bellatrix
+		}
+		if data == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+
+		return &bootstrap{
+			header:                     altairHeader(data.Header),
+			currentSyncCommittee:       data.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: data.CurrentSyncCommitteeBranch,
+			syncCommitteeDepth:         syncCommitteeDepth,
+		}, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+
+		return &bootstrap{
+			header:                     capellaHeader(v.Capella.Header),
+			currentSyncCommittee:       v.Capella.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: v.Capella.CurrentSyncCommitteeBranch,
+			syncCommitteeDepth:         syncCommitteeDepth,
+		}, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+
+		return &bootstrap{
+			header:                     denebHeader(v.Deneb.Header),
+			currentSyncCommittee:       v.Deneb.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: v.Deneb.CurrentSyncCommitteeBranch,
+			syncCommitteeDepth:         syncCommitteeDepth,
+		}, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no bootstrap data")
+		}
+
+		return &bootstrap{
+			header:                     denebHeader(v.Electra.Header),
+			currentSyncCommittee:       v.Electra.CurrentSyncCommittee,
+			currentSyncCommitteeBranch: v.Electra.CurrentSyncCommitteeBranch,
+			syncCommitteeDepth:         electraSyncCommitteeDepth,
+		}, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// updateFromVersioned obtains an update from a versioned update.
+func updateFromVersioned(v *api.VersionedLightClientUpdate) (*update, error) {
+	if v == nil {
+		return nil, errors.New("no update supplied")
+	}
+
+	switch v.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		data := v.Altair
+		if v.Version == spec.DataVersionBellatrix {
+			data = v.Bellatrix
+		}
+		if data == nil {
+			return nil, errors.New("no update data")
+		}
+
+		return &update{
+			attestedHeader:          altairHeader(data.AttestedHeader),
+			nextSyncCommittee:       data.NextSyncCommittee,
+			nextSyncCommitteeBranch: data.NextSyncCommitteeBranch,
+			syncCommitteeDepth:      syncCommitteeDepth,
+			finalizedHeader:         altairHeader(data.FinalizedHeader),
+			finalityBranch:          data.FinalityBranch,
+			finalizedRootDepth:      finalizedRootDepth,
+			syncAggregate:           data.SyncAggregate,
+			signatureSlot:           data.SignatureSlot,
+		}, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no update data")
+		}
+
+		return &update{
+			attestedHeader:          capellaHeader(v.Capella.AttestedHeader),
+			nextSyncCommittee:       v.Capella.NextSyncCommittee,
+			nextSyncCommitteeBranch: v.Capella.NextSyncCommitteeBranch,
+			syncCommitteeDepth:      syncCommitteeDepth,
+			finalizedHeader:         capellaHeader(v.Capella.FinalizedHeader),
+			finalityBranch:          v.Capella.FinalityBranch,
+			finalizedRootDepth:      finalizedRootDepth,
+			syncAggregate:           v.Capella.SyncAggregate,
+			signatureSlot:           v.Capella.SignatureSlot,
+		}, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no update data")
+		}
+
+		return &update{
+			attestedHeader:          denebHeader(v.Deneb.AttestedHeader),
+			nextSyncCommittee:       v.Deneb.NextSyncCommittee,
+			nextSyncCommitteeBranch: v.Deneb.NextSyncCommitteeBranch,
+			syncCommitteeDepth:      syncCommitteeDepth,
+			finalizedHeader:         denebHeader(v.Deneb.FinalizedHeader),
+			finalityBranch:          v.Deneb.FinalityBranch,
+			finalizedRootDepth:      finalizedRootDepth,
+			syncAggregate:           v.Deneb.SyncAggregate,
+			signatureSlot:           v.Deneb.SignatureSlot,
+		}, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no update data")
+		}
+
+		return &update{
+			attestedHeader:          denebHeader(v.Electra.AttestedHeader),
+			nextSyncCommittee:       v.Electra.NextSyncCommittee,
+			nextSyncCommitteeBranch: v.Electra.NextSyncCommitteeBranch,
+			syncCommitteeDepth:      electraSyncCommitteeDepth,
+			finalizedHeader:         denebHeader(v.Electra.FinalizedHeader),
+			finalityBranch:          v.Electra.FinalityBranch,
+			finalizedRootDepth:      electraFinalizedRootDepth,
+			syncAggregate:           v.Electra.SyncAggregate,
+			signatureSlot:           v.Electra.SignatureSlot,
+		}, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
+
+// finalityUpdateFromVersioned obtains an update from a versioned finality update.
+func finalityUpdateFromVersioned(v *api.VersionedLightClientFinalityUpdate) (*update, error) {
+	if v == nil {
+		return nil, errors.New("no finality update supplied")
+	}
+
+	switch v.Version {
+	case spec.DataVersionAltair, spec.DataVersionBellatrix:
+		data := v.Altair
+		if v.Version == spec.DataVersionBellatrix {
+			data = v.Bellatrix
+		}
+		if data == nil {
+			return nil, errors.New("no finality update data")
+		}
+
+		return &update{
+			attestedHeader:     altairHeader(data.AttestedHeader),
+			syncCommitteeDepth: syncCommitteeDepth,
+			finalizedHeader:    altairHeader(data.FinalizedHeader),
+			finalityBranch:     data.FinalityBranch,
+			finalizedRootDepth: finalizedRootDepth,
+			syncAggregate:      data.SyncAggregate,
+			signatureSlot:      data.SignatureSlot,
+		}, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no finality update data")
+		}
+
+		return &update{
+			attestedHeader:     capellaHeader(v.Capella.AttestedHeader),
+			syncCommitteeDepth: syncCommitteeDepth,
+			finalizedHeader:    capellaHeader(v.Capella.FinalizedHeader),
+			finalityBranch:     v.Capella.FinalityBranch,
+			finalizedRootDepth: finalizedRootDepth,
+			syncAggregate:      v.Capella.SyncAggregate,
+			signatureSlot:      v.Capella.SignatureSlot,
+		}, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no finality update data")
+		}
+
+		return &update{
+			attestedHeader:     denebHeader(v.Deneb.AttestedHeader),
+			syncCommitteeDepth: syncCommitteeDepth,
+			finalizedHeader:    denebHeader(v.Deneb.FinalizedHeader),
+			finalityBranch:     v.Deneb.FinalityBranch,
+			finalizedRootDepth: finalizedRootDepth,
+			syncAggregate:      v.Deneb.SyncAggregate,
+			signatureSlot:      v.Deneb.SignatureSlot,
+		}, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no finality update data")
+		}
+
+		return &update{
+			attestedHeader:     denebHeader(v.Electra.AttestedHeader),
+			syncCommitteeDepth: electraSyncCommitteeDepth,
+			finalizedHeader:    denebHeader(v.Electra.FinalizedHeader),
+			finalityBranch:     v.Electra.FinalityBranch,
+			finalizedRootDepth: electraFinalizedRootDepth,
+			syncAggregate:      v.Electra.SyncAggregate,
+			signatureSlot:      v.Electra.SignatureSlot,
+		}, nil
+	default:
+		return nil, errors.New("unsupported version")
+	}
+}
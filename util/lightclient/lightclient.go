@@ -0,0 +1,34 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lightclient provides verification of light client updates against a
+// trusted bootstrap, allowing a light client to follow the chain using the
+// light client data served by a beacon node.  Signatures are verified with a
+// caller-supplied crypto.BLSVerifier.
+package lightclient
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Config contains the chain parameters required to verify light client updates.
+type Config struct {
+	// GenesisValidatorsRoot is the genesis validators root of the chain.
+	GenesisValidatorsRoot phase0.Root
+	// ForkSchedule is the fork schedule of the chain, as returned by a ForkScheduleProvider.
+	ForkSchedule []*phase0.Fork
+	// SlotsPerEpoch is the number of slots in an epoch.
+	SlotsPerEpoch uint64
+	// EpochsPerSyncCommitteePeriod is the number of epochs in a sync committee period.
+	EpochsPerSyncCommitteePeriod uint64
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"crypto/sha256"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Positions of items in their merkle trees, as a depth and index at that depth.
+// The depths of the state items change with electra, as the state gains fields.
+const (
+	// executionPayloadDepth is the depth of the execution payload in the beacon block body.
+	executionPayloadDepth = 4
+	// executionPayloadIndex is the index of the execution payload in the beacon block body.
+	executionPayloadIndex = 9
+	// currentSyncCommitteeIndex is the index of the current sync committee in the beacon state.
+	currentSyncCommitteeIndex = 22
+	// nextSyncCommitteeIndex is the index of the next sync committee in the beacon state.
+	nextSyncCommitteeIndex = 23
+	// finalizedRootIndex is the index of the finalized checkpoint root in the beacon state.
+	finalizedRootIndex = 41
+	// syncCommitteeDepth is the depth of the sync committees in the beacon state prior to electra.
+	syncCommitteeDepth = 5
+	// finalizedRootDepth is the depth of the finalized checkpoint root in the beacon state prior to electra.
+	finalizedRootDepth = 6
+	// electraSyncCommitteeDepth is the depth of the sync committees in the electra beacon state.
+	electraSyncCommitteeDepth = 6
+	// electraFinalizedRootDepth is the depth of the finalized checkpoint root in the electra beacon state.
+	electraFinalizedRootDepth = 7
+)
+
+// IsValidMerkleBranch returns true if the branch proves that the leaf is present at the
+// given depth and index of the tree with the given root.
+func IsValidMerkleBranch(leaf phase0.Root,
+	branch []phase0.Root,
+	depth uint64,
+	index uint64,
+	root phase0.Root,
+) bool {
+	if uint64(len(branch)) != depth {
+		return false
+	}
+
+	value := leaf
+	input := make([]byte, 64)
+	for i := uint64(0); i < depth; i++ {
+		if (index>>i)&1 == 1 {
+			copy(input, branch[i][:])
+			copy(input[32:], value[:])
+		} else {
+			copy(input, value[:])
+			copy(input[32:], branch[i][:])
+		}
+		value = sha256.Sum256(input)
+	}
+
+	return value == root
+}
+
+// isZeroBranch returns true if the branch is absent or contains only zero roots,
+// which is how an update signals that it does not carry the proven item.
+func isZeroBranch(branch []phase0.Root) bool {
+	for i := range branch {
+		if branch[i] != (phase0.Root{}) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,449 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/crypto"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// minSyncCommitteeParticipants is the minimum number of sync committee participants for an update to be considered.
+const minSyncCommitteeParticipants = 1
+
+// Verifier verifies light client updates against the state built from a trusted
+// bootstrap, and applies verified updates to follow the chain.
+//
+// Updates that are not signed by a supermajority of the sync committee advance only
+// the optimistic header.  The verifier does not implement the force update process
+// of the specification, so a light client that sees no finality for a sync committee
+// period must be bootstrapped again.
+// It is safe for concurrent use.
+type Verifier struct {
+	verifier              crypto.BLSVerifier
+	genesisValidatorsRoot phase0.Root
+	forkSchedule          []*phase0.Fork
+	slotsPerEpoch         uint64
+	epochsPerPeriod       uint64
+
+	mu                   sync.RWMutex
+	finalizedHeader      *phase0.BeaconBlockHeader
+	optimisticHeader     *phase0.BeaconBlockHeader
+	currentSyncCommittee *altair.SyncCommittee
+	nextSyncCommittee    *altair.SyncCommittee
+}
+
+// NewVerifier creates a verifier from a bootstrap for the trusted block root.
+func NewVerifier(verifier crypto.BLSVerifier,
+	config *Config,
+	trustedBlockRoot phase0.Root,
+	versionedBootstrap *api.VersionedLightClientBootstrap,
+) (
+	*Verifier,
+	error,
+) {
+	if verifier == nil {
+		return nil, errors.New("no verifier supplied")
+	}
+	if config == nil {
+		return nil, errors.New("no config supplied")
+	}
+	if config.SlotsPerEpoch == 0 {
+		return nil, errors.New("no slots per epoch supplied")
+	}
+	if config.EpochsPerSyncCommitteePeriod == 0 {
+		return nil, errors.New("no epochs per sync committee period supplied")
+	}
+	if len(config.ForkSchedule) == 0 {
+		return nil, errors.New("no fork schedule supplied")
+	}
+
+	bootstrap, err := bootstrapFromVersioned(versionedBootstrap)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyHeader(bootstrap.header); err != nil {
+		return nil, errors.Wrap(err, "invalid bootstrap header")
+	}
+	headerRoot, err := bootstrap.header.beacon.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate bootstrap header root")
+	}
+	if headerRoot != trustedBlockRoot {
+		return nil, errors.New("bootstrap header does not match trusted block root")
+	}
+	if bootstrap.currentSyncCommittee == nil {
+		return nil, errors.New("current sync committee missing")
+	}
+	committeeRoot, err := bootstrap.currentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate current sync committee root")
+	}
+	if !IsValidMerkleBranch(committeeRoot,
+		bootstrap.currentSyncCommitteeBranch,
+		bootstrap.syncCommitteeDepth,
+		currentSyncCommitteeIndex,
+		bootstrap.header.beacon.StateRoot,
+	) {
+		return nil, errors.New("invalid current sync committee branch")
+	}
+
+	forkSchedule := make([]*phase0.Fork, 0, len(config.ForkSchedule))
+	for _, fork := range config.ForkSchedule {
+		if fork == nil {
+			return nil, errors.New("nil fork in fork schedule")
+		}
+		forkSchedule = append(forkSchedule, fork)
+	}
+	sort.Slice(forkSchedule, func(i, j int) bool {
+		return forkSchedule[i].Epoch < forkSchedule[j].Epoch
+	})
+
+	return &Verifier{
+		verifier:              verifier,
+		genesisValidatorsRoot: config.GenesisValidatorsRoot,
+		forkSchedule:          forkSchedule,
+		slotsPerEpoch:         config.SlotsPerEpoch,
+		epochsPerPeriod:       config.EpochsPerSyncCommitteePeriod,
+		finalizedHeader:       bootstrap.header.beacon,
+		optimisticHeader:      bootstrap.header.beacon,
+		currentSyncCommittee:  bootstrap.currentSyncCommittee,
+	}, nil
+}
+
+// FinalizedHeader returns the latest finalized header known to the verifier.
+func (v *Verifier) FinalizedHeader() *phase0.BeaconBlockHeader {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.finalizedHeader
+}
+
+// OptimisticHeader returns the latest header attested to by the sync committee.
+func (v *Verifier) OptimisticHeader() *phase0.BeaconBlockHeader {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.optimisticHeader
+}
+
+// VerifyUpdate verifies a light client update without applying it.
+func (v *Verifier) VerifyUpdate(versionedUpdate *api.VersionedLightClientUpdate) error {
+	update, err := updateFromVersioned(versionedUpdate)
+	if err != nil {
+		return err
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.verify(update)
+}
+
+// ProcessUpdate verifies a light client update and, if it is valid, applies it.
+func (v *Verifier) ProcessUpdate(versionedUpdate *api.VersionedLightClientUpdate) error {
+	update, err := updateFromVersioned(versionedUpdate)
+	if err != nil {
+		return err
+	}
+
+	return v.process(update)
+}
+
+// ProcessFinalityUpdate verifies a light client finality update and, if it is valid, applies it.
+func (v *Verifier) ProcessFinalityUpdate(versionedUpdate *api.VersionedLightClientFinalityUpdate) error {
+	update, err := finalityUpdateFromVersioned(versionedUpdate)
+	if err != nil {
+		return err
+	}
+
+	return v.process(update)
+}
+
+func (v *Verifier) process(update *update) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if err := v.verify(update); err != nil {
+		return err
+	}
+	v.apply(update)
+
+	return nil
+}
+
+// verify verifies an update against the current state.
+// The caller must hold at least a read lock.
+func (v *Verifier) verify(update *update) error {
+	if err := verifyHeader(update.attestedHeader); err != nil {
+		return errors.Wrap(err, "invalid attested header")
+	}
+	if update.syncAggregate == nil {
+		return errors.New("sync aggregate missing")
+	}
+	if update.syncAggregate.SyncCommitteeBits.Count() < minSyncCommitteeParticipants {
+		return errors.New("insufficient sync committee participants")
+	}
+
+	finality := isFinalityUpdate(update)
+	if finality && (update.finalizedHeader == nil || update.finalizedHeader.beacon == nil) {
+		return errors.New("finalized header missing")
+	}
+	attestedSlot := update.attestedHeader.beacon.Slot
+	finalizedSlot := phase0.Slot(0)
+	if finality {
+		finalizedSlot = update.finalizedHeader.beacon.Slot
+	}
+	if update.signatureSlot <= attestedSlot || attestedSlot < finalizedSlot {
+		return errors.New("inconsistent update slots")
+	}
+
+	storePeriod := v.syncCommitteePeriod(v.finalizedHeader.Slot)
+	signaturePeriod := v.syncCommitteePeriod(update.signatureSlot)
+	if signaturePeriod != storePeriod && (v.nextSyncCommittee == nil || signaturePeriod != storePeriod+1) {
+		return errors.New("signature slot outside of known sync committee periods")
+	}
+
+	attestedPeriod := v.syncCommitteePeriod(attestedSlot)
+	syncCommitteeUpdate := isSyncCommitteeUpdate(update)
+	newNextSyncCommittee := v.nextSyncCommittee == nil && syncCommitteeUpdate && attestedPeriod == storePeriod
+	if attestedSlot <= v.finalizedHeader.Slot && !newNextSyncCommittee {
+		return errors.New("update is not relevant")
+	}
+
+	if finality {
+		if err := verifyFinality(update); err != nil {
+			return err
+		}
+	}
+
+	if syncCommitteeUpdate {
+		if err := v.verifyNextSyncCommittee(update, attestedPeriod == storePeriod); err != nil {
+			return err
+		}
+	}
+
+	syncCommittee := v.currentSyncCommittee
+	if signaturePeriod != storePeriod {
+		syncCommittee = v.nextSyncCommittee
+	}
+
+	return v.verifySignature(update, syncCommittee)
+}
+
+// verifyFinality verifies the finalized header of an update.
+func verifyFinality(update *update) error {
+	// The finalized checkpoint root is zero at genesis.
+	var leaf phase0.Root
+	if update.finalizedHeader.beacon.Slot != 0 {
+		if err := verifyHeader(update.finalizedHeader); err != nil {
+			return errors.Wrap(err, "invalid finalized header")
+		}
+		root, err := update.finalizedHeader.beacon.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate finalized header root")
+		}
+		leaf = root
+	}
+	if !IsValidMerkleBranch(leaf,
+		update.finalityBranch,
+		update.finalizedRootDepth,
+		finalizedRootIndex,
+		update.attestedHeader.beacon.StateRoot,
+	) {
+		return errors.New("invalid finality branch")
+	}
+
+	return nil
+}
+
+// verifyNextSyncCommittee verifies the next sync committee of an update.
+// The caller must hold at least a read lock.
+func (v *Verifier) verifyNextSyncCommittee(update *update, inStorePeriod bool) error {
+	if update.nextSyncCommittee == nil {
+		return errors.New("next sync committee missing")
+	}
+	committeeRoot, err := update.nextSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate next sync committee root")
+	}
+	if inStorePeriod && v.nextSyncCommittee != nil {
+		knownRoot, err := v.nextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate known next sync committee root")
+		}
+		if committeeRoot != knownRoot {
+			return errors.New("next sync committee does not match known next sync committee")
+		}
+	}
+	if !IsValidMerkleBranch(committeeRoot,
+		update.nextSyncCommitteeBranch,
+		update.syncCommitteeDepth,
+		nextSyncCommitteeIndex,
+		update.attestedHeader.beacon.StateRoot,
+	) {
+		return errors.New("invalid next sync committee branch")
+	}
+
+	return nil
+}
+
+// verifySignature verifies the sync committee signature of an update.
+// The caller must hold at least a read lock.
+func (v *Verifier) verifySignature(update *update, syncCommittee *altair.SyncCommittee) error {
+	pubKeys := make([]phase0.BLSPubKey, 0, update.syncAggregate.SyncCommitteeBits.Count())
+	bits := update.syncAggregate.SyncCommitteeBits
+	for i := range syncCommittee.Pubkeys {
+		// The bits are read directly rather than with BitAt, which requires the
+		// mainnet sync committee size.
+		if i/8 < len(bits) && bits[i/8]&(1<<(i%8)) != 0 {
+			pubKeys = append(pubKeys, syncCommittee.Pubkeys[i])
+		}
+	}
+	if len(pubKeys) < minSyncCommitteeParticipants {
+		return errors.New("insufficient sync committee participants")
+	}
+
+	// The signature is made in the slot prior to the signature slot.
+	previousSlot := update.signatureSlot
+	if previousSlot > 0 {
+		previousSlot--
+	}
+	forkVersion, err := v.forkVersion(phase0.Epoch(uint64(previousSlot) / v.slotsPerEpoch))
+	if err != nil {
+		return err
+	}
+	domain, err := signing.ComputeDomain(altair.DomainSyncCommittee, forkVersion, v.genesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	root, err := signing.ComputeSigningRoot(update.attestedHeader.beacon, domain)
+	if err != nil {
+		return err
+	}
+	verified, err := v.verifier.FastAggregateVerify(pubKeys, root[:], update.syncAggregate.SyncCommitteeSignature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify sync committee signature")
+	}
+	if !verified {
+		return errors.New("sync committee signature invalid")
+	}
+
+	return nil
+}
+
+// apply applies a verified update.
+// The caller must hold the write lock.
+func (v *Verifier) apply(update *update) {
+	if update.attestedHeader.beacon.Slot > v.optimisticHeader.Slot {
+		v.optimisticHeader = update.attestedHeader.beacon
+	}
+
+	participants := update.syncAggregate.SyncCommitteeBits.Count()
+	if participants*3 < uint64(len(v.currentSyncCommittee.Pubkeys))*2 {
+		// Without a supermajority the update cannot advance finality.
+		return
+	}
+	if !isFinalityUpdate(update) {
+		return
+	}
+
+	finalizedSlot := update.finalizedHeader.beacon.Slot
+	finalizedPeriod := v.syncCommitteePeriod(finalizedSlot)
+	storePeriod := v.syncCommitteePeriod(v.finalizedHeader.Slot)
+	finalizedNextSyncCommittee := v.nextSyncCommittee == nil &&
+		isSyncCommitteeUpdate(update) &&
+		finalizedPeriod == v.syncCommitteePeriod(update.attestedHeader.beacon.Slot)
+	if finalizedSlot <= v.finalizedHeader.Slot && !finalizedNextSyncCommittee {
+		return
+	}
+
+	switch {
+	case v.nextSyncCommittee == nil:
+		if finalizedPeriod != storePeriod {
+			// Cannot move to a new period without knowing its sync committee.
+			return
+		}
+		v.nextSyncCommittee = update.nextSyncCommittee
+	case finalizedPeriod == storePeriod+1:
+		v.currentSyncCommittee = v.nextSyncCommittee
+		v.nextSyncCommittee = update.nextSyncCommittee
+	}
+
+	if finalizedSlot > v.finalizedHeader.Slot {
+		v.finalizedHeader = update.finalizedHeader.beacon
+		if finalizedSlot > v.optimisticHeader.Slot {
+			v.optimisticHeader = update.finalizedHeader.beacon
+		}
+	}
+}
+
+// syncCommitteePeriod returns the sync committee period of a slot.
+func (v *Verifier) syncCommitteePeriod(slot phase0.Slot) uint64 {
+	return uint64(slot) / v.slotsPerEpoch / v.epochsPerPeriod
+}
+
+// forkVersion returns the fork version at the given epoch.
+func (v *Verifier) forkVersion(epoch phase0.Epoch) (phase0.Version, error) {
+	for i := len(v.forkSchedule) - 1; i >= 0; i-- {
+		if v.forkSchedule[i].Epoch <= epoch {
+			return v.forkSchedule[i].CurrentVersion, nil
+		}
+	}
+
+	return phase0.Version{}, errors.New("no fork version for epoch")
+}
+
+// verifyHeader verifies the execution payload header of a light client header, if present.
+func verifyHeader(header *header) error {
+	if header == nil || header.beacon == nil {
+		return errors.New("header missing")
+	}
+	if !header.hasExecution {
+		return nil
+	}
+	if header.execution == nil {
+		return errors.New("execution payload header missing")
+	}
+	root, err := header.execution.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate execution payload header root")
+	}
+	if !IsValidMerkleBranch(root,
+		header.executionBranch,
+		executionPayloadDepth,
+		executionPayloadIndex,
+		header.beacon.BodyRoot,
+	) {
+		return errors.New("invalid execution branch")
+	}
+
+	return nil
+}
+
+// isFinalityUpdate returns true if the update proves a finalized header.
+func isFinalityUpdate(update *update) bool {
+	return !isZeroBranch(update.finalityBranch)
+}
+
+// isSyncCommitteeUpdate returns true if the update proves a next sync committee.
+func isSyncCommitteeUpdate(update *update) bool {
+	return !isZeroBranch(update.nextSyncCommitteeBranch)
+}
@@ -0,0 +1,401 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightclient_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1altair "github.com/attestantio/go-eth2-client/api/v1/altair"
+	"github.com/attestantio/go-eth2-client/crypto"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/lightclient"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// Generalized indices of items in the altair beacon state.
+const (
+	currentSyncCommitteeGIndex = 54
+	nextSyncCommitteeGIndex    = 55
+	finalizedRootGIndex        = 105
+)
+
+var testConfig = &lightclient.Config{
+	GenesisValidatorsRoot: phase0.Root{0x01},
+	ForkSchedule: []*phase0.Fork{
+		{
+			PreviousVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			CurrentVersion:  phase0.Version{0x01, 0x00, 0x00, 0x00},
+			Epoch:           0,
+		},
+	},
+	SlotsPerEpoch:                32,
+	EpochsPerSyncCommitteePeriod: 256,
+}
+
+// testBLSVerifier treats a signature as valid if it starts with the message, and
+// an aggregate signature as valid only if it was made by the entire sync committee.
+type testBLSVerifier struct{}
+
+func (*testBLSVerifier) VerifySignature(_ phase0.BLSPubKey,
+	message []byte,
+	signature phase0.BLSSignature,
+) (
+	bool,
+	error,
+) {
+	return bytes.Equal(signature[:32], message), nil
+}
+
+func (*testBLSVerifier) FastAggregateVerify(pubKeys []phase0.BLSPubKey,
+	message []byte,
+	signature phase0.BLSSignature,
+) (
+	bool,
+	error,
+) {
+	return len(pubKeys) == syncCommitteeSize && bytes.Equal(signature[:32], message), nil
+}
+
+var testVerifier = &testBLSVerifier{}
+
+func syncCommittee(seed byte) *altair.SyncCommittee {
+	committee := &altair.SyncCommittee{
+		Pubkeys: make([]phase0.BLSPubKey, syncCommitteeSize),
+	}
+	for i := range committee.Pubkeys {
+		committee.Pubkeys[i][0] = seed
+		committee.Pubkeys[i][1] = byte(i)
+		committee.Pubkeys[i][2] = byte(i >> 8)
+	}
+
+	return committee
+}
+
+func beaconState(slot phase0.Slot,
+	finalizedRoot phase0.Root,
+	current *altair.SyncCommittee,
+	next *altair.SyncCommittee,
+) *altair.BeaconState {
+	return &altair.BeaconState{
+		Slot:                        slot,
+		Fork:                        testConfig.ForkSchedule[0],
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, slotsPerHistoricalRoot),
+		StateRoots:                  make([]phase0.Root, slotsPerHistoricalRoot),
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		RANDAOMixes:                 make([]phase0.Root, epochsPerHistoricalVector),
+		Slashings:                   make([]phase0.Gwei, epochsPerSlashingsVector),
+		JustificationBits:           bitfield.Bitvector4{0x00},
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{Root: finalizedRoot},
+		CurrentSyncCommittee:        current,
+		NextSyncCommittee:           next,
+	}
+}
+
+func stateRoot(t *testing.T, state *altair.BeaconState) phase0.Root {
+	t.Helper()
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	return root
+}
+
+func branch(t *testing.T, state *altair.BeaconState, gIndex int) []phase0.Root {
+	t.Helper()
+	tree, err := state.GetTree()
+	require.NoError(t, err)
+	proof, err := tree.Prove(gIndex)
+	require.NoError(t, err)
+	res := make([]phase0.Root, len(proof.Hashes))
+	for i := range proof.Hashes {
+		copy(res[i][:], proof.Hashes[i])
+	}
+
+	return res
+}
+
+func headerRoot(t *testing.T, header *phase0.BeaconBlockHeader) phase0.Root {
+	t.Helper()
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+
+	return root
+}
+
+// sign creates a signature over the header for the test verifier.
+func sign(t *testing.T, header *phase0.BeaconBlockHeader) phase0.BLSSignature {
+	t.Helper()
+	domain, err := signing.ComputeDomain(altair.DomainSyncCommittee,
+		testConfig.ForkSchedule[0].CurrentVersion,
+		testConfig.GenesisValidatorsRoot,
+	)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(header, domain)
+	require.NoError(t, err)
+	var signature phase0.BLSSignature
+	copy(signature[:], root[:])
+
+	return signature
+}
+
+func allBits() bitfield.Bitvector512 {
+	bits := make(bitfield.Bitvector512, syncCommitteeSize/8)
+	for i := range bits {
+		bits[i] = 0xff
+	}
+
+	return bits
+}
+
+type fixture struct {
+	trustedRoot phase0.Root
+	bootstrap   *api.VersionedLightClientBootstrap
+	update      *apiv1altair.LightClientUpdate
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	current := syncCommittee(0x01)
+	next := syncCommittee(0x02)
+
+	bootstrapState := beaconState(100, phase0.Root{}, current, next)
+	bootstrapHeader := &phase0.BeaconBlockHeader{
+		Slot:      100,
+		StateRoot: stateRoot(t, bootstrapState),
+		BodyRoot:  phase0.Root{0x10},
+	}
+
+	finalizedHeader := &phase0.BeaconBlockHeader{
+		Slot:      200,
+		StateRoot: phase0.Root{0x20},
+		BodyRoot:  phase0.Root{0x21},
+	}
+	attestedState := beaconState(300, headerRoot(t, finalizedHeader), current, next)
+	attestedHeader := &phase0.BeaconBlockHeader{
+		Slot:      300,
+		StateRoot: stateRoot(t, attestedState),
+		BodyRoot:  phase0.Root{0x30},
+	}
+
+	return &fixture{
+		trustedRoot: headerRoot(t, bootstrapHeader),
+		bootstrap: &api.VersionedLightClientBootstrap{
+			Version: spec.DataVersionAltair,
+			Altair: &apiv1altair.LightClientBootstrap{
+				Header:                     &apiv1altair.LightClientHeader{Beacon: bootstrapHeader},
+				CurrentSyncCommittee:       current,
+				CurrentSyncCommitteeBranch: branch(t, bootstrapState, currentSyncCommitteeGIndex),
+			},
+		},
+		update: &apiv1altair.LightClientUpdate{
+			AttestedHeader:          &apiv1altair.LightClientHeader{Beacon: attestedHeader},
+			NextSyncCommittee:       next,
+			NextSyncCommitteeBranch: branch(t, attestedState, nextSyncCommitteeGIndex),
+			FinalizedHeader:         &apiv1altair.LightClientHeader{Beacon: finalizedHeader},
+			FinalityBranch:          branch(t, attestedState, finalizedRootGIndex),
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits:      allBits(),
+				SyncCommitteeSignature: sign(t, attestedHeader),
+			},
+			SignatureSlot: 301,
+		},
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	f := newFixture(t)
+
+	tests := []struct {
+		name        string
+		verifier    crypto.BLSVerifier
+		config      *lightclient.Config
+		trustedRoot phase0.Root
+		bootstrap   *api.VersionedLightClientBootstrap
+		err         string
+	}{
+		{
+			name:        "VerifierMissing",
+			config:      testConfig,
+			trustedRoot: f.trustedRoot,
+			bootstrap:   f.bootstrap,
+			err:         "no verifier supplied",
+		},
+		{
+			name:        "ConfigMissing",
+			verifier:    testVerifier,
+			trustedRoot: f.trustedRoot,
+			bootstrap:   f.bootstrap,
+			err:         "no config supplied",
+		},
+		{
+			name:        "BootstrapMissing",
+			verifier:    testVerifier,
+			config:      testConfig,
+			trustedRoot: f.trustedRoot,
+			err:         "no bootstrap supplied",
+		},
+		{
+			name:        "TrustedRootIncorrect",
+			verifier:    testVerifier,
+			config:      testConfig,
+			trustedRoot: phase0.Root{0x01},
+			bootstrap:   f.bootstrap,
+			err:         "bootstrap header does not match trusted block root",
+		},
+		{
+			name:        "CurrentSyncCommitteeIncorrect",
+			verifier:    testVerifier,
+			config:      testConfig,
+			trustedRoot: f.trustedRoot,
+			bootstrap: &api.VersionedLightClientBootstrap{
+				Version: spec.DataVersionAltair,
+				Altair: &apiv1altair.LightClientBootstrap{
+					Header:                     f.bootstrap.Altair.Header,
+					CurrentSyncCommittee:       syncCommittee(0x03),
+					CurrentSyncCommitteeBranch: f.bootstrap.Altair.CurrentSyncCommitteeBranch,
+				},
+			},
+			err: "invalid current sync committee branch",
+		},
+		{
+			name:        "Good",
+			verifier:    testVerifier,
+			config:      testConfig,
+			trustedRoot: f.trustedRoot,
+			bootstrap:   f.bootstrap,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier, err := lightclient.NewVerifier(test.verifier, test.config, test.trustedRoot, test.bootstrap)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, phase0.Slot(100), verifier.FinalizedHeader().Slot)
+			}
+		})
+	}
+}
+
+func TestVerifyUpdate(t *testing.T) {
+	f := newFixture(t)
+
+	tests := []struct {
+		name   string
+		modify func(update *apiv1altair.LightClientUpdate)
+		err    string
+	}{
+		{
+			name:   "Good",
+			modify: func(_ *apiv1altair.LightClientUpdate) {},
+		},
+		{
+			name: "NoParticipants",
+			modify: func(update *apiv1altair.LightClientUpdate) {
+				update.SyncAggregate = &altair.SyncAggregate{
+					SyncCommitteeBits:      make(bitfield.Bitvector512, syncCommitteeSize/8),
+					SyncCommitteeSignature: update.SyncAggregate.SyncCommitteeSignature,
+				}
+			},
+			err: "insufficient sync committee participants",
+		},
+		{
+			name: "SignatureSlotTooEarly",
+			modify: func(update *apiv1altair.LightClientUpdate) {
+				update.SignatureSlot = 300
+			},
+			err: "inconsistent update slots",
+		},
+		{
+			name: "SignatureSlotTooLate",
+			modify: func(update *apiv1altair.LightClientUpdate) {
+				update.SignatureSlot = 20000
+			},
+			err: "signature slot outside of known sync committee periods",
+		},
+		{
+			name: "FinalityBranchIncorrect",
+			modify: func(update *apiv1altair.LightClientUpdate) {
+				update.FinalityBranch = append([]phase0.Root{{0x01}}, update.FinalityBranch[1:]...)
+			},
+			err: "invalid finality branch",
+		},
+		{
+			name: "NextSyncCommitteeIncorrect",
+			modify: func(update *apiv1altair.LightClientUpdate) {
+				update.NextSyncCommittee = syncCommittee(0x03)
+			},
+			err: "invalid next sync committee branch",
+		},
+		{
+			name: "SignatureIncorrect",
+			modify: func(update *apiv1altair.LightClientUpdate) {
+				update.SyncAggregate = &altair.SyncAggregate{
+					SyncCommitteeBits:      update.SyncAggregate.SyncCommitteeBits,
+					SyncCommitteeSignature: phase0.BLSSignature{0x01},
+				}
+			},
+			err: "sync committee signature invalid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier, err := lightclient.NewVerifier(testVerifier, testConfig, f.trustedRoot, f.bootstrap)
+			require.NoError(t, err)
+
+			update := *f.update
+			test.modify(&update)
+			err = verifier.VerifyUpdate(&api.VersionedLightClientUpdate{
+				Version: spec.DataVersionAltair,
+				Altair:  &update,
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProcessUpdate(t *testing.T) {
+	f := newFixture(t)
+
+	verifier, err := lightclient.NewVerifier(testVerifier, testConfig, f.trustedRoot, f.bootstrap)
+	require.NoError(t, err)
+
+	update := &api.VersionedLightClientUpdate{
+		Version: spec.DataVersionAltair,
+		Altair:  f.update,
+	}
+	require.NoError(t, verifier.ProcessUpdate(update))
+	require.Equal(t, phase0.Slot(200), verifier.FinalizedHeader().Slot)
+	require.Equal(t, phase0.Slot(300), verifier.OptimisticHeader().Slot)
+
+	// Processing the update again leaves the headers unchanged.
+	require.NoError(t, verifier.ProcessUpdate(update))
+	require.Equal(t, phase0.Slot(200), verifier.FinalizedHeader().Slot)
+	require.Equal(t, phase0.Slot(300), verifier.OptimisticHeader().Slot)
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling
+
+import (
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// cacheKey is the key for a shuffle in the cache.
+// A shuffle depends only on the seed and the number of indices being shuffled.
+type cacheKey struct {
+	seed  phase0.Root
+	count uint64
+}
+
+// Cache holds recently computed shuffles, allowing all of the committee
+// computations for an epoch to share a single shuffle.
+// It is safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[cacheKey][]uint64
+	// order holds the keys in order of insertion, for eviction.
+	order []cacheKey
+}
+
+// NewCache creates a cache that holds up to the given number of shuffles.
+// Each epoch requires one shuffle for its committees, so a small size suffices.
+func NewCache(size int) *Cache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Cache{
+		size:    size,
+		entries: make(map[cacheKey][]uint64, size),
+		order:   make([]cacheKey, 0, size),
+	}
+}
+
+// Shuffle returns the shuffled positions for the given seed and count,
+// computing and storing them if they are not already in the cache.
+// The returned slice is shared and must not be modified.
+func (c *Cache) Shuffle(seed phase0.Root, count uint64) []uint64 {
+	key := cacheKey{seed: seed, count: count}
+
+	c.mu.Lock()
+	positions, exists := c.entries[key]
+	c.mu.Unlock()
+	if exists {
+		return positions
+	}
+
+	// Compute outside of the lock; concurrent computations of the same
+	// shuffle give the same result, so the duplicate work is harmless.
+	positions = ComputeShuffle(seed, count)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, exists := c.entries[key]; exists {
+		return existing
+	}
+	if len(c.order) >= c.size {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.entries[key] = positions
+	c.order = append(c.order, key)
+
+	return positions
+}
+
+// Len returns the number of shuffles in the cache.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
@@ -0,0 +1,183 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ChainParameters are the chain parameters required to compute committees and proposers.
+type ChainParameters struct {
+	SlotsPerEpoch        uint64
+	TargetCommitteeSize  uint64
+	MaxCommitteesPerSlot uint64
+	MaxEffectiveBalance  phase0.Gwei
+	// MaxEffectiveBalanceElectra is the maximum effective balance from electra, used
+	// to select proposers for electra states.  It is zero for chains without electra.
+	MaxEffectiveBalanceElectra phase0.Gwei
+}
+
+// CommitteesPerSlot returns the number of committees in each slot for the given number of active validators.
+func CommitteesPerSlot(params *ChainParameters, activeValidators uint64) uint64 {
+	committees := activeValidators / params.SlotsPerEpoch / params.TargetCommitteeSize
+	if committees > params.MaxCommitteesPerSlot {
+		committees = params.MaxCommitteesPerSlot
+	}
+	if committees < 1 {
+		committees = 1
+	}
+
+	return committees
+}
+
+// BeaconCommittee returns the beacon committee for the given slot and committee index.
+// activeIndices are the indices of the validators active in the slot's epoch, and seed
+// is the epoch's attester seed.
+func (c *Cache) BeaconCommittee(params *ChainParameters,
+	activeIndices []phase0.ValidatorIndex,
+	seed phase0.Root,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	if params == nil {
+		return nil, errors.New("no chain parameters specified")
+	}
+	if params.SlotsPerEpoch == 0 || params.TargetCommitteeSize == 0 {
+		return nil, errors.New("invalid chain parameters")
+	}
+	committeesPerSlot := CommitteesPerSlot(params, uint64(len(activeIndices)))
+	if uint64(committeeIndex) >= committeesPerSlot {
+		return nil, errors.Errorf("committee index %d out of range; %d committees per slot", committeeIndex, committeesPerSlot)
+	}
+
+	count := uint64(len(activeIndices))
+	positions := c.Shuffle(seed, count)
+	index := (uint64(slot)%params.SlotsPerEpoch)*committeesPerSlot + uint64(committeeIndex)
+	committees := committeesPerSlot * params.SlotsPerEpoch
+	start := count * index / committees
+	end := count * (index + 1) / committees
+
+	committee := make([]phase0.ValidatorIndex, 0, end-start)
+	for i := start; i < end; i++ {
+		committee = append(committee, activeIndices[positions[i]])
+	}
+
+	return committee, nil
+}
+
+// ProposerSeed returns the seed used to select the proposer for the given slot,
+// given the epoch's proposer seed.
+func ProposerSeed(epochSeed phase0.Root, slot phase0.Slot) phase0.Root {
+	input := make([]byte, 40)
+	copy(input, epochSeed[:])
+	binary.LittleEndian.PutUint64(input[32:], uint64(slot))
+
+	return sha256.Sum256(input)
+}
+
+// ProposerIndex returns the proposer selected with the given seed, as obtained from ProposerSeed.
+// activeIndices are the indices of the validators active in the epoch, and effectiveBalances
+// their effective balances.
+//
+// This selects proposers for states prior to electra; see ProposerIndexElectra for later states.
+//
+// The proposer seed differs for each slot and only a handful of candidates are usually
+// considered, so candidates are shuffled individually rather than through the cache.
+func ProposerIndex(params *ChainParameters,
+	activeIndices []phase0.ValidatorIndex,
+	effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei,
+	seed phase0.Root,
+) (
+	phase0.ValidatorIndex,
+	error,
+) {
+	if params == nil {
+		return 0, errors.New("no chain parameters specified")
+	}
+
+	// Candidates are accepted against a random byte.
+	return proposerIndex(activeIndices, effectiveBalances, seed, params.MaxEffectiveBalance, 32, 0xff)
+}
+
+// ProposerIndexElectra returns the proposer selected with the given seed for electra and later
+// states, as obtained from ProposerSeed.  activeIndices are the indices of the validators active
+// in the epoch, and effectiveBalances their effective balances.
+func ProposerIndexElectra(params *ChainParameters,
+	activeIndices []phase0.ValidatorIndex,
+	effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei,
+	seed phase0.Root,
+) (
+	phase0.ValidatorIndex,
+	error,
+) {
+	if params == nil {
+		return 0, errors.New("no chain parameters specified")
+	}
+	if params.MaxEffectiveBalanceElectra == 0 {
+		return 0, errors.New("no electra maximum effective balance specified")
+	}
+
+	// Candidates are accepted against a random 16-bit value.
+	return proposerIndex(activeIndices, effectiveBalances, seed, params.MaxEffectiveBalanceElectra, 16, 0xffff)
+}
+
+// proposerIndex selects a proposer, accepting candidates with a probability proportional to
+// their effective balance.  Each hash of the seed provides randomValues random values, each
+// of which is a little-endian value of 32/randomValues bytes with a maximum of maxRandomValue.
+func proposerIndex(activeIndices []phase0.ValidatorIndex,
+	effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei,
+	seed phase0.Root,
+	maxEffectiveBalance phase0.Gwei,
+	randomValues uint64,
+	maxRandomValue uint64,
+) (
+	phase0.ValidatorIndex,
+	error,
+) {
+	if len(activeIndices) == 0 {
+		return 0, errors.New("no active validators")
+	}
+
+	count := uint64(len(activeIndices))
+	valueSize := 32 / randomValues
+	input := make([]byte, 40)
+	copy(input, seed[:])
+	var hash [32]byte
+	// Limit the search; a proposer is found well within this in practice, and it
+	// guards against a validator set without any effective balance.
+	for i := uint64(0); i < count*1024; i++ {
+		if i%randomValues == 0 {
+			binary.LittleEndian.PutUint64(input[32:], i/randomValues)
+			hash = sha256.Sum256(input)
+		}
+		candidate := activeIndices[ComputeShuffledIndex(i%count, count, seed)]
+		randomValue := uint64(0)
+		offset := (i % randomValues) * valueSize
+		for j := valueSize; j > 0; j-- {
+			randomValue = randomValue<<8 | uint64(hash[offset+j-1])
+		}
+		if uint64(effectiveBalances[candidate])*maxRandomValue >= uint64(maxEffectiveBalance)*randomValue {
+			return candidate, nil
+		}
+	}
+
+	return 0, errors.New("failed to select proposer")
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/stretchr/testify/require"
+)
+
+var testParams = &shuffling.ChainParameters{
+	SlotsPerEpoch:              4,
+	TargetCommitteeSize:        4,
+	MaxCommitteesPerSlot:       2,
+	MaxEffectiveBalance:        32000000000,
+	MaxEffectiveBalanceElectra: 2048000000000,
+}
+
+func activeIndices(count int) []phase0.ValidatorIndex {
+	res := make([]phase0.ValidatorIndex, count)
+	for i := range res {
+		// Use non-contiguous indices to ensure values and positions are not confused.
+		res[i] = phase0.ValidatorIndex(i * 3)
+	}
+
+	return res
+}
+
+func TestBeaconCommittee(t *testing.T) {
+	cache := shuffling.NewCache(4)
+	indices := activeIndices(100)
+	seed := phase0.Root{0x01}
+
+	committeesPerSlot := shuffling.CommitteesPerSlot(testParams, uint64(len(indices)))
+	require.Equal(t, uint64(2), committeesPerSlot)
+
+	seen := make(map[phase0.ValidatorIndex]bool)
+	for slot := phase0.Slot(8); slot < 12; slot++ {
+		for committeeIndex := phase0.CommitteeIndex(0); uint64(committeeIndex) < committeesPerSlot; committeeIndex++ {
+			committee, err := cache.BeaconCommittee(testParams, indices, seed, slot, committeeIndex)
+			require.NoError(t, err)
+			for _, index := range committee {
+				require.False(t, seen[index])
+				seen[index] = true
+			}
+		}
+	}
+	// Every active validator should be in exactly one committee, from a single shuffle.
+	require.Len(t, seen, len(indices))
+	require.Equal(t, 1, cache.Len())
+
+	_, err := cache.BeaconCommittee(testParams, indices, seed, 8, 2)
+	require.EqualError(t, err, "committee index 2 out of range; 2 committees per slot")
+	_, err = cache.BeaconCommittee(nil, indices, seed, 8, 0)
+	require.EqualError(t, err, "no chain parameters specified")
+}
+
+func TestProposerIndex(t *testing.T) {
+	indices := activeIndices(10)
+	seed := shuffling.ProposerSeed(phase0.Root{0x01}, 5)
+
+	balances := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	for _, index := range indices {
+		balances[index] = testParams.MaxEffectiveBalance
+	}
+	// With full balances the first candidate is always selected.
+	proposer, err := shuffling.ProposerIndex(testParams, indices, balances, seed)
+	require.NoError(t, err)
+	require.Equal(t, indices[shuffling.ComputeShuffle(seed, uint64(len(indices)))[0]], proposer)
+
+	// Without balance the validator should not be selected with this seed.
+	balances[proposer] = 0
+	other, err := shuffling.ProposerIndex(testParams, indices, balances, seed)
+	require.NoError(t, err)
+	require.NotEqual(t, proposer, other)
+
+	_, err = shuffling.ProposerIndex(testParams, nil, balances, seed)
+	require.EqualError(t, err, "no active validators")
+}
+
+func TestProposerIndexElectra(t *testing.T) {
+	indices := activeIndices(10)
+	balances := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	for _, index := range indices {
+		balances[index] = 32000000000
+	}
+
+	// Expected proposers are from the consensus spec's compute_proposer_index.
+	tests := []struct {
+		slot            phase0.Slot
+		proposer        phase0.ValidatorIndex
+		electraProposer phase0.ValidatorIndex
+	}{
+		{slot: 1, proposer: 15, electraProposer: 15},
+		{slot: 2, proposer: 21, electraProposer: 12},
+		{slot: 3, proposer: 15, electraProposer: 12},
+		{slot: 4, proposer: 0, electraProposer: 24},
+		{slot: 5, proposer: 3, electraProposer: 27},
+		{slot: 6, proposer: 12, electraProposer: 6},
+		{slot: 7, proposer: 18, electraProposer: 0},
+		{slot: 8, proposer: 3, electraProposer: 0},
+	}
+	for _, test := range tests {
+		seed := shuffling.ProposerSeed(phase0.Root{0x01}, test.slot)
+		proposer, err := shuffling.ProposerIndex(testParams, indices, balances, seed)
+		require.NoError(t, err)
+		require.Equal(t, test.proposer, proposer, fmt.Sprintf("slot %d", test.slot))
+		electraProposer, err := shuffling.ProposerIndexElectra(testParams, indices, balances, seed)
+		require.NoError(t, err)
+		require.Equal(t, test.electraProposer, electraProposer, fmt.Sprintf("slot %d", test.slot))
+	}
+
+	_, err := shuffling.ProposerIndexElectra(&shuffling.ChainParameters{MaxEffectiveBalance: 32000000000}, indices, balances, phase0.Root{})
+	require.EqualError(t, err, "no electra maximum effective balance specified")
+}
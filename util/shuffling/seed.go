@@ -0,0 +1,77 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+var (
+	// DomainBeaconProposer is the domain for the proposer seed.
+	DomainBeaconProposer = phase0.DomainBeaconProposer
+	// DomainBeaconAttester is the domain for the attester seed.
+	DomainBeaconAttester = phase0.DomainBeaconAttester
+)
+
+// minSeedLookahead is the number of epochs between a RANDAO mix being finalized and it being used as a seed.
+const minSeedLookahead = 1
+
+// Seed returns the seed for the given epoch and domain, from the RANDAO mixes of a state.
+// The state must be no earlier than the epoch before the requested epoch, and no later than
+// the length of the RANDAO mixes after it, for the relevant mix to be present.
+func Seed(randaoMixes []phase0.Root, epoch phase0.Epoch, domain phase0.DomainType) (phase0.Root, error) {
+	if len(randaoMixes) == 0 {
+		return phase0.Root{}, errors.New("no RANDAO mixes supplied")
+	}
+	historicalVector := uint64(len(randaoMixes))
+	mix := randaoMixes[(uint64(epoch)+historicalVector-minSeedLookahead-1)%historicalVector]
+
+	input := make([]byte, 0, 4+8+32)
+	input = append(input, domain[:]...)
+	input = binary.LittleEndian.AppendUint64(input, uint64(epoch))
+	input = append(input, mix[:]...)
+
+	return sha256.Sum256(input), nil
+}
+
+// ChainParametersFromSpec obtains the chain parameters from the spec, as returned by a SpecProvider.
+func ChainParametersFromSpec(spec map[string]interface{}) (*ChainParameters, error) {
+	params := &ChainParameters{}
+	for name, dst := range map[string]*uint64{
+		"SLOTS_PER_EPOCH":         &params.SlotsPerEpoch,
+		"TARGET_COMMITTEE_SIZE":   &params.TargetCommitteeSize,
+		"MAX_COMMITTEES_PER_SLOT": &params.MaxCommitteesPerSlot,
+	} {
+		val, exists := spec[name].(uint64)
+		if !exists {
+			return nil, errors.Errorf("%s not found in spec", name)
+		}
+		*dst = val
+	}
+	maxEffectiveBalance, exists := spec["MAX_EFFECTIVE_BALANCE"].(uint64)
+	if !exists {
+		return nil, errors.New("MAX_EFFECTIVE_BALANCE not found in spec")
+	}
+	params.MaxEffectiveBalance = phase0.Gwei(maxEffectiveBalance)
+	// Chains without electra do not have an electra maximum effective balance.
+	if maxEffectiveBalanceElectra, exists := spec["MAX_EFFECTIVE_BALANCE_ELECTRA"].(uint64); exists {
+		params.MaxEffectiveBalanceElectra = phase0.Gwei(maxEffectiveBalanceElectra)
+	}
+
+	return params, nil
+}
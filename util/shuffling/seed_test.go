@@ -0,0 +1,70 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeed(t *testing.T) {
+	mixes := make([]phase0.Root, 8)
+	for i := range mixes {
+		mixes[i] = phase0.Root{byte(i + 1)}
+	}
+
+	_, err := shuffling.Seed(nil, 10, shuffling.DomainBeaconAttester)
+	require.EqualError(t, err, "no RANDAO mixes supplied")
+
+	// Epoch 10 uses the mix from epoch 8, which is at index 0.
+	seed, err := shuffling.Seed(mixes, 10, shuffling.DomainBeaconAttester)
+	require.NoError(t, err)
+	input := append([]byte{0x01, 0x00, 0x00, 0x00, 0x0a, 0, 0, 0, 0, 0, 0, 0}, mixes[0][:]...)
+	require.Equal(t, phase0.Root(sha256.Sum256(input)), seed)
+
+	// Proposer seed differs only in its domain.
+	proposerSeed, err := shuffling.Seed(mixes, 10, shuffling.DomainBeaconProposer)
+	require.NoError(t, err)
+	require.NotEqual(t, seed, proposerSeed)
+}
+
+func TestChainParametersFromSpec(t *testing.T) {
+	spec := map[string]interface{}{
+		"SLOTS_PER_EPOCH":         uint64(32),
+		"TARGET_COMMITTEE_SIZE":   uint64(128),
+		"MAX_COMMITTEES_PER_SLOT": uint64(64),
+		"MAX_EFFECTIVE_BALANCE":   uint64(32000000000),
+	}
+	params, err := shuffling.ChainParametersFromSpec(spec)
+	require.NoError(t, err)
+	require.Equal(t, &shuffling.ChainParameters{
+		SlotsPerEpoch:        32,
+		TargetCommitteeSize:  128,
+		MaxCommitteesPerSlot: 64,
+		MaxEffectiveBalance:  32000000000,
+	}, params)
+
+	spec["MAX_EFFECTIVE_BALANCE_ELECTRA"] = uint64(2048000000000)
+	params, err = shuffling.ChainParametersFromSpec(spec)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Gwei(2048000000000), params.MaxEffectiveBalanceElectra)
+
+	delete(spec, "MAX_EFFECTIVE_BALANCE")
+	_, err = shuffling.ChainParametersFromSpec(spec)
+	require.EqualError(t, err, "MAX_EFFECTIVE_BALANCE not found in spec")
+}
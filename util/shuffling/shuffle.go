@@ -0,0 +1,95 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// shuffleRoundCount is the number of rounds in the swap-or-not shuffle.
+const shuffleRoundCount = 90
+
+// ComputeShuffle returns the shuffled positions for a list of the given size.
+// The returned slice is such that element i holds the result of the spec's
+// compute_shuffled_index(i, count, seed), so the shuffled list is built as
+// shuffled[i] = indices[positions[i]].
+//
+// All indices are shuffled together, so this costs O(count) hashes for the
+// whole list rather than O(count) hashes per index.
+func ComputeShuffle(seed phase0.Root, count uint64) []uint64 {
+	positions := make([]uint64, count)
+	for i := range positions {
+		positions[i] = uint64(i)
+	}
+	if count <= 1 {
+		return positions
+	}
+
+	input := make([]byte, 32+1+4)
+	copy(input, seed[:])
+	sources := make([][32]byte, (count+255)/256)
+	for round := 0; round < shuffleRoundCount; round++ {
+		input[32] = byte(round)
+		pivotHash := sha256.Sum256(input[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		for i := range sources {
+			binary.LittleEndian.PutUint32(input[33:], uint32(i))
+			sources[i] = sha256.Sum256(input)
+		}
+		for i, index := range positions {
+			flip := (pivot + count - index) % count
+			position := index
+			if flip > position {
+				position = flip
+			}
+			source := sources[position/256]
+			if (source[(position%256)/8]>>(position%8))&0x01 == 1 {
+				positions[i] = flip
+			}
+		}
+	}
+
+	return positions
+}
+
+// ComputeShuffledIndex returns the shuffled position of a single index in a list of the given size.
+// This is cheaper than ComputeShuffle when only a few positions are required.
+func ComputeShuffledIndex(index uint64, count uint64, seed phase0.Root) uint64 {
+	if count <= 1 {
+		return index
+	}
+
+	input := make([]byte, 32+1+4)
+	copy(input, seed[:])
+	for round := 0; round < shuffleRoundCount; round++ {
+		input[32] = byte(round)
+		pivotHash := sha256.Sum256(input[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		flip := (pivot + count - index) % count
+		position := index
+		if flip > position {
+			position = flip
+		}
+		binary.LittleEndian.PutUint32(input[33:], uint32(position/256))
+		source := sha256.Sum256(input)
+		if (source[(position%256)/8]>>(position%8))&0x01 == 1 {
+			index = flip
+		}
+	}
+
+	return index
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shuffling_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/stretchr/testify/require"
+)
+
+// specShuffledIndex is a direct transcription of the spec's compute_shuffled_index.
+func specShuffledIndex(index uint64, count uint64, seed phase0.Root) uint64 {
+	for round := 0; round < 90; round++ {
+		pivotInput := append(append([]byte{}, seed[:]...), byte(round))
+		pivotHash := sha256.Sum256(pivotInput)
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+		flip := (pivot + count - index) % count
+		position := index
+		if flip > position {
+			position = flip
+		}
+		positionBytes := make([]byte, 4)
+		binary.LittleEndian.PutUint32(positionBytes, uint32(position/256))
+		source := sha256.Sum256(append(pivotInput, positionBytes...))
+		if (source[(position%256)/8]>>(position%8))%2 == 1 {
+			index = flip
+		}
+	}
+
+	return index
+}
+
+func TestComputeShuffle(t *testing.T) {
+	tests := []struct {
+		name  string
+		seed  phase0.Root
+		count uint64
+	}{
+		{
+			name:  "Empty",
+			count: 0,
+		},
+		{
+			name:  "Single",
+			count: 1,
+		},
+		{
+			name:  "Small",
+			seed:  phase0.Root{0x01},
+			count: 7,
+		},
+		{
+			name:  "MultipleSources",
+			seed:  phase0.Root{0x01, 0x02, 0x03},
+			count: 1000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			positions := shuffling.ComputeShuffle(test.seed, test.count)
+			require.Len(t, positions, int(test.count))
+			seen := make(map[uint64]bool)
+			for i, position := range positions {
+				require.Equal(t, specShuffledIndex(uint64(i), test.count, test.seed), position)
+				require.Equal(t, position, shuffling.ComputeShuffledIndex(uint64(i), test.count, test.seed))
+				require.False(t, seen[position])
+				seen[position] = true
+			}
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	cache := shuffling.NewCache(2)
+
+	first := cache.Shuffle(phase0.Root{0x01}, 100)
+	require.Equal(t, 1, cache.Len())
+	// Same seed and count should return the cached shuffle.
+	again := cache.Shuffle(phase0.Root{0x01}, 100)
+	require.Equal(t, &first[0], &again[0])
+	require.Equal(t, 1, cache.Len())
+
+	cache.Shuffle(phase0.Root{0x01}, 101)
+	cache.Shuffle(phase0.Root{0x02}, 100)
+	require.Equal(t, 2, cache.Len())
+	// Oldest entry should have been evicted.
+	evicted := cache.Shuffle(phase0.Root{0x01}, 100)
+	require.NotSame(t, &first[0], &evicted[0])
+	require.Equal(t, first, evicted)
+}
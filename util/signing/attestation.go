@@ -0,0 +1,97 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"github.com/attestantio/go-eth2-client/crypto"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DomainBeaconAttester is the domain type for attestation signatures.
+var DomainBeaconAttester = phase0.DomainBeaconAttester
+
+// VerifyIndexedAttestation verifies that an indexed attestation is valid: its attesting
+// indices must be sorted and unique, and its signature must be a valid aggregate signature
+// of its data by the attesting validators.  The public keys are indexed by validator index,
+// and the domain is the beacon attester domain at the attestation's target epoch.
+func VerifyIndexedAttestation(verifier crypto.BLSVerifier,
+	attestation *phase0.IndexedAttestation,
+	pubKeys []phase0.BLSPubKey,
+	domain phase0.Domain,
+) error {
+	if attestation == nil {
+		return errors.New("no attestation supplied")
+	}
+
+	return verifyIndexedAttestation(verifier, attestation.AttestingIndices, attestation.Data, attestation.Signature, pubKeys, domain)
+}
+
+// VerifyElectraIndexedAttestation verifies that an electra indexed attestation is valid,
+// as per VerifyIndexedAttestation.
+func VerifyElectraIndexedAttestation(verifier crypto.BLSVerifier,
+	attestation *electra.IndexedAttestation,
+	pubKeys []phase0.BLSPubKey,
+	domain phase0.Domain,
+) error {
+	if attestation == nil {
+		return errors.New("no attestation supplied")
+	}
+
+	return verifyIndexedAttestation(verifier, attestation.AttestingIndices, attestation.Data, attestation.Signature, pubKeys, domain)
+}
+
+func verifyIndexedAttestation(verifier crypto.BLSVerifier,
+	attestingIndices []uint64,
+	data *phase0.AttestationData,
+	signature phase0.BLSSignature,
+	pubKeys []phase0.BLSPubKey,
+	domain phase0.Domain,
+) error {
+	if verifier == nil {
+		return errors.New("no verifier supplied")
+	}
+	if data == nil {
+		return errors.New("no attestation data supplied")
+	}
+	if len(attestingIndices) == 0 {
+		return errors.New("no attesting indices")
+	}
+
+	attesters := make([]phase0.BLSPubKey, 0, len(attestingIndices))
+	for i, index := range attestingIndices {
+		if i > 0 && index <= attestingIndices[i-1] {
+			return errors.New("attesting indices not sorted and unique")
+		}
+		if index >= uint64(len(pubKeys)) {
+			return errors.Errorf("no public key for validator %d", index)
+		}
+		attesters = append(attesters, pubKeys[index])
+	}
+
+	root, err := ComputeSigningRoot(data, domain)
+	if err != nil {
+		return err
+	}
+	verified, err := verifier.FastAggregateVerify(attesters, root[:], signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify signature")
+	}
+	if !verified {
+		return errors.New("signature invalid")
+	}
+
+	return nil
+}
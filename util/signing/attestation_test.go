@@ -0,0 +1,171 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingBLSVerifier records the keys and message it is asked to verify, and returns a fixed result.
+type recordingBLSVerifier struct {
+	pubKeys []phase0.BLSPubKey
+	message []byte
+	valid   bool
+	err     error
+}
+
+func (v *recordingBLSVerifier) VerifySignature(pubKey phase0.BLSPubKey, message []byte, _ phase0.BLSSignature) (bool, error) {
+	v.pubKeys = []phase0.BLSPubKey{pubKey}
+	v.message = message
+
+	return v.valid, v.err
+}
+
+func (v *recordingBLSVerifier) FastAggregateVerify(pubKeys []phase0.BLSPubKey, message []byte, _ phase0.BLSSignature) (bool, error) {
+	v.pubKeys = pubKeys
+	v.message = message
+
+	return v.valid, v.err
+}
+
+func TestVerifyIndexedAttestation(t *testing.T) {
+	pubKeys := []phase0.BLSPubKey{{0x00}, {0x01}, {0x02}, {0x03}}
+	data := &phase0.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: phase0.Root{0x01},
+		Source:          &phase0.Checkpoint{},
+		Target:          &phase0.Checkpoint{Root: phase0.Root{0x01}},
+	}
+	domain, err := signing.ComputeDomain(signing.DomainBeaconAttester, phase0.Version{}, phase0.Root{})
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(data, domain)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		verifier    *recordingBLSVerifier
+		attestation *phase0.IndexedAttestation
+		err         string
+	}{
+		{
+			name:     "AttestationNil",
+			verifier: &recordingBLSVerifier{valid: true},
+			err:      "no attestation supplied",
+		},
+		{
+			name:     "IndicesMissing",
+			verifier: &recordingBLSVerifier{valid: true},
+			attestation: &phase0.IndexedAttestation{
+				Data: data,
+			},
+			err: "no attesting indices",
+		},
+		{
+			name:     "IndicesUnsorted",
+			verifier: &recordingBLSVerifier{valid: true},
+			attestation: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{2, 1},
+				Data:             data,
+			},
+			err: "attesting indices not sorted and unique",
+		},
+		{
+			name:     "IndicesDuplicate",
+			verifier: &recordingBLSVerifier{valid: true},
+			attestation: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{1, 1},
+				Data:             data,
+			},
+			err: "attesting indices not sorted and unique",
+		},
+		{
+			name:     "IndexUnknown",
+			verifier: &recordingBLSVerifier{valid: true},
+			attestation: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{1, 4},
+				Data:             data,
+			},
+			err: "no public key for validator 4",
+		},
+		{
+			name:     "Invalid",
+			verifier: &recordingBLSVerifier{},
+			attestation: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{1, 3},
+				Data:             data,
+			},
+			err: "signature invalid",
+		},
+		{
+			name:     "VerifierError",
+			verifier: &recordingBLSVerifier{err: errors.New("bad signature encoding")},
+			attestation: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{1, 3},
+				Data:             data,
+			},
+			err: "failed to verify signature: bad signature encoding",
+		},
+		{
+			name:     "Valid",
+			verifier: &recordingBLSVerifier{valid: true},
+			attestation: &phase0.IndexedAttestation{
+				AttestingIndices: []uint64{1, 3},
+				Data:             data,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := signing.VerifyIndexedAttestation(test.verifier, test.attestation, pubKeys, domain)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []phase0.BLSPubKey{{0x01}, {0x03}}, test.verifier.pubKeys)
+			require.Equal(t, signingRoot[:], test.verifier.message)
+		})
+	}
+}
+
+func TestVerifyElectraIndexedAttestation(t *testing.T) {
+	verifier := &recordingBLSVerifier{valid: true}
+	attestation := &electra.IndexedAttestation{
+		AttestingIndices: []uint64{0, 2},
+		Data: &phase0.AttestationData{
+			Source: &phase0.Checkpoint{},
+			Target: &phase0.Checkpoint{},
+		},
+	}
+
+	require.NoError(t, signing.VerifyElectraIndexedAttestation(verifier, attestation, []phase0.BLSPubKey{{0x00}, {0x01}, {0x02}}, phase0.Domain{}))
+	require.Equal(t, []phase0.BLSPubKey{{0x00}, {0x02}}, verifier.pubKeys)
+
+	require.EqualError(t, signing.VerifyElectraIndexedAttestation(nil, attestation, nil, phase0.Domain{}), "no verifier supplied")
+}
+
+func TestFromBLSVerifier(t *testing.T) {
+	blsVerifier := &recordingBLSVerifier{valid: true}
+	message := &phase0.Checkpoint{Epoch: 1}
+
+	require.NoError(t, signing.VerifyBuilderSignature(signing.FromBLSVerifier(blsVerifier), message, phase0.BLSPubKey{0x04}, phase0.BLSSignature{}, phase0.Version{}))
+	require.Equal(t, []phase0.BLSPubKey{{0x04}}, blsVerifier.pubKeys)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VerifyValidatorRegistration verifies the signature of a validator registration.
+func VerifyValidatorRegistration(verifier Verifier,
+	registration *apiv1.SignedValidatorRegistration,
+	genesisForkVersion phase0.Version,
+) error {
+	if registration == nil || registration.Message == nil {
+		return errors.New("no registration supplied")
+	}
+	domain, err := ApplicationBuilderDomain(genesisForkVersion)
+	if err != nil {
+		return err
+	}
+
+	return VerifySignature(verifier, registration.Message, domain, registration.Message.Pubkey, registration.Signature)
+}
+
+// VerifyVersionedValidatorRegistration verifies the signature of a versioned validator registration.
+func VerifyVersionedValidatorRegistration(verifier Verifier,
+	registration *api.VersionedSignedValidatorRegistration,
+	genesisForkVersion phase0.Version,
+) error {
+	if registration == nil {
+		return errors.New("no registration supplied")
+	}
+	switch registration.Version {
+	case spec.BuilderVersionV1:
+		return VerifyValidatorRegistration(verifier, registration.V1, genesisForkVersion)
+	default:
+		return errors.New("unsupported version")
+	}
+}
+
+// VerifyBuilderSignature verifies the signature of a builder API message, such as the
+// message of a signed builder bid, by the given builder public key.  This allows a
+// proposer to check that a relay's response was signed by the relay before using it.
+func VerifyBuilderSignature(verifier Verifier,
+	message HashRooter,
+	pubKey phase0.BLSPubKey,
+	signature phase0.BLSSignature,
+	genesisForkVersion phase0.Version,
+) error {
+	domain, err := ApplicationBuilderDomain(genesisForkVersion)
+	if err != nil {
+		return err
+	}
+
+	return VerifySignature(verifier, message, domain, pubKey, signature)
+}
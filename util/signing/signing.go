@@ -0,0 +1,178 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing provides domain, fork digest and signing root computation, and verification
+// of signatures on builder API objects and attestations with a caller-supplied BLS implementation.
+package signing
+
+import (
+	"github.com/attestantio/go-eth2-client/crypto"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DomainApplicationBuilder is the domain type for builder API signatures.
+var DomainApplicationBuilder = phase0.DomainApplicationBuilder
+
+// DomainBLSToExecutionChange is the domain type for BLS to execution change signatures.
+var DomainBLSToExecutionChange = capella.DomainBLSToExecutionChange
+
+// Verifier verifies BLS signatures.
+// This package does not include a BLS implementation, so one must be supplied through this interface.
+type Verifier interface {
+	// Verify returns true if the signature is a valid signature of the message by the public key.
+	Verify(pubKey phase0.BLSPubKey, message []byte, signature phase0.BLSSignature) (bool, error)
+}
+
+// VerifierFunc is an adapter to allow the use of an ordinary function as a Verifier.
+type VerifierFunc func(pubKey phase0.BLSPubKey, message []byte, signature phase0.BLSSignature) (bool, error)
+
+// Verify calls f(pubKey, message, signature).
+func (f VerifierFunc) Verify(pubKey phase0.BLSPubKey, message []byte, signature phase0.BLSSignature) (bool, error) {
+	return f(pubKey, message, signature)
+}
+
+// FromBLSVerifier returns a Verifier that verifies signatures with the given BLS verifier.
+func FromBLSVerifier(verifier crypto.BLSVerifier) Verifier {
+	return VerifierFunc(verifier.VerifySignature)
+}
+
+// HashRooter is an object that can provide its SSZ hash tree root.
+type HashRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// ComputeForkDataRoot computes the root of the fork data for a fork version.
+func ComputeForkDataRoot(forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Root,
+	error,
+) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+
+	return root, nil
+}
+
+// ComputeForkDigest computes the fork digest for a fork version, as used to identify
+// the fork in networking.
+func ComputeForkDigest(forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.ForkDigest,
+	error,
+) {
+	root, err := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.ForkDigest{}, err
+	}
+
+	var forkDigest phase0.ForkDigest
+	copy(forkDigest[:], root[:4])
+
+	return forkDigest, nil
+}
+
+// ComputeDomain computes a signature domain.
+func ComputeDomain(domainType phase0.DomainType,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Domain,
+	error,
+) {
+	root, err := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Domain{}, err
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], root[:28])
+
+	return domain, nil
+}
+
+// ApplicationBuilderDomain computes the domain for builder API signatures.  Unlike
+// other domains this is fixed for the chain, using the genesis fork version and an
+// empty genesis validators root.
+func ApplicationBuilderDomain(genesisForkVersion phase0.Version) (phase0.Domain, error) {
+	return ComputeDomain(DomainApplicationBuilder, genesisForkVersion, phase0.Root{})
+}
+
+// BLSToExecutionChangeDomain computes the domain for BLS to execution change signatures.
+// As with the builder domain this is fixed for the chain, using the genesis fork version,
+// so that changes remain valid across forks.
+func BLSToExecutionChangeDomain(genesisForkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Domain,
+	error,
+) {
+	return ComputeDomain(DomainBLSToExecutionChange, genesisForkVersion, genesisValidatorsRoot)
+}
+
+// ComputeSigningRoot computes the root that is signed for an object in the given domain.
+func ComputeSigningRoot(object HashRooter, domain phase0.Domain) (phase0.Root, error) {
+	if object == nil {
+		return phase0.Root{}, errors.New("no object supplied")
+	}
+	objectRoot, err := object.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate object root")
+	}
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate signing root")
+	}
+
+	return root, nil
+}
+
+// VerifySignature verifies the signature of an object in the given domain,
+// returning an error if the signature is not valid.
+func VerifySignature(verifier Verifier,
+	object HashRooter,
+	domain phase0.Domain,
+	pubKey phase0.BLSPubKey,
+	signature phase0.BLSSignature,
+) error {
+	if verifier == nil {
+		return errors.New("no verifier supplied")
+	}
+	root, err := ComputeSigningRoot(object, domain)
+	if err != nil {
+		return err
+	}
+	verified, err := verifier.Verify(pubKey, root[:], signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify signature")
+	}
+	if !verified {
+		return errors.New("signature invalid")
+	}
+
+	return nil
+}
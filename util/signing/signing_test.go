@@ -0,0 +1,221 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/stretchr/testify/require"
+)
+
+func hexToDomain(input string) phase0.Domain {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	var res phase0.Domain
+	copy(res[:], data)
+
+	return res
+}
+
+func TestApplicationBuilderDomain(t *testing.T) {
+	tests := []struct {
+		name               string
+		genesisForkVersion phase0.Version
+		expected           phase0.Domain
+	}{
+		{
+			name:               "Mainnet",
+			genesisForkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			expected:           hexToDomain("0x00000001f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9"),
+		},
+		{
+			name:               "Goerli",
+			genesisForkVersion: phase0.Version{0x00, 0x00, 0x10, 0x20},
+			expected:           hexToDomain("0x00000001e4be9393b074ca1f3e4aabd585ca4bea101170ccfaf71b89ce5c5c38"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain, err := signing.ApplicationBuilderDomain(test.genesisForkVersion)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, domain)
+		})
+	}
+}
+
+func TestComputeForkDigest(t *testing.T) {
+	mainnetGenesisValidatorsRoot := phase0.Root{
+		0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+		0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+	}
+
+	tests := []struct {
+		name        string
+		forkVersion phase0.Version
+		expected    phase0.ForkDigest
+	}{
+		{
+			name:        "Phase0",
+			forkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest{0xb5, 0x30, 0x3f, 0x2a},
+		},
+		{
+			name:        "Altair",
+			forkVersion: phase0.Version{0x01, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest{0xaf, 0xca, 0xab, 0xa0},
+		},
+		{
+			name:        "Bellatrix",
+			forkVersion: phase0.Version{0x02, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest{0x4a, 0x26, 0xc5, 0x8b},
+		},
+		{
+			name:        "Capella",
+			forkVersion: phase0.Version{0x03, 0x00, 0x00, 0x00},
+			expected:    phase0.ForkDigest{0xbb, 0xa4, 0xda, 0x96},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			forkDigest, err := signing.ComputeForkDigest(test.forkVersion, mainnetGenesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, forkDigest)
+
+			// The fork digest is the start of the fork data root, as is the domain after its type.
+			root, err := signing.ComputeForkDataRoot(test.forkVersion, mainnetGenesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, test.expected[:], root[:4])
+			domain, err := signing.ComputeDomain(phase0.DomainType{0x01, 0x00, 0x00, 0x00}, test.forkVersion, mainnetGenesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, root[:28], domain[4:])
+		})
+	}
+}
+
+// recordingVerifier records the message it is asked to verify, and returns a fixed result.
+type recordingVerifier struct {
+	pubKey  phase0.BLSPubKey
+	message []byte
+	valid   bool
+	err     error
+}
+
+func (v *recordingVerifier) Verify(pubKey phase0.BLSPubKey, message []byte, _ phase0.BLSSignature) (bool, error) {
+	v.pubKey = pubKey
+	v.message = message
+
+	return v.valid, v.err
+}
+
+func TestVerifyValidatorRegistration(t *testing.T) {
+	registration := &apiv1.SignedValidatorRegistration{
+		Message: &apiv1.ValidatorRegistration{
+			FeeRecipient: bellatrix.ExecutionAddress{0x01},
+			GasLimit:     30000000,
+			Timestamp:    time.Unix(1606824023, 0),
+			Pubkey:       phase0.BLSPubKey{0x02},
+		},
+		Signature: phase0.BLSSignature{0x03},
+	}
+	domain, err := signing.ApplicationBuilderDomain(phase0.Version{})
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(registration.Message, domain)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		verifier     *recordingVerifier
+		registration *apiv1.SignedValidatorRegistration
+		err          string
+	}{
+		{
+			name:     "RegistrationNil",
+			verifier: &recordingVerifier{valid: true},
+			err:      "no registration supplied",
+		},
+		{
+			name:         "Valid",
+			verifier:     &recordingVerifier{valid: true},
+			registration: registration,
+		},
+		{
+			name:         "Invalid",
+			verifier:     &recordingVerifier{},
+			registration: registration,
+			err:          "signature invalid",
+		},
+		{
+			name:         "VerifierError",
+			verifier:     &recordingVerifier{err: errors.New("bad signature encoding")},
+			registration: registration,
+			err:          "failed to verify signature: bad signature encoding",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := signing.VerifyValidatorRegistration(test.verifier, test.registration, phase0.Version{})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, registration.Message.Pubkey, test.verifier.pubKey)
+			require.Equal(t, signingRoot[:], test.verifier.message)
+		})
+	}
+}
+
+func TestVerifyVersionedValidatorRegistration(t *testing.T) {
+	verifier := signing.VerifierFunc(func(_ phase0.BLSPubKey, _ []byte, _ phase0.BLSSignature) (bool, error) {
+		return true, nil
+	})
+	registration := &api.VersionedSignedValidatorRegistration{
+		Version: spec.BuilderVersionV1,
+		V1: &apiv1.SignedValidatorRegistration{
+			Message: &apiv1.ValidatorRegistration{
+				Timestamp: time.Unix(1606824023, 0),
+			},
+		},
+	}
+	require.NoError(t, signing.VerifyVersionedValidatorRegistration(verifier, registration, phase0.Version{}))
+
+	registration.Version = spec.BuilderVersion(99)
+	require.EqualError(t, signing.VerifyVersionedValidatorRegistration(verifier, registration, phase0.Version{}), "unsupported version")
+}
+
+func TestVerifyBuilderSignature(t *testing.T) {
+	// Any SSZ object can be verified; use a checkpoint as a stand-in for a builder bid.
+	message := &phase0.Checkpoint{Epoch: 1, Root: phase0.Root{0x01}}
+	verifier := &recordingVerifier{valid: true}
+
+	require.NoError(t, signing.VerifyBuilderSignature(verifier, message, phase0.BLSPubKey{0x04}, phase0.BLSSignature{}, phase0.Version{}))
+	require.Equal(t, phase0.BLSPubKey{0x04}, verifier.pubKey)
+
+	require.EqualError(t, signing.VerifyBuilderSignature(nil, message, phase0.BLSPubKey{}, phase0.BLSSignature{}, phase0.Version{}), "no verifier supplied")
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ConflictType is the type of conflict between two attestations.
+type ConflictType int
+
+const (
+	// ConflictDoubleVote is two different attestations with the same target epoch.
+	ConflictDoubleVote ConflictType = iota + 1
+	// ConflictSurrounding is an attestation that surrounds an existing attestation.
+	ConflictSurrounding
+	// ConflictSurrounded is an attestation that is surrounded by an existing attestation.
+	ConflictSurrounded
+)
+
+var conflictTypeStrings = [...]string{
+	"unknown",
+	"double vote",
+	"surrounding vote",
+	"surrounded vote",
+}
+
+// String returns a string representation of the conflict type.
+func (c ConflictType) String() string {
+	if c < 0 || int(c) >= len(conflictTypeStrings) {
+		return "unknown"
+	}
+
+	return conflictTypeStrings[c]
+}
+
+// Conflict is a slashable conflict between an attestation and one already in the index.
+type Conflict struct {
+	Type           ConflictType
+	ValidatorIndex phase0.ValidatorIndex
+	// Attestation is the attestation that was checked.
+	Attestation *phase0.AttestationData
+	// Existing is the attestation in the index with which it conflicts.
+	Existing *phase0.AttestationData
+}
+
+// String returns a string version of the conflict.
+func (c *Conflict) String() string {
+	return fmt.Sprintf("%s by validator %d: %d->%d conflicts with %d->%d",
+		c.Type,
+		c.ValidatorIndex,
+		c.Attestation.Source.Epoch, c.Attestation.Target.Epoch,
+		c.Existing.Source.Epoch, c.Existing.Target.Epoch,
+	)
+}
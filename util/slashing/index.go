@@ -0,0 +1,376 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slashing provides detection of slashable attestations.
+package slashing
+
+import (
+	"math"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// shards is the number of independently locked shards of the index.
+const shards = 64
+
+// noMinSpan is the value of a min span for which there is no attestation.
+const noMinSpan = math.MaxUint16
+
+// Index detects double and surround votes in attestations, keyed by validator.
+//
+// Surround votes are detected with min-max spans: for each validator and epoch
+// the index holds the minimum distance to the target of any attestation with a
+// later source, and the maximum distance to the target of any attestation with
+// an earlier source.  This allows an attestation to be checked against all of
+// a validator's previous attestations in constant time.
+//
+// The index holds a window of history for each validator, ending at the highest
+// target epoch seen for that validator; attestations with a source before the
+// start of the window cannot be checked, so the history length should exceed the
+// longest expected distance between source and target.  Each validator uses 12 bytes of memory
+// per epoch of history.
+// It is safe for concurrent use.
+type Index struct {
+	historyLength phase0.Epoch
+	shards        [shards]indexShard
+}
+
+type indexShard struct {
+	mu         sync.RWMutex
+	validators map[phase0.ValidatorIndex]*validatorHistory
+}
+
+// validatorHistory is the history of a single validator.
+// The slices are circular buffers indexed by epoch modulo the history length.
+type validatorHistory struct {
+	// latest is the highest target epoch seen.
+	latest phase0.Epoch
+	// minSpans holds the minimum distance from the epoch to the target of an attestation with a later source.
+	minSpans []uint16
+	// maxSpans holds the maximum distance from the epoch to the target of an attestation with an earlier source.
+	maxSpans []uint16
+	// attestations holds attestations by target epoch.
+	attestations []*phase0.AttestationData
+}
+
+// NewIndex creates an index with the given number of epochs of history per validator.
+func NewIndex(historyLength phase0.Epoch) (*Index, error) {
+	if historyLength == 0 {
+		return nil, errors.New("history length must be at least 1")
+	}
+	if historyLength > noMinSpan {
+		return nil, errors.Errorf("history length must be at most %d", noMinSpan)
+	}
+
+	index := &Index{
+		historyLength: historyLength,
+	}
+	for i := range index.shards {
+		index.shards[i].validators = make(map[phase0.ValidatorIndex]*validatorHistory)
+	}
+
+	return index, nil
+}
+
+// Check checks an attestation by a validator against the attestations in the index,
+// returning the conflict if the attestation is slashable.
+// The attestation is not added to the index.
+func (i *Index) Check(validatorIndex phase0.ValidatorIndex, data *phase0.AttestationData) (*Conflict, error) {
+	if err := checkData(data); err != nil {
+		return nil, err
+	}
+
+	shard := i.shard(validatorIndex)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	history, exists := shard.validators[validatorIndex]
+	if err := i.checkWindow(history, data); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	return i.conflict(history, validatorIndex, data)
+}
+
+// Record checks an attestation by a validator against the attestations in the index,
+// returning the conflict if the attestation is slashable.  If it is not slashable it
+// is added to the index.
+func (i *Index) Record(validatorIndex phase0.ValidatorIndex, data *phase0.AttestationData) (*Conflict, error) {
+	if err := checkData(data); err != nil {
+		return nil, err
+	}
+
+	shard := i.shard(validatorIndex)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	history, exists := shard.validators[validatorIndex]
+	if err := i.checkWindow(history, data); err != nil {
+		return nil, err
+	}
+	if !exists {
+		history = i.newHistory(data.Target.Epoch)
+		shard.validators[validatorIndex] = history
+	}
+
+	conflict, err := i.conflict(history, validatorIndex, data)
+	if err != nil {
+		return nil, err
+	}
+	if conflict != nil {
+		return conflict, nil
+	}
+
+	i.advance(history, data.Target.Epoch)
+	i.update(history, data)
+
+	return nil, nil
+}
+
+// RecordAttestation records an attestation by multiple validators, for example
+// the attesting indices of an indexed attestation, returning any conflicts.
+func (i *Index) RecordAttestation(validatorIndices []phase0.ValidatorIndex,
+	data *phase0.AttestationData,
+) (
+	[]*Conflict,
+	error,
+) {
+	conflicts := make([]*Conflict, 0)
+	for _, validatorIndex := range validatorIndices {
+		conflict, err := i.Record(validatorIndex, data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to record attestation for validator %d", validatorIndex)
+		}
+		if conflict != nil {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+
+	return conflicts, nil
+}
+
+// Remove removes a validator from the index.
+func (i *Index) Remove(validatorIndex phase0.ValidatorIndex) {
+	shard := i.shard(validatorIndex)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	delete(shard.validators, validatorIndex)
+}
+
+// Len returns the number of validators in the index.
+func (i *Index) Len() int {
+	res := 0
+	for j := range i.shards {
+		i.shards[j].mu.RLock()
+		res += len(i.shards[j].validators)
+		i.shards[j].mu.RUnlock()
+	}
+
+	return res
+}
+
+func checkData(data *phase0.AttestationData) error {
+	if data == nil {
+		return errors.New("no attestation data")
+	}
+	if data.Source == nil {
+		return errors.New("no source checkpoint")
+	}
+	if data.Target == nil {
+		return errors.New("no target checkpoint")
+	}
+	if data.Source.Epoch > data.Target.Epoch {
+		return errors.New("source epoch after target epoch")
+	}
+
+	return nil
+}
+
+// checkWindow checks that the source of the attestation is within the history of
+// the validator, if any, once the history has been advanced to the attestation's target.
+func (i *Index) checkWindow(history *validatorHistory, data *phase0.AttestationData) error {
+	latest := data.Target.Epoch
+	if history != nil && history.latest > latest {
+		latest = history.latest
+	}
+	if data.Source.Epoch+i.historyLength <= latest {
+		return errors.New("attestation source is outside the history of the index")
+	}
+
+	return nil
+}
+
+func (i *Index) shard(validatorIndex phase0.ValidatorIndex) *indexShard {
+	return &i.shards[validatorIndex%shards]
+}
+
+func (i *Index) newHistory(latest phase0.Epoch) *validatorHistory {
+	history := &validatorHistory{
+		latest:       latest,
+		minSpans:     make([]uint16, i.historyLength),
+		maxSpans:     make([]uint16, i.historyLength),
+		attestations: make([]*phase0.AttestationData, i.historyLength),
+	}
+	for j := range history.minSpans {
+		history.minSpans[j] = noMinSpan
+	}
+
+	return history
+}
+
+// conflict returns the conflict between the attestation and those in the history, if any.
+func (i *Index) conflict(history *validatorHistory,
+	validatorIndex phase0.ValidatorIndex,
+	data *phase0.AttestationData,
+) (
+	*Conflict,
+	error,
+) {
+	source := data.Source.Epoch
+	target := data.Target.Epoch
+
+	// Double vote.
+	if existing := i.attestation(history, target); existing != nil {
+		same, err := sameData(existing, data)
+		if err != nil {
+			return nil, err
+		}
+		if same {
+			return nil, nil
+		}
+
+		return &Conflict{
+			Type:           ConflictDoubleVote,
+			ValidatorIndex: validatorIndex,
+			Attestation:    data,
+			Existing:       existing,
+		}, nil
+	}
+
+	if source > history.latest {
+		// Nothing in the history can surround, or be surrounded by, this attestation.
+		return nil, nil
+	}
+	pos := source % i.historyLength
+
+	// Surrounding vote: an existing attestation has a later source and an earlier target.
+	if minSpan := history.minSpans[pos]; minSpan != noMinSpan && source+phase0.Epoch(minSpan) < target {
+		return &Conflict{
+			Type:           ConflictSurrounding,
+			ValidatorIndex: validatorIndex,
+			Attestation:    data,
+			Existing:       i.attestation(history, source+phase0.Epoch(minSpan)),
+		}, nil
+	}
+
+	// Surrounded vote: an existing attestation has an earlier source and a later target.
+	if maxSpan := history.maxSpans[pos]; source+phase0.Epoch(maxSpan) > target {
+		return &Conflict{
+			Type:           ConflictSurrounded,
+			ValidatorIndex: validatorIndex,
+			Attestation:    data,
+			Existing:       i.attestation(history, source+phase0.Epoch(maxSpan)),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// attestation returns the attestation in the history with the given target epoch, if any.
+func (i *Index) attestation(history *validatorHistory, target phase0.Epoch) *phase0.AttestationData {
+	if target > history.latest || target+i.historyLength <= history.latest {
+		return nil
+	}
+	attestation := history.attestations[target%i.historyLength]
+	if attestation == nil || attestation.Target.Epoch != target {
+		return nil
+	}
+
+	return attestation
+}
+
+// advance moves the end of the history forward to the given epoch, clearing the
+// entries for epochs that leave the window.
+func (i *Index) advance(history *validatorHistory, latest phase0.Epoch) {
+	if latest <= history.latest {
+		return
+	}
+	start := history.latest + 1
+	if latest-start >= i.historyLength {
+		start = latest - i.historyLength + 1
+	}
+	for epoch := start; epoch <= latest; epoch++ {
+		pos := epoch % i.historyLength
+		history.minSpans[pos] = noMinSpan
+		history.maxSpans[pos] = 0
+		history.attestations[pos] = nil
+	}
+	history.latest = latest
+}
+
+// update updates the history with the attestation.
+func (i *Index) update(history *validatorHistory, data *phase0.AttestationData) {
+	source := data.Source.Epoch
+	target := data.Target.Epoch
+	history.attestations[target%i.historyLength] = data
+
+	// Update min spans for earlier epochs, stopping once an existing span is lower
+	// as the spans of all earlier epochs will also be lower.
+	var lowest phase0.Epoch
+	if history.latest >= i.historyLength {
+		lowest = history.latest - i.historyLength + 1
+	}
+	for epoch := source; epoch > lowest; {
+		epoch--
+		pos := epoch % i.historyLength
+		span := uint16(target - epoch)
+		if span >= history.minSpans[pos] {
+			break
+		}
+		history.minSpans[pos] = span
+	}
+
+	// Update max spans for epochs between the source and target, stopping once an
+	// existing span is higher as the spans of all later epochs will also be higher.
+	for epoch := source + 1; epoch < target; epoch++ {
+		pos := epoch % i.historyLength
+		span := uint16(target - epoch)
+		if span <= history.maxSpans[pos] {
+			break
+		}
+		history.maxSpans[pos] = span
+	}
+}
+
+// sameData returns true if the two attestation data are the same.
+func sameData(a *phase0.AttestationData, b *phase0.AttestationData) (bool, error) {
+	if a == b {
+		return true, nil
+	}
+	aRoot, err := a.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain root of existing attestation data")
+	}
+	bRoot, err := b.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain root of attestation data")
+	}
+
+	return aRoot == bRoot, nil
+}
@@ -0,0 +1,241 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slashing_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/slashing"
+	"github.com/stretchr/testify/require"
+)
+
+func attestationData(source phase0.Epoch, target phase0.Epoch, root byte) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:            phase0.Slot(target * 32),
+		BeaconBlockRoot: phase0.Root{root},
+		Source:          &phase0.Checkpoint{Epoch: source},
+		Target:          &phase0.Checkpoint{Epoch: target},
+	}
+}
+
+func TestNewIndex(t *testing.T) {
+	_, err := slashing.NewIndex(0)
+	require.EqualError(t, err, "history length must be at least 1")
+	_, err = slashing.NewIndex(65536)
+	require.EqualError(t, err, "history length must be at most 65535")
+	_, err = slashing.NewIndex(4096)
+	require.NoError(t, err)
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []*phase0.AttestationData
+		data     *phase0.AttestationData
+		conflict slashing.ConflictType
+		err      string
+	}{
+		{
+			name: "NilData",
+			err:  "no attestation data",
+		},
+		{
+			name: "SourceAfterTarget",
+			data: attestationData(5, 4, 0),
+			err:  "source epoch after target epoch",
+		},
+		{
+			name: "First",
+			data: attestationData(4, 5, 0),
+		},
+		{
+			name:     "Repeat",
+			existing: []*phase0.AttestationData{attestationData(4, 5, 0)},
+			data:     attestationData(4, 5, 0),
+		},
+		{
+			name:     "DoubleVote",
+			existing: []*phase0.AttestationData{attestationData(4, 5, 0)},
+			data:     attestationData(4, 5, 1),
+			conflict: slashing.ConflictDoubleVote,
+		},
+		{
+			name:     "DoubleVoteDifferentSource",
+			existing: []*phase0.AttestationData{attestationData(4, 5, 0)},
+			data:     attestationData(3, 5, 0),
+			conflict: slashing.ConflictDoubleVote,
+		},
+		{
+			name:     "Surrounding",
+			existing: []*phase0.AttestationData{attestationData(4, 5, 0)},
+			data:     attestationData(3, 6, 0),
+			conflict: slashing.ConflictSurrounding,
+		},
+		{
+			name:     "Surrounded",
+			existing: []*phase0.AttestationData{attestationData(3, 6, 0)},
+			data:     attestationData(4, 5, 0),
+			conflict: slashing.ConflictSurrounded,
+		},
+		{
+			name:     "SurroundedLater",
+			existing: []*phase0.AttestationData{attestationData(3, 6, 0), attestationData(6, 7, 0)},
+			data:     attestationData(4, 5, 0),
+			conflict: slashing.ConflictSurrounded,
+		},
+		{
+			name:     "SameSource",
+			existing: []*phase0.AttestationData{attestationData(3, 6, 0)},
+			data:     attestationData(3, 5, 0),
+		},
+		{
+			name:     "SameTarget",
+			existing: []*phase0.AttestationData{attestationData(3, 6, 0)},
+			data:     attestationData(4, 7, 0),
+		},
+		{
+			name:     "Sequential",
+			existing: []*phase0.AttestationData{attestationData(3, 4, 0), attestationData(4, 5, 0)},
+			data:     attestationData(5, 6, 0),
+		},
+		{
+			name:     "OutsideHistory",
+			existing: []*phase0.AttestationData{attestationData(99, 100, 0)},
+			data:     attestationData(50, 60, 0),
+			err:      "attestation source is outside the history of the index",
+		},
+		{
+			name: "SpanTooLong",
+			data: attestationData(10, 100, 0),
+			err:  "attestation source is outside the history of the index",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, err := slashing.NewIndex(32)
+			require.NoError(t, err)
+			for _, existing := range test.existing {
+				conflict, err := index.Record(1, existing)
+				require.NoError(t, err)
+				require.Nil(t, conflict)
+			}
+
+			// Check must give the same result as Record.
+			checkConflict, checkErr := index.Check(1, test.data)
+			conflict, err := index.Record(1, test.data)
+			if test.err != "" {
+				require.EqualError(t, checkErr, test.err)
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, checkErr)
+			require.NoError(t, err)
+			require.Equal(t, checkConflict, conflict)
+			if test.conflict == 0 {
+				require.Nil(t, conflict)
+				return
+			}
+			require.NotNil(t, conflict)
+			require.Equal(t, test.conflict, conflict.Type)
+			require.Equal(t, phase0.ValidatorIndex(1), conflict.ValidatorIndex)
+			require.Equal(t, test.data, conflict.Attestation)
+			require.NotNil(t, conflict.Existing)
+
+			// Other validators are unaffected.
+			conflict, err = index.Record(2, test.data)
+			require.NoError(t, err)
+			require.Nil(t, conflict)
+		})
+	}
+}
+
+func TestRecordAttestation(t *testing.T) {
+	index, err := slashing.NewIndex(32)
+	require.NoError(t, err)
+
+	conflicts, err := index.RecordAttestation([]phase0.ValidatorIndex{1, 2}, attestationData(4, 5, 0))
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+	require.Equal(t, 2, index.Len())
+
+	conflicts, err = index.RecordAttestation([]phase0.ValidatorIndex{2, 3}, attestationData(3, 6, 0))
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, phase0.ValidatorIndex(2), conflicts[0].ValidatorIndex)
+	require.Equal(t, "surrounding vote by validator 2: 3->6 conflicts with 4->5", conflicts[0].String())
+	require.Equal(t, 3, index.Len())
+
+	index.Remove(2)
+	require.Equal(t, 2, index.Len())
+}
+
+func maxEpoch(a phase0.Epoch, b phase0.Epoch) phase0.Epoch {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// TestRecordRandom compares the results of the index with a direct comparison
+// against all previous attestations.
+func TestRecordRandom(t *testing.T) {
+	const historyLength = 16
+	index, err := slashing.NewIndex(historyLength)
+	require.NoError(t, err)
+
+	rng := rand.New(rand.NewSource(1))
+	recorded := make([]*phase0.AttestationData, 0)
+	latest := phase0.Epoch(0)
+	for i := 0; i < 5000; i++ {
+		target := phase0.Epoch(rng.Intn(100))
+		source := target - phase0.Epoch(rng.Intn(6))
+		if source > target {
+			source = 0
+		}
+		data := attestationData(source, target, byte(rng.Intn(2)))
+
+		conflict, err := index.Record(1, data)
+		if source+historyLength <= maxEpoch(latest, target) {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+
+		var expected *phase0.AttestationData
+		for _, existing := range recorded {
+			if existing.Target.Epoch+historyLength <= maxEpoch(latest, target) {
+				continue
+			}
+			switch {
+			case existing.Target.Epoch == target && (existing.Source.Epoch != source || existing.BeaconBlockRoot != data.BeaconBlockRoot):
+				expected = existing
+			case existing.Source.Epoch > source && existing.Target.Epoch < target:
+				expected = existing
+			case existing.Source.Epoch < source && existing.Target.Epoch > target:
+				expected = existing
+			}
+		}
+		if expected == nil {
+			require.Nil(t, conflict, "unexpected conflict %v", conflict)
+			recorded = append(recorded, data)
+			latest = maxEpoch(latest, target)
+		} else {
+			require.NotNil(t, conflict, "missed conflict of %d->%d with %d->%d", source, target, expected.Source.Epoch, expected.Target.Epoch)
+		}
+	}
+}
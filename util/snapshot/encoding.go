@@ -0,0 +1,116 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// encoder appends values to a buffer.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) uvarint(val uint64) {
+	e.buf = binary.AppendUvarint(e.buf, val)
+}
+
+func (e *encoder) varint(val int64) {
+	e.buf = binary.AppendVarint(e.buf, val)
+}
+
+func (e *encoder) byte(val byte) {
+	e.buf = append(e.buf, val)
+}
+
+func (e *encoder) bytes(val []byte) {
+	e.buf = append(e.buf, val...)
+}
+
+// decoder reads values from a buffer.
+// The first error encountered is retained, and subsequent reads return zero values.
+type decoder struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	val, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		d.err = errors.Errorf("invalid varint at offset %d", d.pos)
+		return 0
+	}
+	d.pos += n
+
+	return val
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	val, n := binary.Varint(d.data[d.pos:])
+	if n <= 0 {
+		d.err = errors.Errorf("invalid varint at offset %d", d.pos)
+		return 0
+	}
+	d.pos += n
+
+	return val
+}
+
+func (d *decoder) byte() byte {
+	val := d.bytes(1)
+	if val == nil {
+		return 0
+	}
+
+	return val[0]
+}
+
+// bytes returns the next n bytes.  The returned slice references the underlying data.
+func (d *decoder) bytes(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.data)-d.pos < n {
+		d.err = errors.Errorf("unexpected end of data at offset %d", d.pos)
+		return nil
+	}
+	val := d.data[d.pos : d.pos+n]
+	d.pos += n
+
+	return val
+}
+
+// count reads a count of items, each of which takes at least minSize bytes.
+// This avoids large allocations when decoding corrupt data.
+func (d *decoder) count(minSize int) int {
+	val := d.uvarint()
+	if d.err != nil {
+		return 0
+	}
+	if val > uint64(len(d.data)-d.pos)/uint64(minSize) {
+		d.err = errors.Errorf("count %d too large for remaining data", val)
+		return 0
+	}
+
+	return int(val)
+}
@@ -0,0 +1,533 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// The snapshot format is a header followed by one column per field, with each column
+// holding the field for every validator in index order.  Grouping similar values in
+// this way keeps snapshots small, and lets them compress well.
+//
+// Header:
+//   - magic "VSNP"
+//   - format version (1 byte)
+//   - kind (1 byte): full or delta
+//
+// A full snapshot continues with the epoch and the number of validators, followed by:
+//   - indices, as the first index then the increment to each subsequent index
+//   - public keys, 48 bytes each
+//   - withdrawal credentials, 32 bytes each
+//   - effective balances
+//   - balances
+//   - statuses, 1 byte each
+//   - slashed flags, as a bitfield
+//   - activation eligibility, activation, exit and withdrawable epochs, one column each
+//
+// A delta continues with the base and target epochs, the indices of validators removed
+// from the base, and the indices of validators added or changed in the target.  For each
+// changed validator a bitmask of the changed fields follows, and then one column per
+// field holding only the changed values.  Balances are stored as the difference from
+// the base, which is small for the balance changes seen from one epoch to the next.
+//
+// All integers are varints.  Epochs are stored offset by one, so that the far future
+// epoch is stored in a single byte.
+
+var magic = []byte("VSNP")
+
+const formatVersion = 1
+
+const (
+	kindFull  byte = 0
+	kindDelta byte = 1
+)
+
+const (
+	pubKeyLength      = 48
+	credentialsLength = 32
+)
+
+// Fields in a delta's change bitmask.
+const (
+	fieldPublicKey uint64 = 1 << iota
+	fieldWithdrawalCredentials
+	fieldEffectiveBalance
+	fieldBalance
+	fieldStatus
+	fieldSlashed
+	fieldActivationEligibilityEpoch
+	fieldActivationEpoch
+	fieldExitEpoch
+	fieldWithdrawableEpoch
+
+	fieldAll = fieldWithdrawableEpoch<<1 - 1
+)
+
+// WriteValidators writes a full snapshot of the validators at the given epoch.
+// Validators are stored in index order, regardless of their order in the supplied slice.
+func WriteValidators(w io.Writer, epoch phase0.Epoch, validators []*apiv1.Validator) error {
+	sorted, err := sortValidators(validators)
+	if err != nil {
+		return err
+	}
+
+	e := newEncoder(kindFull)
+	e.uvarint(uint64(epoch))
+	e.uvarint(uint64(len(sorted)))
+	encodeIndices(e, sorted)
+	for _, validator := range sorted {
+		e.bytes(validator.Validator.PublicKey[:])
+	}
+	for _, validator := range sorted {
+		e.bytes(validator.Validator.WithdrawalCredentials)
+	}
+	for _, validator := range sorted {
+		e.uvarint(uint64(validator.Validator.EffectiveBalance))
+	}
+	for _, validator := range sorted {
+		e.uvarint(uint64(validator.Balance))
+	}
+	for _, validator := range sorted {
+		e.byte(byte(validator.Status))
+	}
+	slashed := make([]byte, (len(sorted)+7)/8)
+	for i, validator := range sorted {
+		if validator.Validator.Slashed {
+			slashed[i/8] |= 1 << (i % 8)
+		}
+	}
+	e.bytes(slashed)
+	for _, epochField := range epochFields {
+		for _, validator := range sorted {
+			e.uvarint(uint64(*epochField(validator.Validator)) + 1)
+		}
+	}
+
+	if _, err := w.Write(e.buf); err != nil {
+		return errors.Wrap(err, "failed to write snapshot")
+	}
+
+	return nil
+}
+
+// ReadValidators reads a full snapshot, returning its epoch and validators in index order.
+func ReadValidators(r io.Reader) (phase0.Epoch, []*apiv1.Validator, error) {
+	d, err := newDecoder(r, kindFull)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	epoch := phase0.Epoch(d.uvarint())
+	count := d.count(1 + pubKeyLength + credentialsLength)
+	indices := decodeIndices(d, count)
+	validators := make([]*apiv1.Validator, count)
+	for i := range validators {
+		validators[i] = &apiv1.Validator{
+			Index:     indices[i],
+			Validator: &phase0.Validator{},
+		}
+	}
+	for _, validator := range validators {
+		copy(validator.Validator.PublicKey[:], d.bytes(pubKeyLength))
+	}
+	for _, validator := range validators {
+		validator.Validator.WithdrawalCredentials = bytes.Clone(d.bytes(credentialsLength))
+	}
+	for _, validator := range validators {
+		validator.Validator.EffectiveBalance = phase0.Gwei(d.uvarint())
+	}
+	for _, validator := range validators {
+		validator.Balance = phase0.Gwei(d.uvarint())
+	}
+	for _, validator := range validators {
+		validator.Status = apiv1.ValidatorState(d.byte())
+	}
+	slashed := d.bytes((count + 7) / 8)
+	if d.err == nil {
+		for i, validator := range validators {
+			validator.Validator.Slashed = slashed[i/8]&(1<<(i%8)) != 0
+		}
+	}
+	for _, epochField := range epochFields {
+		for _, validator := range validators {
+			*epochField(validator.Validator) = phase0.Epoch(d.uvarint() - 1)
+		}
+	}
+	if d.err != nil {
+		return 0, nil, errors.Wrap(d.err, "failed to decode snapshot")
+	}
+
+	return epoch, validators, nil
+}
+
+// WriteValidatorsDelta writes the changes required to go from the base validators at the
+// base epoch to the validators at the given epoch.
+func WriteValidatorsDelta(w io.Writer,
+	baseEpoch phase0.Epoch,
+	base []*apiv1.Validator,
+	epoch phase0.Epoch,
+	validators []*apiv1.Validator,
+) error {
+	sortedBase, err := sortValidators(base)
+	if err != nil {
+		return errors.Wrap(err, "invalid base")
+	}
+	sorted, err := sortValidators(validators)
+	if err != nil {
+		return err
+	}
+
+	baseValidators := make(map[phase0.ValidatorIndex]*apiv1.Validator, len(sortedBase))
+	for _, validator := range sortedBase {
+		baseValidators[validator.Index] = validator
+	}
+
+	changed := make([]*apiv1.Validator, 0)
+	changedBase := make([]*apiv1.Validator, 0)
+	masks := make([]uint64, 0)
+	for _, validator := range sorted {
+		baseValidator, exists := baseValidators[validator.Index]
+		delete(baseValidators, validator.Index)
+		mask := fieldAll
+		if exists {
+			mask = changedFields(baseValidator, validator)
+		} else {
+			baseValidator = &apiv1.Validator{Validator: &phase0.Validator{}}
+		}
+		if mask != 0 {
+			changed = append(changed, validator)
+			changedBase = append(changedBase, baseValidator)
+			masks = append(masks, mask)
+		}
+	}
+	// Anything left in the base map is not present in the target.
+	removed := make([]*apiv1.Validator, 0, len(baseValidators))
+	for _, validator := range sortedBase {
+		if _, exists := baseValidators[validator.Index]; exists {
+			removed = append(removed, validator)
+		}
+	}
+
+	e := newEncoder(kindDelta)
+	e.uvarint(uint64(baseEpoch))
+	e.uvarint(uint64(epoch))
+	e.uvarint(uint64(len(removed)))
+	encodeIndices(e, removed)
+	e.uvarint(uint64(len(changed)))
+	encodeIndices(e, changed)
+	for _, mask := range masks {
+		e.uvarint(mask)
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldPublicKey != 0 {
+			e.bytes(validator.Validator.PublicKey[:])
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldWithdrawalCredentials != 0 {
+			e.bytes(validator.Validator.WithdrawalCredentials)
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldEffectiveBalance != 0 {
+			e.uvarint(uint64(validator.Validator.EffectiveBalance))
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldBalance != 0 {
+			e.varint(int64(validator.Balance - changedBase[i].Balance))
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldStatus != 0 {
+			e.byte(byte(validator.Status))
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldSlashed != 0 {
+			if validator.Validator.Slashed {
+				e.byte(1)
+			} else {
+				e.byte(0)
+			}
+		}
+	}
+	for j, epochField := range epochFields {
+		for i, validator := range changed {
+			if masks[i]&(fieldActivationEligibilityEpoch<<j) != 0 {
+				e.uvarint(uint64(*epochField(validator.Validator)) + 1)
+			}
+		}
+	}
+
+	if _, err := w.Write(e.buf); err != nil {
+		return errors.Wrap(err, "failed to write delta")
+	}
+
+	return nil
+}
+
+// ApplyValidatorsDelta reads a delta and applies it to the base validators at the base epoch,
+// returning the target epoch and validators in index order.  The base validators are not altered.
+func ApplyValidatorsDelta(r io.Reader,
+	baseEpoch phase0.Epoch,
+	base []*apiv1.Validator,
+) (
+	phase0.Epoch,
+	[]*apiv1.Validator,
+	error,
+) {
+	sortedBase, err := sortValidators(base)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid base")
+	}
+
+	d, err := newDecoder(r, kindDelta)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	deltaBaseEpoch := phase0.Epoch(d.uvarint())
+	epoch := phase0.Epoch(d.uvarint())
+	if d.err == nil && deltaBaseEpoch != baseEpoch {
+		return 0, nil, errors.Errorf("delta is from epoch %d but base is for epoch %d", deltaBaseEpoch, baseEpoch)
+	}
+	removed := decodeIndices(d, d.count(1))
+	changedIndices := decodeIndices(d, d.count(1))
+	masks := make([]uint64, len(changedIndices))
+	for i := range masks {
+		masks[i] = d.uvarint()
+	}
+	if d.err != nil {
+		return 0, nil, errors.Wrap(d.err, "failed to decode delta")
+	}
+
+	// Start with a copy of the base, less removed validators.
+	res := make(map[phase0.ValidatorIndex]*apiv1.Validator, len(sortedBase)+len(changedIndices))
+	for _, validator := range sortedBase {
+		res[validator.Index] = copyValidator(validator)
+	}
+	for _, index := range removed {
+		delete(res, index)
+	}
+	changed := make([]*apiv1.Validator, len(changedIndices))
+	for i, index := range changedIndices {
+		validator, exists := res[index]
+		if !exists {
+			if masks[i] != fieldAll {
+				return 0, nil, errors.Errorf("delta changes validator %d which is not in base", index)
+			}
+			validator = &apiv1.Validator{
+				Index:     index,
+				Validator: &phase0.Validator{},
+			}
+			res[index] = validator
+		}
+		changed[i] = validator
+	}
+
+	for i, validator := range changed {
+		if masks[i]&fieldPublicKey != 0 {
+			copy(validator.Validator.PublicKey[:], d.bytes(pubKeyLength))
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldWithdrawalCredentials != 0 {
+			validator.Validator.WithdrawalCredentials = bytes.Clone(d.bytes(credentialsLength))
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldEffectiveBalance != 0 {
+			validator.Validator.EffectiveBalance = phase0.Gwei(d.uvarint())
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldBalance != 0 {
+			validator.Balance += phase0.Gwei(d.varint())
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldStatus != 0 {
+			validator.Status = apiv1.ValidatorState(d.byte())
+		}
+	}
+	for i, validator := range changed {
+		if masks[i]&fieldSlashed != 0 {
+			validator.Validator.Slashed = d.byte() != 0
+		}
+	}
+	for j, epochField := range epochFields {
+		for i, validator := range changed {
+			if masks[i]&(fieldActivationEligibilityEpoch<<j) != 0 {
+				*epochField(validator.Validator) = phase0.Epoch(d.uvarint() - 1)
+			}
+		}
+	}
+	if d.err != nil {
+		return 0, nil, errors.Wrap(d.err, "failed to decode delta")
+	}
+
+	validators := make([]*apiv1.Validator, 0, len(res))
+	for _, validator := range res {
+		validators = append(validators, validator)
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Index < validators[j].Index
+	})
+
+	return epoch, validators, nil
+}
+
+// epochFields provides access to the epoch fields of a validator, in the order they are stored.
+var epochFields = []func(*phase0.Validator) *phase0.Epoch{
+	func(v *phase0.Validator) *phase0.Epoch { return &v.ActivationEligibilityEpoch },
+	func(v *phase0.Validator) *phase0.Epoch { return &v.ActivationEpoch },
+	func(v *phase0.Validator) *phase0.Epoch { return &v.ExitEpoch },
+	func(v *phase0.Validator) *phase0.Epoch { return &v.WithdrawableEpoch },
+}
+
+func newEncoder(kind byte) *encoder {
+	e := &encoder{}
+	e.bytes(magic)
+	e.byte(formatVersion)
+	e.byte(kind)
+
+	return e
+}
+
+func newDecoder(r io.Reader, kind byte) (*decoder, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot")
+	}
+	d := &decoder{data: data}
+	if !bytes.Equal(d.bytes(len(magic)), magic) {
+		return nil, errors.New("not a validator snapshot")
+	}
+	if version := d.byte(); version != formatVersion {
+		return nil, errors.Errorf("unsupported snapshot version %d", version)
+	}
+	if actual := d.byte(); actual != kind {
+		if kind == kindFull {
+			return nil, errors.New("snapshot is a delta")
+		}
+
+		return nil, errors.New("snapshot is not a delta")
+	}
+
+	return d, nil
+}
+
+// sortValidators returns a copy of the validators sorted by index, checking that they can be stored.
+func sortValidators(validators []*apiv1.Validator) ([]*apiv1.Validator, error) {
+	sorted := make([]*apiv1.Validator, len(validators))
+	copy(sorted, validators)
+	for _, validator := range sorted {
+		if validator == nil || validator.Validator == nil {
+			return nil, errors.New("validator missing")
+		}
+		if len(validator.Validator.WithdrawalCredentials) != credentialsLength {
+			return nil, errors.Errorf("validator %d has invalid withdrawal credentials length", validator.Index)
+		}
+		if validator.Status < 0 || validator.Status > 0xff {
+			return nil, errors.Errorf("validator %d has invalid status", validator.Index)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Index == sorted[i-1].Index {
+			return nil, errors.Errorf("duplicate validator %d", sorted[i].Index)
+		}
+	}
+
+	return sorted, nil
+}
+
+// encodeIndices encodes the indices of validators sorted by index.
+func encodeIndices(e *encoder, validators []*apiv1.Validator) {
+	prev := phase0.ValidatorIndex(0)
+	for i, validator := range validators {
+		if i == 0 {
+			e.uvarint(uint64(validator.Index))
+		} else {
+			e.uvarint(uint64(validator.Index - prev))
+		}
+		prev = validator.Index
+	}
+}
+
+func decodeIndices(d *decoder, count int) []phase0.ValidatorIndex {
+	indices := make([]phase0.ValidatorIndex, count)
+	for i := range indices {
+		val := phase0.ValidatorIndex(d.uvarint())
+		if i == 0 {
+			indices[i] = val
+		} else {
+			if val == 0 && d.err == nil {
+				d.err = errors.New("indices not in increasing order")
+			}
+			indices[i] = indices[i-1] + val
+		}
+	}
+
+	return indices
+}
+
+// changedFields returns a bitmask of the fields that differ between the two validators.
+func changedFields(base *apiv1.Validator, validator *apiv1.Validator) uint64 {
+	mask := uint64(0)
+	if base.Validator.PublicKey != validator.Validator.PublicKey {
+		mask |= fieldPublicKey
+	}
+	if !bytes.Equal(base.Validator.WithdrawalCredentials, validator.Validator.WithdrawalCredentials) {
+		mask |= fieldWithdrawalCredentials
+	}
+	if base.Validator.EffectiveBalance != validator.Validator.EffectiveBalance {
+		mask |= fieldEffectiveBalance
+	}
+	if base.Balance != validator.Balance {
+		mask |= fieldBalance
+	}
+	if base.Status != validator.Status {
+		mask |= fieldStatus
+	}
+	if base.Validator.Slashed != validator.Validator.Slashed {
+		mask |= fieldSlashed
+	}
+	for j, epochField := range epochFields {
+		if *epochField(base.Validator) != *epochField(validator.Validator) {
+			mask |= fieldActivationEligibilityEpoch << j
+		}
+	}
+
+	return mask
+}
+
+func copyValidator(validator *apiv1.Validator) *apiv1.Validator {
+	res := *validator
+	specValidator := *validator.Validator
+	specValidator.WithdrawalCredentials = bytes.Clone(validator.Validator.WithdrawalCredentials)
+	res.Validator = &specValidator
+
+	return &res
+}
@@ -0,0 +1,263 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+func testValidator(index phase0.ValidatorIndex) *apiv1.Validator {
+	credentials := make([]byte, 32)
+	credentials[0] = 0x01
+	credentials[31] = byte(index)
+
+	return &apiv1.Validator{
+		Index:   index,
+		Balance: 32000000000 + phase0.Gwei(index),
+		Status:  apiv1.ValidatorStateActiveOngoing,
+		Validator: &phase0.Validator{
+			PublicKey:                  phase0.BLSPubKey{0xa0, byte(index >> 8), byte(index)},
+			WithdrawalCredentials:      credentials,
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            1,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		},
+	}
+}
+
+func testValidators(indices ...phase0.ValidatorIndex) []*apiv1.Validator {
+	res := make([]*apiv1.Validator, len(indices))
+	for i, index := range indices {
+		res[i] = testValidator(index)
+	}
+
+	return res
+}
+
+func TestValidators(t *testing.T) {
+	slashed := testValidator(5)
+	slashed.Status = apiv1.ValidatorStateExitedSlashed
+	slashed.Validator.Slashed = true
+	slashed.Validator.ExitEpoch = 100
+	slashed.Validator.WithdrawableEpoch = 8292
+
+	invalidCredentials := testValidator(6)
+	invalidCredentials.Validator.WithdrawalCredentials = []byte{0x01}
+
+	tests := []struct {
+		name       string
+		validators []*apiv1.Validator
+		expected   []*apiv1.Validator
+		err        string
+	}{
+		{
+			name:       "Empty",
+			validators: []*apiv1.Validator{},
+			expected:   []*apiv1.Validator{},
+		},
+		{
+			name:       "Single",
+			validators: testValidators(3),
+			expected:   testValidators(3),
+		},
+		{
+			name:       "Unordered",
+			validators: append(testValidators(1000, 2, 0, 9), slashed),
+			expected:   append(append(testValidators(0, 2), slashed), testValidators(9, 1000)...),
+		},
+		{
+			name:       "Nil",
+			validators: []*apiv1.Validator{nil},
+			err:        "validator missing",
+		},
+		{
+			name:       "Duplicate",
+			validators: testValidators(1, 2, 1),
+			err:        "duplicate validator 1",
+		},
+		{
+			name:       "InvalidCredentials",
+			validators: []*apiv1.Validator{invalidCredentials},
+			err:        "validator 6 has invalid withdrawal credentials length",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := snapshot.WriteValidators(buf, 12, test.validators)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			epoch, validators, err := snapshot.ReadValidators(buf)
+			require.NoError(t, err)
+			require.Equal(t, phase0.Epoch(12), epoch)
+			require.Equal(t, test.expected, validators)
+		})
+	}
+}
+
+func TestValidatorsSize(t *testing.T) {
+	validators := make([]*apiv1.Validator, 10000)
+	for i := range validators {
+		validators[i] = testValidator(phase0.ValidatorIndex(i))
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidators(buf, 1, validators))
+	data, err := json.Marshal(validators)
+	require.NoError(t, err)
+	require.Less(t, buf.Len()*3, len(data))
+}
+
+func TestReadValidatorsInvalid(t *testing.T) {
+	full := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidators(full, 1, testValidators(1, 2, 3)))
+	delta := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidatorsDelta(delta, 1, testValidators(1), 2, testValidators(1)))
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+			err:   "not a validator snapshot",
+		},
+		{
+			name:  "BadMagic",
+			input: []byte("XXXX\x01\x00"),
+			err:   "not a validator snapshot",
+		},
+		{
+			name:  "BadVersion",
+			input: []byte("VSNP\x02\x00"),
+			err:   "unsupported snapshot version 2",
+		},
+		{
+			name:  "Delta",
+			input: delta.Bytes(),
+			err:   "snapshot is a delta",
+		},
+		{
+			name:  "Truncated",
+			input: full.Bytes()[:full.Len()-1],
+			err:   "failed to decode snapshot: invalid varint at offset 296",
+		},
+		{
+			name:  "CountTooLarge",
+			input: []byte("VSNP\x01\x00\x01\xff\x01"),
+			err:   "failed to decode snapshot: count 255 too large for remaining data",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := snapshot.ReadValidators(bytes.NewReader(test.input))
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestValidatorsDelta(t *testing.T) {
+	base := testValidators(0, 1, 2, 3)
+
+	exiting := testValidator(1)
+	exiting.Status = apiv1.ValidatorStateActiveExiting
+	exiting.Validator.ExitEpoch = 300
+	exiting.Validator.WithdrawableEpoch = 556
+
+	lowerBalance := testValidator(2)
+	lowerBalance.Balance -= 20000
+
+	target := []*apiv1.Validator{testValidator(0), exiting, lowerBalance, testValidator(7)}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidatorsDelta(buf, 10, base, 11, target))
+	deltaLen := buf.Len()
+
+	epoch, validators, err := snapshot.ApplyValidatorsDelta(buf, 10, base)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(11), epoch)
+	require.Equal(t, target, validators)
+	// Base should be untouched.
+	require.Equal(t, testValidators(0, 1, 2, 3), base)
+
+	// Delta should be smaller than a full snapshot.
+	full := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidators(full, 11, target))
+	require.Less(t, deltaLen, full.Len())
+}
+
+func TestApplyValidatorsDeltaInvalid(t *testing.T) {
+	base := testValidators(0, 1)
+	target := testValidators(0, 1)
+	target[1].Balance++
+
+	delta := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidatorsDelta(delta, 10, base, 11, target))
+	full := new(bytes.Buffer)
+	require.NoError(t, snapshot.WriteValidators(full, 11, target))
+
+	tests := []struct {
+		name      string
+		input     []byte
+		baseEpoch phase0.Epoch
+		base      []*apiv1.Validator
+		err       string
+	}{
+		{
+			name:      "Full",
+			input:     full.Bytes(),
+			baseEpoch: 10,
+			base:      base,
+			err:       "snapshot is not a delta",
+		},
+		{
+			name:      "WrongEpoch",
+			input:     delta.Bytes(),
+			baseEpoch: 9,
+			base:      base,
+			err:       "delta is from epoch 10 but base is for epoch 9",
+		},
+		{
+			name:      "MissingBaseValidator",
+			input:     delta.Bytes(),
+			baseEpoch: 10,
+			base:      testValidators(0),
+			err:       "delta changes validator 1 which is not in base",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := snapshot.ApplyValidatorsDelta(bytes.NewReader(test.input), test.baseEpoch, test.base)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stateutil computes validator duties directly from a decoded beacon state,
+// allowing offline tools to verify committees and proposers without querying a node.
+package stateutil
+
+import (
+	"sync"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/pkg/errors"
+)
+
+// State provides duty computations for a beacon state.
+// It is safe for concurrent use.
+type State struct {
+	state       *spec.VersionedBeaconState
+	params      *shuffling.ChainParameters
+	cache       *shuffling.Cache
+	epoch       phase0.Epoch
+	validators  []*phase0.Validator
+	randaoMixes []phase0.Root
+
+	mu                sync.Mutex
+	activeIndices     map[phase0.Epoch][]phase0.ValidatorIndex
+	effectiveBalances map[phase0.ValidatorIndex]phase0.Gwei
+	pubKeyIndices     map[phase0.BLSPubKey]phase0.ValidatorIndex
+}
+
+// New creates duty computations for the supplied state.  The cache may be nil, but
+// supplying one allows shuffles to be shared with other computations.
+func New(state *spec.VersionedBeaconState,
+	params *shuffling.ChainParameters,
+	cache *shuffling.Cache,
+) (
+	*State,
+	error,
+) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+	if params == nil || params.SlotsPerEpoch == 0 || params.TargetCommitteeSize == 0 {
+		return nil, errors.New("invalid chain parameters")
+	}
+	if cache == nil {
+		// Committees can be computed for up to three epochs.
+		cache = shuffling.NewCache(3)
+	}
+	slot, err := state.Slot()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := state.Validators()
+	if err != nil {
+		return nil, err
+	}
+	randaoMixes, err := state.RANDAOMixes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &State{
+		state:         state,
+		params:        params,
+		cache:         cache,
+		epoch:         phase0.Epoch(uint64(slot) / params.SlotsPerEpoch),
+		validators:    validators,
+		randaoMixes:   randaoMixes,
+		activeIndices: make(map[phase0.Epoch][]phase0.ValidatorIndex),
+	}, nil
+}
+
+// Epoch returns the epoch of the state.
+func (s *State) Epoch() phase0.Epoch {
+	return s.epoch
+}
+
+// Validators returns the validators of the state.
+func (s *State) Validators() []*phase0.Validator {
+	return s.validators
+}
+
+// ActiveIndices returns the indices of the validators active in the given epoch.
+func (s *State) ActiveIndices(epoch phase0.Epoch) []phase0.ValidatorIndex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.activeIndicesLocked(epoch)
+}
+
+func (s *State) activeIndicesLocked(epoch phase0.Epoch) []phase0.ValidatorIndex {
+	if indices, exists := s.activeIndices[epoch]; exists {
+		return indices
+	}
+	indices := make([]phase0.ValidatorIndex, 0, len(s.validators))
+	for i, validator := range s.validators {
+		if validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			indices = append(indices, phase0.ValidatorIndex(i))
+		}
+	}
+	s.activeIndices[epoch] = indices
+
+	return indices
+}
+
+// CommitteesPerSlot returns the number of beacon committees in each slot of the given epoch.
+func (s *State) CommitteesPerSlot(epoch phase0.Epoch) (uint64, error) {
+	if err := s.checkCommitteeEpoch(epoch); err != nil {
+		return 0, err
+	}
+
+	return shuffling.CommitteesPerSlot(s.params, uint64(len(s.ActiveIndices(epoch)))), nil
+}
+
+// BeaconCommittee returns the beacon committee for the given slot and committee index.
+// The slot must be in the epoch before, the epoch of, or the epoch after the state.
+func (s *State) BeaconCommittee(slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]phase0.ValidatorIndex, error) {
+	epoch := phase0.Epoch(uint64(slot) / s.params.SlotsPerEpoch)
+	if err := s.checkCommitteeEpoch(epoch); err != nil {
+		return nil, err
+	}
+	activeIndices := s.ActiveIndices(epoch)
+	if len(activeIndices) == 0 {
+		return nil, errors.New("no active validators")
+	}
+	seed, err := shuffling.Seed(s.randaoMixes, epoch, shuffling.DomainBeaconAttester)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cache.BeaconCommittee(s.params, activeIndices, seed, slot, committeeIndex)
+}
+
+// BeaconCommittees returns all beacon committees for the given epoch, ordered by slot
+// and then committee index.
+// The epoch must be the epoch before, the epoch of, or the epoch after the state.
+func (s *State) BeaconCommittees(epoch phase0.Epoch) ([]*apiv1.BeaconCommittee, error) {
+	committeesPerSlot, err := s.CommitteesPerSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*apiv1.BeaconCommittee, 0, committeesPerSlot*s.params.SlotsPerEpoch)
+	firstSlot := phase0.Slot(uint64(epoch) * s.params.SlotsPerEpoch)
+	for slot := firstSlot; slot < firstSlot+phase0.Slot(s.params.SlotsPerEpoch); slot++ {
+		for committeeIndex := phase0.CommitteeIndex(0); uint64(committeeIndex) < committeesPerSlot; committeeIndex++ {
+			committee, err := s.BeaconCommittee(slot, committeeIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to compute committee %d for slot %d", committeeIndex, slot)
+			}
+			res = append(res, &apiv1.BeaconCommittee{
+				Slot:       slot,
+				Index:      committeeIndex,
+				Validators: committee,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// ProposerIndex returns the index of the proposer for the given slot.
+// The slot must be in the epoch of the state, as proposer selection depends on effective
+// balances that can change at each epoch transition.
+func (s *State) ProposerIndex(slot phase0.Slot) (phase0.ValidatorIndex, error) {
+	epoch := phase0.Epoch(uint64(slot) / s.params.SlotsPerEpoch)
+	if epoch != s.epoch {
+		return 0, errors.Errorf("slot %d not in state epoch %d", slot, s.epoch)
+	}
+	if slot == 0 {
+		return 0, errors.New("no proposer for genesis slot")
+	}
+
+	s.mu.Lock()
+	activeIndices := s.activeIndicesLocked(epoch)
+	if s.effectiveBalances == nil {
+		s.effectiveBalances = make(map[phase0.ValidatorIndex]phase0.Gwei, len(activeIndices))
+		for _, index := range activeIndices {
+			s.effectiveBalances[index] = s.validators[index].EffectiveBalance
+		}
+	}
+	effectiveBalances := s.effectiveBalances
+	s.mu.Unlock()
+
+	seed, err := shuffling.Seed(s.randaoMixes, epoch, shuffling.DomainBeaconProposer)
+	if err != nil {
+		return 0, err
+	}
+
+	if s.state.Version >= spec.DataVersionElectra {
+		return shuffling.ProposerIndexElectra(s.params, activeIndices, effectiveBalances, shuffling.ProposerSeed(seed, slot))
+	}
+
+	return shuffling.ProposerIndex(s.params, activeIndices, effectiveBalances, shuffling.ProposerSeed(seed, slot))
+}
+
+// checkCommitteeEpoch ensures that committees for the epoch can be computed from the state.
+func (s *State) checkCommitteeEpoch(epoch phase0.Epoch) error {
+	if epoch+1 < s.epoch || epoch > s.epoch+1 {
+		return errors.Errorf("epoch %d out of range for state at epoch %d", epoch, s.epoch)
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stateutil_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/shuffling"
+	"github.com/attestantio/go-eth2-client/util/stateutil"
+	"github.com/stretchr/testify/require"
+)
+
+var testParams = &shuffling.ChainParameters{
+	SlotsPerEpoch:              4,
+	TargetCommitteeSize:        4,
+	MaxCommitteesPerSlot:       4,
+	MaxEffectiveBalance:        32000000000,
+	MaxEffectiveBalanceElectra: 2048000000000,
+}
+
+func testValidators(count int) []*phase0.Validator {
+	validators := make([]*phase0.Validator, count)
+	for i := range validators {
+		validators[i] = &phase0.Validator{
+			PublicKey:        phase0.BLSPubKey{byte(i)},
+			EffectiveBalance: 32000000000,
+			ExitEpoch:        0xffffffffffffffff,
+		}
+	}
+	// Validator 0 exits at epoch 2.
+	validators[0].ExitEpoch = 2
+
+	return validators
+}
+
+func testRANDAOMixes() []phase0.Root {
+	mixes := make([]phase0.Root, 16)
+	for i := range mixes {
+		mixes[i] = phase0.Root{byte(i)}
+	}
+
+	return mixes
+}
+
+func TestNew(t *testing.T) {
+	_, err := stateutil.New(nil, testParams, nil)
+	require.EqualError(t, err, "no state supplied")
+
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Slot:        9,
+			Validators:  testValidators(64),
+			RANDAOMixes: testRANDAOMixes(),
+		},
+	}
+	_, err = stateutil.New(state, nil, nil)
+	require.EqualError(t, err, "invalid chain parameters")
+	_, err = stateutil.New(&spec.VersionedBeaconState{Version: spec.DataVersionPhase0}, testParams, nil)
+	require.EqualError(t, err, "no Phase0 state")
+
+	st, err := stateutil.New(state, testParams, nil)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Epoch(2), st.Epoch())
+	require.Len(t, st.ActiveIndices(1), 64)
+	require.Len(t, st.ActiveIndices(2), 63)
+}
+
+func TestBeaconCommittees(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Slot:        9,
+			Validators:  testValidators(64),
+			RANDAOMixes: testRANDAOMixes(),
+		},
+	}
+	cache := shuffling.NewCache(3)
+	st, err := stateutil.New(state, testParams, cache)
+	require.NoError(t, err)
+
+	_, err = st.BeaconCommittees(0)
+	require.EqualError(t, err, "epoch 0 out of range for state at epoch 2")
+	_, err = st.BeaconCommittee(16, 0)
+	require.EqualError(t, err, "epoch 4 out of range for state at epoch 2")
+	_, err = st.BeaconCommittee(8, 3)
+	require.EqualError(t, err, "committee index 3 out of range; 3 committees per slot")
+
+	for _, epoch := range []phase0.Epoch{1, 2, 3} {
+		committees, err := st.BeaconCommittees(epoch)
+		require.NoError(t, err)
+		activeIndices := st.ActiveIndices(epoch)
+		seed, err := shuffling.Seed(testRANDAOMixes(), epoch, shuffling.DomainBeaconAttester)
+		require.NoError(t, err)
+
+		// Every active validator should be in exactly one committee.
+		seen := make(map[phase0.ValidatorIndex]bool)
+		for _, committee := range committees {
+			require.Equal(t, epoch, phase0.Epoch(uint64(committee.Slot)/testParams.SlotsPerEpoch))
+			expected, err := cache.BeaconCommittee(testParams, activeIndices, seed, committee.Slot, committee.Index)
+			require.NoError(t, err)
+			require.Equal(t, expected, committee.Validators)
+			for _, index := range committee.Validators {
+				require.False(t, seen[index])
+				seen[index] = true
+			}
+		}
+		require.Len(t, seen, len(activeIndices))
+	}
+	require.Equal(t, 3, cache.Len())
+}
+
+func TestProposerIndex(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Slot:        9,
+			Validators:  testValidators(64),
+			RANDAOMixes: testRANDAOMixes(),
+		},
+	}
+	st, err := stateutil.New(state, testParams, nil)
+	require.NoError(t, err)
+
+	_, err = st.ProposerIndex(12)
+	require.EqualError(t, err, "slot 12 not in state epoch 2")
+
+	activeIndices := st.ActiveIndices(2)
+	effectiveBalances := make(map[phase0.ValidatorIndex]phase0.Gwei)
+	for _, index := range activeIndices {
+		effectiveBalances[index] = 32000000000
+	}
+	seed, err := shuffling.Seed(testRANDAOMixes(), 2, shuffling.DomainBeaconProposer)
+	require.NoError(t, err)
+	for slot := phase0.Slot(8); slot < 12; slot++ {
+		proposer, err := st.ProposerIndex(slot)
+		require.NoError(t, err)
+		expected, err := shuffling.ProposerIndex(testParams, activeIndices, effectiveBalances, shuffling.ProposerSeed(seed, slot))
+		require.NoError(t, err)
+		require.Equal(t, expected, proposer)
+	}
+
+	genesis := &spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Validators:  testValidators(64),
+			RANDAOMixes: testRANDAOMixes(),
+		},
+	}
+	st, err = stateutil.New(genesis, testParams, nil)
+	require.NoError(t, err)
+	_, err = st.ProposerIndex(0)
+	require.EqualError(t, err, "no proposer for genesis slot")
+}
+
+func TestProposerIndexElectra(t *testing.T) {
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.BeaconState{
+			Slot:        9,
+			Validators:  testValidators(64),
+			RANDAOMixes: testRANDAOMixes(),
+		},
+	}
+	st, err := stateutil.New(state, testParams, nil)
+	require.NoError(t, err)
+
+	// Expected proposers are from the consensus spec's compute_proposer_index for electra;
+	// the phase0 selection would give 27, 50, 24 and 57.
+	expected := []phase0.ValidatorIndex{21, 49, 7, 34}
+	for i, slot := range []phase0.Slot{8, 9, 10, 11} {
+		proposer, err := st.ProposerIndex(slot)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], proposer)
+	}
+}
+
+func TestSyncCommittees(t *testing.T) {
+	validators := testValidators(16)
+	current := &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 8)}
+	next := &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 8)}
+	for i := range current.Pubkeys {
+		current.Pubkeys[i] = validators[i].PublicKey
+		next.Pubkeys[i] = validators[(i*3)%5].PublicKey
+	}
+	state := &spec.VersionedBeaconState{
+		Version: spec.DataVersionAltair,
+		Altair: &altair.BeaconState{
+			Slot:                 9,
+			Validators:           validators,
+			RANDAOMixes:          testRANDAOMixes(),
+			CurrentSyncCommittee: current,
+			NextSyncCommittee:    next,
+		},
+	}
+	st, err := stateutil.New(state, testParams, nil)
+	require.NoError(t, err)
+
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{0, 1, 2, 3, 4, 5, 6, 7}, committee.Validators)
+	require.Equal(t, [][]phase0.ValidatorIndex{{0, 1}, {2, 3}, {4, 5}, {6, 7}}, committee.ValidatorAggregates)
+
+	committee, err = st.NextSyncCommittee()
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{0, 3, 1, 4, 2, 0, 3, 1}, committee.Validators)
+	require.Equal(t, []int{0, 5}, stateutil.SyncCommitteeMembership(committee, 0))
+	require.Nil(t, stateutil.SyncCommitteeMembership(committee, 9))
+
+	next.Pubkeys[0] = phase0.BLSPubKey{0xff}
+	_, err = st.NextSyncCommittee()
+	require.ErrorContains(t, err, "not found in validators")
+
+	state.Altair.CurrentSyncCommittee = nil
+	_, err = st.CurrentSyncCommittee()
+	require.EqualError(t, err, "no sync committee in state")
+
+	st, err = stateutil.New(&spec.VersionedBeaconState{
+		Version: spec.DataVersionPhase0,
+		Phase0: &phase0.BeaconState{
+			Validators:  validators,
+			RANDAOMixes: testRANDAOMixes(),
+		},
+	}, testParams, nil)
+	require.NoError(t, err)
+	_, err = st.CurrentSyncCommittee()
+	require.EqualError(t, err, "state does not provide current sync committee")
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stateutil
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SyncCommitteeSubnetCount is the number of subnets over which sync committee messages are aggregated.
+const SyncCommitteeSubnetCount = 4
+
+// CurrentSyncCommittee returns the membership of the sync committee for the state's sync committee period.
+func (s *State) CurrentSyncCommittee() (*apiv1.SyncCommittee, error) {
+	committee, err := s.state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.syncCommittee(committee)
+}
+
+// NextSyncCommittee returns the membership of the sync committee for the period after the state's.
+func (s *State) NextSyncCommittee() (*apiv1.SyncCommittee, error) {
+	committee, err := s.state.NextSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.syncCommittee(committee)
+}
+
+// syncCommittee resolves the public keys of a sync committee to validator indices.
+func (s *State) syncCommittee(committee *altair.SyncCommittee) (*apiv1.SyncCommittee, error) {
+	if committee == nil {
+		return nil, errors.New("no sync committee in state")
+	}
+
+	s.mu.Lock()
+	if s.pubKeyIndices == nil {
+		s.pubKeyIndices = make(map[phase0.BLSPubKey]phase0.ValidatorIndex, len(s.validators))
+		for i, validator := range s.validators {
+			s.pubKeyIndices[validator.PublicKey] = phase0.ValidatorIndex(i)
+		}
+	}
+	pubKeyIndices := s.pubKeyIndices
+	s.mu.Unlock()
+
+	res := &apiv1.SyncCommittee{
+		Validators:          make([]phase0.ValidatorIndex, len(committee.Pubkeys)),
+		ValidatorAggregates: make([][]phase0.ValidatorIndex, SyncCommitteeSubnetCount),
+	}
+	for i, pubKey := range committee.Pubkeys {
+		index, exists := pubKeyIndices[pubKey]
+		if !exists {
+			return nil, errors.Errorf("sync committee member %#x not found in validators", pubKey)
+		}
+		res.Validators[i] = index
+	}
+	subcommitteeSize := (len(res.Validators) + SyncCommitteeSubnetCount - 1) / SyncCommitteeSubnetCount
+	for i := range res.ValidatorAggregates {
+		start := i * subcommitteeSize
+		end := start + subcommitteeSize
+		if start > len(res.Validators) {
+			start = len(res.Validators)
+		}
+		if end > len(res.Validators) {
+			end = len(res.Validators)
+		}
+		res.ValidatorAggregates[i] = res.Validators[start:end]
+	}
+
+	return res, nil
+}
+
+// SyncCommitteeMembership returns the positions of the given validator in the sync committee,
+// or nil if it is not a member.  A validator can occupy more than one position.
+func SyncCommitteeMembership(committee *apiv1.SyncCommittee, index phase0.ValidatorIndex) []int {
+	var positions []int
+	for i, member := range committee.Validators {
+		if member == index {
+			positions = append(positions, i)
+		}
+	}
+
+	return positions
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package withdrawalcredentials
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/pkg/errors"
+)
+
+// Signer signs with BLS withdrawal keys.
+// This package does not hold keys, so signing must be supplied through this interface.
+type Signer interface {
+	// Sign returns the signature of the root by the key with the given public key.
+	Sign(ctx context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error)
+}
+
+// SignerFunc is an adapter to allow the use of an ordinary function as a Signer.
+type SignerFunc func(ctx context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error)
+
+// Sign calls f(ctx, pubKey, root).
+func (f SignerFunc) Sign(ctx context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error) {
+	return f(ctx, pubKey, root)
+}
+
+// Request is a request to change the withdrawal credentials of a validator to an execution address.
+type Request struct {
+	ValidatorIndex phase0.ValidatorIndex
+	// WithdrawalCredentials are the current withdrawal credentials of the validator.
+	WithdrawalCredentials []byte
+	// FromBLSPubkey is the public key of the withdrawal key of the validator.
+	FromBLSPubkey      phase0.BLSPubKey
+	ToExecutionAddress bellatrix.ExecutionAddress
+}
+
+// Result is the result of processing a single request.
+type Result struct {
+	ValidatorIndex phase0.ValidatorIndex
+	// Change is the signed change, if it could be constructed.
+	Change *capella.SignedBLSToExecutionChange
+	// Submitted is true if the change has been accepted by the submitter.
+	Submitted bool
+	// Err is the reason the change could not be constructed or submitted.
+	Err error
+}
+
+// Build validates the requests and constructs a signed change for each valid request.
+// A result is returned for each request, in the order of the requests.  An error is
+// returned only if no changes can be constructed.
+func Build(ctx context.Context,
+	signer Signer,
+	genesisForkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+	requests []*Request,
+) (
+	[]*Result,
+	error,
+) {
+	if signer == nil {
+		return nil, errors.New("no signer supplied")
+	}
+	domain, err := signing.BLSToExecutionChangeDomain(genesisForkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, 0, len(requests))
+	for _, request := range requests {
+		if request == nil {
+			results = append(results, &Result{Err: errors.New("no request supplied")})
+			continue
+		}
+		result := &Result{
+			ValidatorIndex: request.ValidatorIndex,
+		}
+		result.Change, result.Err = build(ctx, signer, domain, request)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func build(ctx context.Context,
+	signer Signer,
+	domain phase0.Domain,
+	request *Request,
+) (
+	*capella.SignedBLSToExecutionChange,
+	error,
+) {
+	if err := ValidateBLSCredentials(request.WithdrawalCredentials, request.FromBLSPubkey); err != nil {
+		return nil, err
+	}
+
+	change := &capella.BLSToExecutionChange{
+		ValidatorIndex:     request.ValidatorIndex,
+		FromBLSPubkey:      request.FromBLSPubkey,
+		ToExecutionAddress: request.ToExecutionAddress,
+	}
+	root, err := signing.ComputeSigningRoot(change, domain)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(ctx, request.FromBLSPubkey, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign change")
+	}
+
+	return &capella.SignedBLSToExecutionChange{
+		Message:   change,
+		Signature: signature,
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package withdrawalcredentials provides construction and submission of changes
+// from BLS withdrawal credentials to execution address withdrawal credentials.
+package withdrawalcredentials
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+const (
+	// BLSWithdrawalPrefix is the prefix of withdrawal credentials that commit to a BLS withdrawal key.
+	BLSWithdrawalPrefix = byte(0x00)
+	// ETH1AddressWithdrawalPrefix is the prefix of withdrawal credentials that commit to an execution address.
+	ETH1AddressWithdrawalPrefix = byte(0x01)
+)
+
+// ValidateBLSCredentials checks that withdrawal credentials commit to the given
+// BLS withdrawal public key, and so can be changed with a key of that public key.
+func ValidateBLSCredentials(credentials []byte, pubKey phase0.BLSPubKey) error {
+	if len(credentials) != 32 {
+		return errors.Errorf("withdrawal credentials have incorrect length %d", len(credentials))
+	}
+	switch credentials[0] {
+	case BLSWithdrawalPrefix:
+	case ETH1AddressWithdrawalPrefix:
+		return errors.New("withdrawal credentials already commit to an execution address")
+	default:
+		return errors.Errorf("withdrawal credentials have unknown prefix %#02x", credentials[0])
+	}
+
+	hash := sha256.Sum256(pubKey[:])
+	if !bytes.Equal(credentials[1:], hash[1:]) {
+		return errors.New("withdrawal credentials do not match withdrawal public key")
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package withdrawalcredentials
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/pkg/errors"
+)
+
+// Submit submits the changes of results that have neither been submitted nor failed,
+// in batches of at most batchSize changes with at least interval between submissions.
+// If a batch is rejected its changes are resubmitted individually, so that the result
+// of each change shows whether that change was accepted.
+// An error is returned only if submission stops early, for example because the
+// context is cancelled, in which case the results of unsubmitted changes are unaltered.
+func Submit(ctx context.Context,
+	submitter consensusclient.BLSToExecutionChangesSubmitter,
+	results []*Result,
+	batchSize int,
+	interval time.Duration,
+) error {
+	if submitter == nil {
+		return errors.New("no submitter supplied")
+	}
+	if batchSize < 1 {
+		return errors.New("batch size must be at least 1")
+	}
+
+	pending := make([]*Result, 0, len(results))
+	for _, result := range results {
+		if result == nil || result.Submitted || result.Err != nil || result.Change == nil {
+			continue
+		}
+		pending = append(pending, result)
+	}
+
+	limiter := &rateLimiter{interval: interval}
+	for start := 0; start < len(pending); start += batchSize {
+		end := start + batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batch := pending[start:end]
+
+		err := limiter.submit(ctx, submitter, batch)
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if len(batch) == 1 {
+			batch[0].Err = err
+			continue
+		}
+
+		// Resubmit individually to find the changes that were rejected.
+		for _, result := range batch {
+			if err := limiter.submit(ctx, submitter, []*Result{result}); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				result.Err = err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rateLimiter ensures a minimum interval between submissions.
+type rateLimiter struct {
+	interval time.Duration
+	last     time.Time
+}
+
+func (r *rateLimiter) submit(ctx context.Context,
+	submitter consensusclient.BLSToExecutionChangesSubmitter,
+	results []*Result,
+) error {
+	if !r.last.IsZero() {
+		if wait := time.Until(r.last.Add(r.interval)); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	r.last = time.Now()
+
+	changes := make([]*capella.SignedBLSToExecutionChange, len(results))
+	for i := range results {
+		changes[i] = results[i].Change
+	}
+	if err := submitter.SubmitBLSToExecutionChanges(ctx, changes); err != nil {
+		return errors.Wrap(err, "failed to submit changes")
+	}
+	for _, result := range results {
+		result.Submitted = true
+	}
+
+	return nil
+}
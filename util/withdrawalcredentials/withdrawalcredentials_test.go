@@ -0,0 +1,232 @@
+// Copyright © 2023 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package withdrawalcredentials_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/util/signing"
+	"github.com/attestantio/go-eth2-client/util/withdrawalcredentials"
+	"github.com/stretchr/testify/require"
+)
+
+func blsCredentials(pubKey phase0.BLSPubKey) []byte {
+	hash := sha256.Sum256(pubKey[:])
+	hash[0] = withdrawalcredentials.BLSWithdrawalPrefix
+
+	return hash[:]
+}
+
+func TestValidateBLSCredentials(t *testing.T) {
+	pubKey := phase0.BLSPubKey{0x01, 0x02}
+	executionCredentials := blsCredentials(pubKey)
+	executionCredentials[0] = withdrawalcredentials.ETH1AddressWithdrawalPrefix
+	unknownCredentials := blsCredentials(pubKey)
+	unknownCredentials[0] = 0x05
+
+	tests := []struct {
+		name        string
+		credentials []byte
+		pubKey      phase0.BLSPubKey
+		err         string
+	}{
+		{
+			name:   "Missing",
+			pubKey: pubKey,
+			err:    "withdrawal credentials have incorrect length 0",
+		},
+		{
+			name:        "ExecutionAddress",
+			credentials: executionCredentials,
+			pubKey:      pubKey,
+			err:         "withdrawal credentials already commit to an execution address",
+		},
+		{
+			name:        "UnknownPrefix",
+			credentials: unknownCredentials,
+			pubKey:      pubKey,
+			err:         "withdrawal credentials have unknown prefix 0x05",
+		},
+		{
+			name:        "WrongKey",
+			credentials: blsCredentials(phase0.BLSPubKey{0x03}),
+			pubKey:      pubKey,
+			err:         "withdrawal credentials do not match withdrawal public key",
+		},
+		{
+			name:        "Good",
+			credentials: blsCredentials(pubKey),
+			pubKey:      pubKey,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := withdrawalcredentials.ValidateBLSCredentials(test.credentials, test.pubKey)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	ctx := context.Background()
+	forkVersion := phase0.Version{0x00, 0x00, 0x10, 0x20}
+	genesisValidatorsRoot := phase0.Root{0x01}
+	domain, err := signing.BLSToExecutionChangeDomain(forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+
+	// The test signer returns the signing root as the signature, so that it can be checked.
+	signer := withdrawalcredentials.SignerFunc(func(_ context.Context, pubKey phase0.BLSPubKey, root phase0.Root) (phase0.BLSSignature, error) {
+		if pubKey[0] == 0xff {
+			return phase0.BLSSignature{}, errors.New("unknown key")
+		}
+		var signature phase0.BLSSignature
+		copy(signature[:], root[:])
+
+		return signature, nil
+	})
+
+	requests := []*withdrawalcredentials.Request{
+		{
+			ValidatorIndex:        1,
+			WithdrawalCredentials: blsCredentials(phase0.BLSPubKey{0x01}),
+			FromBLSPubkey:         phase0.BLSPubKey{0x01},
+			ToExecutionAddress:    [20]byte{0x0a},
+		},
+		{
+			ValidatorIndex:        2,
+			WithdrawalCredentials: blsCredentials(phase0.BLSPubKey{0x01}),
+			FromBLSPubkey:         phase0.BLSPubKey{0x02},
+			ToExecutionAddress:    [20]byte{0x0a},
+		},
+		{
+			ValidatorIndex:        3,
+			WithdrawalCredentials: blsCredentials(phase0.BLSPubKey{0xff}),
+			FromBLSPubkey:         phase0.BLSPubKey{0xff},
+			ToExecutionAddress:    [20]byte{0x0a},
+		},
+		nil,
+	}
+
+	_, err = withdrawalcredentials.Build(ctx, nil, forkVersion, genesisValidatorsRoot, requests)
+	require.EqualError(t, err, "no signer supplied")
+
+	results, err := withdrawalcredentials.Build(ctx, signer, forkVersion, genesisValidatorsRoot, requests)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.NoError(t, results[0].Err)
+	require.Equal(t, phase0.ValidatorIndex(1), results[0].Change.Message.ValidatorIndex)
+	root, err := signing.ComputeSigningRoot(results[0].Change.Message, domain)
+	require.NoError(t, err)
+	require.Equal(t, root[:], results[0].Change.Signature[:32])
+
+	require.EqualError(t, results[1].Err, "withdrawal credentials do not match withdrawal public key")
+	require.Nil(t, results[1].Change)
+	require.EqualError(t, results[2].Err, "failed to sign change: unknown key")
+	require.EqualError(t, results[3].Err, "no request supplied")
+}
+
+type testSubmitter struct {
+	reject phase0.ValidatorIndex
+	calls  [][]phase0.ValidatorIndex
+}
+
+func (s *testSubmitter) SubmitBLSToExecutionChanges(_ context.Context, changes []*capella.SignedBLSToExecutionChange) error {
+	indices := make([]phase0.ValidatorIndex, len(changes))
+	for i := range changes {
+		indices[i] = changes[i].Message.ValidatorIndex
+	}
+	s.calls = append(s.calls, indices)
+	for _, index := range indices {
+		if index == s.reject {
+			return errors.New("rejected")
+		}
+	}
+
+	return nil
+}
+
+func testResult(index phase0.ValidatorIndex) *withdrawalcredentials.Result {
+	return &withdrawalcredentials.Result{
+		ValidatorIndex: index,
+		Change: &capella.SignedBLSToExecutionChange{
+			Message: &capella.BLSToExecutionChange{
+				ValidatorIndex: index,
+			},
+		},
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	ctx := context.Background()
+
+	failed := testResult(4)
+	failed.Err = errors.New("failed")
+	results := []*withdrawalcredentials.Result{
+		testResult(1),
+		testResult(2),
+		testResult(3),
+		failed,
+		testResult(5),
+	}
+
+	submitter := &testSubmitter{reject: 2}
+	require.EqualError(t, withdrawalcredentials.Submit(ctx, nil, results, 2, 0), "no submitter supplied")
+	require.EqualError(t, withdrawalcredentials.Submit(ctx, submitter, results, 0, 0), "batch size must be at least 1")
+
+	require.NoError(t, withdrawalcredentials.Submit(ctx, submitter, results, 2, time.Millisecond))
+	require.Equal(t, [][]phase0.ValidatorIndex{{1, 2}, {1}, {2}, {3, 5}}, submitter.calls)
+
+	require.True(t, results[0].Submitted)
+	require.NoError(t, results[0].Err)
+	require.False(t, results[1].Submitted)
+	require.EqualError(t, results[1].Err, "failed to submit changes: rejected")
+	require.True(t, results[2].Submitted)
+	require.False(t, results[3].Submitted)
+	require.True(t, results[4].Submitted)
+
+	// A second submission has nothing left to submit.
+	submitter.calls = nil
+	require.NoError(t, withdrawalcredentials.Submit(ctx, submitter, results, 2, 0))
+	require.Empty(t, submitter.calls)
+}
+
+func TestSubmitCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	submitter := &testSubmitter{}
+	results := []*withdrawalcredentials.Result{
+		testResult(1),
+		testResult(2),
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	require.ErrorIs(t, withdrawalcredentials.Submit(ctx, submitter, results, 1, time.Minute), context.Canceled)
+	require.True(t, results[0].Submitted)
+	require.False(t, results[1].Submitted)
+	require.NoError(t, results[1].Err)
+}